@@ -139,6 +139,30 @@ func (g GeneratedAccountInfo) MarshalJSON() ([]byte, error) {
 	return append(accountInfoJSON[:len(accountInfoJSON)-1], infoJSON...), nil
 }
 
+// Slip39AccountInfo contains IdentifiedAccountInfo and the SLIP-39 shares of an account, grouped by share group.
+type Slip39AccountInfo struct {
+	IdentifiedAccountInfo
+	Shares [][]string `json:"shares"`
+}
+
+func (s Slip39AccountInfo) MarshalJSON() ([]byte, error) {
+	accountInfoJSON, err := s.IdentifiedAccountInfo.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	type info struct {
+		Shares [][]string `json:"shares"`
+	}
+	infoJSON, err := json.Marshal(info{
+		Shares: s.Shares,
+	})
+	if err != nil {
+		return nil, err
+	}
+	infoJSON[0] = ','
+	return append(accountInfoJSON[:len(accountInfoJSON)-1], infoJSON...), nil
+}
+
 func (g GeneratedAccountInfo) toGeneratedAndDerived(derived map[string]AccountInfo) GeneratedAndDerivedAccountInfo {
 	return GeneratedAndDerivedAccountInfo{
 		GeneratedAccountInfo: g,
@@ -2,6 +2,7 @@ package generator
 
 import (
 	"crypto/ecdsa"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"strings"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/pborman/uuid"
 
+	"github.com/status-im/status-go/account/generator/slip39"
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/keystore"
 	"github.com/status-im/status-go/eth-node/types"
@@ -160,6 +162,60 @@ func (g *Generator) ImportMnemonic(mnemonicPhrase string, bip39Passphrase string
 	return acc.ToGeneratedAccountInfo(id, mnemonicPhrase), nil
 }
 
+// GenerateSlip39Shares generates a random master key of the given strength in bits and splits it
+// in SLIP-39 shares. groupThreshold of the groups are required to recover the key.
+// The master key is loaded in memory like with ImportMnemonic.
+func (g *Generator) GenerateSlip39Shares(strength int, groupThreshold int, groups []slip39.Group, passphrase string) (Slip39AccountInfo, error) {
+	if strength < 128 || strength%16 != 0 {
+		return Slip39AccountInfo{}, slip39.ErrInvalidMasterSecret
+	}
+	masterSecret := make([]byte, strength/8)
+	if _, err := rand.Read(masterSecret); err != nil {
+		return Slip39AccountInfo{}, err
+	}
+
+	shares, err := slip39.GenerateMnemonics(groupThreshold, groups, masterSecret, passphrase, true, slip39.DefaultIterationExponent)
+	if err != nil {
+		return Slip39AccountInfo{}, err
+	}
+
+	info, err := g.importSlip39MasterSecret(masterSecret)
+	if err != nil {
+		return Slip39AccountInfo{}, err
+	}
+
+	return Slip39AccountInfo{
+		IdentifiedAccountInfo: info,
+		Shares:                shares,
+	}, nil
+}
+
+// ImportSlip39Shares combines SLIP-39 shares and imports the recovered master key
+func (g *Generator) ImportSlip39Shares(shares []string, passphrase string) (IdentifiedAccountInfo, error) {
+	masterSecret, err := slip39.CombineMnemonics(shares, passphrase)
+	if err != nil {
+		return IdentifiedAccountInfo{}, err
+	}
+
+	return g.importSlip39MasterSecret(masterSecret)
+}
+
+func (g *Generator) importSlip39MasterSecret(masterSecret []byte) (IdentifiedAccountInfo, error) {
+	masterExtendedKey, err := extkeys.NewMaster(masterSecret)
+	if err != nil {
+		return IdentifiedAccountInfo{}, fmt.Errorf("can not create master extended key: %v", err)
+	}
+
+	acc := &Account{
+		privateKey:  masterExtendedKey.ToECDSA(),
+		extendedKey: masterExtendedKey,
+	}
+
+	id := g.addAccount(acc)
+
+	return acc.ToIdentifiedAccountInfo(id), nil
+}
+
 func (g *Generator) GenerateAndDeriveAddresses(mnemonicPhraseLength int, n int, bip39Passphrase string, pathStrings []string) ([]GeneratedAndDerivedAccountInfo, error) {
 	masterAccounts, err := g.Generate(mnemonicPhraseLength, n, bip39Passphrase)
 	if err != nil {
@@ -8,6 +8,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/status-im/status-go/account/generator/slip39"
+	"github.com/status-im/status-go/extkeys"
 )
 
 var testAccount = struct {
@@ -112,6 +115,43 @@ func TestGenerator_ImportMnemonic(t *testing.T) {
 	assert.Equal(t, testAccount.extendedMasterKey, key.extendedKey.String())
 }
 
+func TestGenerator_ImportSlip39Shares(t *testing.T) {
+	g := New(nil)
+
+	shares := []string{
+		"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed",
+		"shadow pistol academic acid actress prayer class unknown daughter sweater depict flip twice unkind craft early superior advocate guest smoking",
+	}
+	info, err := g.ImportSlip39Shares(shares, "TREZOR")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(g.accounts))
+
+	expected, err := extkeys.NewMaster([]byte{0xb4, 0x3c, 0xeb, 0x7e, 0x57, 0xa0, 0xea, 0x87, 0x66, 0x22, 0x16, 0x24, 0xd0, 0x1b, 0x08, 0x64})
+	assert.NoError(t, err)
+	assert.Equal(t, expected.String(), g.accounts[info.ID].extendedKey.String())
+
+	_, err = g.ImportSlip39Shares(shares[:1], "TREZOR")
+	assert.Error(t, err)
+}
+
+func TestGenerator_GenerateSlip39Shares(t *testing.T) {
+	g := New(nil)
+
+	groups := []slip39.Group{
+		{MemberThreshold: 1, MemberCount: 1},
+		{MemberThreshold: 2, MemberCount: 3},
+	}
+	generated, err := g.GenerateSlip39Shares(256, 1, groups, "passphrase")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(generated.Shares))
+	assert.Equal(t, 3, len(generated.Shares[1]))
+
+	imported, err := g.ImportSlip39Shares([]string{generated.Shares[1][2], generated.Shares[1][0]}, "passphrase")
+	assert.NoError(t, err)
+	assert.Equal(t, generated.Address, imported.Address)
+	assert.Equal(t, generated.KeyUID, imported.KeyUID)
+}
+
 func TestGenerator_ImportJSONKey(t *testing.T) {
 	g := New(nil)
 	assert.Equal(t, 0, len(g.accounts))
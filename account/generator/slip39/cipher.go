@@ -0,0 +1,55 @@
+package slip39
+
+import (
+	"crypto/sha256"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// baseIterationCount is the total number of PBKDF2 iterations of the Feistel
+	// network for an iteration exponent of 0
+	baseIterationCount = 10000
+	// roundCount is the number of rounds of the Feistel network
+	roundCount = 4
+)
+
+func roundFunction(round byte, passphrase []byte, iterationExponent int, salt []byte, r []byte) []byte {
+	password := append([]byte{round}, passphrase...)
+	iterations := (baseIterationCount << iterationExponent) / roundCount
+	return pbkdf2.Key(password, append(append([]byte{}, salt...), r...), iterations, len(r), sha256.New)
+}
+
+func cipherSalt(identifier int, extendable bool) []byte {
+	if extendable {
+		return nil
+	}
+	return append([]byte("shamir"), byte(identifier>>8), byte(identifier))
+}
+
+func xorBytes(a, b []byte) []byte {
+	result := make([]byte, len(a))
+	for i := range a {
+		result[i] = a[i] ^ b[i]
+	}
+	return result
+}
+
+func feistel(input []byte, passphrase []byte, iterationExponent int, identifier int, extendable bool, rounds []byte) []byte {
+	half := len(input) / 2
+	l, r := input[:half], input[half:]
+	salt := cipherSalt(identifier, extendable)
+	for _, round := range rounds {
+		l, r = r, xorBytes(l, roundFunction(round, passphrase, iterationExponent, salt, r))
+	}
+	return append(append([]byte{}, r...), l...)
+}
+
+// encrypt turns the master secret into the encrypted master secret which is then split in shares
+func encrypt(masterSecret []byte, passphrase []byte, iterationExponent int, identifier int, extendable bool) []byte {
+	return feistel(masterSecret, passphrase, iterationExponent, identifier, extendable, []byte{0, 1, 2, 3})
+}
+
+func decrypt(encryptedMasterSecret []byte, passphrase []byte, iterationExponent int, identifier int, extendable bool) []byte {
+	return feistel(encryptedMasterSecret, passphrase, iterationExponent, identifier, extendable, []byte{3, 2, 1, 0})
+}
@@ -0,0 +1,53 @@
+package slip39
+
+// Arithmetic in GF(256) with the Rijndael polynomial x^8 + x^4 + x^3 + x + 1
+var (
+	gfExp [255]byte
+	gfLog [256]byte
+)
+
+func init() {
+	poly := 1
+	for i := 0; i < 255; i++ {
+		gfExp[i] = byte(poly)
+		gfLog[poly] = byte(i)
+		// Multiply by the generator x + 1
+		poly = (poly << 1) ^ poly
+		if poly&0x100 != 0 {
+			poly ^= 0x11B
+		}
+	}
+}
+
+// interpolate evaluates at x the polynomial passing through the given points
+// using Lagrange interpolation. All points x coordinates must be distinct.
+func interpolate(xs []byte, ys [][]byte, x byte) []byte {
+	for i, xi := range xs {
+		if xi == x {
+			return append([]byte{}, ys[i]...)
+		}
+	}
+
+	logProd := 0
+	for _, xi := range xs {
+		logProd += int(gfLog[xi^x])
+	}
+
+	result := make([]byte, len(ys[0]))
+	for i, xi := range xs {
+		logBasis := logProd - int(gfLog[xi^x])
+		for j, xj := range xs {
+			if j != i {
+				logBasis -= int(gfLog[xi^xj])
+			}
+		}
+		logBasis = ((logBasis % 255) + 255) % 255
+
+		for k, y := range ys[i] {
+			if y != 0 {
+				result[k] ^= gfExp[(int(gfLog[y])+logBasis)%255]
+			}
+		}
+	}
+	return result
+}
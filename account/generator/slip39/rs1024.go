@@ -0,0 +1,51 @@
+package slip39
+
+var rs1024Generator = [10]uint32{
+	0xE0E040, 0x1C1C080, 0x3838100, 0x7070200, 0xE0E0009,
+	0x1C0C2412, 0x38086C24, 0x3090FC48, 0x21B1F890, 0x3F3F120,
+}
+
+func rs1024Polymod(values []int) uint32 {
+	chk := uint32(1)
+	for _, v := range values {
+		b := chk >> 20
+		chk = (chk&0xFFFFF)<<10 ^ uint32(v)
+		for i := 0; i < 10; i++ {
+			if (b>>i)&1 == 1 {
+				chk ^= rs1024Generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+func customizationString(extendable bool) []byte {
+	if extendable {
+		return []byte("shamir_extendable")
+	}
+	return []byte("shamir")
+}
+
+func toWordValues(data []byte) []int {
+	values := make([]int, len(data))
+	for i, b := range data {
+		values[i] = int(b)
+	}
+	return values
+}
+
+// rs1024CreateChecksum returns the 3 checksum words appended to a share
+func rs1024CreateChecksum(data []int, extendable bool) []int {
+	values := append(toWordValues(customizationString(extendable)), data...)
+	values = append(values, 0, 0, 0)
+	polymod := rs1024Polymod(values) ^ 1
+	checksum := make([]int, checksumLengthWords)
+	for i := 0; i < checksumLengthWords; i++ {
+		checksum[i] = int(polymod>>(10*(checksumLengthWords-1-i))) & 1023
+	}
+	return checksum
+}
+
+func rs1024VerifyChecksum(data []int, extendable bool) bool {
+	return rs1024Polymod(append(toWordValues(customizationString(extendable)), data...)) == 1
+}
@@ -0,0 +1,172 @@
+package slip39
+
+import (
+	"math/big"
+	"strings"
+)
+
+const (
+	radixBits = 10
+	radixSize = 1 << radixBits
+
+	idLengthBits        = 15
+	extendableFlagBits  = 1
+	iterationExpBits    = 4
+	idExpLengthWords    = 2
+	groupParamsWords    = 2
+	checksumLengthWords = 3
+	metadataLengthWords = idExpLengthWords + groupParamsWords + checksumLengthWords
+
+	minStrengthBits             = 128
+	minMnemonicLengthWords      = metadataLengthWords + (minStrengthBits+radixBits-1)/radixBits
+	maxShareCount               = 16
+	digestLengthBytes           = 4
+	digestIndex            byte = 254
+	secretIndex            byte = 255
+)
+
+var wordIndex map[string]int
+
+func init() {
+	wordIndex = make(map[string]int, radixSize)
+	for i, word := range wordlist {
+		wordIndex[word] = i
+	}
+}
+
+// share is a single decoded SLIP-0039 mnemonic
+type share struct {
+	identifier        int
+	extendable        bool
+	iterationExponent int
+	groupIndex        int
+	groupThreshold    int
+	groupCount        int
+	memberIndex       int
+	memberThreshold   int
+	value             []byte
+}
+
+// commonParams are the parameters that must match for all the shares of a secret
+type commonParams struct {
+	identifier        int
+	extendable        bool
+	iterationExponent int
+	groupThreshold    int
+	groupCount        int
+}
+
+func (s *share) commonParams() commonParams {
+	return commonParams{
+		identifier:        s.identifier,
+		extendable:        s.extendable,
+		iterationExponent: s.iterationExponent,
+		groupThreshold:    s.groupThreshold,
+		groupCount:        s.groupCount,
+	}
+}
+
+// intToWords splits the value in count words of radixBits, most significant first
+func intToWords(value *big.Int, count int) []int {
+	words := make([]int, count)
+	mask := big.NewInt(radixSize - 1)
+	v := new(big.Int).Set(value)
+	for i := count - 1; i >= 0; i-- {
+		words[i] = int(new(big.Int).And(v, mask).Int64())
+		v.Rsh(v, radixBits)
+	}
+	return words
+}
+
+func intFromWords(words []int) *big.Int {
+	value := new(big.Int)
+	for _, w := range words {
+		value.Lsh(value, radixBits)
+		value.Or(value, big.NewInt(int64(w)))
+	}
+	return value
+}
+
+func (s *share) words() []int {
+	valueWordCount := (len(s.value)*8 + radixBits - 1) / radixBits
+
+	idExp := s.identifier<<(extendableFlagBits+iterationExpBits) | s.iterationExponent
+	if s.extendable {
+		idExp |= 1 << iterationExpBits
+	}
+	groupParams := s.groupIndex<<16 | (s.groupThreshold-1)<<12 | (s.groupCount-1)<<8 | s.memberIndex<<4 | (s.memberThreshold - 1)
+
+	data := intToWords(big.NewInt(int64(idExp)), idExpLengthWords)
+	data = append(data, intToWords(big.NewInt(int64(groupParams)), groupParamsWords)...)
+	data = append(data, intToWords(new(big.Int).SetBytes(s.value), valueWordCount)...)
+	return append(data, rs1024CreateChecksum(data, s.extendable)...)
+}
+
+func (s *share) mnemonic() string {
+	words := s.words()
+	result := make([]string, len(words))
+	for i, w := range words {
+		result[i] = wordlist[w]
+	}
+	return strings.Join(result, " ")
+}
+
+func mnemonicToIndices(mnemonic string) ([]int, error) {
+	words := strings.Fields(strings.ToLower(mnemonic))
+	indices := make([]int, len(words))
+	for i, word := range words {
+		index, ok := wordIndex[word]
+		if !ok {
+			return nil, ErrInvalidWord
+		}
+		indices[i] = index
+	}
+	return indices, nil
+}
+
+// parseShare decodes a mnemonic and verifies its checksum
+func parseShare(mnemonic string) (*share, error) {
+	data, err := mnemonicToIndices(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < minMnemonicLengthWords {
+		return nil, ErrInvalidMnemonicLength
+	}
+
+	paddingLen := (radixBits * (len(data) - metadataLengthWords)) % 16
+	if paddingLen > 8 {
+		return nil, ErrInvalidMnemonicLength
+	}
+
+	idExp := int(intFromWords(data[:idExpLengthWords]).Int64())
+	s := &share{
+		identifier:        idExp >> (extendableFlagBits + iterationExpBits),
+		extendable:        (idExp>>iterationExpBits)&1 == 1,
+		iterationExponent: idExp & (1<<iterationExpBits - 1),
+	}
+
+	if !rs1024VerifyChecksum(data, s.extendable) {
+		return nil, ErrInvalidChecksum
+	}
+
+	groupParams := int(intFromWords(data[idExpLengthWords : idExpLengthWords+groupParamsWords]).Int64())
+	s.groupIndex = groupParams >> 16
+	s.groupThreshold = (groupParams>>12)&0xF + 1
+	s.groupCount = (groupParams>>8)&0xF + 1
+	s.memberIndex = (groupParams >> 4) & 0xF
+	s.memberThreshold = groupParams&0xF + 1
+	if s.groupCount < s.groupThreshold {
+		return nil, ErrInvalidGroupThreshold
+	}
+
+	valueData := data[idExpLengthWords+groupParamsWords : len(data)-checksumLengthWords]
+	valueByteCount := (radixBits*len(valueData) - paddingLen) / 8
+	value := intFromWords(valueData)
+	if value.BitLen() > valueByteCount*8 {
+		return nil, ErrInvalidPadding
+	}
+	s.value = value.FillBytes(make([]byte, valueByteCount))
+
+	return s, nil
+}
@@ -0,0 +1,288 @@
+// Package slip39 implements SLIP-0039 Shamir's Secret-Sharing for mnemonic codes.
+// The master secret is encrypted with an optional passphrase and split in groups
+// of mnemonic shares, a threshold of groups, each with a threshold of member shares,
+// is needed to recover it.
+// https://github.com/satoshilabs/slips/blob/master/slip-0039.md
+package slip39
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+)
+
+// DefaultIterationExponent is the iteration exponent used by the reference implementation
+const DefaultIterationExponent = 1
+
+var (
+	ErrInvalidWord                   = errors.New("invalid word in mnemonic share")
+	ErrInvalidMnemonicLength         = errors.New("invalid mnemonic share length")
+	ErrInvalidChecksum               = errors.New("invalid mnemonic share checksum")
+	ErrInvalidPadding                = errors.New("invalid mnemonic share padding")
+	ErrInvalidGroupThreshold         = errors.New("invalid group threshold")
+	ErrInvalidMemberThreshold        = errors.New("invalid member threshold")
+	ErrInvalidMasterSecret           = errors.New("master secret must be at least 128 bits long and have an even number of bytes")
+	ErrInvalidPassphrase             = errors.New("passphrase must contain only printable ASCII characters")
+	ErrInvalidIterationExponent      = errors.New("invalid iteration exponent")
+	ErrEmptyShares                   = errors.New("no mnemonic shares provided")
+	ErrMismatchingShares             = errors.New("mnemonic shares do not belong to the same secret")
+	ErrInsufficientShares            = errors.New("insufficient number of mnemonic shares")
+	ErrInvalidDigest                 = errors.New("invalid digest of the shared secret")
+	ErrSingleMemberThresholdSplitted = errors.New("creating multiple member shares with member threshold 1 is not allowed, use 1-of-1 member sharing instead")
+)
+
+// Group is the member sharing scheme of a group
+type Group struct {
+	MemberThreshold int `json:"memberThreshold"`
+	MemberCount     int `json:"memberCount"`
+}
+
+type rawShare struct {
+	x    byte
+	data []byte
+}
+
+func randomBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	return b, err
+}
+
+func createDigest(randomData, sharedSecret []byte) []byte {
+	mac := hmac.New(sha256.New, randomData)
+	mac.Write(sharedSecret)
+	return mac.Sum(nil)[:digestLengthBytes]
+}
+
+func interpolateShares(shares []rawShare, x byte) []byte {
+	xs := make([]byte, len(shares))
+	ys := make([][]byte, len(shares))
+	for i, s := range shares {
+		xs[i] = s.x
+		ys[i] = s.data
+	}
+	return interpolate(xs, ys, x)
+}
+
+func splitSecret(threshold, count int, secret []byte) ([]rawShare, error) {
+	if threshold < 1 || threshold > count {
+		return nil, ErrInvalidMemberThreshold
+	}
+	if count > maxShareCount {
+		return nil, ErrInvalidMemberThreshold
+	}
+
+	shares := make([]rawShare, 0, count)
+	if threshold == 1 {
+		for i := 0; i < count; i++ {
+			shares = append(shares, rawShare{x: byte(i), data: secret})
+		}
+		return shares, nil
+	}
+
+	randomShareCount := threshold - 2
+	for i := 0; i < randomShareCount; i++ {
+		data, err := randomBytes(len(secret))
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, rawShare{x: byte(i), data: data})
+	}
+
+	randomPart, err := randomBytes(len(secret) - digestLengthBytes)
+	if err != nil {
+		return nil, err
+	}
+	digest := createDigest(randomPart, secret)
+
+	baseShares := append([]rawShare{}, shares...)
+	baseShares = append(baseShares,
+		rawShare{x: digestIndex, data: append(digest, randomPart...)},
+		rawShare{x: secretIndex, data: secret},
+	)
+	for i := randomShareCount; i < count; i++ {
+		shares = append(shares, rawShare{x: byte(i), data: interpolateShares(baseShares, byte(i))})
+	}
+	return shares, nil
+}
+
+func recoverSecret(threshold int, shares []rawShare) ([]byte, error) {
+	if threshold == 1 {
+		return shares[0].data, nil
+	}
+
+	sharedSecret := interpolateShares(shares, secretIndex)
+	digestShare := interpolateShares(shares, digestIndex)
+	digest, randomPart := digestShare[:digestLengthBytes], digestShare[digestLengthBytes:]
+	if !hmac.Equal(digest, createDigest(randomPart, sharedSecret)) {
+		return nil, ErrInvalidDigest
+	}
+	return sharedSecret, nil
+}
+
+func validatePassphrase(passphrase string) error {
+	for _, c := range []byte(passphrase) {
+		if c < 32 || c > 126 {
+			return ErrInvalidPassphrase
+		}
+	}
+	return nil
+}
+
+// GenerateMnemonics splits the master secret in mnemonic shares.
+// groupThreshold groups out of the given ones are required to recover the secret,
+// the result contains for each group the mnemonics of its members.
+func GenerateMnemonics(groupThreshold int, groups []Group, masterSecret []byte, passphrase string, extendable bool, iterationExponent int) ([][]string, error) {
+	if len(masterSecret)*8 < minStrengthBits || len(masterSecret)%2 != 0 {
+		return nil, ErrInvalidMasterSecret
+	}
+	if err := validatePassphrase(passphrase); err != nil {
+		return nil, err
+	}
+	if iterationExponent < 0 || iterationExponent >= 1<<iterationExpBits {
+		return nil, ErrInvalidIterationExponent
+	}
+	if groupThreshold < 1 || groupThreshold > len(groups) {
+		return nil, ErrInvalidGroupThreshold
+	}
+	for _, g := range groups {
+		if g.MemberThreshold == 1 && g.MemberCount > 1 {
+			return nil, ErrSingleMemberThresholdSplitted
+		}
+	}
+
+	id, err := rand.Int(rand.Reader, big.NewInt(1<<idLengthBits))
+	if err != nil {
+		return nil, err
+	}
+	identifier := int(id.Int64())
+
+	encryptedMasterSecret := encrypt(masterSecret, []byte(passphrase), iterationExponent, identifier, extendable)
+	groupShares, err := splitSecret(groupThreshold, len(groups), encryptedMasterSecret)
+	if err != nil {
+		return nil, ErrInvalidGroupThreshold
+	}
+
+	result := make([][]string, len(groups))
+	for i, g := range groups {
+		memberShares, err := splitSecret(g.MemberThreshold, g.MemberCount, groupShares[i].data)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range memberShares {
+			s := &share{
+				identifier:        identifier,
+				extendable:        extendable,
+				iterationExponent: iterationExponent,
+				groupIndex:        int(groupShares[i].x),
+				groupThreshold:    groupThreshold,
+				groupCount:        len(groups),
+				memberIndex:       int(m.x),
+				memberThreshold:   g.MemberThreshold,
+				value:             m.data,
+			}
+			result[i] = append(result[i], s.mnemonic())
+		}
+	}
+	return result, nil
+}
+
+// GenerateMnemonicsWithRandomSecret is like GenerateMnemonics with a random master secret of the given strength in bits
+func GenerateMnemonicsWithRandomSecret(strength int, groupThreshold int, groups []Group, passphrase string, extendable bool, iterationExponent int) ([][]string, error) {
+	if strength < minStrengthBits || strength%16 != 0 {
+		return nil, ErrInvalidMasterSecret
+	}
+	masterSecret, err := randomBytes(strength / 8)
+	if err != nil {
+		return nil, err
+	}
+	return GenerateMnemonics(groupThreshold, groups, masterSecret, passphrase, extendable, iterationExponent)
+}
+
+// CombineMnemonics recovers the master secret from a set of mnemonic shares
+func CombineMnemonics(mnemonics []string, passphrase string) ([]byte, error) {
+	if len(mnemonics) == 0 {
+		return nil, ErrEmptyShares
+	}
+	if err := validatePassphrase(passphrase); err != nil {
+		return nil, err
+	}
+
+	var params commonParams
+	groups := make(map[int][]*share)
+	var groupIndexes []int
+	for i, mnemonic := range mnemonics {
+		s, err := parseShare(mnemonic)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			params = s.commonParams()
+		} else if s.commonParams() != params {
+			return nil, ErrMismatchingShares
+		}
+
+		members, ok := groups[s.groupIndex]
+		if !ok {
+			groupIndexes = append(groupIndexes, s.groupIndex)
+		}
+		for _, m := range members {
+			if m.memberThreshold != s.memberThreshold {
+				return nil, ErrMismatchingShares
+			}
+			if m.memberIndex == s.memberIndex {
+				if !bytes.Equal(m.value, s.value) {
+					return nil, ErrMismatchingShares
+				}
+				// Same share provided twice
+				s = nil
+				break
+			}
+		}
+		if s != nil {
+			groups[s.groupIndex] = append(members, s)
+		}
+	}
+
+	if len(groups) < params.groupThreshold {
+		return nil, ErrInsufficientShares
+	}
+
+	groupShares := make([]rawShare, 0, params.groupThreshold)
+	for _, groupIndex := range groupIndexes {
+		members := groups[groupIndex]
+		if len(members) < members[0].memberThreshold {
+			continue
+		}
+		memberShares := make([]rawShare, 0, members[0].memberThreshold)
+		for _, m := range members[:members[0].memberThreshold] {
+			memberShares = append(memberShares, rawShare{x: byte(m.memberIndex), data: m.value})
+		}
+		secret, err := recoverSecret(members[0].memberThreshold, memberShares)
+		if err != nil {
+			return nil, err
+		}
+		groupShares = append(groupShares, rawShare{x: byte(groupIndex), data: secret})
+		if len(groupShares) == params.groupThreshold {
+			break
+		}
+	}
+	if len(groupShares) < params.groupThreshold {
+		return nil, ErrInsufficientShares
+	}
+
+	encryptedMasterSecret, err := recoverSecret(params.groupThreshold, groupShares)
+	if err != nil {
+		return nil, err
+	}
+	return decrypt(encryptedMasterSecret, []byte(passphrase), params.iterationExponent, params.identifier, params.extendable), nil
+}
+
+// ValidateMnemonic checks that the mnemonic share is well formed and has a valid checksum
+func ValidateMnemonic(mnemonic string) error {
+	_, err := parseShare(mnemonic)
+	return err
+}
@@ -0,0 +1,151 @@
+package slip39
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testPassphrase = "TREZOR"
+
+// testVector is an entry of the trezor test vectors, copied to testdata/vectors.json from
+// https://github.com/trezor/python-shamir-mnemonic/blob/master/vectors.json
+// Each entry is a description, the mnemonics, the master secret and the BIP32 master key,
+// the master secret is empty when combining the mnemonics must fail.
+type testVector struct {
+	description  string
+	mnemonics    []string
+	masterSecret string
+}
+
+func (v *testVector) UnmarshalJSON(data []byte) error {
+	var fields []json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if len(fields) < 3 {
+		return errors.New("invalid test vector")
+	}
+	if err := json.Unmarshal(fields[0], &v.description); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(fields[1], &v.mnemonics); err != nil {
+		return err
+	}
+	return json.Unmarshal(fields[2], &v.masterSecret)
+}
+
+func loadTestVectors(t *testing.T) []testVector {
+	data, err := os.ReadFile(filepath.Join("testdata", "vectors.json"))
+	require.NoError(t, err)
+
+	var vectors []testVector
+	require.NoError(t, json.Unmarshal(data, &vectors))
+	require.NotEmpty(t, vectors)
+	return vectors
+}
+
+func TestCombineMnemonicsVectors(t *testing.T) {
+	for _, v := range loadTestVectors(t) {
+		secret, err := CombineMnemonics(v.mnemonics, testPassphrase)
+		if v.masterSecret == "" {
+			require.Error(t, err, v.description)
+			continue
+		}
+
+		require.NoError(t, err, v.description)
+		require.Equal(t, v.masterSecret, hex.EncodeToString(secret), v.description)
+
+		for _, m := range v.mnemonics {
+			require.NoError(t, ValidateMnemonic(m), v.description)
+		}
+	}
+}
+
+func TestInvalidMnemonics(t *testing.T) {
+	vectors := loadTestVectors(t)
+	// 1. Valid mnemonic without sharing (128 bits)
+	withoutSharing := vectors[0].mnemonics
+	// 4. Basic sharing 2-of-3 (128 bits)
+	basicSharing := vectors[3].mnemonics
+
+	valid := withoutSharing[0]
+	words := strings.Fields(valid)
+
+	// Last word changed
+	invalidChecksum := strings.Join(append(append([]string{}, words[:len(words)-1]...), "academic"), " ")
+	require.ErrorIs(t, ValidateMnemonic(invalidChecksum), ErrInvalidChecksum)
+
+	require.ErrorIs(t, ValidateMnemonic(strings.Join(words[:len(words)-2], " ")), ErrInvalidMnemonicLength)
+	require.ErrorIs(t, ValidateMnemonic(strings.Replace(valid, "duckling", "duckie", 1)), ErrInvalidWord)
+
+	_, err := CombineMnemonics(basicSharing[:1], testPassphrase)
+	require.ErrorIs(t, err, ErrInsufficientShares)
+
+	_, err = CombineMnemonics([]string{withoutSharing[0], basicSharing[0]}, testPassphrase)
+	require.ErrorIs(t, err, ErrMismatchingShares)
+}
+
+func TestGenerateAndCombineMnemonics(t *testing.T) {
+	masterSecret := []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZ123456")
+	groups := []Group{
+		{MemberThreshold: 1, MemberCount: 1},
+		{MemberThreshold: 2, MemberCount: 3},
+		{MemberThreshold: 3, MemberCount: 5},
+	}
+
+	for _, extendable := range []bool{false, true} {
+		mnemonics, err := GenerateMnemonics(2, groups, masterSecret, testPassphrase, extendable, 0)
+		require.NoError(t, err)
+		require.Len(t, mnemonics, len(groups))
+		for i, g := range groups {
+			require.Len(t, mnemonics[i], g.MemberCount)
+		}
+
+		combinations := [][]string{
+			{mnemonics[0][0], mnemonics[1][0], mnemonics[1][2]},
+			{mnemonics[2][4], mnemonics[2][0], mnemonics[1][1], mnemonics[2][2], mnemonics[1][2]},
+			{mnemonics[2][1], mnemonics[2][2], mnemonics[2][3], mnemonics[0][0]},
+		}
+		for _, c := range combinations {
+			secret, err := CombineMnemonics(c, testPassphrase)
+			require.NoError(t, err)
+			require.True(t, bytes.Equal(masterSecret, secret))
+		}
+
+		// Only one complete group
+		_, err = CombineMnemonics([]string{mnemonics[1][0], mnemonics[1][1], mnemonics[2][0]}, testPassphrase)
+		require.ErrorIs(t, err, ErrInsufficientShares)
+
+		// A wrong passphrase decrypts to a different secret
+		secret, err := CombineMnemonics(combinations[0], "")
+		require.NoError(t, err)
+		require.False(t, bytes.Equal(masterSecret, secret))
+	}
+}
+
+func TestGenerateMnemonicsInvalidParams(t *testing.T) {
+	masterSecret := []byte("ABCDEFGHIJKLMNOP")
+
+	_, err := GenerateMnemonics(1, []Group{{1, 1}}, masterSecret[:14], "", false, 0)
+	require.ErrorIs(t, err, ErrInvalidMasterSecret)
+
+	_, err = GenerateMnemonics(2, []Group{{1, 1}}, masterSecret, "", false, 0)
+	require.ErrorIs(t, err, ErrInvalidGroupThreshold)
+
+	_, err = GenerateMnemonics(1, []Group{{1, 2}}, masterSecret, "", false, 0)
+	require.ErrorIs(t, err, ErrSingleMemberThresholdSplitted)
+
+	_, err = GenerateMnemonics(1, []Group{{3, 2}}, masterSecret, "", false, 0)
+	require.ErrorIs(t, err, ErrInvalidMemberThreshold)
+
+	_, err = GenerateMnemonics(1, []Group{{1, 1}}, masterSecret, "pässword", false, 0)
+	require.ErrorIs(t, err, ErrInvalidPassphrase)
+}
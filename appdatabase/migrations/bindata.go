@@ -107,6 +107,8 @@
 // 1706097653_migration_order_fix.up.sql (9.484kB)
 // 1706955596_community_storenodes.up.sql (515B)
 // 1708416025_make_sepolia_default.up.sql (81B)
+// 1709200000_ipfs_config.up.sql (354B)
+// 1709700000_add_community_directory_enabled_to_settings.up.sql (92B)
 // doc.go (74B)
 
 package migrations
@@ -2316,6 +2318,46 @@ func _1708416025_make_sepolia_defaultUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1709200000_ipfs_configUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xce\xc1\x4a\x03\x31\x14\x85\xe1\x7d\x9e\xe2\xec\xda\x42\x17\xee\x5d\xa5\x6d\xa4\xc1\x98\x48\xc8\x58\xba\x0a\x21\xcd\xb4\x17\x74\xa6\x98\x80\x8e\x4f\x2f\x81\x71\x74\x10\xc1\xe5\x85\xff\x72\xbe\xad\x15\xdc\x09\x38\xbe\x51\x02\x74\x6d\xb3\x8f\x7d\xd7\xd2\x19\x4b\x06\xbc\x86\x98\xfc\x39\x94\xf4\x16\x86\x8c\x8d\x31\x4a\x70\x8d\x9d\xb8\xe3\x8d\x72\x68\xc3\x73\x4e\x6b\x06\xbc\x84\x77\x1f\x43\xbc\x24\x9f\xe9\x23\x41\x6a\x07\x6d\x1c\x74\xa3\xd4\x14\xdf\xd4\x30\x0f\x5d\xb9\xa4\x42\xd1\xd3\x09\x4f\xdc\x6e\xf7\xdc\x4e\xc5\x82\x4e\x0b\x3c\x5a\xf9\xc0\xed\x11\xf7\xe2\xc8\x56\x38\x48\xb7\x37\x8d\x83\x35\x07\xb9\xbb\x65\xec\x2f\xec\xb7\xb1\xaa\xc7\x63\x1a\xf8\xb2\x54\xc1\xb5\xcf\x54\xa8\xef\x66\xc8\x7f\xd1\x6a\xf4\x43\x87\xe5\x38\xb3\x9e\xbd\xae\x7e\xab\x3f\x03\x00\x00\xff\xff\xdf\x45\xa2\xa9\x62\x01\x00\x00")
+
+func _1709200000_ipfs_configUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1709200000_ipfs_configUpSql,
+		"1709200000_ipfs_config.up.sql",
+	)
+}
+
+func _1709200000_ipfs_configUpSql() (*asset, error) {
+	bytes, err := _1709200000_ipfs_configUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1709200000_ipfs_config.up.sql", size: 354, mode: os.FileMode(0644), modTime: time.Unix(1792402009, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd7, 0xe3, 0x67, 0x71, 0xdb, 0x63, 0x8f, 0x35, 0xd3, 0x16, 0xc5, 0x3d, 0x51, 0x18, 0x99, 0x14, 0x4c, 0x50, 0xfd, 0x23, 0xf2, 0xdd, 0xd3, 0x46, 0x41, 0x3b, 0x91, 0x3, 0x43, 0x0, 0x5c, 0xfd}}
+	return a, nil
+}
+
+var __1709700000_add_community_directory_enabled_to_settingsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x04\xc0\x41\x0e\x82\x30\x10\x05\xd0\xbd\xa7\xf8\xf7\x70\x35\xd8\x61\xf5\x6d\x13\x9d\xae\x89\xc2\xc4\x34\x91\x92\xc0\xb8\xe0\xf6\x3e\xa1\xe9\x03\x26\x03\x15\x87\x47\xb4\xfe\x39\x20\x29\xe1\x56\x58\xef\x19\xf3\xb6\xae\xbf\xde\xe2\x9c\x96\xb6\xfb\x1c\xdb\x7e\x4e\xde\x5f\xef\xaf\x2f\x18\x4a\xa1\x4a\x46\x2e\x86\x5c\x49\x24\x1d\xa5\xd2\x30\x0a\x9f\x7a\xbd\xfc\x03\x00\x00\xff\xff\x95\x0a\xa3\x88\x5c\x00\x00\x00")
+
+func _1709700000_add_community_directory_enabled_to_settingsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1709700000_add_community_directory_enabled_to_settingsUpSql,
+		"1709700000_add_community_directory_enabled_to_settings.up.sql",
+	)
+}
+
+func _1709700000_add_community_directory_enabled_to_settingsUpSql() (*asset, error) {
+	bytes, err := _1709700000_add_community_directory_enabled_to_settingsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1709700000_add_community_directory_enabled_to_settings.up.sql", size: 92, mode: os.FileMode(0644), modTime: time.Unix(1792422032, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x75, 0xb7, 0x7d, 0xce, 0x58, 0x28, 0xb5, 0x4c, 0xb4, 0xaf, 0x3c, 0xb3, 0x94, 0xf4, 0xf1, 0xd6, 0xf5, 0x1b, 0x79, 0x62, 0x33, 0x78, 0xf, 0xb0, 0x41, 0x79, 0xc4, 0x69, 0x9e, 0x2a, 0x0, 0x82}}
+	return a, nil
+}
+
 var _docGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xc9\xb1\x0d\xc4\x20\x0c\x05\xd0\x9e\x29\xfe\x02\xd8\xfd\x6d\xe3\x4b\xac\x2f\x44\x82\x09\x78\x7f\xa5\x49\xfd\xa6\x1d\xdd\xe8\xd8\xcf\x55\x8a\x2a\xe3\x47\x1f\xbe\x2c\x1d\x8c\xfa\x6f\xe3\xb4\x34\xd4\xd9\x89\xbb\x71\x59\xb6\x18\x1b\x35\x20\xa2\x9f\x0a\x03\xa2\xe5\x0d\x00\x00\xff\xff\x60\xcd\x06\xbe\x4a\x00\x00\x00")
 
 func docGoBytes() ([]byte, error) {
@@ -2639,7 +2681,9 @@ var _bindata = map[string]func() (*asset, error){
 
 	"1706955596_community_storenodes.up.sql": _1706955596_community_storenodesUpSql,
 
-	"1708416025_make_sepolia_default.up.sql": _1708416025_make_sepolia_defaultUpSql,
+	"1708416025_make_sepolia_default.up.sql":                        _1708416025_make_sepolia_defaultUpSql,
+	"1709200000_ipfs_config.up.sql":                                 _1709200000_ipfs_configUpSql,
+	"1709700000_add_community_directory_enabled_to_settings.up.sql": _1709700000_add_community_directory_enabled_to_settingsUpSql,
 
 	"doc.go": docGo,
 }
@@ -2648,11 +2692,13 @@ var _bindata = map[string]func() (*asset, error){
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
 // following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
+//
+//	data/
+//	  foo.txt
+//	  img/
+//	    a.png
+//	    b.png
+//
 // then AssetDir("data") would return []string{"foo.txt", "img"},
 // AssetDir("data/img") would return []string{"a.png", "b.png"},
 // AssetDir("foo.txt") and AssetDir("notexist") would return an error, and
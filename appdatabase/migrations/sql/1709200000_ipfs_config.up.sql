@@ -0,0 +1,12 @@
+CREATE TABLE ipfs_config (
+  race_gateways BOOLEAN DEFAULT false,
+  max_cache_size INT NOT NULL DEFAULT 0,
+  synthetic_id VARCHAR DEFAULT 'id' PRIMARY KEY
+) WITHOUT ROWID;
+
+CREATE TABLE ipfs_config_gateways (
+  gateway VARCHAR NOT NULL,
+  position INT NOT NULL,
+  synthetic_id VARCHAR DEFAULT 'id',
+  PRIMARY KEY (gateway, synthetic_id)
+) WITHOUT ROWID;
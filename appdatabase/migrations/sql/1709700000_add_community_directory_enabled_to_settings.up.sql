@@ -0,0 +1 @@
+ALTER TABLE settings ADD COLUMN community_directory_enabled BOOLEAN NOT NULL DEFAULT FALSE;
@@ -148,23 +148,28 @@ func randomNodeConfig() *params.NodeConfig {
 		Web3ProviderConfig:        params.Web3ProviderConfig{Enabled: randomBool()},
 		SwarmConfig:               params.SwarmConfig{Enabled: randomBool()},
 		MailServerRegistryAddress: randomString(),
-		HTTPEnabled:               randomBool(),
-		HTTPHost:                  randomString(),
-		HTTPPort:                  randomInt(math.MaxInt64),
-		HTTPVirtualHosts:          randomStringSlice(),
-		HTTPCors:                  randomStringSlice(),
-		IPCEnabled:                randomBool(),
-		IPCFile:                   randomString(),
-		LogEnabled:                randomBool(),
-		LogMobileSystem:           randomBool(),
-		LogDir:                    randomString(),
-		LogFile:                   randomString(),
-		LogLevel:                  randomString(),
-		LogMaxBackups:             randomInt(math.MaxInt64),
-		LogMaxSize:                randomInt(math.MaxInt64),
-		LogCompressRotated:        randomBool(),
-		LogToStderr:               randomBool(),
-		UpstreamConfig:            params.UpstreamRPCConfig{Enabled: randomBool(), URL: randomString()},
+		IpfsConfig: params.IpfsConfig{
+			Gateways:     randomStringSlice(),
+			RaceGateways: randomBool(),
+			MaxCacheSize: int64(randomInt(math.MaxInt64)),
+		},
+		HTTPEnabled:        randomBool(),
+		HTTPHost:           randomString(),
+		HTTPPort:           randomInt(math.MaxInt64),
+		HTTPVirtualHosts:   randomStringSlice(),
+		HTTPCors:           randomStringSlice(),
+		IPCEnabled:         randomBool(),
+		IPCFile:            randomString(),
+		LogEnabled:         randomBool(),
+		LogMobileSystem:    randomBool(),
+		LogDir:             randomString(),
+		LogFile:            randomString(),
+		LogLevel:           randomString(),
+		LogMaxBackups:      randomInt(math.MaxInt64),
+		LogMaxSize:         randomInt(math.MaxInt64),
+		LogCompressRotated: randomBool(),
+		LogToStderr:        randomBool(),
+		UpstreamConfig:     params.UpstreamRPCConfig{Enabled: randomBool(), URL: randomString()},
 		ClusterConfig: params.ClusterConfig{
 			Enabled:     randomBool(),
 			Fleet:       randomString(),
@@ -0,0 +1,153 @@
+package admin
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/node"
+	"github.com/status-im/status-go/protocol"
+	"github.com/status-im/status-go/wakuv2"
+)
+
+const (
+	// TokenEnvVar overrides the token of the admin API
+	TokenEnvVar = "STATUSD_ADMIN_TOKEN"
+
+	tokenFileName = "admin-token"
+	tokenLength   = 32
+)
+
+// LoadOrCreateToken returns the token set in TokenEnvVar, or else the one stored in the data dir,
+// which is generated the first time
+func LoadOrCreateToken(dataDir string) (string, error) {
+	if token := os.Getenv(TokenEnvVar); token != "" {
+		return token, nil
+	}
+
+	path := filepath.Join(dataDir, tokenFileName)
+	stored, err := os.ReadFile(path)
+	if err == nil {
+		if token := strings.TrimSpace(string(stored)); token != "" {
+			return token, nil
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+
+	b := make([]byte, tokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(b)
+
+	if err := os.MkdirAll(dataDir, 0700); err != nil {
+		return "", err
+	}
+	return token, os.WriteFile(path, []byte(token), 0600)
+}
+
+// NodeBackend controls a running statusd node
+type NodeBackend struct {
+	statusNode  *node.StatusNode
+	messenger   *protocol.Messenger
+	setLogLevel func(level string) error
+	shutdown    func()
+}
+
+// NewNodeBackend creates the backend of the admin API, messenger is nil when statusd runs without one
+func NewNodeBackend(statusNode *node.StatusNode, messenger *protocol.Messenger, setLogLevel func(level string) error, shutdown func()) *NodeBackend {
+	return &NodeBackend{
+		statusNode:  statusNode,
+		messenger:   messenger,
+		setLogLevel: setLogLevel,
+		shutdown:    shutdown,
+	}
+}
+
+func (b *NodeBackend) waku() (*wakuv2.Waku, error) {
+	if !b.statusNode.IsRunning() {
+		return nil, ErrNodeNotRunning
+	}
+	waku := b.statusNode.WakuV2Service()
+	if waku == nil {
+		return nil, ErrWakuV2NotActive
+	}
+	return waku, nil
+}
+
+func (b *NodeBackend) Running() bool {
+	return b.statusNode.IsRunning()
+}
+
+func (b *NodeBackend) Peers() (map[string]types.WakuV2Peer, error) {
+	waku, err := b.waku()
+	if err != nil {
+		return nil, err
+	}
+	return waku.Peers(), nil
+}
+
+func (b *NodeBackend) AddPeer(address string, store bool) (string, error) {
+	waku, err := b.waku()
+	if err != nil {
+		return "", err
+	}
+
+	add := waku.AddRelayPeer
+	if store {
+		add = waku.AddStorePeer
+	}
+	peerID, err := add(address)
+	if err != nil {
+		return "", err
+	}
+
+	err = waku.DialPeer(address)
+	if err != nil {
+		return "", err
+	}
+	return peerID.String(), nil
+}
+
+func (b *NodeBackend) DropPeer(peerID string) error {
+	waku, err := b.waku()
+	if err != nil {
+		return err
+	}
+	return waku.DropPeer(peerID)
+}
+
+func (b *NodeBackend) StoreStats() (*wakuv2.StoreStats, error) {
+	waku, err := b.waku()
+	if err != nil {
+		return nil, err
+	}
+	return waku.StoreStats()
+}
+
+func (b *NodeBackend) ArchivesStatus() (*ArchivesStatus, error) {
+	status := &ArchivesStatus{MessengerRunning: b.messenger != nil}
+	if b.messenger == nil {
+		return status, nil
+	}
+
+	var err error
+	status.TorrentClientActive, status.Communities, err = b.messenger.HistoryArchivesSeedingStatus()
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func (b *NodeBackend) SetLogLevel(level string) error {
+	return b.setLogLevel(level)
+}
+
+func (b *NodeBackend) Shutdown() {
+	b.shutdown()
+}
@@ -0,0 +1,276 @@
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	_ "embed" // for the OpenAPI spec
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/wakuv2"
+)
+
+//go:embed openapi.yaml
+var openAPISpec []byte
+
+var (
+	ErrNodeNotRunning  = errors.New("node is not running")
+	ErrWakuV2NotActive = errors.New("waku v2 is not active")
+	ErrPeerAddressNil  = errors.New("peer address is required")
+)
+
+// Backend is the part of the node the admin API controls
+type Backend interface {
+	Running() bool
+	Peers() (map[string]types.WakuV2Peer, error)
+	AddPeer(address string, store bool) (string, error)
+	DropPeer(peerID string) error
+	StoreStats() (*wakuv2.StoreStats, error)
+	ArchivesStatus() (*ArchivesStatus, error)
+	SetLogLevel(level string) error
+	Shutdown()
+}
+
+// ArchivesStatus describes the seeding of the community history archives
+type ArchivesStatus struct {
+	MessengerRunning    bool                                    `json:"messengerRunning"`
+	TorrentClientActive bool                                    `json:"torrentClientActive"`
+	Communities         []*protocol.HistoryArchiveSeedingStatus `json:"communities"`
+}
+
+// AddPeer is the body of a request adding a peer
+type AddPeer struct {
+	Address string `json:"address"`
+	Store   bool   `json:"store"`
+}
+
+// Server runs the admin HTTP API of statusd, every route but /health requires the bearer token
+type Server struct {
+	backend Backend
+	token   string
+	server  *http.Server
+}
+
+// NewServer creates an admin server listening on the given address
+func NewServer(address string, token string, backend Backend) *Server {
+	s := &Server{
+		backend: backend,
+		token:   token,
+	}
+	s.server = &http.Server{
+		Addr:              address,
+		ReadHeaderTimeout: 5 * time.Second,
+		Handler:           s.Handler(),
+	}
+	return s
+}
+
+// Handler returns the routes of the admin API
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", s.handleHealth)
+	mux.Handle("/ready", s.authenticated(s.handleReady))
+	mux.Handle("/peers", s.authenticated(s.handlePeers))
+	mux.Handle("/peers/", s.authenticated(s.handlePeer))
+	mux.Handle("/store", s.authenticated(s.handleStore))
+	mux.Handle("/archives", s.authenticated(s.handleArchives))
+	mux.Handle("/log-level", s.authenticated(s.handleLogLevel))
+	mux.Handle("/shutdown", s.authenticated(s.handleShutdown))
+	mux.Handle("/openapi.yaml", s.authenticated(s.handleOpenAPI))
+	return mux
+}
+
+// Listen starts the admin server in the background
+func (s *Server) Listen() {
+	go func() {
+		log.Info("admin server stopped", "err", s.server.ListenAndServe())
+	}()
+	log.Info("admin server started", "address", s.server.Addr)
+}
+
+// Stop shuts the admin server down
+func (s *Server) Stop(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
+
+func (s *Server) authenticated(next http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, errors.New("invalid token"))
+			return
+		}
+		next(w, r)
+	})
+}
+
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (s *Server) handleReady(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet) {
+		return
+	}
+	if !s.backend.Running() {
+		writeError(w, http.StatusServiceUnavailable, ErrNodeNotRunning)
+		return
+	}
+	peers, err := s.backend.Peers()
+	if err != nil {
+		writeError(w, http.StatusServiceUnavailable, err)
+		return
+	}
+	if len(peers) == 0 {
+		writeError(w, http.StatusServiceUnavailable, errors.New("node has no peers"))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"status": "ready", "peers": len(peers)})
+}
+
+func (s *Server) handlePeers(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet, http.MethodPost) {
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		peers, err := s.backend.Peers()
+		if err != nil {
+			writeError(w, http.StatusServiceUnavailable, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, peers)
+		return
+	}
+
+	var request AddPeer
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if request.Address == "" {
+		writeError(w, http.StatusBadRequest, ErrPeerAddressNil)
+		return
+	}
+
+	peerID, err := s.backend.AddPeer(request.Address, request.Store)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]string{"id": peerID})
+}
+
+func (s *Server) handlePeer(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodDelete) {
+		return
+	}
+
+	peerID := strings.TrimPrefix(r.URL.Path, "/peers/")
+	if peerID == "" || strings.Contains(peerID, "/") {
+		writeError(w, http.StatusNotFound, fmt.Errorf("invalid peer id %q", peerID))
+		return
+	}
+
+	if err := s.backend.DropPeer(peerID); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleStore(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet) {
+		return
+	}
+	stats, err := s.backend.StoreStats()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, stats)
+}
+
+func (s *Server) handleArchives(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet) {
+		return
+	}
+	status, err := s.backend.ArchivesStatus()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, status)
+}
+
+func (s *Server) handleLogLevel(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodPut) {
+		return
+	}
+
+	var request requests.SetLogLevel
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := request.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := s.backend.SetLogLevel(request.LogLevel); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, request)
+}
+
+func (s *Server) handleShutdown(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodPost) {
+		return
+	}
+	writeJSON(w, http.StatusAccepted, map[string]string{"status": "shutting down"})
+	// the response is sent before the node, and this server with it, goes down
+	go s.backend.Shutdown()
+}
+
+func (s *Server) handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/yaml")
+	_, err := w.Write(openAPISpec)
+	if err != nil {
+		log.Error("failed to write OpenAPI spec", "err", err)
+	}
+}
+
+func allowMethods(w http.ResponseWriter, r *http.Request, methods ...string) bool {
+	for _, method := range methods {
+		if r.Method == method {
+			return true
+		}
+	}
+	w.Header().Set("Allow", strings.Join(methods, ", "))
+	writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, status int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(payload); err != nil {
+		log.Error("failed to write admin response", "err", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
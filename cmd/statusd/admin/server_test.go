@@ -0,0 +1,174 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/wakuv2"
+)
+
+const testToken = "test-token"
+
+type fakeBackend struct {
+	running  bool
+	peers    map[string]types.WakuV2Peer
+	logLevel string
+	shutdown chan struct{}
+}
+
+func newFakeBackend() *fakeBackend {
+	return &fakeBackend{
+		running:  true,
+		peers:    make(map[string]types.WakuV2Peer),
+		shutdown: make(chan struct{}),
+	}
+}
+
+func (b *fakeBackend) Running() bool {
+	return b.running
+}
+
+func (b *fakeBackend) Peers() (map[string]types.WakuV2Peer, error) {
+	return b.peers, nil
+}
+
+func (b *fakeBackend) AddPeer(address string, store bool) (string, error) {
+	if !strings.HasPrefix(address, "/") {
+		return "", errors.New("invalid multiaddress")
+	}
+	b.peers["peer"] = types.WakuV2Peer{Addresses: []string{address}}
+	return "peer", nil
+}
+
+func (b *fakeBackend) DropPeer(peerID string) error {
+	if _, ok := b.peers[peerID]; !ok {
+		return errors.New("unknown peer")
+	}
+	delete(b.peers, peerID)
+	return nil
+}
+
+func (b *fakeBackend) StoreStats() (*wakuv2.StoreStats, error) {
+	return &wakuv2.StoreStats{Enabled: true, Messages: 3}, nil
+}
+
+func (b *fakeBackend) ArchivesStatus() (*ArchivesStatus, error) {
+	return &ArchivesStatus{}, nil
+}
+
+func (b *fakeBackend) SetLogLevel(level string) error {
+	b.logLevel = level
+	return nil
+}
+
+func (b *fakeBackend) Shutdown() {
+	close(b.shutdown)
+}
+
+func request(t *testing.T, handler http.Handler, method string, path string, body string, token string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func TestAuthentication(t *testing.T) {
+	handler := NewServer("", testToken, newFakeBackend()).Handler()
+
+	require.Equal(t, http.StatusOK, request(t, handler, http.MethodGet, "/health", "", "").Code)
+	require.Equal(t, http.StatusUnauthorized, request(t, handler, http.MethodGet, "/peers", "", "").Code)
+	require.Equal(t, http.StatusUnauthorized, request(t, handler, http.MethodGet, "/peers", "", "wrong").Code)
+	require.Equal(t, http.StatusOK, request(t, handler, http.MethodGet, "/peers", "", testToken).Code)
+	require.Equal(t, http.StatusOK, request(t, handler, http.MethodGet, "/openapi.yaml", "", testToken).Code)
+}
+
+func TestReadiness(t *testing.T) {
+	backend := newFakeBackend()
+	handler := NewServer("", testToken, backend).Handler()
+
+	require.Equal(t, http.StatusServiceUnavailable, request(t, handler, http.MethodGet, "/ready", "", testToken).Code)
+
+	backend.peers["peer"] = types.WakuV2Peer{}
+	require.Equal(t, http.StatusOK, request(t, handler, http.MethodGet, "/ready", "", testToken).Code)
+
+	backend.running = false
+	require.Equal(t, http.StatusServiceUnavailable, request(t, handler, http.MethodGet, "/ready", "", testToken).Code)
+}
+
+func TestAddAndDropPeer(t *testing.T) {
+	backend := newFakeBackend()
+	handler := NewServer("", testToken, backend).Handler()
+
+	w := request(t, handler, http.MethodPost, "/peers", `{"address":"invalid"}`, testToken)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = request(t, handler, http.MethodPost, "/peers", `{}`, testToken)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = request(t, handler, http.MethodPost, "/peers", `{"address":"/dns4/node/tcp/30303/p2p/peer"}`, testToken)
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var added map[string]string
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &added))
+	require.Equal(t, "peer", added["id"])
+	require.Len(t, backend.peers, 1)
+
+	require.Equal(t, http.StatusMethodNotAllowed, request(t, handler, http.MethodGet, "/peers/peer", "", testToken).Code)
+	require.Equal(t, http.StatusNoContent, request(t, handler, http.MethodDelete, "/peers/peer", "", testToken).Code)
+	require.Len(t, backend.peers, 0)
+	require.Equal(t, http.StatusBadRequest, request(t, handler, http.MethodDelete, "/peers/peer", "", testToken).Code)
+}
+
+func TestSetLogLevel(t *testing.T) {
+	backend := newFakeBackend()
+	handler := NewServer("", testToken, backend).Handler()
+
+	require.Equal(t, http.StatusBadRequest, request(t, handler, http.MethodPut, "/log-level", `{"logLevel":"VERBOSE"}`, testToken).Code)
+	require.Empty(t, backend.logLevel)
+
+	require.Equal(t, http.StatusOK, request(t, handler, http.MethodPut, "/log-level", `{"logLevel":"DEBUG"}`, testToken).Code)
+	require.Equal(t, "DEBUG", backend.logLevel)
+}
+
+func TestShutdown(t *testing.T) {
+	backend := newFakeBackend()
+	handler := NewServer("", testToken, backend).Handler()
+
+	require.Equal(t, http.StatusMethodNotAllowed, request(t, handler, http.MethodGet, "/shutdown", "", testToken).Code)
+	require.Equal(t, http.StatusAccepted, request(t, handler, http.MethodPost, "/shutdown", "", testToken).Code)
+	<-backend.shutdown
+}
+
+func TestLoadOrCreateToken(t *testing.T) {
+	dataDir := t.TempDir()
+	t.Setenv(TokenEnvVar, "")
+
+	token, err := LoadOrCreateToken(dataDir)
+	require.NoError(t, err)
+	require.Len(t, token, 2*tokenLength)
+
+	info, err := os.Stat(filepath.Join(dataDir, tokenFileName))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	stored, err := LoadOrCreateToken(dataDir)
+	require.NoError(t, err)
+	require.Equal(t, token, stored)
+
+	t.Setenv(TokenEnvVar, "from-env")
+	token, err = LoadOrCreateToken(dataDir)
+	require.NoError(t, err)
+	require.Equal(t, "from-env", token)
+}
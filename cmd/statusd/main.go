@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -22,6 +23,7 @@ import (
 
 	"github.com/status-im/status-go/api"
 	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/cmd/statusd/admin"
 	"github.com/status-im/status-go/common/dbsetup"
 	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
 	"github.com/status-im/status-go/eth-node/crypto"
@@ -33,6 +35,7 @@ import (
 	"github.com/status-im/status-go/profiling"
 	"github.com/status-im/status-go/protocol"
 	"github.com/status-im/status-go/protocol/pushnotificationserver"
+	"github.com/status-im/status-go/tracing"
 	"github.com/status-im/status-go/walletdatabase"
 )
 
@@ -76,6 +79,12 @@ var (
 	// don't change the name of this flag, https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L41
 	metricsEnabled = flag.Bool("metrics", false, "Expose ethereum metrics with debug_metrics jsonrpc call")
 	metricsPort    = flag.Int("metrics-port", 9305, "Port for the Prometheus /metrics endpoint")
+
+	adminEnabled = flag.Bool("admin", false, "Enable the admin HTTP API on localhost")
+	adminPort    = flag.Int("admin-port", 9306, "Port for the admin HTTP API")
+
+	tracingEnabled  = flag.Bool("tracing", false, "Export traces of the messaging pipeline to an OTLP/HTTP collector")
+	tracingEndpoint = flag.String("tracing-endpoint", getDefaultTracingEndpoint(), "Base URL of the OTLP/HTTP collector")
 )
 
 // All general log messages in this package should be routed through this logger.
@@ -173,8 +182,13 @@ func main() {
 		go startSystemDWatchdog()
 	}
 
-	// handle interrupt signals
-	interruptCh := haltOnInterruptSignal(backend.StatusNode())
+	// handle interrupt signals, and shutdown requests of the admin API
+	shutdownCh := make(chan struct{})
+	var shutdownOnce sync.Once
+	shutdown := func() {
+		shutdownOnce.Do(func() { close(shutdownCh) })
+	}
+	interruptCh := haltOnInterruptSignal(backend.StatusNode(), shutdownCh)
 
 	// Start collecting metrics. Metrics can be enabled by providing `-metrics` flag
 	// or setting `gethmetrics.Enabled` to true during compilation time:
@@ -190,6 +204,16 @@ func main() {
 		profiling.NewProfiler(*pprofPort).Go()
 	}
 
+	if *tracingEnabled {
+		err = tracing.Start(tracing.Config{Endpoint: *tracingEndpoint, ServiceName: serverClientName})
+		if err != nil {
+			logger.Error("failed to start tracing", "error", err)
+			return
+		}
+		defer stopTracing()
+	}
+
+	var messenger *protocol.Messenger
 	if config.PushNotificationServerConfig.Enabled {
 		if config.NodeKey == "" {
 			logger.Error("node key needs to be set if running a push notification server")
@@ -235,7 +259,7 @@ func main() {
 			protocol.WithAccountManager(backend.AccountManager()),
 		}
 
-		messenger, err := protocol.NewMessenger(
+		messenger, err = protocol.NewMessenger(
 			config.Name,
 			identity,
 			gethbridge.NewNodeBridge(backend.StatusNode().GethNode(), backend.StatusNode().WakuService(), backend.StatusNode().WakuV2Service()),
@@ -264,6 +288,20 @@ func main() {
 		go retrieveMessagesLoop(messenger, 300*time.Millisecond, interruptCh)
 	}
 
+	if *adminEnabled {
+		token, err := admin.LoadOrCreateToken(config.DataDir)
+		if err != nil {
+			logger.Error("failed to load admin token", "error", err)
+			return
+		}
+		setLogLevel := func(level string) error {
+			config.LogLevel = level
+			return logutils.OverrideRootLogWithConfig(logSettings(config), logColors())
+		}
+		adminBackend := admin.NewNodeBackend(backend.StatusNode(), messenger, setLogLevel, shutdown)
+		admin.NewServer(fmt.Sprintf("127.0.0.1:%d", *adminPort), token, adminBackend).Listen()
+	}
+
 	gethNode := backend.StatusNode().GethNode()
 	if gethNode != nil {
 		// wait till node has been stopped
@@ -274,6 +312,23 @@ func main() {
 	}
 }
 
+// getDefaultTracingEndpoint returns the endpoint set with the standard OpenTelemetry variable, if any
+func getDefaultTracingEndpoint() string {
+	if endpoint := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"); endpoint != "" {
+		return endpoint
+	}
+	return "http://localhost:4318"
+}
+
+// stopTracing exports the spans that are still queued
+func stopTracing() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := tracing.Stop(ctx); err != nil {
+		logger.Warn("failed to stop tracing", "error", err)
+	}
+}
+
 func getDefaultDataDir() string {
 	if home := os.Getenv("HOME"); home != "" {
 		return filepath.Join(home, ".statusd")
@@ -286,7 +341,13 @@ func setupLogging(config *params.NodeConfig) {
 		config.LogLevel = *logLevel
 	}
 
-	logSettings := logutils.LogSettings{
+	if err := logutils.OverrideRootLogWithConfig(logSettings(config), logColors()); err != nil {
+		stdlog.Fatalf("Error initializing logger: %v", err)
+	}
+}
+
+func logSettings(config *params.NodeConfig) logutils.LogSettings {
+	return logutils.LogSettings{
 		Enabled:         config.LogEnabled,
 		MobileSystem:    config.LogMobileSystem,
 		Level:           config.LogLevel,
@@ -295,10 +356,10 @@ func setupLogging(config *params.NodeConfig) {
 		MaxBackups:      config.LogMaxBackups,
 		CompressRotated: config.LogCompressRotated,
 	}
-	colors := !(*logWithoutColors) && terminal.IsTerminal(int(os.Stdin.Fd()))
-	if err := logutils.OverrideRootLogWithConfig(logSettings, colors); err != nil {
-		stdlog.Fatalf("Error initializing logger: %v", err)
-	}
+}
+
+func logColors() bool {
+	return !(*logWithoutColors) && terminal.IsTerminal(int(os.Stdin.Fd()))
 }
 
 // loop for notifying systemd about process being alive
@@ -395,18 +456,22 @@ Options:
 	flag.PrintDefaults()
 }
 
-// haltOnInterruptSignal catches interrupt signal (SIGINT) and
-// stops the node. It times out after 5 seconds
+// haltOnInterruptSignal catches interrupt signal (SIGINT), or the closing
+// of shutdownCh, and stops the node. It times out after 5 seconds
 // if the node can not be stopped.
-func haltOnInterruptSignal(statusNode *node.StatusNode) <-chan struct{} {
+func haltOnInterruptSignal(statusNode *node.StatusNode, shutdownCh <-chan struct{}) <-chan struct{} {
 	interruptCh := make(chan struct{})
 	go func() {
 		signalCh := make(chan os.Signal, 1)
 		signal.Notify(signalCh, os.Interrupt)
 		defer signal.Stop(signalCh)
-		<-signalCh
+		select {
+		case <-signalCh:
+			logger.Info("Got interrupt, shutting down...")
+		case <-shutdownCh:
+			logger.Info("Got shutdown request, shutting down...")
+		}
 		close(interruptCh)
-		logger.Info("Got interrupt, shutting down...")
 		if err := statusNode.Stop(); err != nil {
 			logger.Error("Failed to stop node", "error", err)
 			os.Exit(1)
@@ -0,0 +1,184 @@
+package ipfs
+
+import (
+	"container/list"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const defaultMaxCacheSize = 200 * 1024 * 1024
+
+type cacheEntry struct {
+	key  string
+	size int64
+}
+
+// cache keeps the downloaded content on disk, evicting the least recently used
+// entries once it grows over maxSize. Pinned entries are never evicted.
+// The modification time of the files keeps the recency across restarts.
+type cache struct {
+	dir     string
+	maxSize int64
+
+	mu      sync.Mutex
+	size    int64
+	lru     *list.List
+	entries map[string]*list.Element
+	pinned  map[string]struct{}
+}
+
+func newCache(dir string, maxSize int64) (*cache, error) {
+	if maxSize <= 0 {
+		maxSize = defaultMaxCacheSize
+	}
+
+	c := &cache{
+		dir:     dir,
+		maxSize: maxSize,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+		pinned:  make(map[string]struct{}),
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	type file struct {
+		name    string
+		size    int64
+		modTime time.Time
+	}
+
+	var cached []file
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		info, err := f.Info()
+		if err != nil {
+			return nil, err
+		}
+		cached = append(cached, file{name: f.Name(), size: info.Size(), modTime: info.ModTime()})
+	}
+
+	sort.Slice(cached, func(i, j int) bool {
+		return cached[i].modTime.Before(cached[j].modTime)
+	})
+
+	for _, f := range cached {
+		c.entries[f.name] = c.lru.PushFront(&cacheEntry{key: f.name, size: f.size})
+		c.size += f.size
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.evict()
+
+	return c, nil
+}
+
+func (c *cache) path(key string) string {
+	return filepath.Join(c.dir, key)
+}
+
+func (c *cache) get(key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+
+	content, err := os.ReadFile(c.path(key))
+	if os.IsNotExist(err) {
+		c.remove(element)
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+
+	c.lru.MoveToFront(element)
+	now := time.Now()
+	if err := os.Chtimes(c.path(key), now, now); err != nil {
+		log.Warn("failed to update ipfs cache entry time", "key", key, "err", err)
+	}
+
+	return content, true, nil
+}
+
+func (c *cache) put(key string, content []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// #nosec G306
+	err := os.WriteFile(c.path(key), content, 0700)
+	if err != nil {
+		return err
+	}
+
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+
+	size := int64(len(content))
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, size: size})
+	c.size += size
+
+	c.evict()
+	return nil
+}
+
+func (c *cache) remove(element *list.Element) {
+	entry := element.Value.(*cacheEntry)
+	c.lru.Remove(element)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+}
+
+// evict removes the least recently used entries that are not pinned until the cache fits maxSize
+func (c *cache) evict() {
+	element := c.lru.Back()
+	for c.size > c.maxSize && element != nil {
+		previous := element.Prev()
+		entry := element.Value.(*cacheEntry)
+
+		if _, pinned := c.pinned[entry.key]; !pinned {
+			err := os.Remove(c.path(entry.key))
+			if err != nil && !os.IsNotExist(err) {
+				log.Warn("failed to evict ipfs cache entry", "key", entry.key, "err", err)
+			} else {
+				c.remove(element)
+			}
+		}
+
+		element = previous
+	}
+}
+
+func (c *cache) pin(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		c.pinned[key] = struct{}{}
+	}
+}
+
+func (c *cache) unpin(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		delete(c.pinned, key)
+	}
+
+	c.evict()
+}
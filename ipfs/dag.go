@@ -0,0 +1,178 @@
+package ipfs
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Content is assembled from at most this many levels of blocks and this many bytes
+const (
+	maxDagDepth    = 8
+	maxContentSize = 50 * 1024 * 1024
+)
+
+// UnixFS data types, see https://github.com/ipfs/specs/blob/main/UNIXFS.md
+const (
+	unixfsRaw  = 0
+	unixfsFile = 2
+)
+
+var ErrUnsupportedHash = errors.New("ipfs: only sha2-256 hashes are supported")
+var ErrHashMismatch = errors.New("ipfs: block does not match the requested cid")
+var ErrUnsupportedContent = errors.New("ipfs: unsupported content type")
+var ErrInvalidBlock = errors.New("ipfs: invalid block")
+var ErrContentTooLarge = errors.New("ipfs: content too large")
+
+// dagNode is a decoded dag-pb block
+type dagNode struct {
+	links []cid.Cid
+	data  []byte
+}
+
+// verifyBlock checks that the block hashes to the cid
+func verifyBlock(c cid.Cid, block []byte) error {
+	decoded, err := multihash.Decode(c.Hash())
+	if err != nil {
+		return err
+	}
+
+	if decoded.Code != multihash.SHA2_256 {
+		return ErrUnsupportedHash
+	}
+
+	digest := sha256.Sum256(block)
+	if !bytes.Equal(digest[:], decoded.Digest) {
+		return ErrHashMismatch
+	}
+
+	return nil
+}
+
+func consumeBytesField(b []byte) ([]byte, int, error) {
+	v, n := protowire.ConsumeBytes(b)
+	if n < 0 {
+		return nil, 0, ErrInvalidBlock
+	}
+	return v, n, nil
+}
+
+// decodeDagPB decodes a dag-pb block, see https://ipld.io/specs/codecs/dag-pb/spec/
+func decodeDagPB(block []byte) (*dagNode, error) {
+	node := &dagNode{}
+
+	for len(block) > 0 {
+		num, typ, n := protowire.ConsumeTag(block)
+		if n < 0 {
+			return nil, ErrInvalidBlock
+		}
+		block = block[n:]
+
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			link, n, err := consumeBytesField(block)
+			if err != nil {
+				return nil, err
+			}
+			block = block[n:]
+
+			linkCid, err := decodeDagPBLink(link)
+			if err != nil {
+				return nil, err
+			}
+			node.links = append(node.links, linkCid)
+
+		case num == 2 && typ == protowire.BytesType:
+			data, n, err := consumeBytesField(block)
+			if err != nil {
+				return nil, err
+			}
+			block = block[n:]
+			node.data = data
+
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, block)
+			if n < 0 {
+				return nil, ErrInvalidBlock
+			}
+			block = block[n:]
+		}
+	}
+
+	return node, nil
+}
+
+func decodeDagPBLink(link []byte) (cid.Cid, error) {
+	for len(link) > 0 {
+		num, typ, n := protowire.ConsumeTag(link)
+		if n < 0 {
+			return cid.Undef, ErrInvalidBlock
+		}
+		link = link[n:]
+
+		if num == 1 && typ == protowire.BytesType {
+			hash, _, err := consumeBytesField(link)
+			if err != nil {
+				return cid.Undef, err
+			}
+			return cid.Cast(hash)
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, link)
+		if n < 0 {
+			return cid.Undef, ErrInvalidBlock
+		}
+		link = link[n:]
+	}
+
+	return cid.Undef, ErrInvalidBlock
+}
+
+// decodeUnixFSFile returns the data of a UnixFS file node
+func decodeUnixFSFile(data []byte) ([]byte, error) {
+	var dataType uint64
+	var content []byte
+
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, ErrInvalidBlock
+		}
+		data = data[n:]
+
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(data)
+			if n < 0 {
+				return nil, ErrInvalidBlock
+			}
+			data = data[n:]
+			dataType = v
+
+		case num == 2 && typ == protowire.BytesType:
+			v, n, err := consumeBytesField(data)
+			if err != nil {
+				return nil, err
+			}
+			data = data[n:]
+			content = v
+
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, data)
+			if n < 0 {
+				return nil, ErrInvalidBlock
+			}
+			data = data[n:]
+		}
+	}
+
+	if dataType != unixfsFile && dataType != unixfsRaw {
+		return nil, ErrUnsupportedContent
+	}
+
+	return content, nil
+}
@@ -0,0 +1,187 @@
+package ipfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-cid"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Blocks are at most 1MiB, gateways returning more are not trusted
+const maxBlockSize = 1024 * 1024
+
+var ErrNoGateways = errors.New("ipfs: no gateways configured")
+
+// GatewayStats are the results of the requests made to a gateway
+type GatewayStats struct {
+	URL       string `json:"url"`
+	Successes uint64 `json:"successes"`
+	Failures  uint64 `json:"failures"`
+	// AverageLatency of the successful requests
+	AverageLatency time.Duration `json:"averageLatency"`
+}
+
+type gateway struct {
+	url string
+
+	mu           sync.Mutex
+	successes    uint64
+	failures     uint64
+	totalLatency time.Duration
+}
+
+func (g *gateway) recordSuccess(latency time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.successes++
+	g.totalLatency += latency
+}
+
+func (g *gateway) recordFailure() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.failures++
+}
+
+func (g *gateway) stats() GatewayStats {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	stats := GatewayStats{
+		URL:       g.url,
+		Successes: g.successes,
+		Failures:  g.failures,
+	}
+	if g.successes > 0 {
+		stats.AverageLatency = g.totalLatency / time.Duration(g.successes)
+	}
+	return stats
+}
+
+// fetchBlockFromGateway requests the raw block of the cid from the gateway and verifies it
+func (d *Downloader) fetchBlockFromGateway(ctx context.Context, g *gateway, c cid.Cid) ([]byte, error) {
+	start := time.Now()
+
+	block, err := d.requestBlock(ctx, g.url, c)
+	if err == nil {
+		err = verifyBlock(c, block)
+	}
+
+	if err != nil {
+		// Requests cancelled because another gateway answered first are not failures
+		if ctx.Err() == nil {
+			g.recordFailure()
+		}
+		return nil, err
+	}
+
+	g.recordSuccess(time.Since(start))
+	return block, nil
+}
+
+func (d *Downloader) requestBlock(ctx context.Context, gatewayURL string, c cid.Cid) ([]byte, error) {
+	// Request the raw block, see https://specs.ipfs.tech/http-gateways/trustless-gateway/
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gatewayURL+c.String()+"?format=raw", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.ipld.raw")
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Error("failed to close the ipfs request body", "err", err)
+		}
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("could not load ipfs data, gateway %s returned %d", gatewayURL, resp.StatusCode)
+	}
+
+	block, err := io.ReadAll(io.LimitReader(resp.Body, maxBlockSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(block) > maxBlockSize {
+		return nil, ErrInvalidBlock
+	}
+
+	return block, nil
+}
+
+// fetchBlock gets a verified block from the gateways,
+// either racing them or trying them in order
+func (d *Downloader) fetchBlock(c cid.Cid) ([]byte, error) {
+	if len(d.gateways) == 0 {
+		return nil, ErrNoGateways
+	}
+
+	if d.raceGateways {
+		return d.raceBlock(c)
+	}
+
+	var err error
+	for _, g := range d.gateways {
+		var block []byte
+		block, err = d.fetchBlockFromGateway(d.ctx, g, c)
+		if err == nil {
+			return block, nil
+		}
+		log.Warn("failed to fetch ipfs block", "gateway", g.url, "cid", c.String(), "err", err)
+	}
+
+	return nil, err
+}
+
+func (d *Downloader) raceBlock(c cid.Cid) ([]byte, error) {
+	ctx, cancel := context.WithCancel(d.ctx)
+	defer cancel()
+
+	type result struct {
+		block []byte
+		err   error
+	}
+
+	results := make(chan result, len(d.gateways))
+	for _, g := range d.gateways {
+		go func(g *gateway) {
+			block, err := d.fetchBlockFromGateway(ctx, g, c)
+			if err != nil {
+				log.Warn("failed to fetch ipfs block", "gateway", g.url, "cid", c.String(), "err", err)
+			}
+			results <- result{block: block, err: err}
+		}(g)
+	}
+
+	var err error
+	for range d.gateways {
+		r := <-results
+		if r.err == nil {
+			return r.block, nil
+		}
+		err = r.err
+	}
+
+	return nil, err
+}
+
+// GatewayStats returns the results of the requests made to each gateway
+func (d *Downloader) GatewayStats() []GatewayStats {
+	stats := make([]GatewayStats, 0, len(d.gateways))
+	for _, g := range d.gateways {
+		stats = append(stats, g.stats())
+	}
+	return stats
+}
@@ -3,7 +3,6 @@ package ipfs
 import (
 	"context"
 	"errors"
-	"io/ioutil"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -14,7 +13,6 @@ import (
 	"github.com/wealdtech/go-multicodec"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/log"
 	"github.com/status-im/status-go/params"
 )
 
@@ -26,7 +24,7 @@ type taskResponse struct {
 }
 
 type taskRequest struct {
-	cid      string
+	cid      cid.Cid
 	download bool
 	doneChan chan taskResponse
 }
@@ -34,7 +32,9 @@ type taskRequest struct {
 type Downloader struct {
 	ctx             context.Context
 	cancel          func()
-	ipfsDir         string
+	cache           *cache
+	gateways        []*gateway
+	raceGateways    bool
 	wg              sync.WaitGroup
 	rateLimiterChan chan taskRequest
 	inputTaskChan   chan taskRequest
@@ -43,18 +43,35 @@ type Downloader struct {
 	quit chan struct{}
 }
 
-func NewDownloader(rootDir string) *Downloader {
+func NewDownloader(rootDir string, config params.IpfsConfig) *Downloader {
 	ipfsDir := filepath.Clean(filepath.Join(rootDir, "./ipfs"))
 	if err := os.MkdirAll(ipfsDir, 0700); err != nil {
 		panic("could not create IPFSDir")
 	}
 
+	cache, err := newCache(ipfsDir, config.MaxCacheSize)
+	if err != nil {
+		panic("could not load IPFS cache")
+	}
+
+	gatewayURLs := config.Gateways
+	if len(gatewayURLs) == 0 && params.IpfsGatewayURL != "" {
+		gatewayURLs = []string{params.IpfsGatewayURL}
+	}
+
+	var gateways []*gateway
+	for _, url := range gatewayURLs {
+		gateways = append(gateways, &gateway{url: url})
+	}
+
 	ctx, cancel := context.WithCancel(context.TODO())
 
 	d := &Downloader{
 		ctx:             ctx,
 		cancel:          cancel,
-		ipfsDir:         ipfsDir,
+		cache:           cache,
+		gateways:        gateways,
+		raceGateways:    config.RaceGateways,
 		rateLimiterChan: make(chan taskRequest, maxRequestsPerSecond),
 		inputTaskChan:   make(chan taskRequest, 1000),
 		wg:              sync.WaitGroup{},
@@ -107,7 +124,7 @@ func (d *Downloader) taskDispatcher() {
 	}
 }
 
-func hashToCid(hash []byte) (string, error) {
+func hashToCid(hash []byte) (cid.Cid, error) {
 	// contract response includes a contenthash, which needs to be decoded to reveal
 	// an IPFS identifier. Once decoded, download the content from IPFS. This content
 	// is in EDN format, ie https://ipfs.infura.io/ipfs/QmWVVLwVKCwkVNjYJrRzQWREVvEk917PhbHYAUhA1gECTM
@@ -115,49 +132,44 @@ func hashToCid(hash []byte) (string, error) {
 
 	data, codec, err := multicodec.RemoveCodec(hash)
 	if err != nil {
-		return "", err
+		return cid.Undef, err
 	}
 
 	codecName, err := multicodec.Name(codec)
 	if err != nil {
-		return "", err
+		return cid.Undef, err
 	}
 
 	if codecName != "ipfs-ns" {
-		return "", errors.New("codecName is not ipfs-ns")
+		return cid.Undef, errors.New("codecName is not ipfs-ns")
 	}
 
-	thisCID, err := cid.Parse(data)
-	if err != nil {
-		return "", err
-	}
-
-	return thisCID.Hash().B58String(), nil
+	return cid.Parse(data)
 }
 
-func decodeStringHash(input string) (string, error) {
+func decodeStringHash(input string) (cid.Cid, error) {
 	hash, err := hexutil.Decode("0x" + input)
 	if err != nil {
-		return "", err
+		return cid.Undef, err
 	}
 
-	cid, err := hashToCid(hash)
-	if err != nil {
-		return "", err
-	}
+	return hashToCid(hash)
+}
 
-	return cid, nil
+// cacheKey names the cached content after the multihash of its cid
+func cacheKey(c cid.Cid) string {
+	return c.Hash().B58String()
 }
 
 // Get checks if an IPFS image exists and returns it from cache
-// otherwise downloads it from INFURA's ipfs gateway
+// otherwise downloads it from the configured ipfs gateways
 func (d *Downloader) Get(hash string, download bool) ([]byte, error) {
-	cid, err := decodeStringHash(hash)
+	c, err := decodeStringHash(hash)
 	if err != nil {
 		return nil, err
 	}
 
-	exists, content, err := d.exists(cid)
+	content, exists, err := d.cache.get(cacheKey(c))
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +182,7 @@ func (d *Downloader) Get(hash string, download bool) ([]byte, error) {
 	d.wg.Add(1)
 
 	d.inputTaskChan <- taskRequest{
-		cid:      cid,
+		cid:      c,
 		download: download,
 		doneChan: doneChan,
 	}
@@ -183,55 +195,102 @@ func (d *Downloader) Get(hash string, download bool) ([]byte, error) {
 	return done.response, done.err
 }
 
-func (d *Downloader) exists(cid string) (bool, []byte, error) {
-	path := filepath.Join(d.ipfsDir, cid)
-	_, err := os.Stat(path)
-	if err == nil {
-		fileContent, err := os.ReadFile(path)
-		return true, fileContent, err
+// Pin keeps the content of the hashes in cache regardless of the cache size
+func (d *Downloader) Pin(hashes ...string) error {
+	keys, err := cacheKeys(hashes)
+	if err != nil {
+		return err
 	}
 
-	return false, nil, nil
+	d.cache.pin(keys...)
+	return nil
 }
 
-func (d *Downloader) download(cid string, download bool) ([]byte, error) {
-	path := filepath.Join(d.ipfsDir, cid)
-
-	req, err := http.NewRequest(http.MethodGet, params.IpfsGatewayURL+cid, nil)
+// Unpin lets the content of the hashes be evicted from cache
+func (d *Downloader) Unpin(hashes ...string) error {
+	keys, err := cacheKeys(hashes)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	req = req.WithContext(d.ctx)
+	d.cache.unpin(keys...)
+	return nil
+}
+
+func cacheKeys(hashes []string) ([]string, error) {
+	var keys []string
+	for _, hash := range hashes {
+		c, err := decodeStringHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, cacheKey(c))
+	}
+	return keys, nil
+}
 
-	resp, err := d.client.Do(req)
+func (d *Downloader) download(c cid.Cid, download bool) ([]byte, error) {
+	size := 0
+	content, err := d.fetchContent(c, 0, &size)
 	if err != nil {
 		return nil, err
 	}
 
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			log.Error("failed to close the stickerpack request body", "err", err)
+	if download {
+		err = d.cache.put(cacheKey(c), content)
+		if err != nil {
+			return nil, err
 		}
-	}()
-
-	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		log.Error("could not load data for", "cid", cid, "code", resp.StatusCode)
-		return nil, errors.New("could not load ipfs data")
 	}
 
-	fileContent, err := ioutil.ReadAll(resp.Body)
+	return content, nil
+}
+
+// fetchContent assembles the content of the cid from verified blocks
+func (d *Downloader) fetchContent(c cid.Cid, depth int, size *int) ([]byte, error) {
+	block, err := d.fetchBlock(c)
 	if err != nil {
 		return nil, err
 	}
 
-	if download {
-		// #nosec G306
-		err = os.WriteFile(path, fileContent, 0700)
+	switch c.Type() {
+	case cid.Raw:
+		*size += len(block)
+		if *size > maxContentSize {
+			return nil, ErrContentTooLarge
+		}
+		return block, nil
+
+	case cid.DagProtobuf:
+		node, err := decodeDagPB(block)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := decodeUnixFSFile(node.data)
 		if err != nil {
 			return nil, err
 		}
+
+		*size += len(content)
+		if *size > maxContentSize {
+			return nil, ErrContentTooLarge
+		}
+
+		if len(node.links) > 0 && depth >= maxDagDepth {
+			return nil, ErrInvalidBlock
+		}
+
+		for _, link := range node.links {
+			child, err := d.fetchContent(link, depth+1, size)
+			if err != nil {
+				return nil, err
+			}
+			content = append(content, child...)
+		}
+
+		return content, nil
 	}
 
-	return fileContent, nil
+	return nil, ErrUnsupportedContent
 }
@@ -0,0 +1,171 @@
+package ipfs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/go-multicodec"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/status-im/status-go/params"
+)
+
+func sha256Cid(t *testing.T, codec uint64, block []byte) cid.Cid {
+	digest := sha256.Sum256(block)
+	hash, err := multihash.Encode(digest[:], multihash.SHA2_256)
+	require.NoError(t, err)
+	if codec == cid.DagProtobuf {
+		return cid.NewCidV0(hash)
+	}
+	return cid.NewCidV1(codec, hash)
+}
+
+// fileNode builds a dag-pb UnixFS file block linking to the given blocks
+func fileNode(data []byte, links []cid.Cid) []byte {
+	var unixfs []byte
+	unixfs = protowire.AppendTag(unixfs, 1, protowire.VarintType)
+	unixfs = protowire.AppendVarint(unixfs, unixfsFile)
+	unixfs = protowire.AppendTag(unixfs, 2, protowire.BytesType)
+	unixfs = protowire.AppendBytes(unixfs, data)
+
+	var node []byte
+	for _, link := range links {
+		var pbLink []byte
+		pbLink = protowire.AppendTag(pbLink, 1, protowire.BytesType)
+		pbLink = protowire.AppendBytes(pbLink, link.Bytes())
+		node = protowire.AppendTag(node, 1, protowire.BytesType)
+		node = protowire.AppendBytes(node, pbLink)
+	}
+	node = protowire.AppendTag(node, 2, protowire.BytesType)
+	node = protowire.AppendBytes(node, unixfs)
+	return node
+}
+
+func contentHash(t *testing.T, c cid.Cid) string {
+	hash, err := multicodec.AddCodec("ipfs-ns", c.Bytes())
+	require.NoError(t, err)
+	return hex.EncodeToString(hash)
+}
+
+func gatewayServer(blocks map[string][]byte, tamper bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		block, ok := blocks[strings.TrimPrefix(r.URL.Path, "/ipfs/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if tamper {
+			block = append([]byte("tampered"), block...)
+		}
+		_, _ = w.Write(block)
+	}))
+}
+
+// testFile returns a file split in two raw blocks and the blocks served by the gateways
+func testFile(t *testing.T) (cid.Cid, []byte, map[string][]byte) {
+	leaf1 := []byte("hello ")
+	leaf2 := []byte("world")
+	leaf1Cid := sha256Cid(t, cid.Raw, leaf1)
+	leaf2Cid := sha256Cid(t, cid.Raw, leaf2)
+	root := fileNode(nil, []cid.Cid{leaf1Cid, leaf2Cid})
+	rootCid := sha256Cid(t, cid.DagProtobuf, root)
+
+	blocks := map[string][]byte{
+		rootCid.String():  root,
+		leaf1Cid.String(): leaf1,
+		leaf2Cid.String(): leaf2,
+	}
+	return rootCid, []byte("hello world"), blocks
+}
+
+func TestDownloaderVerifiesBlocks(t *testing.T) {
+	rootCid, expected, blocks := testFile(t)
+
+	badGateway := gatewayServer(blocks, true)
+	defer badGateway.Close()
+	goodGateway := gatewayServer(blocks, false)
+	defer goodGateway.Close()
+
+	for _, race := range []bool{false, true} {
+		d := NewDownloader(t.TempDir(), params.IpfsConfig{
+			Gateways:     []string{badGateway.URL + "/ipfs/", goodGateway.URL + "/ipfs/"},
+			RaceGateways: race,
+		})
+
+		content, err := d.Get(contentHash(t, rootCid), true)
+		require.NoError(t, err)
+		require.Equal(t, expected, content)
+
+		stats := d.GatewayStats()
+		require.Len(t, stats, 2)
+		require.Equal(t, uint64(0), stats[0].Successes)
+		require.Equal(t, uint64(3), stats[1].Successes)
+		if !race {
+			require.Equal(t, uint64(3), stats[0].Failures)
+		}
+
+		d.Stop()
+	}
+
+	d := NewDownloader(t.TempDir(), params.IpfsConfig{
+		Gateways: []string{badGateway.URL + "/ipfs/"},
+	})
+	defer d.Stop()
+
+	_, err := d.Get(contentHash(t, rootCid), true)
+	require.ErrorIs(t, err, ErrHashMismatch)
+}
+
+func TestCacheEviction(t *testing.T) {
+	dir := t.TempDir()
+	c, err := newCache(dir, 10)
+	require.NoError(t, err)
+
+	c.pin("pinned")
+	require.NoError(t, c.put("pinned", []byte("1234")))
+	require.NoError(t, c.put("a", []byte("1234")))
+	require.NoError(t, c.put("b", []byte("12")))
+
+	// a is the least recently used after being read
+	_, ok, err := c.get("pinned")
+	require.NoError(t, err)
+	require.True(t, ok)
+	_, ok, err = c.get("b")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.NoError(t, c.put("c", []byte("1234")))
+
+	_, ok, err = c.get("a")
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, err = os.Stat(filepath.Join(dir, "a"))
+	require.True(t, os.IsNotExist(err))
+
+	for _, key := range []string{"pinned", "b", "c"} {
+		_, ok, err = c.get(key)
+		require.NoError(t, err)
+		require.True(t, ok, key)
+	}
+
+	// pinned entries are evicted once unpinned
+	c.maxSize = 8
+	c.unpin("pinned")
+	_, ok, err = c.get("pinned")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// the cache is loaded from disk
+	c, err = newCache(dir, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), c.size)
+}
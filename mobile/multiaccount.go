@@ -3,6 +3,8 @@ package statusgo
 import (
 	"encoding/json"
 	"strings"
+
+	"github.com/status-im/status-go/account/generator/slip39"
 )
 
 // MultiAccountGenerateParams are the params sent to MultiAccountGenerate.
@@ -54,6 +56,20 @@ type MultiAccountImportMnemonicParams struct {
 	Paths           []string `json:"paths"`
 }
 
+// MultiAccountGenerateSlip39SharesParams are the params sent to MultiAccountGenerateSlip39Shares.
+type MultiAccountGenerateSlip39SharesParams struct {
+	Strength       int            `json:"strength"`
+	GroupThreshold int            `json:"groupThreshold"`
+	Groups         []slip39.Group `json:"groups"`
+	Passphrase     string         `json:"passphrase"`
+}
+
+// MultiAccountImportSlip39SharesParams are the params sent to MultiAccountImportSlip39Shares.
+type MultiAccountImportSlip39SharesParams struct {
+	Shares     []string `json:"shares"`
+	Passphrase string   `json:"passphrase"`
+}
+
 // MultiAccountGenerate generates account in memory without storing them.
 func MultiAccountGenerate(paramsJSON string) string {
 	var p MultiAccountGenerateParams
@@ -229,6 +245,59 @@ func MultiAccountImportMnemonic(paramsJSON string) string {
 	return string(out)
 }
 
+// MultiAccountGenerateSlip39Shares generates a master key in memory and returns its SLIP-39 shares.
+func MultiAccountGenerateSlip39Shares(paramsJSON string) string {
+	var p MultiAccountGenerateSlip39SharesParams
+
+	if err := json.Unmarshal([]byte(paramsJSON), &p); err != nil {
+		return makeJSONResponse(err)
+	}
+
+	resp, err := statusBackend.AccountManager().AccountsGenerator().GenerateSlip39Shares(p.Strength, p.GroupThreshold, p.Groups, p.Passphrase)
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+
+	out, err := json.Marshal(resp)
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+
+	return string(out)
+}
+
+// MultiAccountImportSlip39Shares imports the master key recovered from SLIP-39 shares.
+func MultiAccountImportSlip39Shares(paramsJSON string) string {
+	var p MultiAccountImportSlip39SharesParams
+
+	if err := json.Unmarshal([]byte(paramsJSON), &p); err != nil {
+		return makeJSONResponse(err)
+	}
+
+	shares := make([]string, len(p.Shares))
+	for i, share := range p.Shares {
+		// remove any duplicate whitespaces
+		shares[i] = strings.Join(strings.Fields(share), " ")
+	}
+
+	resp, err := statusBackend.AccountManager().AccountsGenerator().ImportSlip39Shares(shares, p.Passphrase)
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+
+	out, err := json.Marshal(resp)
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+
+	return string(out)
+}
+
+// ValidateSlip39Share checks that a single SLIP-39 share is well formed and has a valid checksum.
+func ValidateSlip39Share(share string) string {
+	return makeJSONResponse(slip39.ValidateMnemonic(share))
+}
+
 // MultiAccountStoreAccount stores the select account.
 func MultiAccountStoreAccount(paramsJSON string) string {
 	var p MultiAccountStoreAccountParams
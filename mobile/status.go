@@ -1170,6 +1170,12 @@ func InputConnectionStringForBootstrappingAnotherDevice(cs, configJSON string) s
 	return makeJSONResponse(err)
 }
 
+// CancelLocalPairingHistoryTransfer cancels the transfer of the message history that follows a local pairing,
+// on either side of the transfer. The devices stay paired and the messages already transferred are kept.
+func CancelLocalPairingHistoryTransfer() string {
+	return makeJSONResponse(pairing.CancelHistoryTransfer())
+}
+
 // GetConnectionStringForExportingKeypairsKeystores starts a pairing.SenderServer
 // then generates a pairing.ConnectionParams. Used when the device is Logged in and therefore has Account keys
 // and the device might not have a camera, to transfer kestore files of provided key uids.
@@ -39,6 +39,11 @@ var (
 		dBColumnName:   "chaos_mode",
 		valueHandler:   BoolHandler,
 	}
+	CommunityDirectoryEnabled = SettingField{
+		reactFieldName: "community-directory-enabled?",
+		dBColumnName:   "community_directory_enabled",
+		valueHandler:   BoolHandler,
+	}
 	Currency = SettingField{
 		reactFieldName: "currency",
 		dBColumnName:   "currency",
@@ -513,6 +518,7 @@ var (
 		BackupEnabled,
 		BackupFetched,
 		ChaosMode,
+		CommunityDirectoryEnabled,
 		Currency,
 		CurrentUserStatus,
 		CustomBootNodes,
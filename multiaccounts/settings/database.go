@@ -391,7 +391,8 @@ func (db *Database) GetSettings() (Settings, error) {
 		gif_favorites, opensea_enabled, last_backup, backup_enabled, telemetry_server_url, auto_message_enabled, gif_api_key,
 		test_networks_enabled, mutual_contact_enabled, profile_migration_needed, is_goerli_enabled, wallet_token_preferences_group_by_community, url_unfurling_mode,
 		omit_transfers_history_scan, mnemonic_was_not_shown, wallet_show_community_asset_when_sending_tokens, wallet_display_assets_below_balance,
-		wallet_display_assets_below_balance_threshold, wallet_collectible_preferences_group_by_collection, wallet_collectible_preferences_group_by_community
+		wallet_display_assets_below_balance_threshold, wallet_collectible_preferences_group_by_collection, wallet_collectible_preferences_group_by_community,
+		community_directory_enabled
 	FROM
 		settings
 	WHERE
@@ -475,6 +476,7 @@ func (db *Database) GetSettings() (Settings, error) {
 		&s.DisplayAssetsBelowBalanceThreshold,
 		&s.CollectibleGroupByCollection,
 		&s.CollectibleGroupByCommunity,
+		&s.CommunityDirectoryEnabled,
 	)
 
 	return s, err
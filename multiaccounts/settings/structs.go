@@ -126,6 +126,7 @@ type Settings struct {
 	Address                   types.Address    `json:"address"`
 	AnonMetricsShouldSend     bool             `json:"anon-metrics/should-send?,omitempty"`
 	ChaosMode                 bool             `json:"chaos-mode?,omitempty"`
+	CommunityDirectoryEnabled bool             `json:"community-directory-enabled?,omitempty"`
 	Currency                  string           `json:"currency,omitempty"`
 	CurrentNetwork            string           `json:"networks/current-network"`
 	CustomBootnodes           *json.RawMessage `json:"custom-bootnodes,omitempty"`
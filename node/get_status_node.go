@@ -271,7 +271,7 @@ func (n *StatusNode) startWithDB(config *params.NodeConfig, accs *accounts.Manag
 		return err
 	}
 
-	n.downloader = ipfs.NewDownloader(config.RootDataDir)
+	n.downloader = ipfs.NewDownloader(config.RootDataDir, config.IpfsConfig)
 
 	if n.httpServer != nil {
 		if err := n.httpServer.Stop(); err != nil {
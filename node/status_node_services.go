@@ -308,35 +308,42 @@ func (b *StatusNode) wakuService(wakuCfg *params.WakuConfig, clusterCfg *params.
 
 }
 
+// NewWakuV2Config returns the waku v2 configuration of the given node configuration
+func NewWakuV2Config(nodeConfig *params.NodeConfig, telemetryServerURL string) *wakuv2.Config {
+	cfg := &wakuv2.Config{
+		MaxMessageSize:          wakucommon.DefaultMaxMessageSize,
+		Host:                    nodeConfig.WakuV2Config.Host,
+		Port:                    nodeConfig.WakuV2Config.Port,
+		LightClient:             nodeConfig.WakuV2Config.LightClient,
+		KeepAliveInterval:       nodeConfig.WakuV2Config.KeepAliveInterval,
+		Rendezvous:              nodeConfig.Rendezvous,
+		WakuNodes:               nodeConfig.ClusterConfig.WakuNodes,
+		PeerExchange:            nodeConfig.WakuV2Config.PeerExchange,
+		EnableStore:             nodeConfig.WakuV2Config.EnableStore,
+		StoreCapacity:           nodeConfig.WakuV2Config.StoreCapacity,
+		StoreSeconds:            nodeConfig.WakuV2Config.StoreSeconds,
+		DiscoveryLimit:          nodeConfig.WakuV2Config.DiscoveryLimit,
+		DiscV5BootstrapNodes:    nodeConfig.ClusterConfig.DiscV5BootstrapNodes,
+		Nameserver:              nodeConfig.WakuV2Config.Nameserver,
+		EnableDiscV5:            nodeConfig.WakuV2Config.EnableDiscV5,
+		UDPPort:                 nodeConfig.WakuV2Config.UDPPort,
+		AutoUpdate:              nodeConfig.WakuV2Config.AutoUpdate,
+		DefaultShardPubsubTopic: shard.DefaultShardPubsubTopic(),
+		UseShardAsDefaultTopic:  nodeConfig.WakuV2Config.UseShardAsDefaultTopic,
+		TelemetryServerURL:      telemetryServerURL,
+		ClusterID:               nodeConfig.ClusterConfig.ClusterID,
+	}
+
+	if nodeConfig.WakuV2Config.MaxMessageSize > 0 {
+		cfg.MaxMessageSize = nodeConfig.WakuV2Config.MaxMessageSize
+	}
+
+	return cfg
+}
+
 func (b *StatusNode) wakuV2Service(nodeConfig *params.NodeConfig, telemetryServerURL string) (*wakuv2.Waku, error) {
 	if b.wakuV2Srvc == nil {
-		cfg := &wakuv2.Config{
-			MaxMessageSize:          wakucommon.DefaultMaxMessageSize,
-			Host:                    nodeConfig.WakuV2Config.Host,
-			Port:                    nodeConfig.WakuV2Config.Port,
-			LightClient:             nodeConfig.WakuV2Config.LightClient,
-			KeepAliveInterval:       nodeConfig.WakuV2Config.KeepAliveInterval,
-			Rendezvous:              nodeConfig.Rendezvous,
-			WakuNodes:               nodeConfig.ClusterConfig.WakuNodes,
-			PeerExchange:            nodeConfig.WakuV2Config.PeerExchange,
-			EnableStore:             nodeConfig.WakuV2Config.EnableStore,
-			StoreCapacity:           nodeConfig.WakuV2Config.StoreCapacity,
-			StoreSeconds:            nodeConfig.WakuV2Config.StoreSeconds,
-			DiscoveryLimit:          nodeConfig.WakuV2Config.DiscoveryLimit,
-			DiscV5BootstrapNodes:    nodeConfig.ClusterConfig.DiscV5BootstrapNodes,
-			Nameserver:              nodeConfig.WakuV2Config.Nameserver,
-			EnableDiscV5:            nodeConfig.WakuV2Config.EnableDiscV5,
-			UDPPort:                 nodeConfig.WakuV2Config.UDPPort,
-			AutoUpdate:              nodeConfig.WakuV2Config.AutoUpdate,
-			DefaultShardPubsubTopic: shard.DefaultShardPubsubTopic(),
-			UseShardAsDefaultTopic:  nodeConfig.WakuV2Config.UseShardAsDefaultTopic,
-			TelemetryServerURL:      telemetryServerURL,
-			ClusterID:               nodeConfig.ClusterConfig.ClusterID,
-		}
-
-		if nodeConfig.WakuV2Config.MaxMessageSize > 0 {
-			cfg.MaxMessageSize = nodeConfig.WakuV2Config.MaxMessageSize
-		}
+		cfg := NewWakuV2Config(nodeConfig, telemetryServerURL)
 
 		w, err := wakuv2.New(nodeConfig.NodeKey, nodeConfig.ClusterConfig.Fleet, cfg, logutils.ZapLogger(), b.appDB, b.timeSource(), signal.SendHistoricMessagesRequestFailed, signal.SendPeerStats)
 
@@ -201,6 +201,30 @@ func insertTorrentConfig(tx *sql.Tx, c *params.NodeConfig) error {
 	return err
 }
 
+func insertIpfsConfig(tx *sql.Tx, c *params.NodeConfig) error {
+	_, err := tx.Exec(`
+  INSERT OR REPLACE INTO ipfs_config (
+    race_gateways, max_cache_size, synthetic_id
+  ) VALUES (?, ?, 'id')`,
+		c.IpfsConfig.RaceGateways, c.IpfsConfig.MaxCacheSize,
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`DELETE FROM ipfs_config_gateways WHERE synthetic_id = 'id'`); err != nil {
+		return err
+	}
+
+	for position, gateway := range c.IpfsConfig.Gateways {
+		_, err := tx.Exec(`INSERT OR REPLACE INTO ipfs_config_gateways (gateway, position, synthetic_id) VALUES (?, ?, 'id')`, gateway, position)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func insertWakuV2Config(tx *sql.Tx, c *params.NodeConfig) error {
 	_, err := tx.Exec(`
 	INSERT OR REPLACE INTO wakuv2_config (
@@ -378,6 +402,7 @@ func nodeConfigNormalInserts() []insertFn {
 		insertTorrentConfig,
 		insertWakuV2StoreConfig,
 		insertWakuV2ShardConfig,
+		insertIpfsConfig,
 	}
 }
 
@@ -679,6 +704,30 @@ func loadNodeConfig(tx *sql.Tx) (*params.NodeConfig, error) {
 		return nil, err
 	}
 
+	err = tx.QueryRow(`
+  SELECT race_gateways, max_cache_size
+  FROM ipfs_config WHERE synthetic_id = 'id'
+  `).Scan(
+		&nodecfg.IpfsConfig.RaceGateways, &nodecfg.IpfsConfig.MaxCacheSize,
+	)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	rows, err = tx.Query(`SELECT gateway FROM ipfs_config_gateways WHERE synthetic_id = 'id' ORDER BY position ASC`)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var gateway string
+		err = rows.Scan(&gateway)
+		if err != nil {
+			return nil, err
+		}
+		nodecfg.IpfsConfig.Gateways = append(nodecfg.IpfsConfig.Gateways, gateway)
+	}
+
 	err = tx.QueryRow(`
 	SELECT enabled, host, port, keep_alive_interval, light_client, full_node, discovery_limit, data_dir,
 	max_message_size, enable_confirmations, peer_exchange, enable_discv5, udp_port, auto_update,
@@ -482,6 +482,9 @@ type NodeConfig struct {
 
 	TorrentConfig TorrentConfig
 
+	// IpfsConfig extra configuration for the IPFS downloader
+	IpfsConfig IpfsConfig
+
 	// RegisterTopics a list of specific topics where the peer wants to be
 	// discoverable.
 	RegisterTopics []discv5.Topic `json:"RegisterTopics"`
@@ -676,6 +679,16 @@ type TorrentConfig struct {
 	TorrentDir string
 }
 
+// IpfsConfig provides configuration for fetching content from IPFS gateways.
+type IpfsConfig struct {
+	// Gateways are the URL prefixes content is fetched from. IpfsGatewayURL is used if empty.
+	Gateways []string
+	// RaceGateways requests all the gateways at once instead of trying them in order
+	RaceGateways bool
+	// MaxCacheSize is the maximum size in bytes of the content cached on disk
+	MaxCacheSize int64
+}
+
 // Validate validates the ShhextConfig struct and returns an error if inconsistent values are found
 func (c *ShhextConfig) Validate(validate *validator.Validate) error {
 	if err := validate.Struct(c); err != nil {
@@ -40,6 +40,7 @@ const (
 	ActivityCenterNotificationTypeFirstCommunityTokenReceived
 	ActivityCenterNotificationTypeCommunityBanned
 	ActivityCenterNotificationTypeCommunityUnbanned
+	ActivityCenterNotificationTypeCommunityEventReminder
 )
 
 type ActivityCenterMembershipStatus int
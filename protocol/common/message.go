@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -20,9 +21,12 @@ import (
 
 	accountJson "github.com/status-im/status-go/account/json"
 	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/images"
 	"github.com/status-im/status-go/protocol/audio"
+	"github.com/status-im/status-go/protocol/files"
 	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/video"
 )
 
 // QuotedMessage contains the original text of the message replied to
@@ -172,10 +176,22 @@ type Message struct {
 	Base64Audio string `json:"audio,omitempty"`
 	// AudioPath is the path of the audio to be sent
 	AudioPath string `json:"audioPath,omitempty"`
+	// VideoPath is the path of the video to be sent
+	VideoPath string `json:"videoPath,omitempty"`
+	// VideoThumbnailPath is the path of the poster image of the video to be sent
+	VideoThumbnailPath string `json:"videoThumbnailPath,omitempty"`
+	// FilePath is the path of the file to be sent
+	FilePath string `json:"filePath,omitempty"`
 	// ImageLocalURL is the local url of the image
 	ImageLocalURL string `json:"imageLocalUrl,omitempty"`
 	// AudioLocalURL is the local url of the audio
 	AudioLocalURL string `json:"audioLocalUrl,omitempty"`
+	// VideoLocalURL is the local url of the video
+	VideoLocalURL string `json:"videoLocalUrl,omitempty"`
+	// VideoThumbnailLocalURL is the local url of the poster image of the video
+	VideoThumbnailLocalURL string `json:"videoThumbnailLocalUrl,omitempty"`
+	// FileLocalURL is the local url to download the file
+	FileLocalURL string `json:"fileLocalUrl,omitempty"`
 	// StickerLocalURL is the local url of the sticker
 	StickerLocalURL string `json:"stickerLocalUrl,omitempty"`
 
@@ -261,6 +277,17 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		AlbumImagesCount         uint32                           `json:"albumImagesCount,omitempty"`
 		Audio                    string                           `json:"audio,omitempty"`
 		AudioDurationMs          uint64                           `json:"audioDurationMs,omitempty"`
+		Video                    string                           `json:"video,omitempty"`
+		VideoThumbnail           string                           `json:"videoThumbnail,omitempty"`
+		VideoCodec               string                           `json:"videoCodec,omitempty"`
+		VideoDurationMs          uint64                           `json:"videoDurationMs,omitempty"`
+		VideoWidth               uint32                           `json:"videoWidth,omitempty"`
+		VideoHeight              uint32                           `json:"videoHeight,omitempty"`
+		File                     string                           `json:"file,omitempty"`
+		FileName                 string                           `json:"fileName,omitempty"`
+		FileMimeType             string                           `json:"fileMimeType,omitempty"`
+		FileSize                 uint64                           `json:"fileSize,omitempty"`
+		FileHash                 string                           `json:"fileHash,omitempty"`
 		CommunityID              string                           `json:"communityId,omitempty"`
 		Sticker                  *StickerAlias                    `json:"sticker,omitempty"`
 		CommandParameters        *CommandParameters               `json:"commandParameters,omitempty"`
@@ -306,6 +333,9 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		DisplayName:              m.DisplayName,
 		Image:                    m.ImageLocalURL,
 		Audio:                    m.AudioLocalURL,
+		Video:                    m.VideoLocalURL,
+		VideoThumbnail:           m.VideoThumbnailLocalURL,
+		File:                     m.FileLocalURL,
 		CommunityID:              m.CommunityID,
 		Timestamp:                m.Timestamp,
 		ContentType:              m.ContentType,
@@ -338,6 +368,22 @@ func (m *Message) MarshalJSON() ([]byte, error) {
 		item.AudioDurationMs = audio.DurationMs
 	}
 
+	if video := m.GetVideo(); video != nil {
+		item.VideoCodec = video.Codec
+		item.VideoDurationMs = video.DurationMs
+		item.VideoWidth = video.Width
+		item.VideoHeight = video.Height
+	}
+
+	if file := m.GetFile(); file != nil {
+		item.FileName = file.Name
+		item.FileMimeType = file.MimeType
+		item.FileSize = file.Size
+		if len(file.Hash) != 0 {
+			item.FileHash = types.EncodeHex(file.Hash)
+		}
+	}
+
 	if image := m.GetImage(); image != nil {
 		item.AlbumID = image.AlbumId
 		item.ImageWidth = image.Width
@@ -375,6 +421,13 @@ func (m *Message) UnmarshalJSON(data []byte) error {
 		ChatID           string                           `json:"chatId"`
 		Sticker          *protobuf.StickerMessage         `json:"sticker"`
 		AudioDurationMs  uint64                           `json:"audioDurationMs"`
+		VideoCodec       string                           `json:"videoCodec"`
+		VideoDurationMs  uint64                           `json:"videoDurationMs"`
+		VideoWidth       uint32                           `json:"videoWidth"`
+		VideoHeight      uint32                           `json:"videoHeight"`
+		FileName         string                           `json:"fileName"`
+		FileMimeType     string                           `json:"fileMimeType"`
+		FileSize         uint64                           `json:"fileSize"`
 		ParsedText       json.RawMessage                  `json:"parsedText"`
 		ContentType      protobuf.ChatMessage_ContentType `json:"contentType"`
 		AlbumID          string                           `json:"albumId"`
@@ -398,6 +451,25 @@ func (m *Message) UnmarshalJSON(data []byte) error {
 			Audio: &protobuf.AudioMessage{DurationMs: aux.AudioDurationMs},
 		}
 	}
+	if aux.ContentType == protobuf.ChatMessage_VIDEO {
+		m.Payload = &protobuf.ChatMessage_Video{
+			Video: &protobuf.VideoMessage{
+				Codec:      aux.VideoCodec,
+				DurationMs: aux.VideoDurationMs,
+				Width:      aux.VideoWidth,
+				Height:     aux.VideoHeight,
+			},
+		}
+	}
+	if aux.ContentType == protobuf.ChatMessage_FILE {
+		m.Payload = &protobuf.ChatMessage_File{
+			File: &protobuf.FileMessage{
+				Name:     aux.FileName,
+				MimeType: aux.FileMimeType,
+				Size:     aux.FileSize,
+			},
+		}
+	}
 
 	if aux.ContentType == protobuf.ChatMessage_IMAGE {
 		m.Payload = &protobuf.ChatMessage_Image{
@@ -628,6 +700,12 @@ func (m *Message) GetSimplifiedText(identity string, canonicalNames map[string]s
 	if m.ContentType == protobuf.ChatMessage_AUDIO {
 		return "Audio", nil
 	}
+	if m.ContentType == protobuf.ChatMessage_VIDEO {
+		return "Video", nil
+	}
+	if m.ContentType == protobuf.ChatMessage_FILE {
+		return "File", nil
+	}
 	if m.ContentType == protobuf.ChatMessage_STICKER {
 		return "Sticker", nil
 	}
@@ -738,6 +816,82 @@ func (m *Message) LoadAudio() error {
 	return os.Remove(m.AudioPath)
 }
 
+func (m *Message) LoadVideo() error {
+	file, err := os.Open(m.VideoPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() > video.MaxSize {
+		return video.ErrTooLarge
+	}
+
+	payload, err := ioutil.ReadAll(file)
+	if err != nil {
+		return err
+	}
+	// codec, duration and dimensions are provided by the client along with the path
+	videoMessage := m.GetVideo()
+	if videoMessage == nil {
+		videoMessage = &protobuf.VideoMessage{}
+	}
+	videoMessage.Payload = payload
+	videoMessage.Format = video.Type(payload)
+	if videoMessage.Format == protobuf.VideoMessage_UNKNOWN_VIDEO_FORMAT {
+		return errors.New("video format not supported")
+	}
+
+	if len(m.VideoThumbnailPath) != 0 {
+		thumbnail, err := images.OpenAndAdjustImage(images.CroppedImage{ImagePath: m.VideoThumbnailPath}, false)
+		if err != nil {
+			return err
+		}
+		videoMessage.Thumbnail = thumbnail
+		videoMessage.ThumbnailFormat = images.GetProtobufImageFormat(thumbnail)
+	}
+
+	m.Payload = &protobuf.ChatMessage_Video{Video: videoMessage}
+	return nil
+}
+
+func (m *Message) LoadFile() error {
+	file, err := os.Open(m.FilePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() > files.MaxSize {
+		return files.ErrTooLarge
+	}
+
+	payload, err := ioutil.ReadAll(file)
+	if err != nil {
+		return err
+	}
+
+	name := filepath.Base(m.FilePath)
+	m.Payload = &protobuf.ChatMessage_File{
+		File: &protobuf.FileMessage{
+			Payload:  payload,
+			Name:     name,
+			MimeType: files.MIME(name, payload),
+			Size:     uint64(len(payload)),
+			Hash:     files.Hash(payload),
+		},
+	}
+	return nil
+}
+
 func (m *Message) LoadImage() error {
 	payload, err := images.OpenAndAdjustImage(images.CroppedImage{ImagePath: m.ImagePath}, false)
 
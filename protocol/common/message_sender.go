@@ -26,6 +26,7 @@ import (
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/transport"
 	v1protocol "github.com/status-im/status-go/protocol/v1"
+	"github.com/status-im/status-go/tracing"
 )
 
 // Whisper message properties.
@@ -156,7 +157,16 @@ func (s *MessageSender) SendPrivate(
 	ctx context.Context,
 	recipient *ecdsa.PublicKey,
 	rawMessage *RawMessage,
-) ([]byte, error) {
+) (_ []byte, sendErr error) {
+	ctx, span := tracing.StartSpanWithKind(ctx, "MessageSender.SendPrivate", tracing.SpanKindProducer,
+		tracing.String("messageType", MessageTypeLabel(rawMessage.MessageType)),
+	)
+	defer func() {
+		observeSentMessage(rawMessage.MessageType, sendErr)
+		span.RecordError(sendErr)
+		span.End()
+	}()
+
 	s.logger.Debug(
 		"sending a private message",
 		zap.String("public-key", types.EncodeHex(crypto.FromECDSAPub(recipient))),
@@ -182,7 +192,16 @@ func (s *MessageSender) SendPrivate(
 func (s *MessageSender) SendCommunityMessage(
 	ctx context.Context,
 	rawMessage RawMessage,
-) ([]byte, error) {
+) (_ []byte, sendErr error) {
+	ctx, span := tracing.StartSpanWithKind(ctx, "MessageSender.SendCommunityMessage", tracing.SpanKindProducer,
+		tracing.String("messageType", MessageTypeLabel(rawMessage.MessageType)),
+	)
+	defer func() {
+		observeSentMessage(rawMessage.MessageType, sendErr)
+		span.RecordError(sendErr)
+		span.End()
+	}()
+
 	s.logger.Debug(
 		"sending a community message",
 		zap.String("communityId", types.EncodeHex(rawMessage.CommunityID)),
@@ -197,7 +216,16 @@ func (s *MessageSender) SendCommunityMessage(
 func (s *MessageSender) SendPubsubTopicKey(
 	ctx context.Context,
 	rawMessage *RawMessage,
-) ([]byte, error) {
+) (_ []byte, sendErr error) {
+	ctx, span := tracing.StartSpanWithKind(ctx, "MessageSender.SendPubsubTopicKey", tracing.SpanKindProducer,
+		tracing.String("messageType", MessageTypeLabel(rawMessage.MessageType)),
+	)
+	defer func() {
+		observeSentMessage(rawMessage.MessageType, sendErr)
+		span.RecordError(sendErr)
+		span.End()
+	}()
+
 	s.logger.Debug(
 		"sending the protected topic key for a community",
 		zap.String("communityId", types.EncodeHex(rawMessage.CommunityID)),
@@ -232,7 +260,16 @@ func (s *MessageSender) SendGroup(
 	ctx context.Context,
 	recipients []*ecdsa.PublicKey,
 	rawMessage RawMessage,
-) ([]byte, error) {
+) (_ []byte, sendErr error) {
+	ctx, span := tracing.StartSpanWithKind(ctx, "MessageSender.SendGroup", tracing.SpanKindProducer,
+		tracing.String("messageType", MessageTypeLabel(rawMessage.MessageType)),
+	)
+	defer func() {
+		observeSentMessage(rawMessage.MessageType, sendErr)
+		span.RecordError(sendErr)
+		span.End()
+	}()
+
 	s.logger.Debug(
 		"sending a private group message",
 		zap.String("site", "SendGroup"),
@@ -496,7 +533,16 @@ func (s *MessageSender) SendPairInstallation(
 	ctx context.Context,
 	recipient *ecdsa.PublicKey,
 	rawMessage RawMessage,
-) ([]byte, error) {
+) (_ []byte, sendErr error) {
+	ctx, span := tracing.StartSpanWithKind(ctx, "MessageSender.SendPairInstallation", tracing.SpanKindProducer,
+		tracing.String("messageType", MessageTypeLabel(rawMessage.MessageType)),
+	)
+	defer func() {
+		observeSentMessage(rawMessage.MessageType, sendErr)
+		span.RecordError(sendErr)
+		span.End()
+	}()
+
 	s.logger.Debug("sending private message", zap.String("recipient", types.EncodeHex(crypto.FromECDSAPub(recipient))))
 
 	wrappedMessage, err := s.wrapMessageV1(&rawMessage)
@@ -636,7 +682,16 @@ func (s *MessageSender) SendPublic(
 	ctx context.Context,
 	chatName string,
 	rawMessage RawMessage,
-) ([]byte, error) {
+) (_ []byte, sendErr error) {
+	ctx, span := tracing.StartSpanWithKind(ctx, "MessageSender.SendPublic", tracing.SpanKindProducer,
+		tracing.String("messageType", MessageTypeLabel(rawMessage.MessageType)),
+	)
+	defer func() {
+		observeSentMessage(rawMessage.MessageType, sendErr)
+		span.RecordError(sendErr)
+		span.End()
+	}()
+
 	// Set sender
 	if rawMessage.Sender == nil {
 		rawMessage.Sender = s.identity
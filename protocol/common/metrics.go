@@ -0,0 +1,60 @@
+package common
+
+import (
+	prom "github.com/prometheus/client_golang/prometheus"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// By default the /metrics endpoint is not available.
+// It is exposed only if -metrics flag is set.
+
+const unknownMessageType = "UNKNOWN"
+
+var (
+	MessagesSentCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "status_messages_sent_total",
+		Help: "Number of messages sent, by type.",
+	}, []string{"type"})
+	MessagesSendFailuresCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "status_messages_send_failures_total",
+		Help: "Number of messages that failed to be sent, by type.",
+	}, []string{"type"})
+	MessagesReceivedCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "status_messages_received_total",
+		Help: "Number of messages received and handled, by type.",
+	}, []string{"type"})
+	MessagesHandlingFailuresCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "status_messages_handling_failures_total",
+		Help: "Number of received messages that failed to be handled, by type.",
+	}, []string{"type"})
+	MessagesDecodingFailuresCounter = prom.NewCounter(prom.CounterOpts{
+		Name: "status_messages_decoding_failures_total",
+		Help: "Number of received envelopes that could not be decoded.",
+	})
+)
+
+func init() {
+	prom.MustRegister(MessagesSentCounter)
+	prom.MustRegister(MessagesSendFailuresCounter)
+	prom.MustRegister(MessagesReceivedCounter)
+	prom.MustRegister(MessagesHandlingFailuresCounter)
+	prom.MustRegister(MessagesDecodingFailuresCounter)
+}
+
+// MessageTypeLabel returns the label of a message type, types unknown to this version
+// share a single label so that the cardinality of the metrics stays bounded
+func MessageTypeLabel(messageType protobuf.ApplicationMetadataMessage_Type) string {
+	if name, ok := protobuf.ApplicationMetadataMessage_Type_name[int32(messageType)]; ok {
+		return name
+	}
+	return unknownMessageType
+}
+
+func observeSentMessage(messageType protobuf.ApplicationMetadataMessage_Type, err error) {
+	if err != nil {
+		MessagesSendFailuresCounter.WithLabelValues(MessageTypeLabel(messageType)).Inc()
+		return
+	}
+	MessagesSentCounter.WithLabelValues(MessageTypeLabel(messageType)).Inc()
+}
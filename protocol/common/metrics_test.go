@@ -0,0 +1,14 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestMessageTypeLabel(t *testing.T) {
+	require.Equal(t, "CHAT_MESSAGE", MessageTypeLabel(protobuf.ApplicationMetadataMessage_CHAT_MESSAGE))
+	require.Equal(t, unknownMessageType, MessageTypeLabel(protobuf.ApplicationMetadataMessage_Type(100000)))
+}
@@ -0,0 +1,379 @@
+package communities
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrAuditLogBroken = errors.New("audit log chain is broken")
+
+// AuditLogEntry records a privileged change applied by the control node.
+// Each entry keeps the event signed by the member who made the change and
+// the hash of the previous entry, so altering or removing an entry breaks the chain.
+type AuditLogEntry struct {
+	CommunityID types.HexBytes                    `json:"communityId"`
+	Position    uint64                            `json:"position"`
+	Actor       string                            `json:"actor"`
+	Action      protobuf.CommunityEvent_EventType `json:"action"`
+	Target      string                            `json:"target"`
+	Clock       uint64                            `json:"clock"`
+	Timestamp   uint64                            `json:"timestamp"`
+	Payload     types.HexBytes                    `json:"payload"`
+	Signature   types.HexBytes                    `json:"signature"`
+	PrevHash    types.HexBytes                    `json:"prevHash"`
+	Hash        types.HexBytes                    `json:"hash"`
+}
+
+type AuditLogFilter struct {
+	Actor   string
+	Target  string
+	Actions []protobuf.CommunityEvent_EventType
+	// Only entries after the given position are returned, positions start at 1
+	Cursor uint64
+	Limit  int
+}
+
+type AuditLogExport struct {
+	CommunityID types.HexBytes   `json:"communityId"`
+	ExportedAt  uint64           `json:"exportedAt"`
+	Verified    bool             `json:"verified"`
+	Error       string           `json:"error,omitempty"`
+	Entries     []*AuditLogEntry `json:"entries"`
+}
+
+func newAuditLogEntry(communityID types.HexBytes, signedEvent *protobuf.SignedCommunityEvent, prevHash []byte, timestamp uint64) (*AuditLogEntry, error) {
+	event, err := communityEventFromProtobuf(signedEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := event.RecoverSigner()
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &AuditLogEntry{
+		CommunityID: communityID,
+		Actor:       common.PubkeyToHex(signer),
+		Action:      event.Type,
+		Target:      auditLogTarget(event),
+		Clock:       event.CommunityEventClock,
+		Timestamp:   timestamp,
+		Payload:     signedEvent.Payload,
+		Signature:   signedEvent.Signature,
+		PrevHash:    prevHash,
+	}
+	entry.Hash = entry.calculateHash()
+
+	return entry, nil
+}
+
+func auditLogEntryFromProtobuf(communityID types.HexBytes, entry *protobuf.CommunityAuditLogEntry) (*AuditLogEntry, error) {
+	if entry.Event == nil {
+		return nil, errors.New("audit log entry without event")
+	}
+	return newAuditLogEntry(communityID, entry.Event, entry.PrevHash, entry.Timestamp)
+}
+
+func (e *AuditLogEntry) ToProtobuf() *protobuf.CommunityAuditLogEntry {
+	return &protobuf.CommunityAuditLogEntry{
+		Event: &protobuf.SignedCommunityEvent{
+			Payload:   e.Payload,
+			Signature: e.Signature,
+		},
+		PrevHash:  e.PrevHash,
+		Timestamp: e.Timestamp,
+	}
+}
+
+// The hash covers the signed event, so actor, action, target and clock are covered as well
+func (e *AuditLogEntry) calculateHash() []byte {
+	timestamp := make([]byte, 8)
+	binary.BigEndian.PutUint64(timestamp, e.Timestamp)
+
+	return crypto.Keccak256(e.PrevHash, e.CommunityID, crypto.Keccak256(e.Payload), e.Signature, timestamp)
+}
+
+func auditLogTarget(event *CommunityEvent) string {
+	switch event.Type {
+	case protobuf.CommunityEvent_COMMUNITY_MEMBER_KICK,
+		protobuf.CommunityEvent_COMMUNITY_MEMBER_BAN,
+		protobuf.CommunityEvent_COMMUNITY_MEMBER_UNBAN,
+		protobuf.CommunityEvent_COMMUNITY_MEMBER_TIMEOUT,
+		protobuf.CommunityEvent_COMMUNITY_REQUEST_TO_JOIN_ACCEPT,
+		protobuf.CommunityEvent_COMMUNITY_REQUEST_TO_JOIN_REJECT,
+		protobuf.CommunityEvent_COMMUNITY_MEMBER_ROLE_ADD,
+		protobuf.CommunityEvent_COMMUNITY_MEMBER_ROLE_REMOVE,
+		protobuf.CommunityEvent_COMMUNITY_MEMBER_MESSAGES_DELETE:
+		return event.MemberToAction
+	case protobuf.CommunityEvent_COMMUNITY_MESSAGE_DELETE:
+		return event.MessageID
+	case protobuf.CommunityEvent_COMMUNITY_MEMBER_TOKEN_PERMISSION_CHANGE,
+		protobuf.CommunityEvent_COMMUNITY_MEMBER_TOKEN_PERMISSION_DELETE:
+		if event.TokenPermission != nil {
+			return event.TokenPermission.Id
+		}
+	case protobuf.CommunityEvent_COMMUNITY_CHANNEL_CREATE,
+		protobuf.CommunityEvent_COMMUNITY_CHANNEL_EDIT,
+		protobuf.CommunityEvent_COMMUNITY_CHANNEL_DELETE,
+		protobuf.CommunityEvent_COMMUNITY_CHANNEL_REORDER:
+		if event.ChannelData != nil {
+			return event.ChannelData.ChannelId
+		}
+	case protobuf.CommunityEvent_COMMUNITY_CATEGORY_CREATE,
+		protobuf.CommunityEvent_COMMUNITY_CATEGORY_EDIT,
+		protobuf.CommunityEvent_COMMUNITY_CATEGORY_DELETE,
+		protobuf.CommunityEvent_COMMUNITY_CATEGORY_REORDER:
+		if event.CategoryData != nil {
+			return event.CategoryData.CategoryId
+		}
+	case protobuf.CommunityEvent_COMMUNITY_TOKEN_ADD:
+		if event.TokenMetadata != nil {
+			return event.TokenMetadata.Symbol
+		}
+	case protobuf.CommunityEvent_COMMUNITY_INVITE_REVOKE:
+		if event.Invite != nil {
+			return event.Invite.InviteId
+		}
+	case protobuf.CommunityEvent_COMMUNITY_CALENDAR_EVENT_CHANGE,
+		protobuf.CommunityEvent_COMMUNITY_CALENDAR_EVENT_DELETE:
+		if event.CalendarEvent != nil {
+			return event.CalendarEvent.Id
+		}
+	}
+	return ""
+}
+
+// VerifyAuditLog checks that consecutive entries are chained, that every hash matches
+// its entry and that every event is signed by the recorded actor.
+// The first entry is trusted as the anchor of the chain.
+func VerifyAuditLog(communityID types.HexBytes, entries []*AuditLogEntry) error {
+	for i, entry := range entries {
+		if i > 0 && !bytes.Equal(entry.PrevHash, entries[i-1].Hash) {
+			return fmt.Errorf("%w: entry %d does not follow the previous one", ErrAuditLogBroken, i)
+		}
+
+		expected, err := newAuditLogEntry(communityID, &protobuf.SignedCommunityEvent{Payload: entry.Payload, Signature: entry.Signature}, entry.PrevHash, entry.Timestamp)
+		if err != nil {
+			return fmt.Errorf("%w: entry %d: %s", ErrAuditLogBroken, i, err)
+		}
+
+		if !bytes.Equal(expected.Hash, entry.Hash) ||
+			expected.Actor != entry.Actor ||
+			expected.Action != entry.Action ||
+			expected.Target != entry.Target ||
+			expected.Clock != entry.Clock {
+			return fmt.Errorf("%w: entry %d has been altered", ErrAuditLogBroken, i)
+		}
+	}
+	return nil
+}
+
+// addAuditLogEvent keeps a change applied directly by the control node for the audit log
+func (o *Community) addAuditLogEvent(event *CommunityEvent) {
+	o.auditLogEvents = append(o.auditLogEvents, *event)
+}
+
+func (o *Community) takeAuditLogEvents() []CommunityEvent {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	events := o.auditLogEvents
+	o.auditLogEvents = nil
+	return events
+}
+
+// saveAuditLog appends the changes applied by the control node to the audit log
+// and shares the new entries with privileged members
+func (m *Manager) saveAuditLog(community *Community) error {
+	events := community.takeAuditLogEvents()
+	if len(events) == 0 {
+		return nil
+	}
+
+	head, err := m.persistence.GetLastCommunityAuditLogEntry(community.ID())
+	if err != nil {
+		return err
+	}
+
+	var prevHash []byte
+	position := uint64(1)
+	if head != nil {
+		prevHash = head.Hash
+		position = head.Position + 1
+	}
+
+	timestamp := m.timesource.GetCurrentTime()
+	entries := make([]*AuditLogEntry, 0, len(events))
+	for i := range events {
+		event := &events[i]
+		// Changes made by the control node itself are signed by us
+		if len(event.Signature) == 0 {
+			event.Payload, err = proto.Marshal(event.ToProtobuf())
+			if err != nil {
+				return err
+			}
+			err = event.Sign(m.identity)
+			if err != nil {
+				return err
+			}
+		}
+
+		entry, err := newAuditLogEntry(community.ID(), &protobuf.SignedCommunityEvent{Payload: event.Payload, Signature: event.Signature}, prevHash, timestamp)
+		if err != nil {
+			return err
+		}
+		entry.Position = position
+
+		entries = append(entries, entry)
+		prevHash = entry.Hash
+		position++
+	}
+
+	err = m.persistence.SaveCommunityAuditLogEntries(entries)
+	if err != nil {
+		return err
+	}
+
+	skipMembers := make(map[string]struct{})
+	skipMembers[common.PubkeyToHex(&m.identity.PublicKey)] = struct{}{}
+
+	var receivers []*ecdsa.PublicKey
+	for _, members := range community.GetFilteredPrivilegedMembers(skipMembers) {
+		receivers = append(receivers, members...)
+	}
+	m.shareAuditLog(community, entries, receivers)
+
+	return nil
+}
+
+// shareAuditLogWithNewPrivilegedMembers sends the whole audit log to the members who just became privileged
+func (m *Manager) shareAuditLogWithNewPrivilegedMembers(community *Community, newPrivilegedMembers map[protobuf.CommunityMember_Roles][]*ecdsa.PublicKey) error {
+	var receivers []*ecdsa.PublicKey
+	for _, members := range newPrivilegedMembers {
+		receivers = append(receivers, members...)
+	}
+	if len(receivers) == 0 {
+		return nil
+	}
+
+	entries, err := m.persistence.GetCommunityAuditLog(community.ID(), &AuditLogFilter{})
+	if err != nil {
+		return err
+	}
+
+	m.shareAuditLog(community, entries, receivers)
+	return nil
+}
+
+func (m *Manager) shareAuditLog(community *Community, entries []*AuditLogEntry, receivers []*ecdsa.PublicKey) {
+	if len(entries) == 0 || len(receivers) == 0 {
+		return
+	}
+
+	auditLog := make([]*protobuf.CommunityAuditLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		auditLog = append(auditLog, entry.ToProtobuf())
+	}
+
+	m.publish(&Subscription{CommunityPrivilegedMemberSyncMessage: &CommunityPrivilegedMemberSyncMessage{
+		CommunityPrivateKey: community.PrivateKey(),
+		Receivers:           receivers,
+		CommunityPrivilegedUserSyncMessage: &protobuf.CommunityPrivilegedUserSyncMessage{
+			Type:        protobuf.CommunityPrivilegedUserSyncMessage_CONTROL_NODE_AUDIT_LOG,
+			CommunityId: community.ID(),
+			AuditLog:    auditLog,
+		},
+	}})
+}
+
+// HandleAuditLogPrivilegedUserSyncMessage stores audit log entries shared by the control node.
+// Entries we already have are skipped, the remaining ones must continue our local chain.
+func (m *Manager) HandleAuditLogPrivilegedUserSyncMessage(message *protobuf.CommunityPrivilegedUserSyncMessage, communityID types.HexBytes) ([]*AuditLogEntry, error) {
+	head, err := m.persistence.GetLastCommunityAuditLogEntry(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	newEntries := make([]*AuditLogEntry, 0, len(message.AuditLog))
+	for _, entryProto := range message.AuditLog {
+		entry, err := auditLogEntryFromProtobuf(communityID, entryProto)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(newEntries) == 0 {
+			exists, err := m.persistence.HasCommunityAuditLogEntry(communityID, entry.Hash)
+			if err != nil {
+				return nil, err
+			}
+			if exists {
+				continue
+			}
+
+			if head != nil && !bytes.Equal(entry.PrevHash, head.Hash) {
+				return nil, fmt.Errorf("%w: shared entries don't follow the local log", ErrAuditLogBroken)
+			}
+		}
+
+		newEntries = append(newEntries, entry)
+	}
+
+	err = VerifyAuditLog(communityID, newEntries)
+	if err != nil {
+		return nil, err
+	}
+
+	position := uint64(1)
+	if head != nil {
+		position = head.Position + 1
+	}
+	for _, entry := range newEntries {
+		entry.Position = position
+		position++
+	}
+
+	err = m.persistence.SaveCommunityAuditLogEntries(newEntries)
+	if err != nil {
+		return nil, err
+	}
+
+	return newEntries, nil
+}
+
+func (m *Manager) GetCommunityAuditLog(communityID types.HexBytes, filter *AuditLogFilter) ([]*AuditLogEntry, error) {
+	return m.persistence.GetCommunityAuditLog(communityID, filter)
+}
+
+// ExportCommunityAuditLog returns the whole audit log together with the result of its verification
+func (m *Manager) ExportCommunityAuditLog(communityID types.HexBytes) (*AuditLogExport, error) {
+	entries, err := m.persistence.GetCommunityAuditLog(communityID, &AuditLogFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	export := &AuditLogExport{
+		CommunityID: communityID,
+		ExportedAt:  m.timesource.GetCurrentTime(),
+		Verified:    true,
+		Entries:     entries,
+	}
+
+	err = VerifyAuditLog(communityID, entries)
+	if err != nil {
+		export.Verified = false
+		export.Error = err.Error()
+	}
+
+	return export, nil
+}
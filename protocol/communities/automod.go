@@ -0,0 +1,452 @@
+package communities
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"database/sql"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+// Upper bound of recent messages kept per member for rate and duplicate rules
+const maxAutomodHistory = 100
+
+// AutomodMessage is a community message as seen by the automod
+type AutomodMessage struct {
+	Author string
+	Text   string
+	Links  []string
+	// Timestamp in milliseconds
+	Timestamp uint64
+	// JoinedAt is the unix timestamp in seconds the author joined at, 0 if unknown
+	JoinedAt uint64
+}
+
+// AutomodVerdict is the action to take on a message matching a rule
+type AutomodVerdict struct {
+	RuleID          string                               `json:"ruleId"`
+	Type            protobuf.CommunityAutomodRule_Type   `json:"type"`
+	Action          protobuf.CommunityAutomodRule_Action `json:"action"`
+	TimeoutDuration uint32                               `json:"timeoutDuration"`
+	Reason          string                               `json:"reason"`
+}
+
+type automodRuleSet struct {
+	rules   *protobuf.CommunityAutomodRules
+	regexps map[string][]*regexp.Regexp
+	// longest window of rate and duplicate rules, in milliseconds
+	historyWindow uint64
+}
+
+type automodHistoryEntry struct {
+	timestamp uint64
+	hash      [sha256.Size]byte
+}
+
+// Automod evaluates community messages against the moderation rules configured by the control node.
+// Recent messages are only kept in memory, rate and duplicate rules start fresh on restart.
+type Automod struct {
+	mutex    sync.Mutex
+	ruleSets map[string]*automodRuleSet
+	history  map[string]map[string][]automodHistoryEntry
+}
+
+func NewAutomod() *Automod {
+	return &Automod{
+		ruleSets: make(map[string]*automodRuleSet),
+		history:  make(map[string]map[string][]automodHistoryEntry),
+	}
+}
+
+func newAutomodRuleSet(rules *protobuf.CommunityAutomodRules) (*automodRuleSet, error) {
+	ruleSet := &automodRuleSet{
+		rules:   rules,
+		regexps: make(map[string][]*regexp.Regexp),
+	}
+
+	for _, rule := range rules.Rules {
+		switch rule.Type {
+		case protobuf.CommunityAutomodRule_REGEX:
+			for _, pattern := range rule.Patterns {
+				re, err := regexp.Compile(pattern)
+				if err != nil {
+					return nil, errors.Wrapf(err, "invalid automod rule %s", rule.Id)
+				}
+				ruleSet.regexps[rule.Id] = append(ruleSet.regexps[rule.Id], re)
+			}
+		case protobuf.CommunityAutomodRule_MESSAGE_RATE, protobuf.CommunityAutomodRule_DUPLICATE_MESSAGES:
+			window := uint64(rule.Window) * 1000
+			if window > ruleSet.historyWindow {
+				ruleSet.historyWindow = window
+			}
+		}
+	}
+
+	return ruleSet, nil
+}
+
+func (a *Automod) setRules(communityID string, rules *protobuf.CommunityAutomodRules) error {
+	ruleSet, err := newAutomodRuleSet(rules)
+	if err != nil {
+		return err
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.ruleSets[communityID] = ruleSet
+	return nil
+}
+
+func (a *Automod) getRules(communityID string) (*protobuf.CommunityAutomodRules, bool) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	ruleSet, ok := a.ruleSets[communityID]
+	if !ok {
+		return nil, false
+	}
+	return ruleSet.rules, true
+}
+
+// Evaluate records the message and returns the most severe verdict of the matching rules,
+// nil if the message doesn't match any rule
+func (a *Automod) Evaluate(communityID string, message *AutomodMessage) *AutomodVerdict {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	ruleSet, ok := a.ruleSets[communityID]
+	if !ok || !ruleSet.rules.Enabled {
+		return nil
+	}
+
+	history := a.recordMessage(communityID, ruleSet.historyWindow, message)
+
+	var verdict *AutomodVerdict
+	for _, rule := range ruleSet.rules.Rules {
+		if verdict != nil && verdict.Action >= rule.Action {
+			continue
+		}
+
+		if ruleSet.matches(rule, message, history) {
+			verdict = &AutomodVerdict{
+				RuleID:          rule.Id,
+				Type:            rule.Type,
+				Action:          rule.Action,
+				TimeoutDuration: rule.TimeoutDuration,
+				Reason:          rule.Reason,
+			}
+		}
+	}
+
+	return verdict
+}
+
+func (a *Automod) recordMessage(communityID string, window uint64, message *AutomodMessage) []automodHistoryEntry {
+	if window == 0 {
+		return nil
+	}
+
+	members, ok := a.history[communityID]
+	if !ok {
+		members = make(map[string][]automodHistoryEntry)
+		a.history[communityID] = members
+	}
+
+	// Drop the entries that are out of every window, for all members so the history doesn't grow unbounded
+	for member, entries := range members {
+		i := 0
+		for i < len(entries) && entries[i].timestamp+window <= message.Timestamp {
+			i++
+		}
+		if i == len(entries) {
+			delete(members, member)
+		} else {
+			members[member] = entries[i:]
+		}
+	}
+
+	entries := append(members[message.Author], automodHistoryEntry{
+		timestamp: message.Timestamp,
+		hash:      sha256.Sum256([]byte(normalizeAutomodText(message.Text))),
+	})
+	if len(entries) > maxAutomodHistory {
+		entries = entries[len(entries)-maxAutomodHistory:]
+	}
+	members[message.Author] = entries
+
+	return entries
+}
+
+func (r *automodRuleSet) matches(rule *protobuf.CommunityAutomodRule, message *AutomodMessage, history []automodHistoryEntry) bool {
+	switch rule.Type {
+	case protobuf.CommunityAutomodRule_KEYWORDS:
+		text := strings.ToLower(message.Text)
+		for _, keyword := range rule.Patterns {
+			if keyword != "" && strings.Contains(text, strings.ToLower(keyword)) {
+				return true
+			}
+		}
+
+	case protobuf.CommunityAutomodRule_REGEX:
+		for _, re := range r.regexps[rule.Id] {
+			if re.MatchString(message.Text) {
+				return true
+			}
+		}
+
+	case protobuf.CommunityAutomodRule_LINK_DOMAINS:
+		for _, link := range message.Links {
+			domain := linkDomain(link)
+			if domain == "" || matchesDomain(domain, rule.AllowedDomains) {
+				continue
+			}
+			if len(rule.AllowedDomains) > 0 || matchesDomain(domain, rule.Patterns) {
+				return true
+			}
+		}
+
+	case protobuf.CommunityAutomodRule_MESSAGE_RATE:
+		return countAutomodHistory(history, message.Timestamp, rule.Window, nil) > int(rule.Threshold)
+
+	case protobuf.CommunityAutomodRule_DUPLICATE_MESSAGES:
+		if normalizeAutomodText(message.Text) == "" || len(history) == 0 {
+			return false
+		}
+		hash := history[len(history)-1].hash
+		return countAutomodHistory(history, message.Timestamp, rule.Window, &hash) > int(rule.Threshold)
+
+	case protobuf.CommunityAutomodRule_NEW_MEMBER:
+		return len(message.Links) > 0 && message.JoinedAt != 0 && message.JoinedAt+uint64(rule.Window) > message.Timestamp/1000
+	}
+
+	return false
+}
+
+func countAutomodHistory(history []automodHistoryEntry, now uint64, window uint32, hash *[sha256.Size]byte) int {
+	count := 0
+	for _, entry := range history {
+		if entry.timestamp+uint64(window)*1000 <= now {
+			continue
+		}
+		if hash != nil && entry.hash != *hash {
+			continue
+		}
+		count++
+	}
+	return count
+}
+
+func normalizeAutomodText(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
+
+func linkDomain(link string) string {
+	if !strings.Contains(link, "://") {
+		link = "https://" + link
+	}
+
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+}
+
+// matchesDomain returns whether the domain is one of the domains or one of their subdomains
+func matchesDomain(domain string, domains []string) bool {
+	for _, d := range domains {
+		d = strings.TrimPrefix(strings.ToLower(d), "www.")
+		if d != "" && (domain == d || strings.HasSuffix(domain, "."+d)) {
+			return true
+		}
+	}
+	return false
+}
+
+// AutomodRules returns the automod rules of the community,
+// they're empty unless we're the control node or a moderating member
+func (o *Community) AutomodRules() *protobuf.CommunityAutomodRules {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.config.CommunityDescription.AutomodRules == nil {
+		return &protobuf.CommunityAutomodRules{}
+	}
+	return proto.Clone(o.config.CommunityDescription.AutomodRules).(*protobuf.CommunityAutomodRules)
+}
+
+func (o *Community) setAutomodRules(rules *protobuf.CommunityAutomodRules) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !o.IsControlNode() {
+		return ErrNotControlNode
+	}
+
+	o.config.CommunityDescription.AutomodRules = rules
+	o.increaseClock()
+	return nil
+}
+
+// SetAutomodRules replaces the automod rules of the community.
+// They're stored in the community private data, encrypted so only moderating members can read them.
+func (m *Manager) SetAutomodRules(request *requests.SetCommunityAutomodRules) (*protobuf.CommunityAutomodRules, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !community.IsControlNode() {
+		return nil, ErrNotControlNode
+	}
+
+	existing := community.AutomodRules()
+
+	rules := &protobuf.CommunityAutomodRules{
+		Clock:   m.timesource.GetCurrentTime(),
+		Enabled: request.Enabled,
+		Rules:   request.Rules,
+	}
+	if rules.Clock <= existing.Clock {
+		rules.Clock = existing.Clock + 1
+	}
+
+	for _, rule := range rules.Rules {
+		if rule.Id == "" {
+			rule.Id = uuid.New().String()
+		}
+	}
+
+	// Compile first so invalid rules are never published
+	err = m.automod.setRules(community.IDString(), rules)
+	if err != nil {
+		return nil, err
+	}
+
+	err = community.setAutomodRules(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.saveAndPublish(community)
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// GetAutomodRules returns the automod rules of the community,
+// they're only known to the control node and moderating members
+func (m *Manager) GetAutomodRules(communityID types.HexBytes) (*protobuf.CommunityAutomodRules, error) {
+	community, err := m.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.getAutomodRules(community)
+}
+
+func (m *Manager) getAutomodRules(community *Community) (*protobuf.CommunityAutomodRules, error) {
+	rules := community.AutomodRules()
+
+	cached, ok := m.automod.getRules(community.IDString())
+	if ok && cached.Clock == rules.Clock {
+		return cached, nil
+	}
+
+	err := m.automod.setRules(community.IDString(), rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// EvaluateAutomod checks a message posted in the community against its automod rules
+func (m *Manager) EvaluateAutomod(community *Community, author *ecdsa.PublicKey, message *AutomodMessage) (*AutomodVerdict, error) {
+	rules, err := m.getAutomodRules(community)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rules.Enabled {
+		return nil, nil
+	}
+
+	message.Author = common.PubkeyToHex(author)
+
+	for _, rule := range rules.Rules {
+		if rule.Type != protobuf.CommunityAutomodRule_NEW_MEMBER {
+			continue
+		}
+
+		// The request to join tells when the member joined, the clock is in seconds
+		requestToJoin, err := m.persistence.GetRequestToJoinByPkAndCommunityID(message.Author, community.ID())
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+		if requestToJoin != nil && requestToJoin.State == RequestToJoinStateAccepted {
+			message.JoinedAt = requestToJoin.Clock
+		}
+		break
+	}
+
+	return m.automod.Evaluate(community.IDString(), message), nil
+}
+
+// ApplyAutomodVerdict times out or bans the author of a message matching an automod rule,
+// moderating members send a community event for the control node to apply.
+// Deleting the message is up to the caller.
+func (m *Manager) ApplyAutomodVerdict(community *Community, author *ecdsa.PublicKey, verdict *AutomodVerdict) (*Community, error) {
+	switch verdict.Action {
+	case protobuf.CommunityAutomodRule_TIMEOUT:
+		if community.IsTimedOut(author) {
+			return community, nil
+		}
+		_, err := community.TimeoutMember(author, &protobuf.CommunityTimeoutInfo{
+			ExpiresAt: m.timesource.GetCurrentTime()/1000 + uint64(verdict.TimeoutDuration),
+			Reason:    verdict.Reason,
+		})
+		if err != nil {
+			return nil, err
+		}
+	case protobuf.CommunityAutomodRule_BAN:
+		_, err := community.BanUserFromCommunity(author, &protobuf.CommunityBanInfo{
+			DeleteAllMessages: true,
+			Reason:            verdict.Reason,
+		})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return community, nil
+	}
+
+	err := m.saveAndPublish(community)
+	if err != nil {
+		return nil, err
+	}
+
+	m.logger.Info("automod action applied",
+		zap.String("communityID", community.IDString()),
+		zap.String("member", common.PubkeyToHex(author)),
+		zap.String("rule", verdict.RuleID),
+		zap.Stringer("action", verdict.Action))
+
+	return community, nil
+}
@@ -0,0 +1,110 @@
+package communities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const automodCommunityID = "0x01"
+
+func newTestAutomod(t *testing.T, rules ...*protobuf.CommunityAutomodRule) *Automod {
+	automod := NewAutomod()
+	err := automod.setRules(automodCommunityID, &protobuf.CommunityAutomodRules{Enabled: true, Rules: rules})
+	require.NoError(t, err)
+	return automod
+}
+
+func TestAutomodKeywordsAndRegex(t *testing.T) {
+	automod := newTestAutomod(t,
+		&protobuf.CommunityAutomodRule{Id: "keywords", Type: protobuf.CommunityAutomodRule_KEYWORDS, Patterns: []string{"Free Airdrop"}, Action: protobuf.CommunityAutomodRule_DELETE},
+		&protobuf.CommunityAutomodRule{Id: "regex", Type: protobuf.CommunityAutomodRule_REGEX, Patterns: []string{`(?i)seed\s+phrase`}, Action: protobuf.CommunityAutomodRule_BAN, Reason: "phishing"},
+	)
+
+	require.Nil(t, automod.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Text: "hello"}))
+
+	verdict := automod.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Text: "claim your free airdrop"})
+	require.NotNil(t, verdict)
+	require.Equal(t, "keywords", verdict.RuleID)
+	require.Equal(t, protobuf.CommunityAutomodRule_DELETE, verdict.Action)
+
+	// the most severe action wins
+	verdict = automod.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Text: "free airdrop, just send your SEED  phrase"})
+	require.NotNil(t, verdict)
+	require.Equal(t, "regex", verdict.RuleID)
+	require.Equal(t, protobuf.CommunityAutomodRule_BAN, verdict.Action)
+	require.Equal(t, "phishing", verdict.Reason)
+
+	require.Error(t, automod.setRules(automodCommunityID, &protobuf.CommunityAutomodRules{Rules: []*protobuf.CommunityAutomodRule{
+		{Id: "invalid", Type: protobuf.CommunityAutomodRule_REGEX, Patterns: []string{"("}},
+	}}))
+}
+
+func TestAutomodLinkDomains(t *testing.T) {
+	denyList := newTestAutomod(t,
+		&protobuf.CommunityAutomodRule{Id: "deny", Type: protobuf.CommunityAutomodRule_LINK_DOMAINS, Patterns: []string{"scam.xyz"}, Action: protobuf.CommunityAutomodRule_DELETE},
+	)
+	require.NotNil(t, denyList.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Links: []string{"https://www.claim.scam.xyz/airdrop"}}))
+	require.NotNil(t, denyList.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Links: []string{"scam.xyz"}}))
+	require.Nil(t, denyList.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Links: []string{"https://notscam.xyz"}}))
+
+	allowList := newTestAutomod(t,
+		&protobuf.CommunityAutomodRule{Id: "allow", Type: protobuf.CommunityAutomodRule_LINK_DOMAINS, AllowedDomains: []string{"status.app"}, Action: protobuf.CommunityAutomodRule_DELETE},
+	)
+	require.Nil(t, allowList.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Links: []string{"https://status.app/c/abc", "https://www.status.app"}}))
+	require.NotNil(t, allowList.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Links: []string{"https://status.app", "https://example.com"}}))
+}
+
+func TestAutomodMessageRateAndDuplicates(t *testing.T) {
+	automod := newTestAutomod(t,
+		&protobuf.CommunityAutomodRule{Id: "rate", Type: protobuf.CommunityAutomodRule_MESSAGE_RATE, Threshold: 3, Window: 10, Action: protobuf.CommunityAutomodRule_TIMEOUT, TimeoutDuration: 60},
+		&protobuf.CommunityAutomodRule{Id: "duplicates", Type: protobuf.CommunityAutomodRule_DUPLICATE_MESSAGES, Threshold: 1, Window: 60, Action: protobuf.CommunityAutomodRule_DELETE},
+	)
+
+	now := uint64(1000000)
+	require.Nil(t, automod.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Text: "buy now", Timestamp: now}))
+
+	// the same text, whitespace and case aside
+	verdict := automod.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Text: "BUY   now ", Timestamp: now + 1000})
+	require.NotNil(t, verdict)
+	require.Equal(t, "duplicates", verdict.RuleID)
+
+	// other members aren't affected
+	require.Nil(t, automod.Evaluate(automodCommunityID, &AutomodMessage{Author: "b", Text: "buy now", Timestamp: now + 1000}))
+
+	require.Nil(t, automod.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Text: "hello", Timestamp: now + 2000}))
+	verdict = automod.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Text: "hello again", Timestamp: now + 3000})
+	require.NotNil(t, verdict)
+	require.Equal(t, "rate", verdict.RuleID)
+	require.Equal(t, uint32(60), verdict.TimeoutDuration)
+
+	// once the window is over, both the rate and the duplicates are reset
+	require.Nil(t, automod.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Text: "buy now", Timestamp: now + 61000}))
+	require.Len(t, automod.history[automodCommunityID], 1)
+}
+
+func TestAutomodNewMember(t *testing.T) {
+	automod := newTestAutomod(t,
+		&protobuf.CommunityAutomodRule{Id: "new-member", Type: protobuf.CommunityAutomodRule_NEW_MEMBER, Window: 3600, Action: protobuf.CommunityAutomodRule_DELETE},
+	)
+
+	now := uint64(1700000000)
+	links := []string{"https://example.com"}
+
+	require.NotNil(t, automod.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Links: links, Timestamp: now * 1000, JoinedAt: now - 60}))
+	require.Nil(t, automod.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Text: "hi", Timestamp: now * 1000, JoinedAt: now - 60}))
+	require.Nil(t, automod.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Links: links, Timestamp: now * 1000, JoinedAt: now - 7200}))
+	require.Nil(t, automod.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Links: links, Timestamp: now * 1000}))
+}
+
+func TestAutomodDisabled(t *testing.T) {
+	automod := NewAutomod()
+	require.NoError(t, automod.setRules(automodCommunityID, &protobuf.CommunityAutomodRules{Rules: []*protobuf.CommunityAutomodRule{
+		{Id: "keywords", Type: protobuf.CommunityAutomodRule_KEYWORDS, Patterns: []string{"spam"}, Action: protobuf.CommunityAutomodRule_DELETE},
+	}}))
+
+	require.Nil(t, automod.Evaluate(automodCommunityID, &AutomodMessage{Author: "a", Text: "spam"}))
+	require.Nil(t, automod.Evaluate("0x02", &AutomodMessage{Author: "a", Text: "spam"}))
+}
@@ -0,0 +1,518 @@
+package communities
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+var ErrCalendarEventNotFound = errors.New("community calendar event not found")
+var ErrCalendarEventInvalid = errors.New("invalid community calendar event")
+var ErrCalendarEventEnded = errors.New("community calendar event has ended")
+var ErrCalendarEventAttendanceNotAllowed = errors.New("token requirements to attend the community calendar event not satisfied")
+
+// Ended events are kept for a week, so that members can still look them up
+const calendarEventRetention = 7 * 24 * time.Hour
+
+// CalendarEventRsvp is the answer of a member to a calendar event.
+// The control node keeps the ones of every member to count them,
+// members keep their own to be reminded of the event.
+type CalendarEventRsvp struct {
+	CommunityID types.HexBytes                             `json:"communityId"`
+	EventID     string                                     `json:"eventId"`
+	Member      string                                     `json:"member"`
+	Status      protobuf.CommunityCalendarEventRsvp_Status `json:"status"`
+	Clock       uint64                                     `json:"clock"`
+	// RemindedStartTime is the start time of the event the member was reminded of,
+	// rescheduled events are reminded again
+	RemindedStartTime uint64 `json:"remindedStartTime"`
+}
+
+// CalendarEventReminder is an upcoming event the member RSVP'd to
+type CalendarEventReminder struct {
+	Community     *Community
+	CalendarEvent *protobuf.CommunityCalendarEvent
+	Rsvp          *CalendarEventRsvp
+}
+
+func validateCalendarEvent(calendarEvent *protobuf.CommunityCalendarEvent) error {
+	if len(calendarEvent.Id) == 0 || len(calendarEvent.Title) == 0 || calendarEvent.StartTime == 0 {
+		return ErrCalendarEventInvalid
+	}
+
+	if calendarEvent.EndTime != 0 && calendarEvent.EndTime < calendarEvent.StartTime {
+		return ErrCalendarEventInvalid
+	}
+
+	for _, permission := range calendarEvent.AttendancePermissions {
+		if permission == nil || len(permission.TokenCriteria) == 0 {
+			return ErrCalendarEventInvalid
+		}
+	}
+
+	return nil
+}
+
+// calendarEventEnded takes the current time in unix seconds,
+// events without an end time are over once they started
+func calendarEventEnded(calendarEvent *protobuf.CommunityCalendarEvent, now uint64) bool {
+	endTime := calendarEvent.EndTime
+	if endTime == 0 {
+		endTime = calendarEvent.StartTime
+	}
+	return endTime <= now
+}
+
+func (o *Community) CalendarEvents() map[string]*protobuf.CommunityCalendarEvent {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	calendarEvents := make(map[string]*protobuf.CommunityCalendarEvent, len(o.config.CommunityDescription.CalendarEvents))
+	for id, calendarEvent := range o.config.CommunityDescription.CalendarEvents {
+		calendarEvents[id] = calendarEvent
+	}
+	return calendarEvents
+}
+
+func (o *Community) CalendarEvent(eventID string) *protobuf.CommunityCalendarEvent {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	return o.config.CommunityDescription.CalendarEvents[eventID]
+}
+
+func (o *Community) UpsertCalendarEvent(calendarEvent *protobuf.CommunityCalendarEvent) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !(o.IsControlNode() || o.hasPermissionToSendCommunityEvent(protobuf.CommunityEvent_COMMUNITY_CALENDAR_EVENT_CHANGE)) {
+		return nil, ErrNotAuthorized
+	}
+
+	err := validateCalendarEvent(calendarEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	if calendarEvent.ChatId != "" && o.config.CommunityDescription.Chats[calendarEvent.ChatId] == nil {
+		return nil, ErrChatNotFound
+	}
+
+	if o.IsControlNode() {
+		o.upsertCalendarEvent(calendarEvent)
+		o.addAuditLogEvent(o.ToCalendarEventChangeCommunityEvent(calendarEvent))
+		o.increaseClock()
+	} else {
+		err := o.addNewCommunityEvent(o.ToCalendarEventChangeCommunityEvent(calendarEvent))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return o.config.CommunityDescription, nil
+}
+
+func (o *Community) DeleteCalendarEvent(eventID string) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !(o.IsControlNode() || o.hasPermissionToSendCommunityEvent(protobuf.CommunityEvent_COMMUNITY_CALENDAR_EVENT_DELETE)) {
+		return nil, ErrNotAuthorized
+	}
+
+	calendarEvent, ok := o.config.CommunityDescription.CalendarEvents[eventID]
+	if !ok {
+		return nil, ErrCalendarEventNotFound
+	}
+
+	if o.IsControlNode() {
+		o.deleteCalendarEvent(eventID)
+		o.addAuditLogEvent(o.ToCalendarEventDeleteCommunityEvent(calendarEvent))
+		o.increaseClock()
+	} else {
+		err := o.addNewCommunityEvent(o.ToCalendarEventDeleteCommunityEvent(calendarEvent))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return o.config.CommunityDescription, nil
+}
+
+// setCalendarEventRsvpCounts is called by the control node once it counted
+// the RSVPs of members, it returns whether the counts changed.
+// The clock is left to the caller, so that the counts of all events are published at once.
+func (o *Community) setCalendarEventRsvpCounts(eventID string, going uint32, interested uint32) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	calendarEvent, ok := o.config.CommunityDescription.CalendarEvents[eventID]
+	if !ok || (calendarEvent.GoingCount == going && calendarEvent.InterestedCount == interested) {
+		return false
+	}
+
+	calendarEvent.GoingCount = going
+	calendarEvent.InterestedCount = interested
+	return true
+}
+
+// upsertCalendarEvent keeps the RSVP counts, which are only set by the control node,
+// and prunes the events that ended more than calendarEventRetention ago
+func (o *Community) upsertCalendarEvent(calendarEvent *protobuf.CommunityCalendarEvent) {
+	now := o.timesource.GetCurrentTime() / 1000
+	retention := uint64(calendarEventRetention / time.Second)
+	for id, existing := range o.config.CommunityDescription.CalendarEvents {
+		if now > retention && calendarEventEnded(existing, now-retention) {
+			delete(o.config.CommunityDescription.CalendarEvents, id)
+		}
+	}
+
+	existing, ok := o.config.CommunityDescription.CalendarEvents[calendarEvent.Id]
+	if ok && existing.Clock > calendarEvent.Clock {
+		return
+	}
+
+	calendarEvent = proto.Clone(calendarEvent).(*protobuf.CommunityCalendarEvent)
+	calendarEvent.GoingCount = 0
+	calendarEvent.InterestedCount = 0
+	if ok {
+		calendarEvent.GoingCount = existing.GoingCount
+		calendarEvent.InterestedCount = existing.InterestedCount
+	}
+
+	if o.config.CommunityDescription.CalendarEvents == nil {
+		o.config.CommunityDescription.CalendarEvents = make(map[string]*protobuf.CommunityCalendarEvent)
+	}
+	o.config.CommunityDescription.CalendarEvents[calendarEvent.Id] = calendarEvent
+}
+
+func (o *Community) deleteCalendarEvent(eventID string) {
+	delete(o.config.CommunityDescription.CalendarEvents, eventID)
+}
+
+func (m *Manager) CreateCalendarEvent(request *requests.CreateCommunityCalendarEvent) (*Community, *protobuf.CommunityCalendarEvent, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	calendarEvent := request.ToCalendarEvent()
+	calendarEvent.Id = uuid.New().String()
+	calendarEvent.Clock = m.timesource.GetCurrentTime()
+	calendarEvent.Creator = common.PubkeyToHex(&m.identity.PublicKey)
+	for _, permission := range calendarEvent.AttendancePermissions {
+		permission.Id = calendarEvent.Id
+	}
+
+	_, err = community.UpsertCalendarEvent(calendarEvent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.saveAndPublish(community)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return community, calendarEvent, nil
+}
+
+func (m *Manager) EditCalendarEvent(request *requests.EditCommunityCalendarEvent) (*Community, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := community.CalendarEvent(request.EventID)
+	if existing == nil {
+		return nil, ErrCalendarEventNotFound
+	}
+
+	calendarEvent := request.ToCalendarEvent()
+	calendarEvent.Id = existing.Id
+	calendarEvent.Creator = existing.Creator
+	calendarEvent.Clock = m.timesource.GetCurrentTime()
+	for _, permission := range calendarEvent.AttendancePermissions {
+		permission.Id = calendarEvent.Id
+	}
+
+	_, err = community.UpsertCalendarEvent(calendarEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.saveAndPublish(community)
+	if err != nil {
+		return nil, err
+	}
+
+	return community, nil
+}
+
+func (m *Manager) DeleteCalendarEvent(request *requests.DeleteCommunityCalendarEvent) (*Community, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = community.DeleteCalendarEvent(request.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.saveAndPublish(community)
+	if err != nil {
+		return nil, err
+	}
+
+	return community, nil
+}
+
+// CreateCalendarEventRsvp validates and records the RSVP of the user,
+// the returned message is to be sent to the control node, unless the user is the control node
+func (m *Manager) CreateCalendarEventRsvp(request *requests.RsvpCommunityCalendarEvent) (*Community, *protobuf.CommunityCalendarEventRsvp, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !community.HasMember(&m.identity.PublicKey) {
+		return nil, nil, ErrMemberNotFound
+	}
+
+	calendarEvent := community.CalendarEvent(request.EventID)
+	if calendarEvent == nil {
+		return nil, nil, ErrCalendarEventNotFound
+	}
+
+	if request.Status != protobuf.CommunityCalendarEventRsvp_NOT_GOING && calendarEventEnded(calendarEvent, m.timesource.GetCurrentTime()/1000) {
+		return nil, nil, ErrCalendarEventEnded
+	}
+
+	member := common.PubkeyToHex(&m.identity.PublicKey)
+
+	// The control node checks it as well, but we'd rather not be reminded of an event we can't attend
+	if request.Status == protobuf.CommunityCalendarEventRsvp_GOING && !community.IsControlNode() {
+		err = m.checkCalendarEventAttendance(community, calendarEvent, member)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	rsvp := &CalendarEventRsvp{
+		CommunityID: community.ID(),
+		EventID:     request.EventID,
+		Member:      member,
+		Status:      request.Status,
+		Clock:       m.timesource.GetCurrentTime(),
+	}
+
+	_, err = m.persistence.SaveCalendarEventRsvp(rsvp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if community.IsControlNode() {
+		err = m.updateCalendarEventRsvpCounts(community)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return community, &protobuf.CommunityCalendarEventRsvp{
+		Clock:       rsvp.Clock,
+		CommunityId: community.ID(),
+		EventId:     rsvp.EventID,
+		Status:      rsvp.Status,
+	}, nil
+}
+
+// checkCalendarEventAttendance returns ErrCalendarEventAttendanceNotAllowed unless the addresses
+// the member shared with the community satisfy one of the attendance permissions of the event
+func (m *Manager) checkCalendarEventAttendance(community *Community, calendarEvent *protobuf.CommunityCalendarEvent, member string) error {
+	if len(calendarEvent.AttendancePermissions) == 0 {
+		return nil
+	}
+
+	revealedAccounts, err := m.GetRevealedAddresses(community.ID(), member)
+	if err != nil {
+		return err
+	}
+
+	permissions := make([]*CommunityTokenPermission, 0, len(calendarEvent.AttendancePermissions))
+	for _, permission := range calendarEvent.AttendancePermissions {
+		permissions = append(permissions, NewCommunityTokenPermission(permission))
+	}
+
+	permissionResponse, err := m.PermissionChecker.CheckPermissions(permissions, revealedAccountsToAccountsAndChainIDsCombination(revealedAccounts), true)
+	if err != nil {
+		return err
+	}
+	if !permissionResponse.Satisfied {
+		return ErrCalendarEventAttendanceNotAllowed
+	}
+
+	return nil
+}
+
+// HandleCommunityCalendarEventRsvp records the RSVP of a member on the control node.
+// Members going to a token gated event need the addresses they shared with the community
+// to satisfy one of its permissions. The counts of the event are only updated by
+// UpdateCalendarEventRsvpCounts, so that the community isn't published on every RSVP.
+func (m *Manager) HandleCommunityCalendarEventRsvp(signer *ecdsa.PublicKey, rsvpProto *protobuf.CommunityCalendarEventRsvp) error {
+	community, err := m.GetByID(rsvpProto.CommunityId)
+	if err != nil {
+		return err
+	}
+
+	if !community.IsControlNode() {
+		return ErrNotControlNode
+	}
+
+	if !community.HasMember(signer) {
+		return ErrMemberNotFound
+	}
+
+	calendarEvent := community.CalendarEvent(rsvpProto.EventId)
+	if calendarEvent == nil {
+		return ErrCalendarEventNotFound
+	}
+
+	member := common.PubkeyToHex(signer)
+
+	if rsvpProto.Status == protobuf.CommunityCalendarEventRsvp_GOING {
+		err = m.checkCalendarEventAttendance(community, calendarEvent, member)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = m.persistence.SaveCalendarEventRsvp(&CalendarEventRsvp{
+		CommunityID: community.ID(),
+		EventID:     rsvpProto.EventId,
+		Member:      member,
+		Status:      rsvpProto.Status,
+		Clock:       rsvpProto.Clock,
+	})
+	if err != nil {
+		return err
+	}
+
+	m.logger.Debug("community calendar event rsvp", zap.String("eventID", rsvpProto.EventId), zap.String("member", member))
+
+	return nil
+}
+
+// HandleCommunityCalendarEventRsvpRejected drops our RSVP the control node didn't accept,
+// unless we answered again since. Returns whether the RSVP was dropped.
+func (m *Manager) HandleCommunityCalendarEventRsvpRejected(signer *ecdsa.PublicKey, rejected *protobuf.CommunityCalendarEventRsvpRejected) (*Community, bool, error) {
+	community, err := m.GetByID(rejected.CommunityId)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !common.IsPubKeyEqual(community.ControlNode(), signer) {
+		return nil, false, ErrNotAuthorized
+	}
+
+	deleted, err := m.persistence.DeleteCalendarEventRsvp(community.ID(), rejected.EventId, common.PubkeyToHex(&m.identity.PublicKey), rejected.Clock)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return community, deleted, nil
+}
+
+// UpdateCalendarEventRsvpCounts counts the RSVPs to the events of a community we control,
+// the community is only published if any count changed
+func (m *Manager) UpdateCalendarEventRsvpCounts(communityID types.HexBytes) error {
+	community, err := m.GetByID(communityID)
+	if err != nil {
+		return err
+	}
+
+	if !community.IsControlNode() {
+		return ErrNotControlNode
+	}
+
+	return m.updateCalendarEventRsvpCounts(community)
+}
+
+func (m *Manager) updateCalendarEventRsvpCounts(community *Community) error {
+	changed := false
+	for eventID := range community.CalendarEvents() {
+		going, interested, err := m.persistence.GetCalendarEventRsvpCounts(community.ID(), eventID)
+		if err != nil {
+			return err
+		}
+
+		if community.setCalendarEventRsvpCounts(eventID, going, interested) {
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	community.increaseClock()
+	return m.saveAndPublish(community)
+}
+
+// GetCalendarEventRsvp returns the RSVP of the user to the event, if any
+func (m *Manager) GetCalendarEventRsvp(communityID types.HexBytes, eventID string) (*CalendarEventRsvp, error) {
+	return m.persistence.GetCalendarEventRsvp(communityID, eventID, common.PubkeyToHex(&m.identity.PublicKey))
+}
+
+// CalendarEventReminders returns the events of joined communities the user is going to,
+// or interested in, starting within the given time and that haven't been reminded yet
+func (m *Manager) CalendarEventReminders(within time.Duration) ([]*CalendarEventReminder, error) {
+	rsvps, err := m.persistence.GetPendingCalendarEventRsvps(common.PubkeyToHex(&m.identity.PublicKey))
+	if err != nil {
+		return nil, err
+	}
+
+	now := m.timesource.GetCurrentTime() / 1000
+	remindFrom := now + uint64(within/time.Second)
+
+	var reminders []*CalendarEventReminder
+	for _, rsvp := range rsvps {
+		community, err := m.GetByID(rsvp.CommunityID)
+		if err == ErrOrgNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !community.Joined() {
+			continue
+		}
+
+		calendarEvent := community.CalendarEvent(rsvp.EventID)
+		if calendarEvent == nil || calendarEvent.StartTime <= now || calendarEvent.StartTime > remindFrom ||
+			calendarEvent.StartTime == rsvp.RemindedStartTime {
+			continue
+		}
+
+		reminders = append(reminders, &CalendarEventReminder{
+			Community:     community,
+			CalendarEvent: calendarEvent,
+			Rsvp:          rsvp,
+		})
+	}
+
+	return reminders, nil
+}
+
+func (m *Manager) SetCalendarEventReminded(communityID types.HexBytes, eventID string, startTime uint64) error {
+	return m.persistence.SetCalendarEventRsvpReminded(communityID, eventID, common.PubkeyToHex(&m.identity.PublicKey), startTime)
+}
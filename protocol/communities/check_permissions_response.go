@@ -56,16 +56,7 @@ func calculateRolesAndHighestRole(permissions map[string]*PermissionTokenCriteri
 			}
 		}
 
-		satisfied := true
-		for _, tr := range p.TokenRequirements {
-			if !tr.Satisfied {
-				satisfied = false
-				break
-			}
-
-		}
-
-		if satisfied {
+		if p.IsSatisfied() {
 			byRoleMap[p.Role].Satisfied = true
 			// we prepend
 			byRoleMap[p.Role].Criteria = append([]*PermissionTokenCriteriaResult{p}, byRoleMap[p.Role].Criteria...)
@@ -124,6 +115,26 @@ type PermissionTokenCriteriaResult struct {
 	Role              protobuf.CommunityTokenPermission_Type `json:"roles"`
 	TokenRequirements []TokenRequirementResponse             `json:"tokenRequirement"`
 	Criteria          []bool                                 `json:"criteria"`
+	Expression        *ClauseResult                          `json:"expression,omitempty"`
+}
+
+// IsSatisfied tells whether the permission is satisfied, according to its expression
+// if there is one, or else requiring every token criteria
+func (p *PermissionTokenCriteriaResult) IsSatisfied() bool {
+	if p.Expression != nil {
+		return p.Expression.Satisfied
+	}
+	for _, tr := range p.TokenRequirements {
+		if !tr.Satisfied {
+			return false
+		}
+	}
+	for _, criteria := range p.Criteria {
+		if !criteria {
+			return false
+		}
+	}
+	return true
 }
 
 type AccountChainIDsCombination struct {
@@ -139,14 +150,7 @@ func (c *CheckPermissionsResponse) calculateSatisfied() {
 
 	c.Satisfied = false
 	for _, p := range c.Permissions {
-		satisfied := true
-		for _, criteria := range p.Criteria {
-			if !criteria {
-				satisfied = false
-				break
-			}
-		}
-		if satisfied {
+		if p.IsSatisfied() {
 			c.Satisfied = true
 			return
 		}
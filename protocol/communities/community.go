@@ -63,6 +63,9 @@ type Community struct {
 	mutex      sync.Mutex
 	timesource common.TimeSource
 	encryptor  DescriptionEncryptor
+
+	// changes applied by the control node, not yet written to the audit log
+	auditLogEvents []CommunityEvent
 }
 
 func New(config Config, timesource common.TimeSource, encryptor DescriptionEncryptor) (*Community, error) {
@@ -539,6 +542,7 @@ func (o *Community) CreateChat(chatID string, chat *protobuf.CommunityChat) (*Co
 	changes.ChatsAdded[chatID] = chat
 
 	if o.IsControlNode() {
+		o.addAuditLogEvent(o.ToCreateChannelCommunityEvent(chatID, chat))
 		o.increaseClock()
 	} else {
 		err := o.addNewCommunityEvent(o.ToCreateChannelCommunityEvent(chatID, chat))
@@ -568,6 +572,7 @@ func (o *Community) EditChat(chatID string, chat *protobuf.CommunityChat) (*Comm
 	}
 
 	if o.IsControlNode() {
+		o.addAuditLogEvent(o.ToEditChannelCommunityEvent(chatID, chat))
 		o.increaseClock()
 	} else {
 		err := o.addNewCommunityEvent(o.ToEditChannelCommunityEvent(chatID, chat))
@@ -590,6 +595,7 @@ func (o *Community) DeleteChat(chatID string) (*CommunityChanges, error) {
 	changes := o.deleteChat(chatID)
 
 	if o.IsControlNode() {
+		o.addAuditLogEvent(o.ToDeleteChannelCommunityEvent(chatID))
 		o.increaseClock()
 	} else {
 		err := o.addNewCommunityEvent(o.ToDeleteChannelCommunityEvent(chatID))
@@ -759,6 +765,7 @@ func (o *Community) RemoveUserFromOrg(pk *ecdsa.PublicKey) (*protobuf.CommunityD
 
 	if o.IsControlNode() {
 		o.removeMemberFromOrg(pk)
+		o.addAuditLogEvent(o.ToKickCommunityMemberCommunityEvent(common.PubkeyToHex(pk)))
 		o.increaseClock()
 	} else {
 		err := o.addNewCommunityEvent(o.ToKickCommunityMemberCommunityEvent(common.PubkeyToHex(pk)))
@@ -812,6 +819,7 @@ func (o *Community) AddCommunityTokensMetadata(token *protobuf.CommunityTokenMet
 	o.config.CommunityDescription.CommunityTokensMetadata = append(o.config.CommunityDescription.CommunityTokensMetadata, token)
 
 	if o.IsControlNode() {
+		o.addAuditLogEvent(o.ToAddTokenMetadataCommunityEvent(token))
 		o.increaseClock()
 	} else {
 		err := o.addNewCommunityEvent(o.ToAddTokenMetadataCommunityEvent(token))
@@ -833,6 +841,7 @@ func (o *Community) UnbanUserFromCommunity(pk *ecdsa.PublicKey) (*protobuf.Commu
 
 	if o.IsControlNode() {
 		o.unbanUserFromCommunity(pk)
+		o.addAuditLogEvent(o.ToUnbanCommunityMemberCommunityEvent(common.PubkeyToHex(pk)))
 		o.increaseClock()
 	} else {
 		err := o.addNewCommunityEvent(o.ToUnbanCommunityMemberCommunityEvent(common.PubkeyToHex(pk)))
@@ -858,6 +867,7 @@ func (o *Community) BanUserFromCommunity(pk *ecdsa.PublicKey, communityBanInfo *
 
 	if o.IsControlNode() {
 		o.banUserFromCommunity(pk, communityBanInfo)
+		o.addAuditLogEvent(o.ToBanCommunityMemberCommunityEvent(common.PubkeyToHex(pk)))
 		o.increaseClock()
 	} else {
 		err := o.addNewCommunityEvent(o.ToBanCommunityMemberCommunityEvent(common.PubkeyToHex(pk)))
@@ -869,6 +879,53 @@ func (o *Community) BanUserFromCommunity(pk *ecdsa.PublicKey, communityBanInfo *
 	return o.config.CommunityDescription, nil
 }
 
+// TimeoutMember prevents a member from posting until timeoutInfo.ExpiresAt (unix seconds),
+// an expiry in the past lifts the timeout
+func (o *Community) TimeoutMember(pk *ecdsa.PublicKey, timeoutInfo *protobuf.CommunityTimeoutInfo) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !(o.IsControlNode() || o.hasPermissionToSendCommunityEvent(protobuf.CommunityEvent_COMMUNITY_MEMBER_TIMEOUT)) {
+		return nil, ErrNotAuthorized
+	}
+
+	if !o.IsControlNode() && !canRolesKickOrBanMember(o.rolesOf(o.config.MemberIdentity), o.rolesOf(pk)) {
+		return nil, ErrNotAuthorized
+	}
+
+	if !o.hasMember(pk) {
+		return nil, ErrMemberNotFound
+	}
+
+	if o.IsControlNode() {
+		o.timeoutMember(pk, timeoutInfo)
+		o.addAuditLogEvent(o.ToTimeoutCommunityMemberCommunityEvent(common.PubkeyToHex(pk), timeoutInfo))
+		o.increaseClock()
+	} else {
+		err := o.addNewCommunityEvent(o.ToTimeoutCommunityMemberCommunityEvent(common.PubkeyToHex(pk), timeoutInfo))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return o.config.CommunityDescription, nil
+}
+
+func (o *Community) IsTimedOut(pk *ecdsa.PublicKey) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	return o.isTimedOut(pk)
+}
+
+func (o *Community) isTimedOut(pk *ecdsa.PublicKey) bool {
+	timeoutInfo, ok := o.config.CommunityDescription.TimedOutMembers[common.PubkeyToHex(pk)]
+	if !ok {
+		return false
+	}
+	return timeoutInfo.ExpiresAt > o.timesource.GetCurrentTime()/1000
+}
+
 func (o *Community) AddRoleToMember(pk *ecdsa.PublicKey, role protobuf.CommunityMember_Roles) (*protobuf.CommunityDescription, error) {
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
@@ -900,6 +957,7 @@ func (o *Community) AddRoleToMember(pk *ecdsa.PublicKey, role protobuf.Community
 	}
 
 	if updated {
+		o.addAuditLogEvent(o.ToAddRoleToMemberCommunityEvent(common.PubkeyToHex(pk), role))
 		o.increaseClock()
 	}
 	return o.config.CommunityDescription, nil
@@ -942,6 +1000,7 @@ func (o *Community) RemoveRoleFromMember(pk *ecdsa.PublicKey, role protobuf.Comm
 	}
 
 	if updated {
+		o.addAuditLogEvent(o.ToRemoveRoleFromMemberCommunityEvent(common.PubkeyToHex(pk), role))
 		o.increaseClock()
 	}
 	return o.config.CommunityDescription, nil
@@ -1174,6 +1233,7 @@ func (o *Community) GetFilteredPrivilegedMembers(skipMembers map[string]struct{}
 	privilegedMembers[protobuf.CommunityMember_ROLE_TOKEN_MASTER] = []*ecdsa.PublicKey{}
 	privilegedMembers[protobuf.CommunityMember_ROLE_ADMIN] = []*ecdsa.PublicKey{}
 	privilegedMembers[protobuf.CommunityMember_ROLE_OWNER] = []*ecdsa.PublicKey{}
+	privilegedMembers[protobuf.CommunityMember_ROLE_MODERATOR] = []*ecdsa.PublicKey{}
 
 	members := o.GetMemberPubkeys()
 	for _, member := range members {
@@ -1186,7 +1246,7 @@ func (o *Community) GetFilteredPrivilegedMembers(skipMembers map[string]struct{}
 
 		memberRole := o.MemberRole(member)
 		if memberRole == protobuf.CommunityMember_ROLE_OWNER || memberRole == protobuf.CommunityMember_ROLE_ADMIN ||
-			memberRole == protobuf.CommunityMember_ROLE_TOKEN_MASTER {
+			memberRole == protobuf.CommunityMember_ROLE_TOKEN_MASTER || memberRole == protobuf.CommunityMember_ROLE_MODERATOR {
 
 			privilegedMembers[memberRole] = append(privilegedMembers[memberRole], member)
 		}
@@ -1195,7 +1255,7 @@ func (o *Community) GetFilteredPrivilegedMembers(skipMembers map[string]struct{}
 }
 
 func (o *Community) HasPermissionToSendCommunityEvents() bool {
-	return !o.IsControlNode() && o.hasRoles(o.config.MemberIdentity, manageCommunityRoles())
+	return !o.IsControlNode() && o.hasRoles(o.config.MemberIdentity, moderateCommunityRoles())
 }
 
 func (o *Community) hasPermissionToSendCommunityEvent(event protobuf.CommunityEvent_EventType) bool {
@@ -1219,10 +1279,37 @@ func (o *Community) IsMemberAdmin(publicKey *ecdsa.PublicKey) bool {
 	return o.hasRoles(publicKey, adminRole())
 }
 
+func (o *Community) IsMemberModerator(publicKey *ecdsa.PublicKey) bool {
+	return o.hasRoles(publicKey, moderatorRole())
+}
+
 func (o *Community) IsPrivilegedMember(publicKey *ecdsa.PublicKey) bool {
 	return o.hasRoles(publicKey, manageCommunityRoles())
 }
 
+// IsModeratingMember returns whether the member can moderate content and members,
+// that is any privileged member or a moderator
+func (o *Community) IsModeratingMember(publicKey *ecdsa.PublicKey) bool {
+	return o.hasRoles(publicKey, moderateCommunityRoles())
+}
+
+// moderatingMembers returns the members that can moderate, they hold the moderators encryption key
+func (o *Community) moderatingMembers() map[string]*protobuf.CommunityMember {
+	members := make(map[string]*protobuf.CommunityMember)
+	roles := moderateCommunityRoles()
+	for pubKey, member := range o.config.CommunityDescription.Members {
+		if o.memberHasRoles(member, roles) {
+			members[pubKey] = member
+		}
+	}
+	return members
+}
+
+// ModeratorsHashRatchetGroupID returns the group of the key only shared with moderating members
+func (o *Community) ModeratorsHashRatchetGroupID() []byte {
+	return []byte(o.IDString() + "moderators")
+}
+
 func manageCommunityRoles() map[protobuf.CommunityMember_Roles]bool {
 	roles := make(map[protobuf.CommunityMember_Roles]bool)
 	roles[protobuf.CommunityMember_ROLE_OWNER] = true
@@ -1231,6 +1318,12 @@ func manageCommunityRoles() map[protobuf.CommunityMember_Roles]bool {
 	return roles
 }
 
+func moderateCommunityRoles() map[protobuf.CommunityMember_Roles]bool {
+	roles := manageCommunityRoles()
+	roles[protobuf.CommunityMember_ROLE_MODERATOR] = true
+	return roles
+}
+
 func ownerRole() map[protobuf.CommunityMember_Roles]bool {
 	roles := make(map[protobuf.CommunityMember_Roles]bool)
 	roles[protobuf.CommunityMember_ROLE_OWNER] = true
@@ -1249,6 +1342,12 @@ func tokenMasterRole() map[protobuf.CommunityMember_Roles]bool {
 	return roles
 }
 
+func moderatorRole() map[protobuf.CommunityMember_Roles]bool {
+	roles := make(map[protobuf.CommunityMember_Roles]bool)
+	roles[protobuf.CommunityMember_ROLE_MODERATOR] = true
+	return roles
+}
+
 func (o *Community) MemberRole(pubKey *ecdsa.PublicKey) protobuf.CommunityMember_Roles {
 	if o.IsMemberOwner(pubKey) {
 		return protobuf.CommunityMember_ROLE_OWNER
@@ -1256,6 +1355,8 @@ func (o *Community) MemberRole(pubKey *ecdsa.PublicKey) protobuf.CommunityMember
 		return protobuf.CommunityMember_ROLE_TOKEN_MASTER
 	} else if o.IsMemberAdmin(pubKey) {
 		return protobuf.CommunityMember_ROLE_ADMIN
+	} else if o.IsMemberModerator(pubKey) {
+		return protobuf.CommunityMember_ROLE_MODERATOR
 	}
 
 	return protobuf.CommunityMember_ROLE_NONE
@@ -1705,6 +1806,7 @@ func (o *Community) UpsertTokenPermission(tokenPermission *protobuf.CommunityTok
 			return nil, err
 		}
 
+		o.addAuditLogEvent(o.ToCommunityTokenPermissionChangeCommunityEvent(tokenPermission))
 		o.increaseClock()
 
 		return changes, nil
@@ -1750,6 +1852,7 @@ func (o *Community) DeleteTokenPermission(permissionID string) (*CommunityChange
 			return nil, err
 		}
 
+		o.addAuditLogEvent(o.ToCommunityTokenPermissionDeleteCommunityEvent(tokenPermission))
 		o.increaseClock()
 
 		return changes, nil
@@ -1829,6 +1932,11 @@ func (o *Community) CanPost(pk *ecdsa.PublicKey, chatID string) (bool, error) {
 		return false, nil
 	}
 
+	if o.isTimedOut(pk) {
+		o.config.Logger.Debug("Community.CanPost: user is timed out", zap.String("chat-id", chatID))
+		return false, nil
+	}
+
 	if o.config.CommunityDescription.Members == nil {
 		o.config.Logger.Debug("Community.CanPost: no members in org", zap.String("chat-id", chatID))
 		return false, nil
@@ -1914,14 +2022,48 @@ func (o *Community) CanManageUsers(pk *ecdsa.PublicKey) bool {
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 
-	return o.IsPrivilegedMember(pk)
+	return o.IsModeratingMember(pk)
 }
 
 func (o *Community) CanDeleteMessageForEveryone(pk *ecdsa.PublicKey) bool {
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 
-	return o.IsPrivilegedMember(pk)
+	return o.IsModeratingMember(pk)
+}
+
+// DeleteMessageForEveryone records the deletion of another member's message.
+// Nothing changes in the description, the deletion is kept in the audit log.
+func (o *Community) DeleteMessageForEveryone(author string, chatID string, messageID string) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !(o.IsControlNode() || o.hasPermissionToSendCommunityEvent(protobuf.CommunityEvent_COMMUNITY_MESSAGE_DELETE)) {
+		return ErrNotAuthorized
+	}
+
+	if o.IsControlNode() {
+		o.addAuditLogEvent(o.ToDeleteMessageCommunityEvent(author, chatID, messageID))
+		return nil
+	}
+	return o.addNewCommunityEvent(o.ToDeleteMessageCommunityEvent(author, chatID, messageID))
+}
+
+// DeleteAllMemberMessages records the deletion of all the messages of a member.
+// Nothing changes in the description, the deletion is kept in the audit log.
+func (o *Community) DeleteAllMemberMessages(pk *ecdsa.PublicKey) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !(o.IsControlNode() || o.hasPermissionToSendCommunityEvent(protobuf.CommunityEvent_COMMUNITY_MEMBER_MESSAGES_DELETE)) {
+		return ErrNotAuthorized
+	}
+
+	if o.IsControlNode() {
+		o.addAuditLogEvent(o.ToDeleteMemberMessagesCommunityEvent(common.PubkeyToHex(pk)))
+		return nil
+	}
+	return o.addNewCommunityEvent(o.ToDeleteMemberMessagesCommunityEvent(common.PubkeyToHex(pk)))
 }
 
 func (o *Community) isMember() bool {
@@ -1968,7 +2110,7 @@ func (o *Community) nextClock() uint64 {
 
 func (o *Community) CanManageUsersPublicKeys() ([]*ecdsa.PublicKey, error) {
 	var response []*ecdsa.PublicKey
-	roles := manageCommunityRoles()
+	roles := moderateCommunityRoles()
 	for pkString, member := range o.config.CommunityDescription.Members {
 		if o.memberHasRoles(member, roles) {
 			pk, err := common.HexToPubkey(pkString)
@@ -2215,6 +2357,8 @@ func (o *Community) banUserFromCommunity(pk *ecdsa.PublicKey, communityBanInfo *
 		o.config.CommunityDescription.BannedMembers[key] = communityBanInfo
 	}
 
+	delete(o.config.CommunityDescription.TimedOutMembers, key)
+
 	for _, u := range o.config.CommunityDescription.BanList {
 		if u == key {
 			return
@@ -2224,6 +2368,26 @@ func (o *Community) banUserFromCommunity(pk *ecdsa.PublicKey, communityBanInfo *
 	o.config.CommunityDescription.BanList = append(o.config.CommunityDescription.BanList, key)
 }
 
+func (o *Community) timeoutMember(pk *ecdsa.PublicKey, timeoutInfo *protobuf.CommunityTimeoutInfo) {
+	now := o.timesource.GetCurrentTime() / 1000
+	for key, timeoutInfo := range o.config.CommunityDescription.TimedOutMembers {
+		if timeoutInfo.ExpiresAt <= now {
+			delete(o.config.CommunityDescription.TimedOutMembers, key)
+		}
+	}
+
+	key := common.PubkeyToHex(pk)
+	if timeoutInfo.ExpiresAt <= now {
+		delete(o.config.CommunityDescription.TimedOutMembers, key)
+		return
+	}
+
+	if o.config.CommunityDescription.TimedOutMembers == nil {
+		o.config.CommunityDescription.TimedOutMembers = make(map[string]*protobuf.CommunityTimeoutInfo)
+	}
+	o.config.CommunityDescription.TimedOutMembers[key] = timeoutInfo
+}
+
 func (o *Community) editChat(chatID string, chat *protobuf.CommunityChat) error {
 	err := validateCommunityChat(o.config.CommunityDescription, chat)
 	if err != nil {
@@ -2362,6 +2526,7 @@ func (o *Community) DeclineRequestToJoin(dbRequest *RequestToJoin) (adminEventCr
 			return false, err
 		}
 		o.removeMemberFromOrg(pk)
+		o.addAuditLogEvent(o.ToCommunityRequestToJoinRejectCommunityEvent(dbRequest.PublicKey, dbRequest.ToCommunityRequestToJoinProtobuf()))
 		o.increaseClock()
 	} else {
 		err = o.addNewCommunityEvent(o.ToCommunityRequestToJoinRejectCommunityEvent(dbRequest.PublicKey, dbRequest.ToCommunityRequestToJoinProtobuf()))
@@ -60,6 +60,7 @@ func (o *Community) CreateCategory(categoryID string, categoryName string, chatI
 	}
 
 	if o.IsControlNode() {
+		o.addAuditLogEvent(o.ToCreateCategoryCommunityEvent(categoryID, categoryName, chatIDs))
 		o.increaseClock()
 	} else {
 		err := o.addNewCommunityEvent(o.ToCreateCategoryCommunityEvent(categoryID, categoryName, chatIDs))
@@ -95,6 +96,7 @@ func (o *Community) EditCategory(categoryID string, categoryName string, chatIDs
 	}
 
 	if o.IsControlNode() {
+		o.addAuditLogEvent(o.ToEditCategoryCommunityEvent(categoryID, categoryName, chatIDs))
 		o.increaseClock()
 	} else {
 		err := o.addNewCommunityEvent(o.ToEditCategoryCommunityEvent(categoryID, categoryName, chatIDs))
@@ -120,6 +122,7 @@ func (o *Community) ReorderCategories(categoryID string, newPosition int) (*Comm
 	}
 
 	if o.IsControlNode() {
+		o.addAuditLogEvent(o.ToReorderCategoryCommunityEvent(categoryID, newPosition))
 		o.increaseClock()
 	} else {
 		err := o.addNewCommunityEvent(o.ToReorderCategoryCommunityEvent(categoryID, newPosition))
@@ -155,6 +158,7 @@ func (o *Community) ReorderChat(categoryID string, chatID string, newPosition in
 	}
 
 	if o.IsControlNode() {
+		o.addAuditLogEvent(o.ToReorderChannelCommunityEvent(categoryID, chatID, newPosition))
 		o.increaseClock()
 	} else {
 		err := o.addNewCommunityEvent(o.ToReorderChannelCommunityEvent(categoryID, chatID, newPosition))
@@ -305,6 +309,7 @@ func (o *Community) DeleteCategory(categoryID string) (*CommunityChanges, error)
 	}
 
 	if o.IsControlNode() {
+		o.addAuditLogEvent(o.ToDeleteCategoryCommunityEvent(categoryID))
 		o.increaseClock()
 	} else {
 		err := o.addNewCommunityEvent(o.ToDeleteCategoryCommunityEvent(categoryID))
@@ -12,10 +12,11 @@ import (
 type DescriptionEncryptor interface {
 	encryptCommunityDescription(community *Community, d *protobuf.CommunityDescription) (string, []byte, error)
 	encryptCommunityDescriptionChannel(community *Community, channelID string, d *protobuf.CommunityDescription) (string, []byte, error)
+	encryptCommunityDescriptionModerators(community *Community, d *protobuf.CommunityDescription) (string, []byte, error)
 	decryptCommunityDescription(keyIDSeqNo string, d []byte) (*DecryptCommunityResponse, error)
 }
 
-// Encrypts members and chats
+// Encrypts members, chats and automod rules
 func encryptDescription(encryptor DescriptionEncryptor, community *Community, description *protobuf.CommunityDescription) error {
 	description.PrivateData = make(map[string][]byte)
 
@@ -57,6 +58,22 @@ func encryptDescription(encryptor DescriptionEncryptor, community *Community, de
 		description.Chats = make(map[string]*protobuf.CommunityChat)
 	}
 
+	// Automod rules are only readable by moderating members, regardless of the community being encrypted
+	if description.AutomodRules != nil {
+		descriptionToEncrypt := &protobuf.CommunityDescription{
+			AutomodRules: description.AutomodRules,
+		}
+
+		keyIDSeqNo, encryptedDescription, err := encryptor.encryptCommunityDescriptionModerators(community, descriptionToEncrypt)
+		if err != nil {
+			return err
+		}
+
+		// Set private data and cleanup unencrypted automod rules
+		description.PrivateData[keyIDSeqNo] = encryptedDescription
+		description.AutomodRules = nil
+	}
+
 	return nil
 }
 
@@ -65,7 +82,7 @@ type CommunityPrivateDataFailedToDecrypt struct {
 	KeyID   []byte
 }
 
-// Decrypts members and chats
+// Decrypts members, chats and automod rules
 func decryptDescription(id types.HexBytes, encryptor DescriptionEncryptor, description *protobuf.CommunityDescription, logger *zap.Logger) ([]*CommunityPrivateDataFailedToDecrypt, error) {
 	if len(description.PrivateData) == 0 {
 		return nil, nil
@@ -105,6 +122,10 @@ func decryptDescription(id types.HexBytes, encryptor DescriptionEncryptor, descr
 				description.Chats[id] = decryptedChannel
 			}
 		}
+
+		if decryptedDescription.AutomodRules != nil {
+			description.AutomodRules = decryptedDescription.AutomodRules
+		}
 	}
 
 	return failedToDecrypt, nil
@@ -61,6 +61,12 @@ func (dem *DescriptionEncryptorMock) encryptCommunityDescriptionChannel(communit
 	return keyIDSeqNo, []byte("encryptedDescription"), nil
 }
 
+func (dem *DescriptionEncryptorMock) encryptCommunityDescriptionModerators(community *Community, d *protobuf.CommunityDescription) (string, []byte, error) {
+	keyIDSeqNo := uuid.New().String()
+	dem.descriptions[keyIDSeqNo] = d
+	return keyIDSeqNo, []byte("encryptedDescription"), nil
+}
+
 func (dem *DescriptionEncryptorMock) decryptCommunityDescription(keyIDSeqNo string, d []byte) (*DecryptCommunityResponse, error) {
 	description := dem.descriptions[keyIDSeqNo]
 	if description == nil {
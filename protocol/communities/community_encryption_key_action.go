@@ -31,6 +31,9 @@ type EncryptionKeyActions struct {
 
 	// channel-level encryption key actions
 	ChannelKeysActions map[string]EncryptionKeyAction // key is: chatID
+
+	// moderators encryption key action, the key protects the automod rules
+	ModeratorsKeyAction EncryptionKeyAction
 }
 
 func EvaluateCommunityEncryptionKeyActions(origin, modified *Community) *EncryptionKeyActions {
@@ -56,8 +59,9 @@ func EvaluateCommunityEncryptionKeyActions(origin, modified *Community) *Encrypt
 	changes := EvaluateCommunityChanges(origin, modified)
 
 	result := &EncryptionKeyActions{
-		CommunityKeyAction: *evaluateCommunityLevelEncryptionKeyAction(origin, modified, changes),
-		ChannelKeysActions: *evaluateChannelLevelEncryptionKeyActions(origin, modified, changes),
+		CommunityKeyAction:  *evaluateCommunityLevelEncryptionKeyAction(origin, modified, changes),
+		ChannelKeysActions:  *evaluateChannelLevelEncryptionKeyActions(origin, modified, changes),
+		ModeratorsKeyAction: *evaluateModeratorsEncryptionKeyAction(origin, modified, changes),
 	}
 	return result
 }
@@ -99,6 +103,35 @@ func evaluateChannelLevelEncryptionKeyActions(origin, modified *Community, chang
 	return &result
 }
 
+func evaluateModeratorsEncryptionKeyAction(origin, modified *Community, changes *CommunityChanges) *EncryptionKeyAction {
+	originModerators := origin.moderatingMembers()
+	modifiedModerators := modified.moderatingMembers()
+
+	membersAdded := make(map[string]*protobuf.CommunityMember)
+	for pubKey, member := range modifiedModerators {
+		if _, ok := originModerators[pubKey]; !ok {
+			membersAdded[pubKey] = member
+		}
+	}
+
+	membersRemoved := make(map[string]*protobuf.CommunityMember)
+	for pubKey, member := range originModerators {
+		if _, ok := modifiedModerators[pubKey]; !ok {
+			membersRemoved[pubKey] = member
+		}
+	}
+
+	// The key is only needed once automod rules are set
+	return evaluateEncryptionKeyAction(
+		origin.config.CommunityDescription.AutomodRules != nil,
+		modified.config.CommunityDescription.AutomodRules != nil,
+		changes.ControlNodeChanged != nil,
+		modifiedModerators,
+		membersAdded,
+		membersRemoved,
+	)
+}
+
 func evaluateEncryptionKeyAction(originEncrypted, modifiedEncrypted, controlNodeChanged bool,
 	allMembers, membersAdded, membersRemoved map[string]*protobuf.CommunityMember) *EncryptionKeyAction {
 	result := &EncryptionKeyAction{
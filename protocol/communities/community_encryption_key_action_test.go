@@ -789,6 +789,82 @@ func (s *CommunityEncryptionKeyActionSuite) TestChannelLevelKeyActions() {
 	}
 }
 
+func (s *CommunityEncryptionKeyActionSuite) TestModeratorsKeyActions() {
+	admin := []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_ADMIN}
+	rules := &protobuf.CommunityAutomodRules{Clock: 1, Enabled: true}
+
+	testCases := []struct {
+		name              string
+		originRules       *protobuf.CommunityAutomodRules
+		modifiedRules     *protobuf.CommunityAutomodRules
+		originAdmins      []*ecdsa.PublicKey
+		modifiedAdmins    []*ecdsa.PublicKey
+		expectedAction    EncryptionKeyActionType
+		expectedReceivers []string
+	}{
+		{
+			name:           "admin added without automod rules",
+			modifiedAdmins: []*ecdsa.PublicKey{&s.member1.PublicKey},
+			expectedAction: EncryptionKeyNone,
+		},
+		{
+			name:              "automod rules set",
+			modifiedRules:     rules,
+			originAdmins:      []*ecdsa.PublicKey{&s.member1.PublicKey},
+			modifiedAdmins:    []*ecdsa.PublicKey{&s.member1.PublicKey},
+			expectedAction:    EncryptionKeyAdd,
+			expectedReceivers: []string{s.member1Key},
+		},
+		{
+			name:              "admin added",
+			originRules:       rules,
+			modifiedRules:     rules,
+			originAdmins:      []*ecdsa.PublicKey{&s.member1.PublicKey},
+			modifiedAdmins:    []*ecdsa.PublicKey{&s.member1.PublicKey, &s.member2.PublicKey},
+			expectedAction:    EncryptionKeySendToMembers,
+			expectedReceivers: []string{s.member2Key},
+		},
+		{
+			name:              "admin removed",
+			originRules:       rules,
+			modifiedRules:     rules,
+			originAdmins:      []*ecdsa.PublicKey{&s.member1.PublicKey, &s.member2.PublicKey},
+			modifiedAdmins:    []*ecdsa.PublicKey{&s.member1.PublicKey},
+			expectedAction:    EncryptionKeyRekey,
+			expectedReceivers: []string{s.member1Key},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			origin, err := createTestCommunity(s.identity)
+			s.Require().NoError(err)
+			modified := origin.CreateDeepCopy()
+
+			origin.config.CommunityDescription.AutomodRules = tc.originRules
+			modified.config.CommunityDescription.AutomodRules = tc.modifiedRules
+
+			for _, member := range tc.originAdmins {
+				_, err := origin.AddMember(member, admin)
+				s.Require().NoError(err)
+			}
+
+			for _, member := range tc.modifiedAdmins {
+				_, err := modified.AddMember(member, admin)
+				s.Require().NoError(err)
+			}
+
+			actions := EvaluateCommunityEncryptionKeyActions(origin, modified)
+			s.Require().Equal(tc.expectedAction, actions.ModeratorsKeyAction.ActionType)
+			s.Require().Len(actions.ModeratorsKeyAction.Members, len(tc.expectedReceivers))
+			for _, memberKey := range tc.expectedReceivers {
+				_, exists := actions.ModeratorsKeyAction.Members[memberKey]
+				s.Require().True(exists)
+			}
+		})
+	}
+}
+
 func (s *CommunityEncryptionKeyActionSuite) TestNilOrigin() {
 	newCommunity, err := createTestCommunity(s.identity)
 	s.Require().NoError(err)
@@ -856,6 +932,10 @@ func (s *CommunityEncryptionKeyActionSuite) TestControlNodeChange() {
 						Members:    map[string]*protobuf.CommunityMember{},
 					},
 				},
+				ModeratorsKeyAction: EncryptionKeyAction{
+					ActionType: EncryptionKeyNone,
+					Members:    map[string]*protobuf.CommunityMember{},
+				},
 			},
 		},
 		{
@@ -884,6 +964,10 @@ func (s *CommunityEncryptionKeyActionSuite) TestControlNodeChange() {
 						Members:    map[string]*protobuf.CommunityMember{},
 					},
 				},
+				ModeratorsKeyAction: EncryptionKeyAction{
+					ActionType: EncryptionKeyNone,
+					Members:    map[string]*protobuf.CommunityMember{},
+				},
 			},
 		},
 		{
@@ -911,6 +995,10 @@ func (s *CommunityEncryptionKeyActionSuite) TestControlNodeChange() {
 						},
 					},
 				},
+				ModeratorsKeyAction: EncryptionKeyAction{
+					ActionType: EncryptionKeyNone,
+					Members:    map[string]*protobuf.CommunityMember{},
+				},
 			},
 		},
 		{
@@ -947,6 +1035,10 @@ func (s *CommunityEncryptionKeyActionSuite) TestControlNodeChange() {
 						},
 					},
 				},
+				ModeratorsKeyAction: EncryptionKeyAction{
+					ActionType: EncryptionKeyNone,
+					Members:    map[string]*protobuf.CommunityMember{},
+				},
 			},
 		},
 	}
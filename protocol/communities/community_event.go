@@ -22,6 +22,11 @@ type CommunityEvent struct {
 	MemberToAction      string                             `json:"memberToAction,omitempty"`
 	RequestToJoin       *protobuf.CommunityRequestToJoin   `json:"requestToJoin,omitempty"`
 	TokenMetadata       *protobuf.CommunityTokenMetadata   `json:"tokenMetadata,omitempty"`
+	TimeoutInfo         *protobuf.CommunityTimeoutInfo     `json:"timeoutInfo,omitempty"`
+	Invite              *protobuf.CommunityInvite          `json:"invite,omitempty"`
+	CalendarEvent       *protobuf.CommunityCalendarEvent   `json:"calendarEvent,omitempty"`
+	Role                protobuf.CommunityMember_Roles     `json:"role,omitempty"`
+	MessageID           string                             `json:"messageId,omitempty"`
 	Payload             []byte                             `json:"payload"`
 	Signature           []byte                             `json:"signature"`
 }
@@ -50,6 +55,11 @@ func (e *CommunityEvent) ToProtobuf() *protobuf.CommunityEvent {
 		RejectedRequestsToJoin: rejectedRequestsToJoin,
 		AcceptedRequestsToJoin: acceptedRequestsToJoin,
 		TokenMetadata:          e.TokenMetadata,
+		TimeoutInfo:            e.TimeoutInfo,
+		Invite:                 e.Invite,
+		CalendarEvent:          e.CalendarEvent,
+		Role:                   e.Role,
+		MessageId:              e.MessageID,
 	}
 }
 
@@ -88,6 +98,11 @@ func communityEventFromProtobuf(msg *protobuf.SignedCommunityEvent) (*CommunityE
 		MemberToAction:      memberToAction,
 		RequestToJoin:       requestToJoin,
 		TokenMetadata:       decodedEvent.TokenMetadata,
+		TimeoutInfo:         decodedEvent.TimeoutInfo,
+		Invite:              decodedEvent.Invite,
+		CalendarEvent:       decodedEvent.CalendarEvent,
+		Role:                decodedEvent.Role,
+		MessageID:           decodedEvent.MessageId,
 		Payload:             msg.Payload,
 		Signature:           msg.Signature,
 	}, nil
@@ -203,6 +218,41 @@ func (e *CommunityEvent) Validate() error {
 		if e.TokenMetadata == nil || len(e.TokenMetadata.ContractAddresses) == 0 {
 			return errors.New("invalid add community token event")
 		}
+
+	case protobuf.CommunityEvent_COMMUNITY_MEMBER_TIMEOUT:
+		if len(e.MemberToAction) == 0 || e.TimeoutInfo == nil {
+			return errors.New("invalid community member timeout event")
+		}
+
+	case protobuf.CommunityEvent_COMMUNITY_INVITE_REVOKE:
+		if e.Invite == nil || len(e.Invite.InviteId) == 0 {
+			return errors.New("invalid community invite revoke event")
+		}
+
+	case protobuf.CommunityEvent_COMMUNITY_CALENDAR_EVENT_CHANGE:
+		if e.CalendarEvent == nil || validateCalendarEvent(e.CalendarEvent) != nil {
+			return errors.New("invalid community calendar event change event")
+		}
+
+	case protobuf.CommunityEvent_COMMUNITY_CALENDAR_EVENT_DELETE:
+		if e.CalendarEvent == nil || len(e.CalendarEvent.Id) == 0 {
+			return errors.New("invalid community calendar event delete event")
+		}
+
+	case protobuf.CommunityEvent_COMMUNITY_MEMBER_ROLE_ADD, protobuf.CommunityEvent_COMMUNITY_MEMBER_ROLE_REMOVE:
+		if len(e.MemberToAction) == 0 || e.Role == protobuf.CommunityMember_ROLE_NONE {
+			return errors.New("invalid community member role event")
+		}
+
+	case protobuf.CommunityEvent_COMMUNITY_MESSAGE_DELETE:
+		if len(e.MemberToAction) == 0 || len(e.MessageID) == 0 {
+			return errors.New("invalid community message delete event")
+		}
+
+	case protobuf.CommunityEvent_COMMUNITY_MEMBER_MESSAGES_DELETE:
+		if len(e.MemberToAction) == 0 {
+			return errors.New("invalid community member messages delete event")
+		}
 	}
 	return nil
 }
@@ -233,11 +283,27 @@ func (e *CommunityEvent) EventTypeID() string {
 		protobuf.CommunityEvent_COMMUNITY_REQUEST_TO_JOIN_REJECT,
 		protobuf.CommunityEvent_COMMUNITY_MEMBER_KICK,
 		protobuf.CommunityEvent_COMMUNITY_MEMBER_BAN,
-		protobuf.CommunityEvent_COMMUNITY_MEMBER_UNBAN:
+		protobuf.CommunityEvent_COMMUNITY_MEMBER_UNBAN,
+		protobuf.CommunityEvent_COMMUNITY_MEMBER_TIMEOUT,
+		protobuf.CommunityEvent_COMMUNITY_MEMBER_MESSAGES_DELETE:
 		return fmt.Sprintf("%d-%s", e.Type, e.MemberToAction)
 
+	case protobuf.CommunityEvent_COMMUNITY_MEMBER_ROLE_ADD,
+		protobuf.CommunityEvent_COMMUNITY_MEMBER_ROLE_REMOVE:
+		return fmt.Sprintf("%d-%s-%d", e.Type, e.MemberToAction, e.Role)
+
+	case protobuf.CommunityEvent_COMMUNITY_MESSAGE_DELETE:
+		return fmt.Sprintf("%d-%s", e.Type, e.MessageID)
+
 	case protobuf.CommunityEvent_COMMUNITY_TOKEN_ADD:
 		return fmt.Sprintf("%d-%s", e.Type, e.TokenMetadata.Name)
+
+	case protobuf.CommunityEvent_COMMUNITY_INVITE_REVOKE:
+		return fmt.Sprintf("%d-%s", e.Type, e.Invite.InviteId)
+
+	case protobuf.CommunityEvent_COMMUNITY_CALENDAR_EVENT_CHANGE,
+		protobuf.CommunityEvent_COMMUNITY_CALENDAR_EVENT_DELETE:
+		return fmt.Sprintf("%d-%s", e.Type, e.CalendarEvent.Id)
 	}
 
 	return ""
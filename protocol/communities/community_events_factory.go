@@ -115,6 +115,77 @@ func (o *Community) ToKickCommunityMemberCommunityEvent(pubkey string) *Communit
 	}
 }
 
+func (o *Community) ToTimeoutCommunityMemberCommunityEvent(pubkey string, timeoutInfo *protobuf.CommunityTimeoutInfo) *CommunityEvent {
+	return &CommunityEvent{
+		CommunityEventClock: o.nextEventClock(),
+		Type:                protobuf.CommunityEvent_COMMUNITY_MEMBER_TIMEOUT,
+		MemberToAction:      pubkey,
+		TimeoutInfo:         timeoutInfo,
+	}
+}
+
+func (o *Community) ToAddRoleToMemberCommunityEvent(pubkey string, role protobuf.CommunityMember_Roles) *CommunityEvent {
+	return &CommunityEvent{
+		CommunityEventClock: o.nextEventClock(),
+		Type:                protobuf.CommunityEvent_COMMUNITY_MEMBER_ROLE_ADD,
+		MemberToAction:      pubkey,
+		Role:                role,
+	}
+}
+
+func (o *Community) ToRemoveRoleFromMemberCommunityEvent(pubkey string, role protobuf.CommunityMember_Roles) *CommunityEvent {
+	return &CommunityEvent{
+		CommunityEventClock: o.nextEventClock(),
+		Type:                protobuf.CommunityEvent_COMMUNITY_MEMBER_ROLE_REMOVE,
+		MemberToAction:      pubkey,
+		Role:                role,
+	}
+}
+
+func (o *Community) ToDeleteMessageCommunityEvent(author string, chatID string, messageID string) *CommunityEvent {
+	return &CommunityEvent{
+		CommunityEventClock: o.nextEventClock(),
+		Type:                protobuf.CommunityEvent_COMMUNITY_MESSAGE_DELETE,
+		MemberToAction:      author,
+		ChannelData: &protobuf.ChannelData{
+			ChannelId: chatID,
+		},
+		MessageID: messageID,
+	}
+}
+
+func (o *Community) ToDeleteMemberMessagesCommunityEvent(pubkey string) *CommunityEvent {
+	return &CommunityEvent{
+		CommunityEventClock: o.nextEventClock(),
+		Type:                protobuf.CommunityEvent_COMMUNITY_MEMBER_MESSAGES_DELETE,
+		MemberToAction:      pubkey,
+	}
+}
+
+func (o *Community) ToRevokeInviteCommunityEvent(invite *protobuf.CommunityInvite) *CommunityEvent {
+	return &CommunityEvent{
+		CommunityEventClock: o.nextEventClock(),
+		Type:                protobuf.CommunityEvent_COMMUNITY_INVITE_REVOKE,
+		Invite:              invite,
+	}
+}
+
+func (o *Community) ToCalendarEventChangeCommunityEvent(calendarEvent *protobuf.CommunityCalendarEvent) *CommunityEvent {
+	return &CommunityEvent{
+		CommunityEventClock: o.nextEventClock(),
+		Type:                protobuf.CommunityEvent_COMMUNITY_CALENDAR_EVENT_CHANGE,
+		CalendarEvent:       calendarEvent,
+	}
+}
+
+func (o *Community) ToCalendarEventDeleteCommunityEvent(calendarEvent *protobuf.CommunityCalendarEvent) *CommunityEvent {
+	return &CommunityEvent{
+		CommunityEventClock: o.nextEventClock(),
+		Type:                protobuf.CommunityEvent_COMMUNITY_CALENDAR_EVENT_DELETE,
+		CalendarEvent:       calendarEvent,
+	}
+}
+
 func (o *Community) ToCommunityEditCommunityEvent(description *protobuf.CommunityDescription) *CommunityEvent {
 	return &CommunityEvent{
 		CommunityEventClock: o.nextEventClock(),
@@ -157,6 +157,12 @@ func (o *Community) applyEvents() {
 		err := o.applyEvent(event)
 		if err != nil {
 			o.config.Logger.Warn("failed to apply event", zap.String("EventTypeID", event.EventTypeID()), zap.Uint64("clock", event.CommunityEventClock), zap.Error(err))
+			continue
+		}
+
+		// Control node clears events once applied, so each of them is recorded once
+		if o.IsControlNode() {
+			o.auditLogEvents = append(o.auditLogEvents, event)
 		}
 	}
 }
@@ -256,6 +262,20 @@ func (o *Community) applyEvent(communityEvent CommunityEvent) error {
 			}
 			o.unbanUserFromCommunity(pk)
 		}
+	case protobuf.CommunityEvent_COMMUNITY_MEMBER_TIMEOUT:
+		if o.IsControlNode() {
+			pk, err := common.HexToPubkey(communityEvent.MemberToAction)
+			if err != nil {
+				return err
+			}
+			o.timeoutMember(pk, communityEvent.TimeoutInfo)
+		}
+	case protobuf.CommunityEvent_COMMUNITY_INVITE_REVOKE:
+		o.revokeInvite(communityEvent.Invite)
+	case protobuf.CommunityEvent_COMMUNITY_CALENDAR_EVENT_CHANGE:
+		o.upsertCalendarEvent(communityEvent.CalendarEvent)
+	case protobuf.CommunityEvent_COMMUNITY_CALENDAR_EVENT_DELETE:
+		o.deleteCalendarEvent(communityEvent.CalendarEvent.Id)
 	case protobuf.CommunityEvent_COMMUNITY_TOKEN_ADD:
 		o.config.CommunityDescription.CommunityTokensMetadata = append(o.config.CommunityDescription.CommunityTokensMetadata, communityEvent.TokenMetadata)
 	}
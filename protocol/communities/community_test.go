@@ -501,6 +501,102 @@ func (s *CommunitySuite) TestCanPost() {
 	}
 }
 
+func (s *CommunitySuite) TestTimeoutMember() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	member := &s.member1.PublicKey
+	now := org.timesource.GetCurrentTime() / 1000
+
+	_, err := org.TimeoutMember(member, &protobuf.CommunityTimeoutInfo{ExpiresAt: now + 60, Reason: "spam"})
+	s.Require().NoError(err)
+	s.Require().True(org.IsTimedOut(member))
+	s.Require().Equal("spam", org.config.CommunityDescription.TimedOutMembers[s.member1Key].Reason)
+
+	canPost, err := org.CanPost(member, testChatID1)
+	s.Require().NoError(err)
+	s.Require().False(canPost)
+
+	// lifting the timeout
+	_, err = org.TimeoutMember(member, &protobuf.CommunityTimeoutInfo{})
+	s.Require().NoError(err)
+	s.Require().False(org.IsTimedOut(member))
+	s.Require().Empty(org.config.CommunityDescription.TimedOutMembers)
+
+	canPost, err = org.CanPost(member, testChatID1)
+	s.Require().NoError(err)
+	s.Require().True(canPost)
+
+	// expired timeouts don't prevent posting
+	org.config.CommunityDescription.TimedOutMembers = map[string]*protobuf.CommunityTimeoutInfo{
+		s.member1Key: {ExpiresAt: now - 1},
+	}
+	s.Require().False(org.IsTimedOut(member))
+
+	_, err = org.TimeoutMember(&s.member3.PublicKey, &protobuf.CommunityTimeoutInfo{ExpiresAt: now + 60})
+	s.Require().ErrorIs(err, ErrMemberNotFound)
+}
+
+func (s *CommunitySuite) TestModeratorPermissions() {
+	description := s.buildCommunityDescription()
+	description.Members[s.member2Key] = &protobuf.CommunityMember{Roles: []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_MODERATOR}}
+	description.Members[s.member3Key] = &protobuf.CommunityMember{Roles: []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_ADMIN}}
+
+	config := s.config()
+	config.CommunityDescription = description
+	config.MemberIdentity = &s.member2.PublicKey
+	config.PrivateKey = nil
+
+	org, err := New(config, &TimeSourceStub{}, &DescriptionEncryptorMock{})
+	s.Require().NoError(err)
+
+	moderator := &s.member2.PublicKey
+	s.Require().Equal(protobuf.CommunityMember_ROLE_MODERATOR, org.MemberRole(moderator))
+	s.Require().False(org.IsPrivilegedMember(moderator))
+	s.Require().True(org.CanManageUsers(moderator))
+	s.Require().True(org.CanDeleteMessageForEveryone(moderator))
+	s.Require().True(org.HasPermissionToSendCommunityEvents())
+
+	s.Require().False(org.hasPermissionToSendCommunityEvent(protobuf.CommunityEvent_COMMUNITY_EDIT))
+	s.Require().False(org.hasPermissionToSendCommunityEvent(protobuf.CommunityEvent_COMMUNITY_MEMBER_TOKEN_PERMISSION_CHANGE))
+	s.Require().False(org.hasPermissionToSendCommunityEvent(protobuf.CommunityEvent_COMMUNITY_MEMBER_BAN))
+	s.Require().True(org.hasPermissionToSendCommunityEvent(protobuf.CommunityEvent_COMMUNITY_REQUEST_TO_JOIN_ACCEPT))
+	s.Require().True(org.hasPermissionToSendCommunityEvent(protobuf.CommunityEvent_COMMUNITY_MEMBER_TIMEOUT))
+
+	// moderators can't time out admins
+	_, err = org.TimeoutMember(&s.member3.PublicKey, &protobuf.CommunityTimeoutInfo{ExpiresAt: org.timesource.GetCurrentTime()/1000 + 60})
+	s.Require().ErrorIs(err, ErrNotAuthorized)
+
+	moderatorRoles := []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_MODERATOR}
+	adminRoles := []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_ADMIN}
+	timeoutEvent := org.ToTimeoutCommunityMemberCommunityEvent(s.member1Key, &protobuf.CommunityTimeoutInfo{ExpiresAt: 1})
+
+	s.Require().True(RolesAuthorizedToPerformEvent(moderatorRoles, []protobuf.CommunityMember_Roles{}, timeoutEvent))
+	s.Require().False(RolesAuthorizedToPerformEvent(moderatorRoles, moderatorRoles, timeoutEvent))
+	s.Require().False(RolesAuthorizedToPerformEvent(moderatorRoles, adminRoles, timeoutEvent))
+	s.Require().True(RolesAuthorizedToPerformEvent(adminRoles, moderatorRoles, timeoutEvent))
+	s.Require().False(RolesAuthorizedToPerformEvent(moderatorRoles, []protobuf.CommunityMember_Roles{}, org.ToBanCommunityMemberCommunityEvent(s.member1Key)))
+}
+
+func (s *CommunitySuite) TestControlNodeRecordsAppliedEventsForAuditLog() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+
+	event := org.ToKickCommunityMemberCommunityEvent(s.member1Key)
+	org.config.EventsData = &EventsData{Events: []CommunityEvent{*event}}
+	org.applyEvents()
+
+	s.Require().False(org.hasMember(&s.member1.PublicKey))
+
+	events := org.takeAuditLogEvents()
+	s.Require().Len(events, 1)
+	s.Require().Equal(protobuf.CommunityEvent_COMMUNITY_MEMBER_KICK, events[0].Type)
+	s.Require().Empty(org.takeAuditLogEvents())
+
+	// events applied by other members are not recorded
+	org.config.PrivateKey = nil
+	org.config.EventsData = &EventsData{Events: []CommunityEvent{*org.ToCommunityEditCommunityEvent(org.config.CommunityDescription)}}
+	org.applyEvents()
+	s.Require().Empty(org.takeAuditLogEvents())
+}
+
 func (s *CommunitySuite) TestHandleCommunityDescription() {
 	key, err := crypto.GenerateKey()
 	s.Require().NoError(err)
@@ -795,6 +891,101 @@ func (s *CommunitySuite) configENSOnly() Config {
 	return s.newConfig(s.identity, description)
 }
 
+func (s *CommunitySuite) TestValidateInvite() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	now := org.timesource.GetCurrentTime() / 1000
+
+	invite := &Invite{ID: "invite-id", CommunityID: org.ID(), ExpiresAt: now + 60, ChannelID: testChatID1}
+	s.Require().NoError(invite.sign(s.identity))
+
+	decoded, err := NewInviteFromProtobuf(invite.ToSignedProtobuf())
+	s.Require().NoError(err)
+	s.Require().Equal(invite.Creator, decoded.Creator)
+	s.Require().NoError(org.ValidateInvite(decoded))
+
+	// only privileged members can invite
+	memberInvite := &Invite{ID: "member-invite-id", CommunityID: org.ID()}
+	s.Require().NoError(memberInvite.sign(s.member1))
+	s.Require().ErrorIs(org.ValidateInvite(memberInvite), ErrInviteInvalid)
+
+	expiredInvite := &Invite{ID: "expired-invite-id", CommunityID: org.ID(), ExpiresAt: now - 1}
+	s.Require().NoError(expiredInvite.sign(s.identity))
+	s.Require().ErrorIs(org.ValidateInvite(expiredInvite), ErrInviteExpired)
+
+	channelInvite := &Invite{ID: "channel-invite-id", CommunityID: org.ID(), ChannelID: "unknown"}
+	s.Require().NoError(channelInvite.sign(s.identity))
+	s.Require().ErrorIs(org.ValidateInvite(channelInvite), ErrChatNotFound)
+
+	_, err = NewInviteFromProtobuf(&protobuf.SignedCommunityInvite{Payload: invite.Payload, Signature: []byte{0x01}})
+	s.Require().ErrorIs(err, ErrInviteInvalid)
+}
+
+func (s *CommunitySuite) TestRevokeInvite() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	now := org.timesource.GetCurrentTime() / 1000
+
+	invite := &Invite{ID: "invite-id", CommunityID: org.ID(), ExpiresAt: now + 60}
+	s.Require().NoError(invite.sign(s.identity))
+
+	// expired revocations are pruned
+	org.config.CommunityDescription.RevokedInvites = map[string]uint64{"expired-invite-id": now - 1}
+
+	_, err := org.RevokeInvite(invite)
+	s.Require().NoError(err)
+	s.Require().True(org.IsInviteRevoked(invite.ID))
+	s.Require().False(org.IsInviteRevoked("expired-invite-id"))
+	s.Require().ErrorIs(org.ValidateInvite(invite), ErrInviteRevoked)
+
+	events := org.takeAuditLogEvents()
+	s.Require().Len(events, 1)
+	s.Require().Equal(protobuf.CommunityEvent_COMMUNITY_INVITE_REVOKE, events[0].Type)
+}
+
+func (s *CommunitySuite) TestUpsertCalendarEvent() {
+	org := s.buildCommunity(&s.identity.PublicKey)
+	now := org.timesource.GetCurrentTime() / 1000
+
+	calendarEvent := &protobuf.CommunityCalendarEvent{Id: "event-id", Title: "AMA", StartTime: now + 3600, Clock: 2, ChatId: testChatID1}
+	_, err := org.UpsertCalendarEvent(calendarEvent)
+	s.Require().NoError(err)
+	s.Require().True(org.setCalendarEventRsvpCounts(calendarEvent.Id, 3, 1))
+	s.Require().False(org.setCalendarEventRsvpCounts(calendarEvent.Id, 3, 1))
+
+	// counts are kept on edits and stale edits are ignored
+	_, err = org.UpsertCalendarEvent(&protobuf.CommunityCalendarEvent{Id: "event-id", Title: "AMA with the team", StartTime: now + 3600, Clock: 3, GoingCount: 100})
+	s.Require().NoError(err)
+	_, err = org.UpsertCalendarEvent(&protobuf.CommunityCalendarEvent{Id: "event-id", Title: "stale", StartTime: now + 3600, Clock: 1})
+	s.Require().NoError(err)
+
+	stored := org.CalendarEvent(calendarEvent.Id)
+	s.Require().Equal("AMA with the team", stored.Title)
+	s.Require().Equal(uint32(3), stored.GoingCount)
+	s.Require().Equal(uint32(1), stored.InterestedCount)
+
+	_, err = org.UpsertCalendarEvent(&protobuf.CommunityCalendarEvent{Id: "invalid-id", Title: "invalid", StartTime: now + 10, EndTime: now})
+	s.Require().ErrorIs(err, ErrCalendarEventInvalid)
+	_, err = org.UpsertCalendarEvent(&protobuf.CommunityCalendarEvent{Id: "channel-id", Title: "channel", StartTime: now, ChatId: "unknown"})
+	s.Require().ErrorIs(err, ErrChatNotFound)
+
+	// events that ended long ago are pruned
+	retention := uint64(calendarEventRetention / time.Second)
+	org.config.CommunityDescription.CalendarEvents["old-event-id"] = &protobuf.CommunityCalendarEvent{Id: "old-event-id", Title: "old", StartTime: now - retention - 10, EndTime: now - retention - 1}
+	_, err = org.UpsertCalendarEvent(&protobuf.CommunityCalendarEvent{Id: "new-event-id", Title: "new", StartTime: now + 60, Clock: 1})
+	s.Require().NoError(err)
+	s.Require().Nil(org.CalendarEvent("old-event-id"))
+	s.Require().Len(org.CalendarEvents(), 2)
+
+	_, err = org.DeleteCalendarEvent("new-event-id")
+	s.Require().NoError(err)
+	_, err = org.DeleteCalendarEvent("new-event-id")
+	s.Require().ErrorIs(err, ErrCalendarEventNotFound)
+
+	events := org.takeAuditLogEvents()
+	s.Require().Len(events, 5)
+	s.Require().Equal(protobuf.CommunityEvent_COMMUNITY_CALENDAR_EVENT_CHANGE, events[0].Type)
+	s.Require().Equal(protobuf.CommunityEvent_COMMUNITY_CALENDAR_EVENT_DELETE, events[4].Type)
+}
+
 func (s *CommunitySuite) config() Config {
 	config := s.configOnRequestOrgOnRequestChat()
 	return config
@@ -3,6 +3,8 @@ package communities
 import (
 	"reflect"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/status-im/status-go/protocol/protobuf"
 )
 
@@ -27,10 +29,21 @@ func NewCommunityTokenPermission(base *protobuf.CommunityTokenPermission) *Commu
 	}
 }
 
+// CheckedTokenCriteria returns the criteria the permission is checked against.
+// With an expression the flat list only holds its required criteria, the
+// expression leaves refer to ExpressionTokenCriteria.
+func (p *CommunityTokenPermission) CheckedTokenCriteria() []*protobuf.TokenCriteria {
+	if p.Expression != nil {
+		return p.ExpressionTokenCriteria
+	}
+	return p.TokenCriteria
+}
+
 func (p *CommunityTokenPermission) Equals(other *CommunityTokenPermission) bool {
 	if p.Id != other.Id ||
 		p.Type != other.Type ||
 		len(p.TokenCriteria) != len(other.TokenCriteria) ||
+		len(p.ExpressionTokenCriteria) != len(other.ExpressionTokenCriteria) ||
 		len(p.ChatIds) != len(other.ChatIds) ||
 		p.IsPrivate != other.IsPrivate ||
 		p.State != other.State {
@@ -43,6 +56,16 @@ func (p *CommunityTokenPermission) Equals(other *CommunityTokenPermission) bool
 		}
 	}
 
+	for i := range p.ExpressionTokenCriteria {
+		if !compareTokenCriteria(p.ExpressionTokenCriteria[i], other.ExpressionTokenCriteria[i]) {
+			return false
+		}
+	}
+
+	if !proto.Equal(p.Expression, other.Expression) {
+		return false
+	}
+
 	return reflect.DeepEqual(p.ChatIds, other.ChatIds)
 }
 
@@ -0,0 +1,181 @@
+package communities
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"time"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/common/shard"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+var ErrDirectoryEntryInvalid = errors.New("invalid community directory entry")
+var ErrDirectoryEntryWrongSigner = errors.New("community directory entry not signed by the community")
+
+// Names and descriptions longer than this are truncated before being indexed
+const (
+	directoryEntryMaxNameLength        = 30
+	directoryEntryMaxDescriptionLength = 140
+)
+
+// Maximum number of entries indexed, the least recently announced ones are evicted first
+const directoryMaxEntries = 1000
+
+// DirectoryEntry is the summary of a community announced in the community directory
+type DirectoryEntry struct {
+	CommunityID  types.HexBytes `json:"communityId"`
+	Clock        uint64         `json:"clock"`
+	Name         string         `json:"name"`
+	Description  string         `json:"description"`
+	Tags         []string       `json:"tags"`
+	MembersCount uint32         `json:"membersCount"`
+	Color        string         `json:"color"`
+	Shard        *shard.Shard   `json:"shard"`
+	ReceivedAt   uint64         `json:"receivedAt"`
+	// Verified is set for the communities of the curated list
+	Verified bool `json:"verified"`
+}
+
+func truncateString(s string, length int) string {
+	runes := []rune(s)
+	if len(runes) <= length {
+		return s
+	}
+	return string(runes[:length])
+}
+
+// ToDirectoryEntry builds the summary of the community announced in the directory
+func (o *Community) ToDirectoryEntry(clock uint64) *protobuf.CommunityDirectoryEntry {
+	return &protobuf.CommunityDirectoryEntry{
+		Clock:        clock,
+		CommunityId:  o.ID(),
+		Name:         o.Name(),
+		Description:  o.DescriptionText(),
+		Tags:         o.TagsRaw(),
+		MembersCount: uint32(o.MembersCount()),
+		Color:        o.Color(),
+		Shard:        o.Shard().Protobuffer(),
+	}
+}
+
+// SetDirectoryListing lists or unlists a community in the directory,
+// only the control node can announce the community
+func (m *Manager) SetDirectoryListing(request *requests.SetCommunityDirectoryListing) (*Community, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !community.IsControlNode() {
+		return nil, ErrNotControlNode
+	}
+
+	err = m.persistence.SetDirectoryListing(community.ID(), request.Listed)
+	if err != nil {
+		return nil, err
+	}
+
+	return community, nil
+}
+
+func (m *Manager) IsDirectoryListed(communityID types.HexBytes) (bool, error) {
+	return m.persistence.IsDirectoryListed(communityID)
+}
+
+// DirectoryListedCommunities returns the listed communities we are still the control node of
+func (m *Manager) DirectoryListedCommunities() ([]*Community, error) {
+	communityIDs, err := m.persistence.GetDirectoryListings()
+	if err != nil {
+		return nil, err
+	}
+
+	var communities []*Community
+	for _, communityID := range communityIDs {
+		community, err := m.GetByID(communityID)
+		if err != nil && err != ErrOrgNotFound {
+			return nil, err
+		}
+
+		if community == nil || !community.IsControlNode() {
+			err = m.persistence.SetDirectoryListing(communityID, false)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		communities = append(communities, community)
+	}
+
+	return communities, nil
+}
+
+// HandleCommunityDirectoryEntry indexes an entry announced in the directory.
+// The entry must be signed with the community key, or by the control node
+// of a community we already know of.
+func (m *Manager) HandleCommunityDirectoryEntry(signer *ecdsa.PublicKey, entryProto *protobuf.CommunityDirectoryEntry) (*DirectoryEntry, error) {
+	if len(entryProto.CommunityId) == 0 || len(entryProto.Name) == 0 {
+		return nil, ErrDirectoryEntryInvalid
+	}
+
+	if !bytes.Equal(crypto.CompressPubkey(signer), entryProto.CommunityId) {
+		community, err := m.GetByID(entryProto.CommunityId)
+		if err == ErrOrgNotFound {
+			return nil, ErrDirectoryEntryWrongSigner
+		} else if err != nil {
+			return nil, err
+		}
+
+		if !common.IsPubKeyEqual(community.ControlNode(), signer) {
+			return nil, ErrDirectoryEntryWrongSigner
+		}
+	}
+
+	entry := &DirectoryEntry{
+		CommunityID:  entryProto.CommunityId,
+		Clock:        entryProto.Clock,
+		Name:         truncateString(entryProto.Name, directoryEntryMaxNameLength),
+		Description:  truncateString(entryProto.Description, directoryEntryMaxDescriptionLength),
+		Tags:         requests.RemoveUnknownAndDeduplicateTags(entryProto.Tags),
+		MembersCount: entryProto.MembersCount,
+		Color:        entryProto.Color,
+		Shard:        shard.FromProtobuff(entryProto.Shard),
+		ReceivedAt:   m.timesource.GetCurrentTime(),
+	}
+
+	saved, err := m.persistence.SaveDirectoryEntry(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	if !saved {
+		return nil, nil
+	}
+
+	err = m.persistence.DeleteDirectoryEntriesBeyond(directoryMaxEntries)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (m *Manager) SearchDirectory(request *requests.SearchCommunityDirectory) ([]*DirectoryEntry, error) {
+	return m.persistence.SearchDirectory(request)
+}
+
+// EvictStaleDirectoryEntries removes the entries that were not announced again within ttl
+func (m *Manager) EvictStaleDirectoryEntries(ttl time.Duration) error {
+	now := m.timesource.GetCurrentTime()
+	ttlMs := uint64(ttl.Milliseconds())
+	if now <= ttlMs {
+		return nil
+	}
+
+	return m.persistence.DeleteDirectoryEntriesReceivedBefore(now - ttlMs)
+}
@@ -0,0 +1,375 @@
+package communities
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+var ErrInviteNotFound = errors.New("community invite not found")
+var ErrInviteInvalid = errors.New("invalid community invite")
+var ErrInviteExpired = errors.New("community invite expired")
+var ErrInviteRevoked = errors.New("community invite revoked")
+var ErrInviteExhausted = errors.New("community invite has no uses left")
+
+// Invite is a link to a community signed by one of its owners or admins.
+// The control node accepts it until it expires, runs out of uses or gets revoked.
+type Invite struct {
+	ID          string         `json:"id"`
+	CommunityID types.HexBytes `json:"communityId"`
+	Creator     string         `json:"creator"`
+	Clock       uint64         `json:"clock"`
+	// ExpiresAt is a unix timestamp in seconds, 0 means the invite never expires
+	ExpiresAt uint64 `json:"expiresAt"`
+	// MaxUses is the number of members that can redeem the invite, 0 means unlimited
+	MaxUses    uint32 `json:"maxUses"`
+	ChannelID  string `json:"channelId,omitempty"`
+	AutoAccept bool   `json:"autoAccept"`
+	Uses       uint32 `json:"uses"`
+	Revoked    bool   `json:"revoked"`
+
+	Payload   []byte `json:"-"`
+	Signature []byte `json:"-"`
+}
+
+// NewInviteFromProtobuf decodes a signed invite and recovers its creator
+func NewInviteFromProtobuf(signedInvite *protobuf.SignedCommunityInvite) (*Invite, error) {
+	if signedInvite == nil || len(signedInvite.Payload) == 0 || len(signedInvite.Signature) == 0 {
+		return nil, ErrInviteInvalid
+	}
+
+	inviteProto := &protobuf.CommunityInvite{}
+	err := proto.Unmarshal(signedInvite.Payload, inviteProto)
+	if err != nil {
+		return nil, ErrInviteInvalid
+	}
+
+	if len(inviteProto.CommunityId) == 0 || inviteProto.InviteId == "" {
+		return nil, ErrInviteInvalid
+	}
+
+	creator, err := crypto.SigToPub(crypto.Keccak256(signedInvite.Payload), signedInvite.Signature)
+	if err != nil {
+		return nil, ErrInviteInvalid
+	}
+
+	return &Invite{
+		ID:          inviteProto.InviteId,
+		CommunityID: inviteProto.CommunityId,
+		Creator:     common.PubkeyToHex(creator),
+		Clock:       inviteProto.Clock,
+		ExpiresAt:   inviteProto.ExpiresAt,
+		MaxUses:     inviteProto.MaxUses,
+		ChannelID:   inviteProto.ChannelId,
+		AutoAccept:  inviteProto.AutoAccept,
+		Payload:     signedInvite.Payload,
+		Signature:   signedInvite.Signature,
+	}, nil
+}
+
+func (i *Invite) ToProtobuf() *protobuf.CommunityInvite {
+	return &protobuf.CommunityInvite{
+		CommunityId: i.CommunityID,
+		InviteId:    i.ID,
+		Clock:       i.Clock,
+		ExpiresAt:   i.ExpiresAt,
+		MaxUses:     i.MaxUses,
+		ChannelId:   i.ChannelID,
+		AutoAccept:  i.AutoAccept,
+	}
+}
+
+func (i *Invite) ToSignedProtobuf() *protobuf.SignedCommunityInvite {
+	return &protobuf.SignedCommunityInvite{
+		Payload:   i.Payload,
+		Signature: i.Signature,
+	}
+}
+
+// Expired takes the current time in unix seconds
+func (i *Invite) Expired(now uint64) bool {
+	return i.ExpiresAt != 0 && i.ExpiresAt <= now
+}
+
+func (i *Invite) sign(pk *ecdsa.PrivateKey) error {
+	payload, err := proto.Marshal(i.ToProtobuf())
+	if err != nil {
+		return err
+	}
+
+	signature, err := crypto.Sign(crypto.Keccak256(payload), pk)
+	if err != nil {
+		return err
+	}
+
+	i.Creator = common.PubkeyToHex(&pk.PublicKey)
+	i.Payload = payload
+	i.Signature = signature
+	return nil
+}
+
+func (o *Community) RevokeInvite(invite *Invite) (*protobuf.CommunityDescription, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !(o.IsControlNode() || o.hasPermissionToSendCommunityEvent(protobuf.CommunityEvent_COMMUNITY_INVITE_REVOKE)) {
+		return nil, ErrNotAuthorized
+	}
+
+	if o.IsControlNode() {
+		o.revokeInvite(invite.ToProtobuf())
+		o.addAuditLogEvent(o.ToRevokeInviteCommunityEvent(invite.ToProtobuf()))
+		o.increaseClock()
+	} else {
+		err := o.addNewCommunityEvent(o.ToRevokeInviteCommunityEvent(invite.ToProtobuf()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return o.config.CommunityDescription, nil
+}
+
+func (o *Community) IsInviteRevoked(inviteID string) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	_, ok := o.config.CommunityDescription.RevokedInvites[inviteID]
+	return ok
+}
+
+// ValidateInvite checks that the invite has been issued for this community by a
+// privileged member and that it can still be redeemed. Uses are counted by the control node.
+func (o *Community) ValidateInvite(invite *Invite) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if !bytes.Equal(invite.CommunityID, o.ID()) {
+		return ErrInviteInvalid
+	}
+
+	creator, err := common.HexToPubkey(invite.Creator)
+	if err != nil {
+		return ErrInviteInvalid
+	}
+
+	if !creator.Equal(o.ControlNode()) && !o.IsPrivilegedMember(creator) {
+		return ErrInviteInvalid
+	}
+
+	if _, ok := o.config.CommunityDescription.RevokedInvites[invite.ID]; ok {
+		return ErrInviteRevoked
+	}
+
+	if invite.Expired(o.timesource.GetCurrentTime() / 1000) {
+		return ErrInviteExpired
+	}
+
+	if invite.ChannelID != "" && o.config.CommunityDescription.Chats[invite.ChannelID] == nil {
+		return ErrChatNotFound
+	}
+
+	return nil
+}
+
+// revokeInvite keeps the revoked invite until it expires, expired invites are rejected anyway
+func (o *Community) revokeInvite(invite *protobuf.CommunityInvite) {
+	now := o.timesource.GetCurrentTime() / 1000
+	for inviteID, expiresAt := range o.config.CommunityDescription.RevokedInvites {
+		if expiresAt != 0 && expiresAt <= now {
+			delete(o.config.CommunityDescription.RevokedInvites, inviteID)
+		}
+	}
+
+	if invite.ExpiresAt != 0 && invite.ExpiresAt <= now {
+		return
+	}
+
+	if o.config.CommunityDescription.RevokedInvites == nil {
+		o.config.CommunityDescription.RevokedInvites = make(map[string]uint64)
+	}
+	o.config.CommunityDescription.RevokedInvites[invite.InviteId] = invite.ExpiresAt
+}
+
+func (m *Manager) CreateInvite(request *requests.CreateCommunityInvite) (*Invite, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !community.IsControlNode() && !community.IsPrivilegedMember(&m.identity.PublicKey) {
+		return nil, ErrNotAuthorized
+	}
+
+	if request.ChannelID != "" && community.Chats()[request.ChannelID] == nil {
+		return nil, ErrChatNotFound
+	}
+
+	now := m.timesource.GetCurrentTime()
+
+	invite := &Invite{
+		ID:          uuid.New().String(),
+		CommunityID: community.ID(),
+		Clock:       now,
+		MaxUses:     request.MaxUses,
+		ChannelID:   request.ChannelID,
+		AutoAccept:  request.AutoAccept,
+	}
+	if request.Duration > 0 {
+		invite.ExpiresAt = now/1000 + request.Duration
+	}
+
+	err = invite.sign(m.identity)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveCommunityInvite(invite)
+	if err != nil {
+		return nil, err
+	}
+
+	return invite, nil
+}
+
+func (m *Manager) RevokeInvite(request *requests.RevokeCommunityInvite) (*Community, error) {
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	invite, err := m.persistence.GetCommunityInvite(request.CommunityID, request.InviteID)
+	if err != nil {
+		return nil, err
+	}
+	if invite == nil {
+		return nil, ErrInviteNotFound
+	}
+
+	_, err = community.RevokeInvite(invite)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SetCommunityInviteRevoked(request.CommunityID, request.InviteID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.saveAndPublish(community)
+	if err != nil {
+		return nil, err
+	}
+
+	return community, nil
+}
+
+// GetInvites returns the invites known locally, the number of uses is only
+// accurate on the control node, as it's the one redeeming invites
+func (m *Manager) GetInvites(communityID types.HexBytes) ([]*Invite, error) {
+	community, err := m.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	invites, err := m.persistence.GetCommunityInvites(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, invite := range invites {
+		invite.Revoked = invite.Revoked || community.IsInviteRevoked(invite.ID)
+	}
+
+	return invites, nil
+}
+
+// attachInvite validates the invite attached to a request to join and keeps it
+// until the request is decided. The invite is only used once the request is accepted.
+func (m *Manager) attachInvite(community *Community, signer *ecdsa.PublicKey, signedInvite *protobuf.SignedCommunityInvite, clock uint64) (*Invite, error) {
+	member := common.PubkeyToHex(signer)
+
+	// Only the invite of the latest request is kept
+	err := m.persistence.DeleteCommunityInvitePendingRedemptions(community.ID(), member)
+	if err != nil {
+		return nil, err
+	}
+
+	if signedInvite == nil {
+		return nil, nil
+	}
+
+	invite, err := NewInviteFromProtobuf(signedInvite)
+	if err != nil {
+		return nil, err
+	}
+
+	err = community.ValidateInvite(invite)
+	if err != nil {
+		return nil, err
+	}
+
+	redemptions, err := m.persistence.GetCommunityInviteRedemptions(community.ID(), invite.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, redeemedBy := range redemptions {
+		if redeemedBy == member {
+			return invite, nil
+		}
+	}
+
+	if invite.MaxUses > 0 && uint32(len(redemptions)) >= invite.MaxUses {
+		return nil, ErrInviteExhausted
+	}
+
+	// Invites created by admins are not known to the control node until used
+	err = m.persistence.SaveCommunityInvite(invite)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.persistence.SaveCommunityInvitePendingRedemption(community.ID(), invite.ID, member, clock)
+	if err != nil {
+		return nil, err
+	}
+
+	return invite, nil
+}
+
+// redeemInvite records the use of the invite attached to the accepted request of the member.
+// A member sending several requests with the same invite only uses it once, and an
+// invite used up in the meantime is not counted for requests accepted by hand.
+func (m *Manager) redeemInvite(community *Community, pk *ecdsa.PublicKey) error {
+	member := common.PubkeyToHex(pk)
+	inviteID, err := m.persistence.GetCommunityInvitePendingRedemption(community.ID(), member)
+	if err != nil || inviteID == "" {
+		return err
+	}
+
+	invite, err := m.persistence.GetCommunityInvite(community.ID(), inviteID)
+	if err != nil {
+		return err
+	}
+
+	if invite != nil && (invite.MaxUses == 0 || invite.Uses < invite.MaxUses) {
+		err = m.persistence.AcceptCommunityInviteRedemption(community.ID(), inviteID, member)
+		if err != nil {
+			return err
+		}
+		m.logger.Debug("community invite redeemed", zap.String("inviteID", inviteID), zap.String("member", member))
+	}
+
+	return m.persistence.DeleteCommunityInvitePendingRedemptions(community.ID(), member)
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"net"
 	"os"
 	"sort"
@@ -103,6 +104,7 @@ type Manager struct {
 	PermissionChecker                PermissionChecker
 	keyDistributor                   KeyDistributor
 	communityLock                    *CommunityLock
+	automod                          *Automod
 }
 
 type CommunityLock struct {
@@ -219,6 +221,7 @@ func (m *DefaultTokenManager) GetAllChainIDs() ([]uint64, error) {
 
 type CollectiblesManager interface {
 	FetchBalancesByOwnerAndContractAddress(ctx context.Context, chainID walletcommon.ChainID, ownerAddress gethcommon.Address, contractAddresses []gethcommon.Address) (thirdparty.TokenBalancesPerContractAddress, error)
+	FetchERC1155Balances(ctx context.Context, chainID walletcommon.ChainID, ownerAddress gethcommon.Address, contractAddress gethcommon.Address, tokenIDs []*big.Int) ([]thirdparty.TokenBalance, error)
 	GetCollectibleOwnership(id thirdparty.CollectibleUniqueID) ([]thirdparty.AccountBalance, error)
 }
 
@@ -319,6 +322,7 @@ func NewManager(identity *ecdsa.PrivateKey, installationID string, db *sql.DB, e
 		historyArchiveDownloadTasks: make(map[string]*HistoryArchiveDownloadTask),
 		keyDistributor:              keyDistributor,
 		communityLock:               NewCommunityLock(logger),
+		automod:                     NewAutomod(),
 	}
 
 	manager.persistence = &Persistence{
@@ -1131,7 +1135,12 @@ func (m *Manager) ReevaluateCommunityMembersPermissions(community *Community) er
 		return err
 	}
 
-	return m.shareRequestsToJoinWithNewPrivilegedMembers(community, newPrivilegedMembers)
+	err = m.shareRequestsToJoinWithNewPrivilegedMembers(community, newPrivilegedMembers)
+	if err != nil {
+		return err
+	}
+
+	return m.shareAuditLogWithNewPrivilegedMembers(community, newPrivilegedMembers)
 }
 
 func (m *Manager) DeleteCommunity(id types.HexBytes) error {
@@ -1229,6 +1238,7 @@ func (m *Manager) EditCommunity(request *requests.EditCommunity) (*Community, er
 	}
 
 	if community.IsControlNode() {
+		community.addAuditLogEvent(community.ToCommunityEditCommunityEvent(newDescription))
 		community.increaseClock()
 	} else {
 		err := community.addNewCommunityEvent(community.ToCommunityEditCommunityEvent(newDescription))
@@ -1894,7 +1904,7 @@ func (m *Manager) HandleCommunityEventsMessage(signer *ecdsa.PublicKey, message
 		return nil, err
 	}
 
-	if !community.IsPrivilegedMember(signer) {
+	if !community.IsModeratingMember(signer) {
 		return nil, errors.New("user has not permissions to send events")
 	}
 
@@ -1950,6 +1960,11 @@ func (m *Manager) HandleCommunityEventsMessage(signer *ecdsa.PublicKey, message
 			return nil, err
 		}
 
+		err = m.saveAuditLog(community)
+		if err != nil {
+			return nil, err
+		}
+
 		m.publish(&Subscription{Community: community})
 	} else {
 		err = m.persistence.SaveCommunity(community)
@@ -2385,6 +2400,13 @@ func (m *Manager) AcceptRequestToJoin(dbRequest *RequestToJoin) (*Community, err
 			}
 		}
 
+		err = m.redeemInvite(community, pk)
+		if err != nil {
+			return nil, err
+		}
+
+		community.addAuditLogEvent(community.ToCommunityRequestToJoinAcceptCommunityEvent(dbRequest.PublicKey, dbRequest.ToCommunityRequestToJoinProtobuf()))
+
 		dbRequest.State = RequestToJoinStateAccepted
 		if err := m.markRequestToJoinAsAccepted(pk, community); err != nil {
 			return nil, err
@@ -2398,13 +2420,16 @@ func (m *Manager) AcceptRequestToJoin(dbRequest *RequestToJoin) (*Community, err
 		// if accepted member has a privilege role, share with him requests to join
 		memberRole := community.MemberRole(pk)
 		if memberRole == protobuf.CommunityMember_ROLE_OWNER || memberRole == protobuf.CommunityMember_ROLE_ADMIN ||
-			memberRole == protobuf.CommunityMember_ROLE_TOKEN_MASTER {
+			memberRole == protobuf.CommunityMember_ROLE_TOKEN_MASTER || memberRole == protobuf.CommunityMember_ROLE_MODERATOR {
 
 			newPrivilegedMember := make(map[protobuf.CommunityMember_Roles][]*ecdsa.PublicKey)
 			newPrivilegedMember[memberRole] = []*ecdsa.PublicKey{pk}
 			if err = m.shareRequestsToJoinWithNewPrivilegedMembers(community, newPrivilegedMember); err != nil {
 				return nil, err
 			}
+			if err = m.shareAuditLogWithNewPrivilegedMembers(community, newPrivilegedMember); err != nil {
+				return nil, err
+			}
 		}
 	} else if community.hasPermissionToSendCommunityEvent(protobuf.CommunityEvent_COMMUNITY_REQUEST_TO_JOIN_ACCEPT) {
 		err := community.addNewCommunityEvent(community.ToCommunityRequestToJoinAcceptCommunityEvent(dbRequest.PublicKey, dbRequest.ToCommunityRequestToJoinProtobuf()))
@@ -2629,6 +2654,15 @@ func (m *Manager) HandleCommunityRequestToJoin(signer *ecdsa.PublicKey, receiver
 		// It may happen when member removes itself from community and then tries to rejoin
 		// More specifically, CommunityRequestToLeave may be delivered later than CommunityRequestToJoin, or not delivered at all
 		acceptAutomatically := community.AutoAccept() || community.HasMember(signer)
+
+		// An invalid invite doesn't invalidate the request, it's handled as any other request
+		invite, err := m.attachInvite(community, signer, request.Invite, request.Clock)
+		if err != nil {
+			m.logger.Warn("failed to attach community invite", zap.Error(err))
+		} else if invite != nil && invite.AutoAccept {
+			acceptAutomatically = true
+		}
+
 		if acceptAutomatically {
 			// Don't check permissions here,
 			// it will be done further in the processing pipeline.
@@ -2715,6 +2749,10 @@ func calculateChainIDsSet(accountsAndChainIDs []*AccountChainIDsCombination, req
 
 type CollectiblesByChain = map[uint64]map[gethcommon.Address]thirdparty.TokenBalancesPerContractAddress
 
+func (m *Manager) GetOwnedERC1155Tokens(walletAddresses []gethcommon.Address, tokenRequirements map[uint64]map[string][]*protobuf.TokenCriteria, chainIDs []uint64) (CollectiblesByChain, error) {
+	return fetchOwnedERC1155Tokens(m.collectiblesManager, walletAddresses, tokenRequirements, chainIDs)
+}
+
 func (m *Manager) GetOwnedERC721Tokens(walletAddresses []gethcommon.Address, tokenRequirements map[uint64]map[string]*protobuf.TokenCriteria, chainIDs []uint64) (CollectiblesByChain, error) {
 	if m.collectiblesManager == nil {
 		return nil, errors.New("no collectibles manager")
@@ -3209,13 +3247,11 @@ func (m *Manager) AddRoleToMember(request *requests.AddRoleToMember) (*Community
 		return nil, err
 	}
 
-	err = m.persistence.SaveCommunity(community)
+	err = m.saveAndPublish(community)
 	if err != nil {
 		return nil, err
 	}
 
-	m.publish(&Subscription{Community: community})
-
 	return community, nil
 }
 
@@ -3240,13 +3276,11 @@ func (m *Manager) RemoveRoleFromMember(request *requests.RemoveRoleFromMember) (
 		return nil, err
 	}
 
-	err = m.persistence.SaveCommunity(community)
+	err = m.saveAndPublish(community)
 	if err != nil {
 		return nil, err
 	}
 
-	m.publish(&Subscription{Community: community})
-
 	return community, nil
 }
 
@@ -3268,6 +3302,61 @@ func (m *Manager) BanUserFromCommunity(request *requests.BanUserFromCommunity) (
 		return nil, err
 	}
 
+	if request.DeleteAllMessages {
+		err = community.DeleteAllMemberMessages(publicKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = m.saveAndPublish(community)
+	if err != nil {
+		return nil, err
+	}
+
+	return community, nil
+}
+
+// DeleteMessageForEveryone records the deletion of another member's message in the audit log
+func (m *Manager) DeleteMessageForEveryone(communityID types.HexBytes, author string, chatID string, messageID string) error {
+	community, err := m.GetByID(communityID)
+	if err != nil {
+		return err
+	}
+
+	err = community.DeleteMessageForEveryone(author, chatID, messageID)
+	if err != nil {
+		return err
+	}
+
+	if community.IsControlNode() {
+		// The description is unchanged, there is nothing to publish
+		return m.saveAuditLog(community)
+	}
+	return m.saveAndPublish(community)
+}
+
+func (m *Manager) TimeoutMember(request *requests.TimeoutCommunityMember) (*Community, error) {
+	publicKey, err := common.HexToPubkey(request.User.String())
+	if err != nil {
+		return nil, err
+	}
+
+	community, err := m.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutInfo := &protobuf.CommunityTimeoutInfo{Reason: request.Reason}
+	if request.Duration > 0 {
+		timeoutInfo.ExpiresAt = m.timesource.GetCurrentTime()/1000 + request.Duration
+	}
+
+	_, err = community.TimeoutMember(publicKey, timeoutInfo)
+	if err != nil {
+		return nil, err
+	}
+
 	err = m.saveAndPublish(community)
 	if err != nil {
 		return nil, err
@@ -3421,6 +3510,18 @@ func (m *Manager) PendingRequestsToJoinForCommunity(id types.HexBytes) ([]*Reque
 	return m.persistence.PendingRequestsToJoinForCommunity(id)
 }
 
+func (m *Manager) RequestsToJoinCountByState(id types.HexBytes, from uint64, to uint64) (map[RequestToJoinState]int, error) {
+	return m.persistence.RequestsToJoinCountByState(id, from, to)
+}
+
+func (m *Manager) RequestsToLeaveCount(id types.HexBytes, from uint64, to uint64) (int, error) {
+	return m.persistence.RequestsToLeaveCount(id, from, to)
+}
+
+func (m *Manager) AcceptedRequestsToJoinPublicKeys(id types.HexBytes, from uint64, to uint64) ([]string, error) {
+	return m.persistence.AcceptedRequestsToJoinPublicKeys(id, from, to)
+}
+
 func (m *Manager) DeclinedRequestsToJoinForCommunity(id types.HexBytes) ([]*RequestToJoin, error) {
 	m.logger.Info("fetching declined invitations", zap.String("community-id", id.String()))
 	return m.persistence.DeclinedRequestsToJoinForCommunity(id)
@@ -4726,6 +4827,11 @@ func (m *Manager) saveAndPublish(community *Community) error {
 	}
 
 	if community.IsControlNode() {
+		err = m.saveAuditLog(community)
+		if err != nil {
+			return err
+		}
+
 		m.publish(&Subscription{Community: community})
 		return nil
 	} else if community.HasPermissionToSendCommunityEvents() {
@@ -4929,6 +5035,10 @@ func (m *Manager) ValidateCommunityPrivilegedUserSyncMessage(message *protobuf.C
 		if message.SyncRequestsToJoin == nil || len(message.SyncRequestsToJoin) == 0 {
 			return errors.New("invalid sync requests to join in CommunityPrivilegedUserSyncMessage message")
 		}
+	case protobuf.CommunityPrivilegedUserSyncMessage_CONTROL_NODE_AUDIT_LOG:
+		if len(message.AuditLog) == 0 {
+			return errors.New("invalid audit log in CommunityPrivilegedUserSyncMessage message")
+		}
 	}
 
 	return nil
@@ -5052,7 +5162,7 @@ func (m *Manager) shareRequestsToJoinWithNewPrivilegedMembers(community *Communi
 		subscriptionMsg.Receivers = members
 
 		switch role {
-		case protobuf.CommunityMember_ROLE_ADMIN:
+		case protobuf.CommunityMember_ROLE_ADMIN, protobuf.CommunityMember_ROLE_MODERATOR:
 			subscriptionMsg.CommunityPrivilegedUserSyncMessage = syncMsgWithoutRevealedAccounts
 		case protobuf.CommunityMember_ROLE_OWNER:
 			continue
@@ -5109,7 +5219,7 @@ func (m *Manager) shareAcceptedRequestToJoinWithPrivilegedMembers(community *Com
 		subscriptionMsg.Receivers = members
 
 		switch role {
-		case protobuf.CommunityMember_ROLE_ADMIN:
+		case protobuf.CommunityMember_ROLE_ADMIN, protobuf.CommunityMember_ROLE_MODERATOR:
 			subscriptionMsg.CommunityPrivilegedUserSyncMessage = msgWithoutRevealedAccounts
 		case protobuf.CommunityMember_ROLE_OWNER:
 			fallthrough
@@ -5231,6 +5341,10 @@ func (m *Manager) encryptCommunityDescriptionChannel(community *Community, chann
 	return m.encryptCommunityDescriptionImpl([]byte(community.IDString()+channelID), d)
 }
 
+func (m *Manager) encryptCommunityDescriptionModerators(community *Community, d *protobuf.CommunityDescription) (string, []byte, error) {
+	return m.encryptCommunityDescriptionImpl(community.ModeratorsHashRatchetGroupID(), d)
+}
+
 // TODO: add collectiblesManager to messenger intance
 func (m *Manager) GetCollectiblesManager() CollectiblesManager {
 	return m.collectiblesManager
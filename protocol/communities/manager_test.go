@@ -3,6 +3,7 @@ package communities
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
 	"errors"
 	"image"
 	"image/png"
@@ -115,6 +116,20 @@ func (m *testCollectiblesManager) FetchBalancesByOwnerAndContractAddress(ctx con
 	return m.response[uint64(chainID)][ownerAddress], nil
 }
 
+func (m *testCollectiblesManager) FetchERC1155Balances(ctx context.Context, chainID walletCommon.ChainID, ownerAddress gethcommon.Address, contractAddress gethcommon.Address, tokenIDs []*big.Int) ([]thirdparty.TokenBalance, error) {
+	balances := make([]thirdparty.TokenBalance, 0, len(tokenIDs))
+	for _, tokenID := range tokenIDs {
+		balance := thirdparty.TokenBalance{TokenID: &bigint.BigInt{Int: tokenID}, Balance: &bigint.BigInt{Int: big.NewInt(0)}}
+		for _, owned := range m.response[uint64(chainID)][ownerAddress][contractAddress] {
+			if owned.TokenID.Cmp(tokenID) == 0 {
+				balance.Balance = owned.Balance
+			}
+		}
+		balances = append(balances, balance)
+	}
+	return balances, nil
+}
+
 func (m *testCollectiblesManager) GetCollectibleOwnership(id thirdparty.CollectibleUniqueID) ([]thirdparty.AccountBalance, error) {
 	return nil, errors.New("GetCollectibleOwnership is not implemented for testCollectiblesManager")
 }
@@ -997,6 +1012,243 @@ func (s *ManagerSuite) TestCheckChannelPermissions_ViewOnlyPermissions() {
 	s.Require().False(resp.ViewAndPostPermissions.Satisfied)
 }
 
+func (s *ManagerSuite) TestCheckPermissions_ERC1155() {
+
+	m, cm, _ := s.setupManagerForTokenPermissions()
+
+	var chainID uint64 = 5
+	contractAddress := gethcommon.HexToAddress("0x1155")
+	account1 := gethcommon.HexToAddress("0x1")
+	account2 := gethcommon.HexToAddress("0x2")
+
+	accountChainIDsCombination := []*AccountChainIDsCombination{
+		&AccountChainIDsCombination{Address: account1, ChainIDs: []uint64{chainID}},
+		&AccountChainIDsCombination{Address: account2, ChainIDs: []uint64{chainID}},
+	}
+
+	permissions := []*CommunityTokenPermission{
+		&CommunityTokenPermission{
+			CommunityTokenPermission: &protobuf.CommunityTokenPermission{
+				Id:   "some-id",
+				Type: protobuf.CommunityTokenPermission_BECOME_MEMBER,
+				TokenCriteria: []*protobuf.TokenCriteria{
+					&protobuf.TokenCriteria{
+						ContractAddresses: map[uint64]string{chainID: contractAddress.Hex()},
+						Symbol:            "EDITION",
+						Type:              protobuf.CommunityTokenType_ERC1155,
+						Name:              "Edition",
+						Amount:            "3",
+						TokenIds:          []uint64{1, 2},
+					},
+				},
+			},
+		},
+	}
+
+	// Token ID 3 is not part of the criteria
+	cm.setResponse(chainID, account1, contractAddress, []thirdparty.TokenBalance{
+		thirdparty.TokenBalance{TokenID: &bigint.BigInt{Int: big.NewInt(1)}, Balance: &bigint.BigInt{Int: big.NewInt(1)}},
+		thirdparty.TokenBalance{TokenID: &bigint.BigInt{Int: big.NewInt(3)}, Balance: &bigint.BigInt{Int: big.NewInt(5)}},
+	})
+
+	resp, err := m.PermissionChecker.CheckPermissions(permissions, accountChainIDsCombination, false)
+	s.Require().NoError(err)
+	s.Require().False(resp.Satisfied)
+
+	// The required amount is reached summing the balances of both accounts
+	cm.setResponse(chainID, account2, contractAddress, []thirdparty.TokenBalance{
+		thirdparty.TokenBalance{TokenID: &bigint.BigInt{Int: big.NewInt(2)}, Balance: &bigint.BigInt{Int: big.NewInt(2)}},
+	})
+
+	resp, err = m.PermissionChecker.CheckPermissions(permissions, accountChainIDsCombination, false)
+	s.Require().NoError(err)
+	s.Require().True(resp.Satisfied)
+	s.Require().Len(resp.ValidCombinations, 2)
+}
+
+func (s *ManagerSuite) TestCheckPermissions_Expression() {
+
+	m, cm, tm := s.setupManagerForTokenPermissions()
+
+	var chainID uint64 = 5
+	var decimals uint64 = 2
+	nftAAddress := gethcommon.HexToAddress("0xA")
+	nftBAddress := gethcommon.HexToAddress("0xB")
+	sntAddress := gethcommon.HexToAddress("0xC")
+	account := gethcommon.HexToAddress("0x1")
+
+	accountChainIDsCombination := []*AccountChainIDsCombination{
+		&AccountChainIDsCombination{Address: account, ChainIDs: []uint64{chainID}},
+	}
+
+	// (NFT A OR NFT B) AND 100 SNT
+	permissions := []*CommunityTokenPermission{
+		&CommunityTokenPermission{
+			CommunityTokenPermission: &protobuf.CommunityTokenPermission{
+				Id:   "some-id",
+				Type: protobuf.CommunityTokenPermission_BECOME_MEMBER,
+				ExpressionTokenCriteria: []*protobuf.TokenCriteria{
+					&protobuf.TokenCriteria{
+						ContractAddresses: map[uint64]string{chainID: nftAAddress.Hex()},
+						Type:              protobuf.CommunityTokenType_ERC721,
+						Symbol:            "NFTA",
+						Amount:            "1",
+					},
+					&protobuf.TokenCriteria{
+						ContractAddresses: map[uint64]string{chainID: nftBAddress.Hex()},
+						Type:              protobuf.CommunityTokenType_ERC721,
+						Symbol:            "NFTB",
+						Amount:            "1",
+					},
+					&protobuf.TokenCriteria{
+						ContractAddresses: map[uint64]string{chainID: sntAddress.Hex()},
+						Type:              protobuf.CommunityTokenType_ERC20,
+						Symbol:            "SNT",
+						Amount:            "100",
+						Decimals:          decimals,
+					},
+				},
+				Expression: &protobuf.TokenCriteriaExpression{
+					Operator: protobuf.TokenCriteriaExpression_AND,
+					Operands: []*protobuf.TokenCriteriaExpression{
+						&protobuf.TokenCriteriaExpression{
+							Operator: protobuf.TokenCriteriaExpression_OR,
+							Operands: []*protobuf.TokenCriteriaExpression{
+								&protobuf.TokenCriteriaExpression{CriteriaIndex: 0},
+								&protobuf.TokenCriteriaExpression{CriteriaIndex: 1},
+							},
+						},
+						&protobuf.TokenCriteriaExpression{CriteriaIndex: 2},
+					},
+				},
+			},
+		},
+	}
+
+	cm.setResponse(chainID, account, nftBAddress, []thirdparty.TokenBalance{
+		thirdparty.TokenBalance{TokenID: &bigint.BigInt{Int: big.NewInt(1)}, Balance: &bigint.BigInt{Int: big.NewInt(1)}},
+	})
+	tm.setResponse(chainID, account, sntAddress, int64(10*math.Pow(10, float64(decimals))))
+
+	resp, err := m.PermissionChecker.CheckPermissions(permissions, accountChainIDsCombination, false)
+	s.Require().NoError(err)
+	s.Require().False(resp.Satisfied)
+
+	// The failing clause is reported
+	expression := resp.Permissions["some-id"].Expression
+	s.Require().NotNil(expression)
+	s.Require().True(expression.Clauses[0].Satisfied)
+	s.Require().False(expression.Clauses[1].Satisfied)
+	// Flat results are reported for every criteria
+	s.Require().Equal([]bool{false, true, false}, resp.Permissions["some-id"].Criteria)
+	// Accounts are not revealed when the permission is not satisfied
+	s.Require().Empty(resp.ValidCombinations)
+
+	tm.setResponse(chainID, account, sntAddress, int64(100*math.Pow(10, float64(decimals))))
+
+	resp, err = m.PermissionChecker.CheckPermissions(permissions, accountChainIDsCombination, false)
+	s.Require().NoError(err)
+	s.Require().True(resp.Satisfied)
+	s.Require().True(resp.Permissions["some-id"].IsSatisfied())
+	s.Require().Len(resp.ValidCombinations, 1)
+
+	// With shortcircuit, NFT B isn't checked once NFT A satisfies the OR
+	cm.setResponse(chainID, account, nftAAddress, []thirdparty.TokenBalance{
+		thirdparty.TokenBalance{TokenID: &bigint.BigInt{Int: big.NewInt(1)}, Balance: &bigint.BigInt{Int: big.NewInt(1)}},
+	})
+
+	resp, err = m.PermissionChecker.CheckPermissions(permissions, accountChainIDsCombination, true)
+	s.Require().NoError(err)
+	s.Require().True(resp.Satisfied)
+	s.Require().Equal([]bool{true, false, true}, resp.Permissions["some-id"].Criteria)
+	s.Require().Len(resp.Permissions["some-id"].Expression.Clauses[0].Clauses, 1)
+	s.Require().Len(resp.ValidCombinations, 1)
+}
+
+func (s *ManagerSuite) TestCheckPermissions_ExpressionNegatedTokenNotRevealed() {
+
+	m, cm, tm := s.setupManagerForTokenPermissions()
+
+	var chainID uint64 = 5
+	var decimals uint64 = 2
+	nftAAddress := gethcommon.HexToAddress("0xA")
+	nftBAddress := gethcommon.HexToAddress("0xB")
+	sntAddress := gethcommon.HexToAddress("0xC")
+	account := gethcommon.HexToAddress("0x1")
+	otherAccount := gethcommon.HexToAddress("0x2")
+
+	accountChainIDsCombination := []*AccountChainIDsCombination{
+		&AccountChainIDsCombination{Address: account, ChainIDs: []uint64{chainID}},
+		&AccountChainIDsCombination{Address: otherAccount, ChainIDs: []uint64{chainID}},
+	}
+
+	// 100 SNT AND (NFT B OR NOT NFT A)
+	permissions := []*CommunityTokenPermission{
+		&CommunityTokenPermission{
+			CommunityTokenPermission: &protobuf.CommunityTokenPermission{
+				Id:   "some-id",
+				Type: protobuf.CommunityTokenPermission_BECOME_MEMBER,
+				ExpressionTokenCriteria: []*protobuf.TokenCriteria{
+					&protobuf.TokenCriteria{
+						ContractAddresses: map[uint64]string{chainID: sntAddress.Hex()},
+						Type:              protobuf.CommunityTokenType_ERC20,
+						Symbol:            "SNT",
+						Amount:            "100",
+						Decimals:          decimals,
+					},
+					&protobuf.TokenCriteria{
+						ContractAddresses: map[uint64]string{chainID: nftBAddress.Hex()},
+						Type:              protobuf.CommunityTokenType_ERC721,
+						Symbol:            "NFTB",
+						Amount:            "1",
+					},
+					&protobuf.TokenCriteria{
+						ContractAddresses: map[uint64]string{chainID: nftAAddress.Hex()},
+						Type:              protobuf.CommunityTokenType_ERC721,
+						Symbol:            "NFTA",
+						Amount:            "1",
+					},
+				},
+				Expression: &protobuf.TokenCriteriaExpression{
+					Operator: protobuf.TokenCriteriaExpression_AND,
+					Operands: []*protobuf.TokenCriteriaExpression{
+						&protobuf.TokenCriteriaExpression{CriteriaIndex: 0},
+						&protobuf.TokenCriteriaExpression{
+							Operator: protobuf.TokenCriteriaExpression_OR,
+							Operands: []*protobuf.TokenCriteriaExpression{
+								&protobuf.TokenCriteriaExpression{CriteriaIndex: 1},
+								&protobuf.TokenCriteriaExpression{
+									Operator: protobuf.TokenCriteriaExpression_NOT,
+									Operands: []*protobuf.TokenCriteriaExpression{
+										&protobuf.TokenCriteriaExpression{CriteriaIndex: 2},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tm.setResponse(chainID, account, sntAddress, int64(100*math.Pow(10, float64(decimals))))
+	cm.setResponse(chainID, account, nftBAddress, []thirdparty.TokenBalance{
+		thirdparty.TokenBalance{TokenID: &bigint.BigInt{Int: big.NewInt(1)}, Balance: &bigint.BigInt{Int: big.NewInt(1)}},
+	})
+	cm.setResponse(chainID, otherAccount, nftAAddress, []thirdparty.TokenBalance{
+		thirdparty.TokenBalance{TokenID: &bigint.BigInt{Int: big.NewInt(1)}, Balance: &bigint.BigInt{Int: big.NewInt(1)}},
+	})
+
+	resp, err := m.PermissionChecker.CheckPermissions(permissions, accountChainIDsCombination, false)
+	s.Require().NoError(err)
+	s.Require().True(resp.Satisfied)
+
+	// The account holding the negated token does not meet the permission
+	s.Require().Len(resp.ValidCombinations, 1)
+	s.Require().Equal(account, resp.ValidCombinations[0].Address)
+	s.Require().Equal([]uint64{chainID}, resp.ValidCombinations[0].ChainIDs)
+}
+
 func (s *ManagerSuite) TestCheckChannelPermissions_ViewAndPostPermissions() {
 
 	m, _, tm := s.setupManagerForTokenPermissions()
@@ -1899,3 +2151,640 @@ func (s *ManagerSuite) TestCommunityQueueMultipleDifferentSignersIgnoreIfNotRetu
 	s.Require().NoError(err)
 	s.Require().Equal(clock1, fetchedCommunity.config.CommunityDescription.Clock)
 }
+
+func (s *ManagerSuite) TestCommunityAuditLog() {
+	community, chatID, err := s.buildCommunityWithChat()
+	s.Require().NoError(err)
+
+	bannedKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	bannedPk := common.PubkeyToHex(&bannedKey.PublicKey)
+
+	_, err = s.manager.BanUserFromCommunity(&requests.BanUserFromCommunity{
+		CommunityID: community.ID(),
+		User:        types.HexBytes(crypto.FromECDSAPub(&bannedKey.PublicKey)),
+	})
+	s.Require().NoError(err)
+
+	entries, err := s.manager.GetCommunityAuditLog(community.ID(), &AuditLogFilter{})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 2)
+
+	actor := common.PubkeyToHex(&s.manager.identity.PublicKey)
+	s.Require().Equal(protobuf.CommunityEvent_COMMUNITY_CHANNEL_CREATE, entries[0].Action)
+	s.Require().Equal(chatID, entries[0].Target)
+	s.Require().Equal(actor, entries[0].Actor)
+	s.Require().Empty(entries[0].PrevHash)
+
+	s.Require().Equal(protobuf.CommunityEvent_COMMUNITY_MEMBER_BAN, entries[1].Action)
+	s.Require().Equal(bannedPk, entries[1].Target)
+	s.Require().Equal(actor, entries[1].Actor)
+	s.Require().Equal(entries[0].Hash, entries[1].PrevHash)
+
+	entries, err = s.manager.GetCommunityAuditLog(community.ID(), &AuditLogFilter{Actions: []protobuf.CommunityEvent_EventType{protobuf.CommunityEvent_COMMUNITY_MEMBER_BAN}})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+
+	entries, err = s.manager.GetCommunityAuditLog(community.ID(), &AuditLogFilter{Cursor: 1})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Require().Equal(uint64(2), entries[0].Position)
+
+	export, err := s.manager.ExportCommunityAuditLog(community.ID())
+	s.Require().NoError(err)
+	s.Require().True(export.Verified)
+	s.Require().Len(export.Entries, 2)
+
+	// altering an entry breaks the chain
+	_, err = s.manager.persistence.db.Exec(`UPDATE community_audit_log SET target = ? WHERE position = 2`, actor)
+	s.Require().NoError(err)
+
+	export, err = s.manager.ExportCommunityAuditLog(community.ID())
+	s.Require().NoError(err)
+	s.Require().False(export.Verified)
+	s.Require().NotEmpty(export.Error)
+}
+
+func (s *ManagerSuite) TestCommunityAuditLogRolesAndDeletions() {
+	community, chatID, err := s.buildCommunityWithChat()
+	s.Require().NoError(err)
+
+	memberKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	memberPk := common.PubkeyToHex(&memberKey.PublicKey)
+	_, err = community.AddMember(&memberKey.PublicKey, []protobuf.CommunityMember_Roles{})
+	s.Require().NoError(err)
+	s.Require().NoError(s.manager.persistence.SaveCommunity(community))
+
+	member := types.HexBytes(crypto.FromECDSAPub(&memberKey.PublicKey))
+	_, err = s.manager.AddRoleToMember(&requests.AddRoleToMember{CommunityID: community.ID(), User: member, Role: protobuf.CommunityMember_ROLE_MODERATOR})
+	s.Require().NoError(err)
+	_, err = s.manager.RemoveRoleFromMember(&requests.RemoveRoleFromMember{CommunityID: community.ID(), User: member, Role: protobuf.CommunityMember_ROLE_MODERATOR})
+	s.Require().NoError(err)
+
+	err = s.manager.DeleteMessageForEveryone(community.ID(), memberPk, chatID, "message-id")
+	s.Require().NoError(err)
+
+	_, err = s.manager.BanUserFromCommunity(&requests.BanUserFromCommunity{CommunityID: community.ID(), User: member, DeleteAllMessages: true})
+	s.Require().NoError(err)
+
+	entries, err := s.manager.GetCommunityAuditLog(community.ID(), &AuditLogFilter{Cursor: 1})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 5)
+
+	s.Require().Equal(protobuf.CommunityEvent_COMMUNITY_MEMBER_ROLE_ADD, entries[0].Action)
+	s.Require().Equal(memberPk, entries[0].Target)
+	s.Require().Equal(protobuf.CommunityEvent_COMMUNITY_MEMBER_ROLE_REMOVE, entries[1].Action)
+	s.Require().Equal(memberPk, entries[1].Target)
+	s.Require().Equal(protobuf.CommunityEvent_COMMUNITY_MESSAGE_DELETE, entries[2].Action)
+	s.Require().Equal("message-id", entries[2].Target)
+	s.Require().Equal(protobuf.CommunityEvent_COMMUNITY_MEMBER_BAN, entries[3].Action)
+	s.Require().Equal(protobuf.CommunityEvent_COMMUNITY_MEMBER_MESSAGES_DELETE, entries[4].Action)
+	s.Require().Equal(memberPk, entries[4].Target)
+
+	export, err := s.manager.ExportCommunityAuditLog(community.ID())
+	s.Require().NoError(err)
+	s.Require().True(export.Verified)
+}
+
+func (s *ManagerSuite) TestCommunityInviteRedemption() {
+	community, _, err := s.buildCommunityWithChat()
+	s.Require().NoError(err)
+
+	invite, err := s.manager.CreateInvite(&requests.CreateCommunityInvite{
+		CommunityID: community.ID(),
+		Duration:    60,
+		MaxUses:     2,
+		AutoAccept:  true,
+	})
+	s.Require().NoError(err)
+	s.Require().NotZero(invite.ExpiresAt)
+
+	members := make([]*ecdsa.PrivateKey, 3)
+	for i := range members {
+		members[i], err = crypto.GenerateKey()
+		s.Require().NoError(err)
+	}
+
+	for i, member := range members {
+		attached, err := s.manager.attachInvite(community, &member.PublicKey, invite.ToSignedProtobuf(), uint64(i+1))
+		s.Require().NoError(err)
+		s.Require().True(attached.AutoAccept)
+	}
+
+	// invites attached to requests to join are not used until accepted
+	invites, err := s.manager.GetInvites(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(invites, 1)
+	s.Require().Zero(invites[0].Uses)
+
+	s.Require().NoError(s.manager.redeemInvite(community, &members[0].PublicKey))
+
+	// the same member is only counted once
+	_, err = s.manager.attachInvite(community, &members[0].PublicKey, invite.ToSignedProtobuf(), 4)
+	s.Require().NoError(err)
+	s.Require().NoError(s.manager.redeemInvite(community, &members[0].PublicKey))
+
+	s.Require().NoError(s.manager.redeemInvite(community, &members[1].PublicKey))
+
+	// a used up invite is not counted for requests accepted afterwards
+	s.Require().NoError(s.manager.redeemInvite(community, &members[2].PublicKey))
+
+	_, err = s.manager.attachInvite(community, &members[2].PublicKey, invite.ToSignedProtobuf(), 5)
+	s.Require().ErrorIs(err, ErrInviteExhausted)
+
+	invites, err = s.manager.GetInvites(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(invites, 1)
+	s.Require().Equal(uint32(2), invites[0].Uses)
+	s.Require().False(invites[0].Revoked)
+
+	unlimitedInvite, err := s.manager.CreateInvite(&requests.CreateCommunityInvite{CommunityID: community.ID()})
+	s.Require().NoError(err)
+	s.Require().Zero(unlimitedInvite.ExpiresAt)
+
+	community, err = s.manager.RevokeInvite(&requests.RevokeCommunityInvite{CommunityID: community.ID(), InviteID: unlimitedInvite.ID})
+	s.Require().NoError(err)
+	s.Require().True(community.IsInviteRevoked(unlimitedInvite.ID))
+
+	_, err = s.manager.attachInvite(community, &members[2].PublicKey, unlimitedInvite.ToSignedProtobuf(), 6)
+	s.Require().ErrorIs(err, ErrInviteRevoked)
+
+	invite, err = s.manager.persistence.GetCommunityInvite(community.ID(), unlimitedInvite.ID)
+	s.Require().NoError(err)
+	s.Require().True(invite.Revoked)
+
+	_, err = s.manager.RevokeInvite(&requests.RevokeCommunityInvite{CommunityID: community.ID(), InviteID: "unknown"})
+	s.Require().ErrorIs(err, ErrInviteNotFound)
+}
+
+func (s *ManagerSuite) TestCalendarEventRsvps() {
+	m, _, tm := s.setupManagerForTokenPermissions()
+
+	community, err := m.CreateCommunity(&requests.CreateCommunity{
+		Name:        "status",
+		Description: "status community description",
+		Membership:  protobuf.CommunityPermissions_AUTO_ACCEPT,
+	}, true)
+	s.Require().NoError(err)
+
+	var chainID uint64 = 5
+	contractAddress := "0x3d6afaa395c31fcd391fe3d562e75fe9e8ec7e6a"
+	now := m.timesource.GetCurrentTime() / 1000
+
+	community, calendarEvent, err := m.CreateCalendarEvent(&requests.CreateCommunityCalendarEvent{
+		CommunityID: community.ID(),
+		Title:       "AMA",
+		StartTime:   now + 3600,
+		Location:    "https://status.app/ama",
+		AttendanceTokenCriteria: []*protobuf.TokenCriteria{{
+			ContractAddresses: map[uint64]string{chainID: contractAddress},
+			Type:              protobuf.CommunityTokenType_ERC20,
+			Symbol:            "STT",
+			Amount:            "1",
+		}},
+	})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(calendarEvent.Id)
+	s.Require().Len(calendarEvent.AttendancePermissions, 1)
+
+	member, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	rsvp := &protobuf.CommunityCalendarEventRsvp{
+		Clock:       1,
+		CommunityId: community.ID(),
+		EventId:     calendarEvent.Id,
+		Status:      protobuf.CommunityCalendarEventRsvp_INTERESTED,
+	}
+
+	err = m.HandleCommunityCalendarEventRsvp(&member.PublicKey, rsvp)
+	s.Require().ErrorIs(err, ErrMemberNotFound)
+
+	_, err = community.AddMember(&member.PublicKey, []protobuf.CommunityMember_Roles{})
+	s.Require().NoError(err)
+	s.Require().NoError(m.SaveCommunity(community))
+
+	address := gethcommon.HexToAddress("0xD6b912e09E797D291E8D0eA3D3D17F8000e01c32")
+	err = m.persistence.SaveRequestToJoinRevealedAddresses(CalculateRequestID(common.PubkeyToHex(&member.PublicKey), community.ID()), []*protobuf.RevealedAccount{
+		{Address: address.Hex(), ChainIds: []uint64{chainID}},
+	})
+	s.Require().NoError(err)
+
+	// the counts are only published by UpdateCalendarEventRsvpCounts
+	updateCounts := func() *Community {
+		s.Require().NoError(m.UpdateCalendarEventRsvpCounts(community.ID()))
+		community, err := m.GetByID(community.ID())
+		s.Require().NoError(err)
+		return community
+	}
+
+	err = m.HandleCommunityCalendarEventRsvp(&member.PublicKey, rsvp)
+	s.Require().NoError(err)
+	stored, err := m.GetByID(community.ID())
+	s.Require().NoError(err)
+	s.Require().Zero(stored.CalendarEvent(calendarEvent.Id).InterestedCount)
+
+	community = updateCounts()
+	s.Require().Equal(uint32(1), community.CalendarEvent(calendarEvent.Id).InterestedCount)
+
+	// unchanged counts aren't published again
+	clock := community.Clock()
+	community = updateCounts()
+	s.Require().Equal(clock, community.Clock())
+
+	// going to a token gated event needs the tokens
+	rsvp.Clock = 2
+	rsvp.Status = protobuf.CommunityCalendarEventRsvp_GOING
+	err = m.HandleCommunityCalendarEventRsvp(&member.PublicKey, rsvp)
+	s.Require().ErrorIs(err, ErrCalendarEventAttendanceNotAllowed)
+
+	tm.setResponse(chainID, address, gethcommon.HexToAddress(contractAddress), 1)
+	err = m.HandleCommunityCalendarEventRsvp(&member.PublicKey, rsvp)
+	s.Require().NoError(err)
+	community = updateCounts()
+	s.Require().Equal(uint32(1), community.CalendarEvent(calendarEvent.Id).GoingCount)
+	s.Require().Zero(community.CalendarEvent(calendarEvent.Id).InterestedCount)
+
+	// stale RSVPs are ignored
+	rsvp.Clock = 1
+	rsvp.Status = protobuf.CommunityCalendarEventRsvp_NOT_GOING
+	err = m.HandleCommunityCalendarEventRsvp(&member.PublicKey, rsvp)
+	s.Require().NoError(err)
+	community = updateCounts()
+	s.Require().Equal(uint32(1), community.CalendarEvent(calendarEvent.Id).GoingCount)
+}
+
+func (s *ManagerSuite) TestCalendarEventRsvpRejected() {
+	community, _, err := s.buildCommunityWithChat()
+	s.Require().NoError(err)
+
+	now := s.manager.timesource.GetCurrentTime() / 1000
+	community, calendarEvent, err := s.manager.CreateCalendarEvent(&requests.CreateCommunityCalendarEvent{
+		CommunityID: community.ID(),
+		Title:       "AMA",
+		StartTime:   now + 3600,
+	})
+	s.Require().NoError(err)
+
+	_, rsvp, err := s.manager.CreateCalendarEventRsvp(&requests.RsvpCommunityCalendarEvent{
+		CommunityID: community.ID(),
+		EventID:     calendarEvent.Id,
+		Status:      protobuf.CommunityCalendarEventRsvp_GOING,
+	})
+	s.Require().NoError(err)
+
+	rejected := &protobuf.CommunityCalendarEventRsvpRejected{
+		Clock:       rsvp.Clock,
+		CommunityId: community.ID(),
+		EventId:     calendarEvent.Id,
+	}
+
+	// only the control node rejects RSVPs
+	otherKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	_, _, err = s.manager.HandleCommunityCalendarEventRsvpRejected(&otherKey.PublicKey, rejected)
+	s.Require().ErrorIs(err, ErrNotAuthorized)
+
+	// a newer RSVP is kept
+	rejected.Clock = rsvp.Clock - 1
+	_, deleted, err := s.manager.HandleCommunityCalendarEventRsvpRejected(community.ControlNode(), rejected)
+	s.Require().NoError(err)
+	s.Require().False(deleted)
+
+	rejected.Clock = rsvp.Clock
+	_, deleted, err = s.manager.HandleCommunityCalendarEventRsvpRejected(community.ControlNode(), rejected)
+	s.Require().NoError(err)
+	s.Require().True(deleted)
+
+	stored, err := s.manager.GetCalendarEventRsvp(community.ID(), calendarEvent.Id)
+	s.Require().NoError(err)
+	s.Require().Nil(stored)
+}
+
+func (s *ManagerSuite) TestCalendarEventReminders() {
+	community, _, err := s.buildCommunityWithChat()
+	s.Require().NoError(err)
+
+	now := s.manager.timesource.GetCurrentTime() / 1000
+
+	community, calendarEvent, err := s.manager.CreateCalendarEvent(&requests.CreateCommunityCalendarEvent{
+		CommunityID: community.ID(),
+		Title:       "AMA",
+		StartTime:   now + 60,
+	})
+	s.Require().NoError(err)
+
+	_, _, err = s.manager.CreateCalendarEvent(&requests.CreateCommunityCalendarEvent{
+		CommunityID: community.ID(),
+		Title:       "Later",
+		StartTime:   now + 3600,
+	})
+	s.Require().NoError(err)
+
+	for _, e := range community.CalendarEvents() {
+		_, rsvp, err := s.manager.CreateCalendarEventRsvp(&requests.RsvpCommunityCalendarEvent{
+			CommunityID: community.ID(),
+			EventID:     e.Id,
+			Status:      protobuf.CommunityCalendarEventRsvp_GOING,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(e.Id, rsvp.EventId)
+	}
+
+	// the control node counts its own RSVPs
+	community, err = s.manager.GetByID(community.ID())
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(1), community.CalendarEvent(calendarEvent.Id).GoingCount)
+
+	reminders, err := s.manager.CalendarEventReminders(15 * time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(reminders, 1)
+	s.Require().Equal(calendarEvent.Id, reminders[0].CalendarEvent.Id)
+
+	s.Require().NoError(s.manager.SetCalendarEventReminded(community.ID(), calendarEvent.Id, calendarEvent.StartTime))
+	reminders, err = s.manager.CalendarEventReminders(15 * time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(reminders, 0)
+
+	// rescheduled events are reminded again
+	_, err = s.manager.EditCalendarEvent(&requests.EditCommunityCalendarEvent{
+		EventID: calendarEvent.Id,
+		CreateCommunityCalendarEvent: requests.CreateCommunityCalendarEvent{
+			CommunityID: community.ID(),
+			Title:       "AMA",
+			StartTime:   now + 120,
+		},
+	})
+	s.Require().NoError(err)
+
+	reminders, err = s.manager.CalendarEventReminders(15 * time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(reminders, 1)
+
+	_, err = s.manager.DeleteCalendarEvent(&requests.DeleteCommunityCalendarEvent{CommunityID: community.ID(), EventID: calendarEvent.Id})
+	s.Require().NoError(err)
+
+	reminders, err = s.manager.CalendarEventReminders(15 * time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(reminders, 0)
+}
+
+func (s *ManagerSuite) TestAutomodRules() {
+	community, _, err := s.buildCommunityWithChat()
+	s.Require().NoError(err)
+
+	rules, err := s.manager.SetAutomodRules(&requests.SetCommunityAutomodRules{
+		CommunityID: community.ID(),
+		Enabled:     true,
+		Rules: []*protobuf.CommunityAutomodRule{
+			{
+				Type:            protobuf.CommunityAutomodRule_KEYWORDS,
+				Patterns:        []string{"airdrop"},
+				Action:          protobuf.CommunityAutomodRule_TIMEOUT,
+				TimeoutDuration: 60,
+				Reason:          "spam",
+			},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(rules.Rules[0].Id)
+
+	// rules are stored in the community description
+	s.manager.automod = NewAutomod()
+	community, err = s.manager.GetByID(community.ID())
+	s.Require().NoError(err)
+	s.Require().True(proto.Equal(rules, community.AutomodRules()))
+
+	savedRules, err := s.manager.GetAutomodRules(community.ID())
+	s.Require().NoError(err)
+	s.Require().True(proto.Equal(rules, savedRules))
+
+	memberKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	_, err = community.AddMember(&memberKey.PublicKey, []protobuf.CommunityMember_Roles{})
+	s.Require().NoError(err)
+
+	verdict, err := s.manager.EvaluateAutomod(community, &memberKey.PublicKey, &AutomodMessage{Text: "free AIRDROP"})
+	s.Require().NoError(err)
+	s.Require().NotNil(verdict)
+	s.Require().Equal(protobuf.CommunityAutomodRule_TIMEOUT, verdict.Action)
+
+	community, err = s.manager.ApplyAutomodVerdict(community, &memberKey.PublicKey, verdict)
+	s.Require().NoError(err)
+	s.Require().True(community.IsTimedOut(&memberKey.PublicKey))
+	s.Require().Equal("spam", community.Description().TimedOutMembers[common.PubkeyToHex(&memberKey.PublicKey)].Reason)
+
+	entries, err := s.manager.GetCommunityAuditLog(community.ID(), &AuditLogFilter{Actions: []protobuf.CommunityEvent_EventType{protobuf.CommunityEvent_COMMUNITY_MEMBER_TIMEOUT}})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+}
+
+func (s *ManagerSuite) TestHandleAuditLogPrivilegedUserSyncMessage() {
+	community, _, err := s.buildCommunityWithChat()
+	s.Require().NoError(err)
+
+	_, err = s.manager.EditCommunity(&requests.EditCommunity{
+		CommunityID: community.ID(),
+		CreateCommunity: requests.CreateCommunity{
+			Name:        "status edited",
+			Description: "status community description",
+			Membership:  protobuf.CommunityPermissions_AUTO_ACCEPT,
+		},
+	})
+	s.Require().NoError(err)
+
+	entries, err := s.manager.GetCommunityAuditLog(community.ID(), &AuditLogFilter{})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 2)
+
+	message := &protobuf.CommunityPrivilegedUserSyncMessage{
+		Type:        protobuf.CommunityPrivilegedUserSyncMessage_CONTROL_NODE_AUDIT_LOG,
+		CommunityId: community.ID(),
+	}
+	for _, entry := range entries {
+		message.AuditLog = append(message.AuditLog, entry.ToProtobuf())
+	}
+
+	_, err = s.manager.persistence.db.Exec(`DELETE FROM community_audit_log WHERE position = 2`)
+	s.Require().NoError(err)
+
+	// known entries are skipped, the rest continue the local chain
+	newEntries, err := s.manager.HandleAuditLogPrivilegedUserSyncMessage(message, community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(newEntries, 1)
+	s.Require().Equal(entries[1].Hash, newEntries[0].Hash)
+
+	newEntries, err = s.manager.HandleAuditLogPrivilegedUserSyncMessage(message, community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(newEntries, 0)
+
+	// entries which don't follow the local chain are rejected
+	_, err = s.manager.persistence.db.Exec(`DELETE FROM community_audit_log WHERE position = 1`)
+	s.Require().NoError(err)
+	message.AuditLog = message.AuditLog[:1]
+
+	_, err = s.manager.HandleAuditLogPrivilegedUserSyncMessage(message, community.ID())
+	s.Require().ErrorIs(err, ErrAuditLogBroken)
+}
+
+func (s *ManagerSuite) TestCommunityDirectory() {
+	key1, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	key2, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	communityID1 := types.HexBytes(crypto.CompressPubkey(&key1.PublicKey))
+	communityID2 := types.HexBytes(crypto.CompressPubkey(&key2.PublicKey))
+
+	entry, err := s.manager.HandleCommunityDirectoryEntry(&key1.PublicKey, &protobuf.CommunityDirectoryEntry{
+		Clock:        1,
+		CommunityId:  communityID1,
+		Name:         "Gardeners",
+		Description:  "Everything about vegetable gardens",
+		Tags:         []string{"Environment", "Hobby", "unknown", "Environment"},
+		MembersCount: 1000,
+		Shard:        &protobuf.Shard{Cluster: 16, Index: 32},
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(entry)
+	s.Require().Equal([]string{"Environment", "Hobby"}, entry.Tags)
+
+	_, err = s.manager.HandleCommunityDirectoryEntry(&key2.PublicKey, &protobuf.CommunityDirectoryEntry{
+		Clock:        1,
+		CommunityId:  communityID2,
+		Name:         "Garage bands",
+		Description:  "Music made at home",
+		Tags:         []string{"Music", "Hobby"},
+		MembersCount: 20,
+	})
+	s.Require().NoError(err)
+
+	// entries must be signed by the community
+	_, err = s.manager.HandleCommunityDirectoryEntry(&key1.PublicKey, &protobuf.CommunityDirectoryEntry{
+		Clock:       2,
+		CommunityId: communityID2,
+		Name:        "Spam",
+	})
+	s.Require().ErrorIs(err, ErrDirectoryEntryWrongSigner)
+
+	// stale entries are ignored
+	entry, err = s.manager.HandleCommunityDirectoryEntry(&key2.PublicKey, &protobuf.CommunityDirectoryEntry{
+		Clock:       1,
+		CommunityId: communityID2,
+		Name:        "Stale",
+	})
+	s.Require().NoError(err)
+	s.Require().Nil(entry)
+
+	entries, err := s.manager.SearchDirectory(&requests.SearchCommunityDirectory{})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 2)
+	s.Require().Equal(communityID2, entries[0].CommunityID)
+	s.Require().Equal(communityID1, entries[1].CommunityID)
+	s.Require().Equal(uint16(16), entries[1].Shard.Cluster)
+
+	entries, err = s.manager.SearchDirectory(&requests.SearchCommunityDirectory{SortBy: requests.CommunityDirectorySortByName})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 2)
+	s.Require().Equal(communityID2, entries[0].CommunityID)
+
+	// prefix search on name, description and tags
+	entries, err = s.manager.SearchDirectory(&requests.SearchCommunityDirectory{Query: "gar"})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 2)
+
+	entries, err = s.manager.SearchDirectory(&requests.SearchCommunityDirectory{Query: "vegetable GARD"})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Require().Equal(communityID1, entries[0].CommunityID)
+
+	entries, err = s.manager.SearchDirectory(&requests.SearchCommunityDirectory{Query: "\"music"})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Require().Equal(communityID2, entries[0].CommunityID)
+
+	entries, err = s.manager.SearchDirectory(&requests.SearchCommunityDirectory{Tags: []string{"Hobby", "Environment"}})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Require().Equal(communityID1, entries[0].CommunityID)
+
+	entries, err = s.manager.SearchDirectory(&requests.SearchCommunityDirectory{Limit: 1, Offset: 1})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Require().Equal(communityID1, entries[0].CommunityID)
+
+	// curated communities are verified
+	err = s.manager.SetCuratedCommunities(&CuratedCommunities{ContractCommunities: []string{communityID1.String()}})
+	s.Require().NoError(err)
+
+	entries, err = s.manager.SearchDirectory(&requests.SearchCommunityDirectory{VerifiedOnly: true})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Require().Equal(communityID1, entries[0].CommunityID)
+	s.Require().True(entries[0].Verified)
+
+	// verified communities are ranked first, self-reported member counts are ignored
+	entries, err = s.manager.SearchDirectory(&requests.SearchCommunityDirectory{})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 2)
+	s.Require().Equal(communityID1, entries[0].CommunityID)
+
+	// entries that were not announced again are evicted
+	saved, err := s.manager.persistence.SaveDirectoryEntry(&DirectoryEntry{
+		CommunityID: communityID1,
+		Clock:       2,
+		Name:        "Gardeners",
+		Tags:        []string{"Environment"},
+		ReceivedAt:  1,
+	})
+	s.Require().NoError(err)
+	s.Require().True(saved)
+
+	err = s.manager.persistence.DeleteDirectoryEntriesReceivedBefore(2)
+	s.Require().NoError(err)
+
+	entries, err = s.manager.SearchDirectory(&requests.SearchCommunityDirectory{Query: "gardeners"})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 0)
+
+	entries, err = s.manager.SearchDirectory(&requests.SearchCommunityDirectory{Tags: []string{"Environment"}})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 0)
+
+	entries, err = s.manager.SearchDirectory(&requests.SearchCommunityDirectory{})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+}
+
+func (s *ManagerSuite) TestCommunityDirectoryMaxEntries() {
+	var communityIDs []types.HexBytes
+	for i := 0; i < 3; i++ {
+		key, err := crypto.GenerateKey()
+		s.Require().NoError(err)
+		communityID := types.HexBytes(crypto.CompressPubkey(&key.PublicKey))
+		communityIDs = append(communityIDs, communityID)
+
+		saved, err := s.manager.persistence.SaveDirectoryEntry(&DirectoryEntry{
+			CommunityID: communityID,
+			Clock:       1,
+			Name:        "Community",
+			Tags:        []string{"Hobby"},
+			ReceivedAt:  uint64(i + 1),
+		})
+		s.Require().NoError(err)
+		s.Require().True(saved)
+	}
+
+	// the oldest entry is kept while curated
+	err := s.manager.SetCuratedCommunities(&CuratedCommunities{ContractCommunities: []string{communityIDs[0].String()}})
+	s.Require().NoError(err)
+
+	err = s.manager.persistence.DeleteDirectoryEntriesBeyond(1)
+	s.Require().NoError(err)
+
+	entries, err := s.manager.SearchDirectory(&requests.SearchCommunityDirectory{Query: "community", Tags: []string{"Hobby"}})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 2)
+	s.Require().Equal(communityIDs[0], entries[0].CommunityID)
+	s.Require().Equal(communityIDs[2], entries[1].CommunityID)
+}
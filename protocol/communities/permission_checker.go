@@ -94,6 +94,56 @@ func (p *DefaultPermissionChecker) GetOwnedERC721Tokens(walletAddresses []gethco
 	return ownedERC721Tokens, nil
 }
 
+func (p *DefaultPermissionChecker) GetOwnedERC1155Tokens(walletAddresses []gethcommon.Address, tokenRequirements map[uint64]map[string][]*protobuf.TokenCriteria, chainIDs []uint64) (CollectiblesByChain, error) {
+	return fetchOwnedERC1155Tokens(p.collectiblesManager, walletAddresses, tokenRequirements, chainIDs)
+}
+
+// fetchOwnedERC1155Tokens reads the balances of the token IDs required by the ERC1155 criteria
+func fetchOwnedERC1155Tokens(collectiblesManager CollectiblesManager, walletAddresses []gethcommon.Address, tokenRequirements map[uint64]map[string][]*protobuf.TokenCriteria, chainIDs []uint64) (CollectiblesByChain, error) {
+	if collectiblesManager == nil {
+		return nil, errors.New("no collectibles manager")
+	}
+
+	ctx := context.Background()
+
+	ownedERC1155Tokens := make(CollectiblesByChain)
+
+	for chainID, erc1155Tokens := range tokenRequirements {
+		skipChain := true
+		for _, cID := range chainIDs {
+			if chainID == cID {
+				skipChain = false
+			}
+		}
+
+		if skipChain {
+			continue
+		}
+
+		if _, exists := ownedERC1155Tokens[chainID]; !exists {
+			ownedERC1155Tokens[chainID] = make(map[gethcommon.Address]thirdparty.TokenBalancesPerContractAddress)
+		}
+
+		for contractAddressStr, criteria := range erc1155Tokens {
+			contractAddress := gethcommon.HexToAddress(contractAddressStr)
+			tokenIDs := erc1155TokenIDs(criteria)
+
+			for _, owner := range walletAddresses {
+				balances, err := collectiblesManager.FetchERC1155Balances(ctx, walletcommon.ChainID(chainID), owner, contractAddress, tokenIDs)
+				if err != nil {
+					return nil, err
+				}
+
+				if _, exists := ownedERC1155Tokens[chainID][owner]; !exists {
+					ownedERC1155Tokens[chainID][owner] = make(thirdparty.TokenBalancesPerContractAddress)
+				}
+				ownedERC1155Tokens[chainID][owner][contractAddress] = balances
+			}
+		}
+	}
+	return ownedERC1155Tokens, nil
+}
+
 func (p *DefaultPermissionChecker) accountChainsCombinationToMap(combinations []*AccountChainIDsCombination) map[gethcommon.Address][]uint64 {
 	result := make(map[gethcommon.Address][]uint64)
 	for _, combination := range combinations {
@@ -206,10 +256,11 @@ func (p *DefaultPermissionChecker) CheckPermissions(permissions []*CommunityToke
 		ValidCombinations: make([]*AccountChainIDsCombination, 0),
 	}
 
-	erc20TokenRequirements, erc721TokenRequirements, _ := ExtractTokenCriteria(permissions)
+	erc20TokenRequirements, erc721TokenRequirements, erc1155TokenRequirements, _ := ExtractTokenCriteria(permissions)
 
 	erc20ChainIDsMap := make(map[uint64]bool)
 	erc721ChainIDsMap := make(map[uint64]bool)
+	erc1155ChainIDsMap := make(map[uint64]bool)
 
 	erc20TokenAddresses := make([]gethcommon.Address, 0)
 	accounts := make([]gethcommon.Address, 0)
@@ -230,8 +281,13 @@ func (p *DefaultPermissionChecker) CheckPermissions(permissions []*CommunityToke
 		erc721ChainIDsMap[chainID] = true
 	}
 
+	for chainID := range erc1155TokenRequirements {
+		erc1155ChainIDsMap[chainID] = true
+	}
+
 	chainIDsForERC20 := calculateChainIDsSet(accountsAndChainIDs, erc20ChainIDsMap)
 	chainIDsForERC721 := calculateChainIDsSet(accountsAndChainIDs, erc721ChainIDsMap)
+	chainIDsForERC1155 := calculateChainIDsSet(accountsAndChainIDs, erc1155ChainIDsMap)
 
 	// if there are no chain IDs that match token criteria chain IDs
 	// we aren't able to check balances on selected networks
@@ -259,183 +315,289 @@ func (p *DefaultPermissionChecker) CheckPermissions(permissions []*CommunityToke
 		ownedERC721Tokens = collectibles
 	}
 
+	ownedERC1155Tokens := make(CollectiblesByChain)
+	if len(chainIDsForERC1155) > 0 {
+		collectibles, err := p.GetOwnedERC1155Tokens(accounts, erc1155TokenRequirements, chainIDsForERC1155)
+		if err != nil {
+			return nil, err
+		}
+		ownedERC1155Tokens = collectibles
+	}
+
 	accountsChainIDsCombinations := make(map[gethcommon.Address]map[uint64]bool)
 
 	for _, tokenPermission := range permissions {
 
+		permissionResult := &PermissionTokenCriteriaResult{Role: tokenPermission.Type}
+		response.Permissions[tokenPermission.Id] = permissionResult
+
+		tokenCriteria := tokenPermission.CheckedTokenCriteria()
+
+		// Each token requirement records the account and chain ID combinations
+		// holding its tokens, only those of requirements actually satisfying
+		// the permission are revealed
+		tokenRequirementsCombinations := make([]map[gethcommon.Address]map[uint64]bool, len(tokenCriteria))
+		tokenRequirementsMet := make([]bool, len(tokenCriteria))
+		tokenRequirementsChecked := make([]bool, len(tokenCriteria))
+		checkCriteria := func(index int) (bool, error) {
+			if index < 0 || index >= len(tokenCriteria) {
+				return false, ErrInvalidTokenCriteriaExpression
+			}
+			if !tokenRequirementsChecked[index] {
+				tokenRequirementsCombinations[index] = make(map[gethcommon.Address]map[uint64]bool)
+				tokenRequirementMet, err := p.checkTokenRequirement(tokenCriteria[index], accounts, ownedERC20TokenBalances, ownedERC721Tokens, ownedERC1155Tokens, tokenRequirementsCombinations[index], shortcircuit)
+				if err != nil {
+					return false, err
+				}
+				tokenRequirementsMet[index] = tokenRequirementMet
+				tokenRequirementsChecked[index] = true
+			}
+			return tokenRequirementsMet[index], nil
+		}
+
 		permissionRequirementsMet := true
-		response.Permissions[tokenPermission.Id] = &PermissionTokenCriteriaResult{Role: tokenPermission.Type}
+		var satisfyingRequirements []int
+		if tokenPermission.Expression != nil {
+			// The criteria are checked as the expression reaches them
+			expressionResult, err := evaluateTokenCriteriaExpression(tokenPermission.Expression, checkCriteria, shortcircuit)
+			if err != nil {
+				return nil, err
+			}
+			permissionResult.Expression = expressionResult
+			permissionRequirementsMet = expressionResult.Satisfied
+			satisfyingRequirements = expressionResult.satisfyingCriteria()
+		} else {
+			for index := range tokenCriteria {
+				if _, err := checkCriteria(index); err != nil {
+					return nil, err
+				}
+			}
+
+			// There can be multiple token requirements per permission.
+			// If only one is not met, the entire permission is marked
+			// as not fulfilled
+			for index, tokenRequirementMet := range tokenRequirementsMet {
+				if !tokenRequirementMet {
+					permissionRequirementsMet = false
+					continue
+				}
+				satisfyingRequirements = append(satisfyingRequirements, index)
+			}
+		}
+
+		// Criteria the expression didn't reach are reported as not satisfied
+		for index, tokenRequirement := range tokenCriteria {
+			permissionResult.TokenRequirements = append(permissionResult.TokenRequirements, TokenRequirementResponse{TokenCriteria: tokenRequirement, Satisfied: tokenRequirementsMet[index]})
+			permissionResult.Criteria = append(permissionResult.Criteria, tokenRequirementsMet[index])
+		}
+
+		for _, index := range satisfyingRequirements {
+			for account, chainIDs := range tokenRequirementsCombinations[index] {
+				if _, exists := accountsChainIDsCombinations[account]; !exists {
+					accountsChainIDsCombinations[account] = make(map[uint64]bool)
+				}
+				for chainID := range chainIDs {
+					accountsChainIDsCombinations[account][chainID] = true
+				}
+			}
+		}
+
+		// multiple permissions are treated as logical OR, meaning
+		// if only one of them is fulfilled, the user gets permission
+		// to join and we can stop early
+		if shortcircuit && permissionRequirementsMet {
+			break
+		}
+	}
+
+	// attach valid account and chainID combinations to response
+	for account, chainIDs := range accountsChainIDsCombinations {
+		combination := &AccountChainIDsCombination{
+			Address: account,
+		}
+		for chainID := range chainIDs {
+			combination.ChainIDs = append(combination.ChainIDs, chainID)
+		}
+		response.ValidCombinations = append(response.ValidCombinations, combination)
+	}
 
-		// There can be multiple token requirements per permission.
-		// If only one is not met, the entire permission is marked
-		// as not fulfilled
-		for _, tokenRequirement := range tokenPermission.TokenCriteria {
+	response.calculateSatisfied()
+
+	return response, nil
+}
 
-			tokenRequirementMet := false
-			tokenRequirementResponse := TokenRequirementResponse{TokenCriteria: tokenRequirement}
+// checkTokenRequirement tells whether the accounts meet the token requirement, recording
+// the account and chain ID combinations holding the required tokens
+func (p *DefaultPermissionChecker) checkTokenRequirement(tokenRequirement *protobuf.TokenCriteria, accounts []gethcommon.Address, ownedERC20TokenBalances BalancesByChain, ownedERC721Tokens CollectiblesByChain, ownedERC1155Tokens CollectiblesByChain, accountsChainIDsCombinations map[gethcommon.Address]map[uint64]bool, shortcircuit bool) (bool, error) {
+	tokenRequirementMet := false
 
-			if tokenRequirement.Type == protobuf.CommunityTokenType_ERC721 {
-				if len(ownedERC721Tokens) == 0 {
+	if tokenRequirement.Type == protobuf.CommunityTokenType_ERC721 {
+		if len(ownedERC721Tokens) == 0 {
+			return false, nil
+		}
 
-					response.Permissions[tokenPermission.Id].TokenRequirements = append(response.Permissions[tokenPermission.Id].TokenRequirements, tokenRequirementResponse)
-					response.Permissions[tokenPermission.Id].Criteria = append(response.Permissions[tokenPermission.Id].Criteria, false)
+	chainIDLoopERC721:
+		for chainID, addressStr := range tokenRequirement.ContractAddresses {
+			contractAddress := gethcommon.HexToAddress(addressStr)
+			if _, exists := ownedERC721Tokens[chainID]; !exists || len(ownedERC721Tokens[chainID]) == 0 {
+				continue chainIDLoopERC721
+			}
+
+			for account := range ownedERC721Tokens[chainID] {
+				if _, exists := ownedERC721Tokens[chainID][account]; !exists {
 					continue
 				}
 
-			chainIDLoopERC721:
-				for chainID, addressStr := range tokenRequirement.ContractAddresses {
-					contractAddress := gethcommon.HexToAddress(addressStr)
-					if _, exists := ownedERC721Tokens[chainID]; !exists || len(ownedERC721Tokens[chainID]) == 0 {
-						continue chainIDLoopERC721
+				tokenBalances := ownedERC721Tokens[chainID][account][contractAddress]
+				if len(tokenBalances) > 0 {
+					// 'account' owns some TokenID owned from contract 'address'
+					if _, exists := accountsChainIDsCombinations[account]; !exists {
+						accountsChainIDsCombinations[account] = make(map[uint64]bool)
 					}
 
-					for account := range ownedERC721Tokens[chainID] {
-						if _, exists := ownedERC721Tokens[chainID][account]; !exists {
-							continue
-						}
+					if len(tokenRequirement.TokenIds) == 0 {
+						// no specific tokenId of this collection is needed
+						tokenRequirementMet = true
+						accountsChainIDsCombinations[account][chainID] = true
+						break chainIDLoopERC721
+					}
 
-						tokenBalances := ownedERC721Tokens[chainID][account][contractAddress]
-						if len(tokenBalances) > 0 {
-							// 'account' owns some TokenID owned from contract 'address'
-							if _, exists := accountsChainIDsCombinations[account]; !exists {
-								accountsChainIDsCombinations[account] = make(map[uint64]bool)
-							}
+				tokenIDsLoop:
+					for _, tokenID := range tokenRequirement.TokenIds {
+						tokenIDBigInt := new(big.Int).SetUint64(tokenID)
 
-							if len(tokenRequirement.TokenIds) == 0 {
-								// no specific tokenId of this collection is needed
+						for _, asset := range tokenBalances {
+							if asset.TokenID.Cmp(tokenIDBigInt) == 0 && asset.Balance.Sign() > 0 {
 								tokenRequirementMet = true
 								accountsChainIDsCombinations[account][chainID] = true
-								break chainIDLoopERC721
-							}
-
-						tokenIDsLoop:
-							for _, tokenID := range tokenRequirement.TokenIds {
-								tokenIDBigInt := new(big.Int).SetUint64(tokenID)
-
-								for _, asset := range tokenBalances {
-									if asset.TokenID.Cmp(tokenIDBigInt) == 0 && asset.Balance.Sign() > 0 {
-										tokenRequirementMet = true
-										accountsChainIDsCombinations[account][chainID] = true
-										break tokenIDsLoop
-									}
-								}
+								break tokenIDsLoop
 							}
 						}
 					}
 				}
-			} else if tokenRequirement.Type == protobuf.CommunityTokenType_ERC20 {
-				if len(ownedERC20TokenBalances) == 0 {
-					response.Permissions[tokenPermission.Id].TokenRequirements = append(response.Permissions[tokenPermission.Id].TokenRequirements, tokenRequirementResponse)
-					response.Permissions[tokenPermission.Id].Criteria = append(response.Permissions[tokenPermission.Id].Criteria, false)
-					continue
-				}
+			}
+		}
+	} else if tokenRequirement.Type == protobuf.CommunityTokenType_ERC20 {
+		if len(ownedERC20TokenBalances) == 0 {
+			return false, nil
+		}
 
-				accumulatedBalance := new(big.Float)
+		accumulatedBalance := new(big.Float)
 
-			chainIDLoopERC20:
-				for chainID, address := range tokenRequirement.ContractAddresses {
-					if _, exists := ownedERC20TokenBalances[chainID]; !exists || len(ownedERC20TokenBalances[chainID]) == 0 {
-						continue chainIDLoopERC20
-					}
-					contractAddress := gethcommon.HexToAddress(address)
-					for account := range ownedERC20TokenBalances[chainID] {
-						if _, exists := ownedERC20TokenBalances[chainID][account][contractAddress]; !exists {
-							continue
-						}
+	chainIDLoopERC20:
+		for chainID, address := range tokenRequirement.ContractAddresses {
+			if _, exists := ownedERC20TokenBalances[chainID]; !exists || len(ownedERC20TokenBalances[chainID]) == 0 {
+				continue chainIDLoopERC20
+			}
+			contractAddress := gethcommon.HexToAddress(address)
+			for account := range ownedERC20TokenBalances[chainID] {
+				if _, exists := ownedERC20TokenBalances[chainID][account][contractAddress]; !exists {
+					continue
+				}
 
-						value := ownedERC20TokenBalances[chainID][account][contractAddress]
+				value := ownedERC20TokenBalances[chainID][account][contractAddress]
 
-						accountChainBalance := new(big.Float).Quo(
-							new(big.Float).SetInt(value.ToInt()),
-							big.NewFloat(math.Pow(10, float64(tokenRequirement.Decimals))),
-						)
+				accountChainBalance := new(big.Float).Quo(
+					new(big.Float).SetInt(value.ToInt()),
+					big.NewFloat(math.Pow(10, float64(tokenRequirement.Decimals))),
+				)
 
-						if _, exists := accountsChainIDsCombinations[account]; !exists {
-							accountsChainIDsCombinations[account] = make(map[uint64]bool)
-						}
+				if _, exists := accountsChainIDsCombinations[account]; !exists {
+					accountsChainIDsCombinations[account] = make(map[uint64]bool)
+				}
 
-						if accountChainBalance.Cmp(big.NewFloat(0)) > 0 {
-							// account has balance > 0 on this chain for this token, so let's add it the chain IDs
-							accountsChainIDsCombinations[account][chainID] = true
-						}
+				if accountChainBalance.Cmp(big.NewFloat(0)) > 0 {
+					// account has balance > 0 on this chain for this token, so let's add it the chain IDs
+					accountsChainIDsCombinations[account][chainID] = true
+				}
 
-						// check if adding current chain account balance to accumulated balance
-						// satisfies required amount
-						prevBalance := accumulatedBalance
-						accumulatedBalance.Add(prevBalance, accountChainBalance)
+				// check if adding current chain account balance to accumulated balance
+				// satisfies required amount
+				prevBalance := accumulatedBalance
+				accumulatedBalance.Add(prevBalance, accountChainBalance)
 
-						requiredAmount, err := strconv.ParseFloat(tokenRequirement.Amount, 32)
-						if err != nil {
-							return nil, err
-						}
+				requiredAmount, err := strconv.ParseFloat(tokenRequirement.Amount, 32)
+				if err != nil {
+					return false, err
+				}
 
-						if accumulatedBalance.Cmp(big.NewFloat(requiredAmount)) != -1 {
-							tokenRequirementMet = true
-							if shortcircuit {
-								break chainIDLoopERC20
-							}
-						}
+				if accumulatedBalance.Cmp(big.NewFloat(requiredAmount)) != -1 {
+					tokenRequirementMet = true
+					if shortcircuit {
+						break chainIDLoopERC20
 					}
 				}
+			}
+		}
 
-			} else if tokenRequirement.Type == protobuf.CommunityTokenType_ENS {
+	} else if tokenRequirement.Type == protobuf.CommunityTokenType_ERC1155 {
+		if len(ownedERC1155Tokens) == 0 {
+			return false, nil
+		}
 
-				for _, account := range accounts {
-					ownedENSNames, err := p.getOwnedENS([]gethcommon.Address{account})
-					if err != nil {
-						return nil, err
-					}
+		requiredAmount, err := erc1155RequiredAmount(tokenRequirement)
+		if err != nil {
+			return false, err
+		}
 
-					if _, exists := accountsChainIDsCombinations[account]; !exists {
-						accountsChainIDsCombinations[account] = make(map[uint64]bool)
-					}
+		// the required amount can be reached by summing the listed token IDs
+		// across all accounts and chains
+		accumulatedBalance := new(big.Int)
 
-					if !strings.HasPrefix(tokenRequirement.EnsPattern, "*.") {
-						for _, ownedENS := range ownedENSNames {
-							if ownedENS == tokenRequirement.EnsPattern {
-								tokenRequirementMet = true
-								accountsChainIDsCombinations[account][walletcommon.EthereumMainnet] = true
-							}
-						}
-					} else {
-						parentName := tokenRequirement.EnsPattern[2:]
-						for _, ownedENS := range ownedENSNames {
-							if strings.HasSuffix(ownedENS, parentName) {
-								tokenRequirementMet = true
-								accountsChainIDsCombinations[account][walletcommon.EthereumMainnet] = true
-							}
-						}
+	chainIDLoopERC1155:
+		for chainID, addressStr := range tokenRequirement.ContractAddresses {
+			contractAddress := gethcommon.HexToAddress(addressStr)
+			for account, tokenBalancesPerContract := range ownedERC1155Tokens[chainID] {
+				balance := erc1155CriteriaBalance(tokenBalancesPerContract[contractAddress], tokenRequirement)
+				if balance.Sign() <= 0 {
+					continue
+				}
+
+				if _, exists := accountsChainIDsCombinations[account]; !exists {
+					accountsChainIDsCombinations[account] = make(map[uint64]bool)
+				}
+				accountsChainIDsCombinations[account][chainID] = true
+
+				accumulatedBalance.Add(accumulatedBalance, balance)
+				if accumulatedBalance.Cmp(requiredAmount) >= 0 {
+					tokenRequirementMet = true
+					if shortcircuit {
+						break chainIDLoopERC1155
 					}
 				}
 			}
-			if !tokenRequirementMet {
-				permissionRequirementsMet = false
+		}
+
+	} else if tokenRequirement.Type == protobuf.CommunityTokenType_ENS {
+
+		for _, account := range accounts {
+			ownedENSNames, err := p.getOwnedENS([]gethcommon.Address{account})
+			if err != nil {
+				return false, err
 			}
 
-			tokenRequirementResponse.Satisfied = tokenRequirementMet
-			response.Permissions[tokenPermission.Id].TokenRequirements = append(response.Permissions[tokenPermission.Id].TokenRequirements, tokenRequirementResponse)
-			response.Permissions[tokenPermission.Id].Criteria = append(response.Permissions[tokenPermission.Id].Criteria, tokenRequirementMet)
-		}
-		// multiple permissions are treated as logical OR, meaning
-		// if only one of them is fulfilled, the user gets permission
-		// to join and we can stop early
-		if shortcircuit && permissionRequirementsMet {
-			break
-		}
-	}
+			if _, exists := accountsChainIDsCombinations[account]; !exists {
+				accountsChainIDsCombinations[account] = make(map[uint64]bool)
+			}
 
-	// attach valid account and chainID combinations to response
-	for account, chainIDs := range accountsChainIDsCombinations {
-		combination := &AccountChainIDsCombination{
-			Address: account,
-		}
-		for chainID := range chainIDs {
-			combination.ChainIDs = append(combination.ChainIDs, chainID)
+			if !strings.HasPrefix(tokenRequirement.EnsPattern, "*.") {
+				for _, ownedENS := range ownedENSNames {
+					if ownedENS == tokenRequirement.EnsPattern {
+						tokenRequirementMet = true
+						accountsChainIDsCombinations[account][walletcommon.EthereumMainnet] = true
+					}
+				}
+			} else {
+				parentName := tokenRequirement.EnsPattern[2:]
+				for _, ownedENS := range ownedENSNames {
+					if strings.HasSuffix(ownedENS, parentName) {
+						tokenRequirementMet = true
+						accountsChainIDsCombinations[account][walletcommon.EthereumMainnet] = true
+					}
+				}
+			}
 		}
-		response.ValidCombinations = append(response.ValidCombinations, combination)
 	}
 
-	response.calculateSatisfied()
-
-	return response, nil
+	return tokenRequirementMet, nil
 }
@@ -0,0 +1,98 @@
+package communities
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrInvalidTokenCriteriaExpression = errors.New("invalid token criteria expression")
+
+// ClauseResult is the outcome of a clause of a permission expression.
+type ClauseResult struct {
+	Operator      protobuf.TokenCriteriaExpression_Operator `json:"operator"`
+	CriteriaIndex uint32                                    `json:"criteriaIndex"`
+	Satisfied     bool                                      `json:"satisfied"`
+	Clauses       []*ClauseResult                           `json:"clauses,omitempty"`
+}
+
+// evaluateTokenCriteriaExpression evaluates the expression, checking its token criteria
+// only when they are reached. With shortcircuit, an AND stops at its first unsatisfied
+// clause and an OR at its first satisfied one, otherwise every clause is evaluated so
+// that it can be reported.
+func evaluateTokenCriteriaExpression(expression *protobuf.TokenCriteriaExpression, checkCriteria func(index int) (bool, error), shortcircuit bool) (*ClauseResult, error) {
+	if expression == nil {
+		return nil, ErrInvalidTokenCriteriaExpression
+	}
+
+	result := &ClauseResult{
+		Operator:      expression.Operator,
+		CriteriaIndex: expression.CriteriaIndex,
+	}
+
+	switch expression.Operator {
+	case protobuf.TokenCriteriaExpression_CRITERIA:
+		satisfied, err := checkCriteria(int(expression.CriteriaIndex))
+		if err != nil {
+			return nil, err
+		}
+		result.Satisfied = satisfied
+		return result, nil
+
+	case protobuf.TokenCriteriaExpression_NOT:
+		if len(expression.Operands) != 1 {
+			return nil, ErrInvalidTokenCriteriaExpression
+		}
+		operand, err := evaluateTokenCriteriaExpression(expression.Operands[0], checkCriteria, shortcircuit)
+		if err != nil {
+			return nil, err
+		}
+		result.Clauses = []*ClauseResult{operand}
+		result.Satisfied = !operand.Satisfied
+		return result, nil
+
+	case protobuf.TokenCriteriaExpression_AND, protobuf.TokenCriteriaExpression_OR:
+		if len(expression.Operands) == 0 {
+			return nil, ErrInvalidTokenCriteriaExpression
+		}
+
+		isAnd := expression.Operator == protobuf.TokenCriteriaExpression_AND
+		result.Satisfied = isAnd
+		for _, operandExpression := range expression.Operands {
+			operand, err := evaluateTokenCriteriaExpression(operandExpression, checkCriteria, shortcircuit)
+			if err != nil {
+				return nil, err
+			}
+			result.Clauses = append(result.Clauses, operand)
+			if operand.Satisfied != isAnd {
+				result.Satisfied = !isAnd
+				if shortcircuit {
+					break
+				}
+			}
+		}
+		return result, nil
+	}
+
+	return nil, ErrInvalidTokenCriteriaExpression
+}
+
+// satisfyingCriteria returns the criteria held that make the clause satisfied.
+// Negated criteria never satisfy a clause by being held.
+func (r *ClauseResult) satisfyingCriteria() []int {
+	if !r.Satisfied {
+		return nil
+	}
+
+	switch r.Operator {
+	case protobuf.TokenCriteriaExpression_CRITERIA:
+		return []int{int(r.CriteriaIndex)}
+	case protobuf.TokenCriteriaExpression_AND, protobuf.TokenCriteriaExpression_OR:
+		var indexes []int
+		for _, clause := range r.Clauses {
+			indexes = append(indexes, clause.satisfyingCriteria()...)
+		}
+		return indexes
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package communities
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func criteriaClause(index uint32) *protobuf.TokenCriteriaExpression {
+	return &protobuf.TokenCriteriaExpression{
+		Operator:      protobuf.TokenCriteriaExpression_CRITERIA,
+		CriteriaIndex: index,
+	}
+}
+
+func operatorClause(operator protobuf.TokenCriteriaExpression_Operator, operands ...*protobuf.TokenCriteriaExpression) *protobuf.TokenCriteriaExpression {
+	return &protobuf.TokenCriteriaExpression{
+		Operator: operator,
+		Operands: operands,
+	}
+}
+
+func TestEvaluateTokenCriteriaExpression(t *testing.T) {
+	// (0 OR 1) AND NOT 2
+	expression := operatorClause(protobuf.TokenCriteriaExpression_AND,
+		operatorClause(protobuf.TokenCriteriaExpression_OR, criteriaClause(0), criteriaClause(1)),
+		operatorClause(protobuf.TokenCriteriaExpression_NOT, criteriaClause(2)),
+	)
+
+	testCases := []struct {
+		name       string
+		met        []bool
+		satisfied  bool
+		satisfying []int
+	}{
+		{
+			name:       "first operand of OR",
+			met:        []bool{true, false, false},
+			satisfied:  true,
+			satisfying: []int{0},
+		},
+		{
+			name:       "both operands of OR",
+			met:        []bool{true, true, false},
+			satisfied:  true,
+			satisfying: []int{0, 1},
+		},
+		{
+			name:      "unmet OR",
+			met:       []bool{false, false, false},
+			satisfied: false,
+		},
+		{
+			name:      "negated criteria is met",
+			met:       []bool{true, true, true},
+			satisfied: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := evaluateTokenCriteriaExpression(expression, func(index int) (bool, error) {
+				return tc.met[index], nil
+			}, false)
+			require.NoError(t, err)
+			require.Equal(t, tc.satisfied, result.Satisfied)
+			require.Equal(t, tc.satisfying, result.satisfyingCriteria())
+			require.Len(t, result.Clauses, 2)
+		})
+	}
+}
+
+func TestEvaluateTokenCriteriaExpressionReportsAllClauses(t *testing.T) {
+	expression := operatorClause(protobuf.TokenCriteriaExpression_AND, criteriaClause(0), criteriaClause(1))
+
+	result, err := evaluateTokenCriteriaExpression(expression, func(index int) (bool, error) {
+		return index == 1, nil
+	}, false)
+	require.NoError(t, err)
+	require.False(t, result.Satisfied)
+	require.Len(t, result.Clauses, 2)
+
+	require.False(t, result.Clauses[0].Satisfied)
+	require.Equal(t, uint32(0), result.Clauses[0].CriteriaIndex)
+
+	require.True(t, result.Clauses[1].Satisfied)
+	require.Equal(t, uint32(1), result.Clauses[1].CriteriaIndex)
+
+	// criteria of an unsatisfied clause do not satisfy the permission
+	require.Empty(t, result.satisfyingCriteria())
+}
+
+func TestEvaluateTokenCriteriaExpressionErrors(t *testing.T) {
+	_, err := evaluateTokenCriteriaExpression(operatorClause(protobuf.TokenCriteriaExpression_NOT), func(index int) (bool, error) {
+		return true, nil
+	}, false)
+	require.ErrorIs(t, err, ErrInvalidTokenCriteriaExpression)
+
+	_, err = evaluateTokenCriteriaExpression(operatorClause(protobuf.TokenCriteriaExpression_OR), func(index int) (bool, error) {
+		return true, nil
+	}, false)
+	require.ErrorIs(t, err, ErrInvalidTokenCriteriaExpression)
+
+	checkErr := errors.New("check failed")
+	_, err = evaluateTokenCriteriaExpression(criteriaClause(0), func(index int) (bool, error) {
+		return false, checkErr
+	}, false)
+	require.ErrorIs(t, err, checkErr)
+}
+
+func TestEvaluateTokenCriteriaExpressionShortcircuit(t *testing.T) {
+	// (0 AND 1) OR (2 AND 3)
+	expression := operatorClause(protobuf.TokenCriteriaExpression_OR,
+		operatorClause(protobuf.TokenCriteriaExpression_AND, criteriaClause(0), criteriaClause(1)),
+		operatorClause(protobuf.TokenCriteriaExpression_AND, criteriaClause(2), criteriaClause(3)),
+	)
+
+	testCases := []struct {
+		name         string
+		met          []bool
+		shortcircuit bool
+		satisfied    bool
+		checked      []int
+	}{
+		{
+			name:         "AND stops at its first unsatisfied clause",
+			met:          []bool{false, true, true, true},
+			shortcircuit: true,
+			satisfied:    true,
+			checked:      []int{0, 2, 3},
+		},
+		{
+			name:         "OR stops at its first satisfied clause",
+			met:          []bool{true, true, true, true},
+			shortcircuit: true,
+			satisfied:    true,
+			checked:      []int{0, 1},
+		},
+		{
+			name:         "unsatisfied expression",
+			met:          []bool{false, true, false, true},
+			shortcircuit: true,
+			satisfied:    false,
+			checked:      []int{0, 2},
+		},
+		{
+			name:         "every criteria is checked for reporting",
+			met:          []bool{true, true, true, true},
+			shortcircuit: false,
+			satisfied:    true,
+			checked:      []int{0, 1, 2, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var checked []int
+			result, err := evaluateTokenCriteriaExpression(expression, func(index int) (bool, error) {
+				checked = append(checked, index)
+				return tc.met[index], nil
+			}, tc.shortcircuit)
+			require.NoError(t, err)
+			require.Equal(t, tc.satisfied, result.Satisfied)
+			require.Equal(t, tc.checked, checked)
+		})
+	}
+}
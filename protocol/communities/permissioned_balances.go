@@ -34,7 +34,7 @@ func calculatePermissionedBalancesERC20(
 	usedBalances := make(map[string]bool)
 
 	for _, permission := range tokenPermissions {
-		for _, criteria := range permission.TokenCriteria {
+		for _, criteria := range permission.CheckedTokenCriteria() {
 			if criteria.Type != protobuf.CommunityTokenType_ERC20 {
 				continue
 			}
@@ -117,7 +117,7 @@ func (m *Manager) calculatePermissionedBalancesERC721(
 	usedBalances := make(map[string]bool)
 
 	for _, permission := range tokenPermissions {
-		for _, criteria := range permission.TokenCriteria {
+		for _, criteria := range permission.CheckedTokenCriteria() {
 			if criteria.Type != protobuf.CommunityTokenType_ERC721 {
 				continue
 			}
@@ -172,11 +172,83 @@ func (m *Manager) calculatePermissionedBalancesERC721(
 	return res
 }
 
+func calculatePermissionedBalancesERC1155(
+	accountAddresses []gethcommon.Address,
+	balances CollectiblesByChain,
+	tokenPermissions []*CommunityTokenPermission,
+) map[gethcommon.Address]map[string]*PermissionedBalance {
+	res := make(map[gethcommon.Address]map[string]*PermissionedBalance)
+
+	// Set with composite key (chain ID + wallet address + contract address +
+	// token IDs) to store if we already processed the balance.
+	usedBalances := make(map[string]bool)
+
+	for _, permission := range tokenPermissions {
+		for _, criteria := range permission.CheckedTokenCriteria() {
+			if criteria.Type != protobuf.CommunityTokenType_ERC1155 {
+				continue
+			}
+
+			tokenIDsKey := ""
+			for _, tokenID := range criteria.TokenIds {
+				tokenIDsKey += "-" + strconv.FormatUint(tokenID, 10)
+			}
+
+			for _, accountAddress := range accountAddresses {
+				for chainID, hexContractAddress := range criteria.ContractAddresses {
+					usedKey := strconv.FormatUint(chainID, 10) + "-" + accountAddress.Hex() + "-" + hexContractAddress + tokenIDsKey
+
+					if _, ok := balances[chainID]; !ok {
+						continue
+					}
+					if _, ok := balances[chainID][accountAddress]; !ok {
+						continue
+					}
+
+					contractAddress := gethcommon.HexToAddress(hexContractAddress)
+					tokenBalances, ok := balances[chainID][accountAddress][contractAddress]
+					if !ok || len(tokenBalances) == 0 {
+						continue
+					}
+
+					// Skip the contract address if it has been used already in the sum.
+					if _, ok := usedBalances[usedKey]; ok {
+						continue
+					}
+
+					usedBalances[usedKey] = true
+
+					if _, ok := res[accountAddress]; !ok {
+						res[accountAddress] = make(map[string]*PermissionedBalance, 0)
+					}
+					if _, ok := res[accountAddress][criteria.Symbol]; !ok {
+						res[accountAddress][criteria.Symbol] = &PermissionedBalance{
+							Type:     criteria.Type,
+							Symbol:   criteria.Symbol,
+							Name:     criteria.Name,
+							Decimals: criteria.Decimals,
+							Amount:   &bigint.BigInt{Int: big.NewInt(0)},
+						}
+					}
+
+					res[accountAddress][criteria.Symbol].Amount.Add(
+						res[accountAddress][criteria.Symbol].Amount.Int,
+						erc1155CriteriaBalance(tokenBalances, criteria),
+					)
+				}
+			}
+		}
+	}
+
+	return res
+}
+
 func (m *Manager) calculatePermissionedBalances(
 	chainIDs []uint64,
 	accountAddresses []gethcommon.Address,
 	erc20Balances BalancesByChain,
 	erc721Balances CollectiblesByChain,
+	erc1155Balances CollectiblesByChain,
 	tokenPermissions []*CommunityTokenPermission,
 ) map[gethcommon.Address][]PermissionedBalance {
 	res := make(map[gethcommon.Address][]PermissionedBalance, 0)
@@ -190,6 +262,15 @@ func (m *Manager) calculatePermissionedBalances(
 		}
 	}
 
+	aggregatedERC1155Balances := calculatePermissionedBalancesERC1155(accountAddresses, erc1155Balances, tokenPermissions)
+	for accountAddress, tokens := range aggregatedERC1155Balances {
+		for _, permissionedToken := range tokens {
+			if permissionedToken.Amount.Sign() > 0 {
+				res[accountAddress] = append(res[accountAddress], *permissionedToken)
+			}
+		}
+	}
+
 	aggregatedERC20Balances := calculatePermissionedBalancesERC20(accountAddresses, erc20Balances, tokenPermissions)
 	for accountAddress, tokens := range aggregatedERC20Balances {
 		for _, permissionedToken := range tokens {
@@ -241,7 +322,7 @@ func (m *Manager) GetPermissionedBalances(
 	}
 	accountsAndChainIDs := combineAddressesAndChainIDs(accountAddresses, allChainIDs)
 
-	erc20TokenCriteriaByChain, erc721TokenCriteriaByChain, _ := ExtractTokenCriteria(tokenPermissions)
+	erc20TokenCriteriaByChain, erc721TokenCriteriaByChain, erc1155TokenCriteriaByChain, _ := ExtractTokenCriteria(tokenPermissions)
 
 	accounts := make([]gethcommon.Address, 0, len(accountsAndChainIDs))
 	for _, accountAndChainIDs := range accountsAndChainIDs {
@@ -262,8 +343,14 @@ func (m *Manager) GetPermissionedBalances(
 		erc721ChainIDsSet[chainID] = true
 	}
 
+	erc1155ChainIDsSet := make(map[uint64]bool)
+	for chainID := range erc1155TokenCriteriaByChain {
+		erc1155ChainIDsSet[chainID] = true
+	}
+
 	erc20ChainIDs := calculateChainIDsSet(accountsAndChainIDs, erc20ChainIDsSet)
 	erc721ChainIDs := calculateChainIDsSet(accountsAndChainIDs, erc721ChainIDsSet)
+	erc1155ChainIDs := calculateChainIDsSet(accountsAndChainIDs, erc1155ChainIDsSet)
 
 	erc20Balances, err := m.tokenManager.GetBalancesByChain(ctx, accounts, erc20TokenAddresses, erc20ChainIDs)
 	if err != nil {
@@ -280,5 +367,15 @@ func (m *Manager) GetPermissionedBalances(
 		erc721Balances = balances
 	}
 
-	return m.calculatePermissionedBalances(allChainIDs, accountAddresses, erc20Balances, erc721Balances, tokenPermissions), nil
+	erc1155Balances := make(CollectiblesByChain)
+	if len(erc1155ChainIDs) > 0 {
+		balances, err := m.GetOwnedERC1155Tokens(accounts, erc1155TokenCriteriaByChain, erc1155ChainIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		erc1155Balances = balances
+	}
+
+	return m.calculatePermissionedBalances(allChainIDs, accountAddresses, erc20Balances, erc721Balances, erc1155Balances, tokenPermissions), nil
 }
@@ -29,6 +29,7 @@ func (s *ManagerSuite) Test_calculatePermissionedBalances() {
 	mainnetTMasterAddress := gethcommon.HexToAddress("0x123")
 	mainnetOwnerAddress := gethcommon.HexToAddress("0x1234")
 	mainnetTMasterNoTokenIDsAddress := gethcommon.HexToAddress("0x456")
+	mainnetEditionAddress := gethcommon.HexToAddress("0xE")
 
 	account1Address := gethcommon.HexToAddress("0x1")
 	account2Address := gethcommon.HexToAddress("0x2")
@@ -37,17 +38,35 @@ func (s *ManagerSuite) Test_calculatePermissionedBalances() {
 
 	erc20Balances := make(BalancesByChain)
 	erc721Balances := make(CollectiblesByChain)
+	erc1155Balances := make(CollectiblesByChain)
 
 	erc20Balances[mainnetID] = make(map[gethcommon.Address]map[gethcommon.Address]*hexutil.Big)
 	erc20Balances[arbitrumID] = make(map[gethcommon.Address]map[gethcommon.Address]*hexutil.Big)
 	erc20Balances[gnosisID] = make(map[gethcommon.Address]map[gethcommon.Address]*hexutil.Big)
 	erc721Balances[mainnetID] = make(map[gethcommon.Address]thirdparty.TokenBalancesPerContractAddress)
+	erc1155Balances[mainnetID] = make(map[gethcommon.Address]thirdparty.TokenBalancesPerContractAddress)
 
 	// Account 1 balances
 	erc20Balances[mainnetID][account1Address] = make(map[gethcommon.Address]*hexutil.Big)
 	erc20Balances[mainnetID][account1Address][mainnetETHContractAddress] = intToBig(10)
 	erc20Balances[arbitrumID][account1Address] = make(map[gethcommon.Address]*hexutil.Big)
 	erc20Balances[arbitrumID][account1Address][arbitrumETHContractAddress] = intToBig(25)
+	erc1155Balances[mainnetID][account1Address] = make(thirdparty.TokenBalancesPerContractAddress)
+	erc1155Balances[mainnetID][account1Address][mainnetEditionAddress] = []thirdparty.TokenBalance{
+		thirdparty.TokenBalance{
+			TokenID: uintToDecBig(7),
+			Balance: uintToDecBig(3),
+		},
+		thirdparty.TokenBalance{
+			TokenID: uintToDecBig(8),
+			Balance: uintToDecBig(2),
+		},
+		// Not listed in the criteria, so it must not be counted.
+		thirdparty.TokenBalance{
+			TokenID: uintToDecBig(9),
+			Balance: uintToDecBig(10),
+		},
+	}
 
 	// Account 2 balances
 	erc20Balances[mainnetID][account2Address] = make(map[gethcommon.Address]*hexutil.Big)
@@ -182,6 +201,21 @@ func (s *ManagerSuite) Test_calculatePermissionedBalances() {
 				},
 			},
 		},
+		&CommunityTokenPermission{
+			CommunityTokenPermission: &protobuf.CommunityTokenPermission{
+				Type: protobuf.CommunityTokenPermission_BECOME_MEMBER,
+				TokenCriteria: []*protobuf.TokenCriteria{
+					&protobuf.TokenCriteria{
+						Type:              protobuf.CommunityTokenType_ERC1155,
+						Symbol:            "EDITION",
+						Name:              "Edition",
+						Amount:            "4",
+						TokenIds:          []uint64{7, 8},
+						ContractAddresses: map[uint64]string{mainnetID: mainnetEditionAddress.Hex()},
+					},
+				},
+			},
+		},
 		&CommunityTokenPermission{
 			CommunityTokenPermission: &protobuf.CommunityTokenPermission{
 				// Unknown permission should be ignored.
@@ -205,6 +239,7 @@ func (s *ManagerSuite) Test_calculatePermissionedBalances() {
 		accountAddresses,
 		erc20Balances,
 		erc721Balances,
+		erc1155Balances,
 		tokenPermissions,
 	)
 
@@ -217,6 +252,12 @@ func (s *ManagerSuite) Test_calculatePermissionedBalances() {
 			Decimals: 18,
 			Amount:   &bigint.BigInt{Int: big.NewInt(35)},
 		},
+		PermissionedBalance{
+			Type:   protobuf.CommunityTokenType_ERC1155,
+			Symbol: "EDITION",
+			Name:   "Edition",
+			Amount: &bigint.BigInt{Int: big.NewInt(5)},
+		},
 	}
 	expected[account2Address] = []PermissionedBalance{
 		PermissionedBalance{
@@ -275,6 +316,7 @@ func (s *ManagerSuite) Test_GetPermissionedBalances() {
 	var chainID uint64 = 5
 	erc20ETHAddress := gethcommon.HexToAddress("0xA")
 	erc721Address := gethcommon.HexToAddress("0x123")
+	erc1155Address := gethcommon.HexToAddress("0x1155")
 
 	permissionRequest := &requests.CreateCommunityTokenPermission{
 		CommunityID: community.ID(),
@@ -312,7 +354,31 @@ func (s *ManagerSuite) Test_GetPermissionedBalances() {
 	s.Require().NoError(err)
 	s.Require().Len(changes.TokenPermissionsAdded, 1)
 
+	permissionRequest = &requests.CreateCommunityTokenPermission{
+		CommunityID: community.ID(),
+		Type:        protobuf.CommunityTokenPermission_BECOME_MEMBER,
+		TokenCriteria: []*protobuf.TokenCriteria{
+			&protobuf.TokenCriteria{
+				Type:              protobuf.CommunityTokenType_ERC1155,
+				Symbol:            "EDITION",
+				Name:              "Edition",
+				Amount:            "2",
+				TokenIds:          []uint64{1, 2},
+				ContractAddresses: map[uint64]string{chainID: erc1155Address.Hex()},
+			},
+		},
+	}
+	_, changes, err = m.CreateCommunityTokenPermission(permissionRequest)
+	s.Require().NoError(err)
+	s.Require().Len(changes.TokenPermissionsAdded, 1)
+
 	tokenManager.setResponse(chainID, accountAddress, erc20ETHAddress, 42)
+	collectiblesManager.setResponse(chainID, accountAddress, erc1155Address, []thirdparty.TokenBalance{
+		thirdparty.TokenBalance{
+			TokenID: uintToDecBig(2),
+			Balance: uintToDecBig(4),
+		},
+	})
 	collectiblesManager.setResponse(chainID, accountAddress, erc721Address, []thirdparty.TokenBalance{
 		thirdparty.TokenBalance{
 			TokenID: uintToDecBig(666),
@@ -338,6 +404,12 @@ func (s *ManagerSuite) Test_GetPermissionedBalances() {
 			Name:   "TMaster-Test",
 			Amount: &bigint.BigInt{Int: big.NewInt(1)},
 		},
+		PermissionedBalance{
+			Type:   protobuf.CommunityTokenType_ERC1155,
+			Symbol: "EDITION",
+			Name:   "Edition",
+			Amount: &bigint.BigInt{Int: big.NewInt(4)},
+		},
 	}
 
 	_, ok := actual[accountAddress]
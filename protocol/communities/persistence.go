@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/golang/protobuf/proto"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/status-im/status-go/protocol/common/shard"
 	"github.com/status-im/status-go/protocol/communities/token"
 	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
 	"github.com/status-im/status-go/services/wallet/bigint"
 )
 
@@ -668,6 +670,55 @@ func (p *Persistence) SaveRequestToLeave(request *RequestToLeave) error {
 	return err
 }
 
+// RequestsToJoinCountByState counts the requests to join made
+// in the given period, clocks are unix timestamps in seconds
+func (p *Persistence) RequestsToJoinCountByState(communityID []byte, from uint64, to uint64) (map[RequestToJoinState]int, error) {
+	rows, err := p.db.Query(`SELECT state, COUNT(*) FROM communities_requests_to_join WHERE community_id = ? AND clock >= ? AND clock <= ? GROUP BY state`, communityID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[RequestToJoinState]int)
+	for rows.Next() {
+		var state RequestToJoinState
+		var count int
+		err := rows.Scan(&state, &count)
+		if err != nil {
+			return nil, err
+		}
+		counts[state] = count
+	}
+	return counts, rows.Err()
+}
+
+func (p *Persistence) RequestsToLeaveCount(communityID []byte, from uint64, to uint64) (int, error) {
+	var count int
+	err := p.db.QueryRow(`SELECT COUNT(*) FROM communities_requests_to_leave WHERE community_id = ? AND clock >= ? AND clock <= ?`, communityID, from, to).Scan(&count)
+	return count, err
+}
+
+// AcceptedRequestsToJoinPublicKeys returns the members whose
+// request to join, made in the given period, got accepted
+func (p *Persistence) AcceptedRequestsToJoinPublicKeys(communityID []byte, from uint64, to uint64) ([]string, error) {
+	rows, err := p.db.Query(`SELECT public_key FROM communities_requests_to_join WHERE community_id = ? AND state = ? AND clock >= ? AND clock <= ?`, communityID, RequestToJoinStateAccepted, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var publicKeys []string
+	for rows.Next() {
+		var publicKey string
+		err := rows.Scan(&publicKey)
+		if err != nil {
+			return nil, err
+		}
+		publicKeys = append(publicKeys, publicKey)
+	}
+	return publicKeys, rows.Err()
+}
+
 func (p *Persistence) CanceledRequestsToJoinForUser(pk string) ([]*RequestToJoin, error) {
 	var requests []*RequestToJoin
 	rows, err := p.db.Query(`SELECT id,public_key,clock,ens_name,chat_id,community_id,state FROM communities_requests_to_join WHERE state = ? AND public_key = ?`, RequestToJoinStateCanceled, pk)
@@ -1869,3 +1920,624 @@ func (p *Persistence) UpsertAppliedCommunityEvents(communityID types.HexBytes, p
 	}
 	return err
 }
+
+const auditLogColumns = `community_id, position, hash, prev_hash, actor, action, target, clock, timestamp, payload, signature`
+
+func scanAuditLogEntry(scanner interface{ Scan(...interface{}) error }) (*AuditLogEntry, error) {
+	entry := &AuditLogEntry{}
+	var communityID string
+	var prevHash []byte
+
+	err := scanner.Scan(&communityID, &entry.Position, &entry.Hash, &prevHash, &entry.Actor, &entry.Action, &entry.Target,
+		&entry.Clock, &entry.Timestamp, &entry.Payload, &entry.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.CommunityID, err = types.DecodeHex(communityID)
+	if err != nil {
+		return nil, err
+	}
+	entry.PrevHash = prevHash
+
+	return entry, nil
+}
+
+func (p *Persistence) SaveCommunityAuditLogEntries(entries []*AuditLogEntry) (err error) {
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	for _, entry := range entries {
+		_, err = tx.Exec(`INSERT INTO community_audit_log(`+auditLogColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			entry.CommunityID.String(), entry.Position, entry.Hash, entry.PrevHash, entry.Actor, entry.Action, entry.Target,
+			entry.Clock, entry.Timestamp, entry.Payload, entry.Signature)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *Persistence) GetLastCommunityAuditLogEntry(communityID types.HexBytes) (*AuditLogEntry, error) {
+	row := p.db.QueryRow(`SELECT `+auditLogColumns+` FROM community_audit_log WHERE community_id = ? ORDER BY position DESC LIMIT 1`, communityID.String())
+
+	entry, err := scanAuditLogEntry(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return entry, err
+}
+
+func (p *Persistence) HasCommunityAuditLogEntry(communityID types.HexBytes, hash []byte) (bool, error) {
+	var exists bool
+	err := p.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM community_audit_log WHERE community_id = ? AND hash = ?)`, communityID.String(), hash).Scan(&exists)
+	return exists, err
+}
+
+func (p *Persistence) GetCommunityAuditLog(communityID types.HexBytes, filter *AuditLogFilter) ([]*AuditLogEntry, error) {
+	query := `SELECT ` + auditLogColumns + ` FROM community_audit_log WHERE community_id = ?`
+	args := []interface{}{communityID.String()}
+
+	if filter.Actor != "" {
+		query += ` AND actor = ?`
+		args = append(args, filter.Actor)
+	}
+
+	if filter.Target != "" {
+		query += ` AND target = ?`
+		args = append(args, filter.Target)
+	}
+
+	if len(filter.Actions) > 0 {
+		query += ` AND action IN (?` + strings.Repeat(",?", len(filter.Actions)-1) + `)`
+		for _, action := range filter.Actions {
+			args = append(args, action)
+		}
+	}
+
+	if filter.Cursor > 0 {
+		query += ` AND position > ?`
+		args = append(args, filter.Cursor)
+	}
+
+	query += ` ORDER BY position ASC`
+
+	if filter.Limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, filter.Limit)
+	}
+
+	rows, err := p.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*AuditLogEntry
+	for rows.Next() {
+		entry, err := scanAuditLogEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+const communityInviteColumns = `i.id, i.community_id, i.creator, i.clock, i.expires_at, i.max_uses, i.channel_id, i.auto_accept, i.payload, i.signature, i.revoked,
+	(SELECT COUNT(*) FROM community_invite_redemptions r WHERE r.community_id = i.community_id AND r.invite_id = i.id AND r.accepted)`
+
+func scanCommunityInvite(scanner interface{ Scan(...interface{}) error }) (*Invite, error) {
+	invite := &Invite{}
+	var communityID string
+
+	err := scanner.Scan(&invite.ID, &communityID, &invite.Creator, &invite.Clock, &invite.ExpiresAt, &invite.MaxUses,
+		&invite.ChannelID, &invite.AutoAccept, &invite.Payload, &invite.Signature, &invite.Revoked, &invite.Uses)
+	if err != nil {
+		return nil, err
+	}
+
+	invite.CommunityID, err = types.DecodeHex(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	return invite, nil
+}
+
+// SaveCommunityInvite keeps the first copy of an invite, invites are immutable
+func (p *Persistence) SaveCommunityInvite(invite *Invite) error {
+	_, err := p.db.Exec(`INSERT OR IGNORE INTO community_invites(id, community_id, creator, clock, expires_at, max_uses, channel_id, auto_accept, payload, signature)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		invite.ID, invite.CommunityID.String(), invite.Creator, invite.Clock, invite.ExpiresAt, invite.MaxUses,
+		invite.ChannelID, invite.AutoAccept, invite.Payload, invite.Signature)
+	return err
+}
+
+func (p *Persistence) GetCommunityInvite(communityID types.HexBytes, inviteID string) (*Invite, error) {
+	row := p.db.QueryRow(`SELECT `+communityInviteColumns+` FROM community_invites i WHERE i.community_id = ? AND i.id = ?`, communityID.String(), inviteID)
+
+	invite, err := scanCommunityInvite(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return invite, err
+}
+
+func (p *Persistence) GetCommunityInvites(communityID types.HexBytes) ([]*Invite, error) {
+	rows, err := p.db.Query(`SELECT `+communityInviteColumns+` FROM community_invites i WHERE i.community_id = ? ORDER BY i.clock DESC`, communityID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invites []*Invite
+	for rows.Next() {
+		invite, err := scanCommunityInvite(rows)
+		if err != nil {
+			return nil, err
+		}
+		invites = append(invites, invite)
+	}
+
+	return invites, rows.Err()
+}
+
+func (p *Persistence) SetCommunityInviteRevoked(communityID types.HexBytes, inviteID string) error {
+	_, err := p.db.Exec(`UPDATE community_invites SET revoked = TRUE WHERE community_id = ? AND id = ?`, communityID.String(), inviteID)
+	return err
+}
+
+// SaveCommunityInvitePendingRedemption records the invite attached to a request to join,
+// it only counts as a use once the request is accepted
+func (p *Persistence) SaveCommunityInvitePendingRedemption(communityID types.HexBytes, inviteID string, member string, clock uint64) error {
+	_, err := p.db.Exec(`INSERT OR IGNORE INTO community_invite_redemptions(community_id, invite_id, member, clock, accepted) VALUES (?, ?, ?, ?, FALSE)`,
+		communityID.String(), inviteID, member, clock)
+	return err
+}
+
+// GetCommunityInvitePendingRedemption returns the invite attached to the latest request to join of the member
+func (p *Persistence) GetCommunityInvitePendingRedemption(communityID types.HexBytes, member string) (string, error) {
+	var inviteID string
+	err := p.db.QueryRow(`SELECT invite_id FROM community_invite_redemptions WHERE community_id = ? AND member = ? AND NOT accepted ORDER BY clock DESC LIMIT 1`,
+		communityID.String(), member).Scan(&inviteID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return inviteID, err
+}
+
+func (p *Persistence) AcceptCommunityInviteRedemption(communityID types.HexBytes, inviteID string, member string) error {
+	_, err := p.db.Exec(`UPDATE community_invite_redemptions SET accepted = TRUE WHERE community_id = ? AND invite_id = ? AND member = ?`,
+		communityID.String(), inviteID, member)
+	return err
+}
+
+func (p *Persistence) DeleteCommunityInvitePendingRedemptions(communityID types.HexBytes, member string) error {
+	_, err := p.db.Exec(`DELETE FROM community_invite_redemptions WHERE community_id = ? AND member = ? AND NOT accepted`, communityID.String(), member)
+	return err
+}
+
+// GetCommunityInviteRedemptions returns the members who joined with the invite
+func (p *Persistence) GetCommunityInviteRedemptions(communityID types.HexBytes, inviteID string) ([]string, error) {
+	rows, err := p.db.Query(`SELECT member FROM community_invite_redemptions WHERE community_id = ? AND invite_id = ? AND accepted ORDER BY clock ASC`, communityID.String(), inviteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []string
+	for rows.Next() {
+		var member string
+		err := rows.Scan(&member)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+
+	return members, rows.Err()
+}
+
+// SaveCalendarEventRsvp returns false when a more recent RSVP of the member is already stored
+func (p *Persistence) SaveCalendarEventRsvp(rsvp *CalendarEventRsvp) (saved bool, err error) {
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	var clock, remindedStartTime uint64
+	err = tx.QueryRow(`SELECT clock, reminded_start_time FROM community_calendar_event_rsvps WHERE community_id = ? AND event_id = ? AND member = ?`,
+		rsvp.CommunityID.String(), rsvp.EventID, rsvp.Member).Scan(&clock, &remindedStartTime)
+	if err == sql.ErrNoRows {
+		err = nil
+	} else if err != nil {
+		return false, err
+	} else if clock >= rsvp.Clock {
+		return false, nil
+	}
+
+	_, err = tx.Exec(`INSERT OR REPLACE INTO community_calendar_event_rsvps(community_id, event_id, member, status, clock, reminded_start_time) VALUES (?, ?, ?, ?, ?, ?)`,
+		rsvp.CommunityID.String(), rsvp.EventID, rsvp.Member, rsvp.Status, rsvp.Clock, remindedStartTime)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (p *Persistence) GetCalendarEventRsvp(communityID types.HexBytes, eventID string, member string) (*CalendarEventRsvp, error) {
+	rsvp := &CalendarEventRsvp{
+		CommunityID: communityID,
+		EventID:     eventID,
+		Member:      member,
+	}
+
+	err := p.db.QueryRow(`SELECT status, clock, reminded_start_time FROM community_calendar_event_rsvps WHERE community_id = ? AND event_id = ? AND member = ?`,
+		communityID.String(), eventID, member).Scan(&rsvp.Status, &rsvp.Clock, &rsvp.RemindedStartTime)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rsvp, nil
+}
+
+// DeleteCalendarEventRsvp deletes the RSVP of the member with the given clock,
+// it returns false if the member answered again since
+func (p *Persistence) DeleteCalendarEventRsvp(communityID types.HexBytes, eventID string, member string, clock uint64) (bool, error) {
+	result, err := p.db.Exec(`DELETE FROM community_calendar_event_rsvps WHERE community_id = ? AND event_id = ? AND member = ? AND clock = ?`,
+		communityID.String(), eventID, member, clock)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (p *Persistence) GetCalendarEventRsvpCounts(communityID types.HexBytes, eventID string) (going uint32, interested uint32, err error) {
+	err = p.db.QueryRow(`SELECT COALESCE(SUM(status = ?), 0), COALESCE(SUM(status = ?), 0) FROM community_calendar_event_rsvps WHERE community_id = ? AND event_id = ?`,
+		protobuf.CommunityCalendarEventRsvp_GOING, protobuf.CommunityCalendarEventRsvp_INTERESTED, communityID.String(), eventID).Scan(&going, &interested)
+	return going, interested, err
+}
+
+// GetPendingCalendarEventRsvps returns the RSVPs of the member to events
+// they are going to or interested in
+func (p *Persistence) GetPendingCalendarEventRsvps(member string) ([]*CalendarEventRsvp, error) {
+	rows, err := p.db.Query(`SELECT community_id, event_id, status, clock, reminded_start_time FROM community_calendar_event_rsvps WHERE member = ? AND status IN (?, ?)`,
+		member, protobuf.CommunityCalendarEventRsvp_GOING, protobuf.CommunityCalendarEventRsvp_INTERESTED)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rsvps []*CalendarEventRsvp
+	for rows.Next() {
+		var communityID string
+		rsvp := &CalendarEventRsvp{Member: member}
+		err := rows.Scan(&communityID, &rsvp.EventID, &rsvp.Status, &rsvp.Clock, &rsvp.RemindedStartTime)
+		if err != nil {
+			return nil, err
+		}
+
+		rsvp.CommunityID, err = types.DecodeHex(communityID)
+		if err != nil {
+			return nil, err
+		}
+		rsvps = append(rsvps, rsvp)
+	}
+
+	return rsvps, rows.Err()
+}
+
+func (p *Persistence) SetCalendarEventRsvpReminded(communityID types.HexBytes, eventID string, member string, startTime uint64) error {
+	_, err := p.db.Exec(`UPDATE community_calendar_event_rsvps SET reminded_start_time = ? WHERE community_id = ? AND event_id = ? AND member = ?`,
+		startTime, communityID.String(), eventID, member)
+	return err
+}
+
+// SaveDirectoryEntry indexes the entry, unless a newer one of the community is already known
+func (p *Persistence) SaveDirectoryEntry(entry *DirectoryEntry) (saved bool, err error) {
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	communityID := entry.CommunityID.String()
+
+	var clock uint64
+	err = tx.QueryRow(`SELECT clock FROM community_directory WHERE community_id = ?`, communityID).Scan(&clock)
+	if err == sql.ErrNoRows {
+		err = nil
+	} else if err != nil {
+		return false, err
+	} else if clock >= entry.Clock {
+		return false, nil
+	}
+
+	var cluster, index *uint16
+	if entry.Shard != nil {
+		cluster = &entry.Shard.Cluster
+		index = &entry.Shard.Index
+	}
+
+	_, err = tx.Exec(`INSERT INTO community_directory(community_id, clock, name, description, members_count, color, shard_cluster, shard_index, received_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		communityID, entry.Clock, entry.Name, entry.Description, entry.MembersCount, entry.Color, cluster, index, entry.ReceivedAt)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = tx.Exec(`DELETE FROM community_directory_tags WHERE community_id = ?`, communityID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, tag := range entry.Tags {
+		_, err = tx.Exec(`INSERT INTO community_directory_tags(community_id, tag) VALUES (?, ?)`, communityID, tag)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	_, err = tx.Exec(`DELETE FROM community_directory_search WHERE community_id = ?`, communityID)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = tx.Exec(`INSERT INTO community_directory_search(community_id, name, description, tags) VALUES (?, ?, ?, ?)`,
+		communityID, entry.Name, entry.Description, strings.Join(entry.Tags, ", "))
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// directorySearchQuery turns the user input into a full-text query
+// matching the entries containing all of its words as prefixes
+func directorySearchQuery(query string) string {
+	words := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for i, word := range words {
+		words[i] = word + "*"
+	}
+
+	return strings.Join(words, " ")
+}
+
+func (p *Persistence) SearchDirectory(request *requests.SearchCommunityDirectory) ([]*DirectoryEntry, error) {
+	query := `SELECT d.community_id, d.clock, d.name, d.description, d.members_count, d.color, d.shard_cluster, d.shard_index, d.received_at,
+		EXISTS(SELECT 1 FROM curated_communities c WHERE c.community_id = d.community_id)
+		FROM community_directory d WHERE 1`
+	var args []interface{}
+
+	if searchQuery := directorySearchQuery(request.Query); searchQuery != "" {
+		query += ` AND d.community_id IN (SELECT community_id FROM community_directory_search WHERE community_directory_search MATCH ?)`
+		args = append(args, searchQuery)
+	}
+
+	for _, tag := range request.Tags {
+		query += ` AND d.community_id IN (SELECT community_id FROM community_directory_tags WHERE tag = ?)`
+		args = append(args, tag)
+	}
+
+	if request.VerifiedOnly {
+		query += ` AND d.community_id IN (SELECT community_id FROM curated_communities)`
+	}
+
+	switch request.SortBy {
+	case requests.CommunityDirectorySortByName:
+		query += ` ORDER BY d.name COLLATE NOCASE ASC`
+	case requests.CommunityDirectorySortByRecent:
+		query += ` ORDER BY d.clock DESC`
+	default:
+		query += ` ORDER BY EXISTS(SELECT 1 FROM curated_communities c WHERE c.community_id = d.community_id) DESC, d.received_at DESC, d.name COLLATE NOCASE ASC`
+	}
+
+	limit := request.Limit
+	if limit == 0 {
+		// No limit
+		limit = -1
+	}
+	query += ` LIMIT ? OFFSET ?`
+	args = append(args, limit, request.Offset)
+
+	rows, err := p.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*DirectoryEntry
+	for rows.Next() {
+		var communityID string
+		var cluster, index sql.NullInt64
+		entry := &DirectoryEntry{}
+		err := rows.Scan(&communityID, &entry.Clock, &entry.Name, &entry.Description, &entry.MembersCount, &entry.Color, &cluster, &index, &entry.ReceivedAt, &entry.Verified)
+		if err != nil {
+			return nil, err
+		}
+
+		entry.CommunityID, err = types.DecodeHex(communityID)
+		if err != nil {
+			return nil, err
+		}
+
+		if cluster.Valid && index.Valid {
+			entry.Shard = &shard.Shard{
+				Cluster: uint16(cluster.Int64),
+				Index:   uint16(index.Int64),
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		entry.Tags, err = p.getDirectoryEntryTags(entry.CommunityID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, nil
+}
+
+func (p *Persistence) getDirectoryEntryTags(communityID types.HexBytes) ([]string, error) {
+	rows, err := p.db.Query(`SELECT tag FROM community_directory_tags WHERE community_id = ? ORDER BY tag`, communityID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := []string{}
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, rows.Err()
+}
+
+func (p *Persistence) DeleteDirectoryEntriesReceivedBefore(receivedAt uint64) error {
+	return p.deleteDirectoryEntries(`SELECT community_id FROM community_directory WHERE received_at < ?`, receivedAt)
+}
+
+// DeleteDirectoryEntriesBeyond keeps the maxEntries most recently received entries,
+// the entries of the curated communities are always kept
+func (p *Persistence) DeleteDirectoryEntriesBeyond(maxEntries int) error {
+	return p.deleteDirectoryEntries(`SELECT community_id FROM community_directory
+		WHERE community_id NOT IN (SELECT community_id FROM curated_communities)
+		ORDER BY received_at DESC LIMIT -1 OFFSET ?`, maxEntries)
+}
+
+func (p *Persistence) deleteDirectoryEntries(entriesQuery string, args ...interface{}) (err error) {
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	var communityIDs []string
+	rows, err := tx.Query(entriesQuery, args...)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var communityID string
+		if err = rows.Scan(&communityID); err != nil {
+			rows.Close()
+			return err
+		}
+		communityIDs = append(communityIDs, communityID)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	for _, communityID := range communityIDs {
+		for _, table := range []string{"community_directory_tags", "community_directory_search", "community_directory"} {
+			_, err = tx.Exec(`DELETE FROM `+table+` WHERE community_id = ?`, communityID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *Persistence) SetDirectoryListing(communityID types.HexBytes, listed bool) error {
+	if listed {
+		_, err := p.db.Exec(`INSERT INTO community_directory_listings(community_id) VALUES (?)`, communityID.String())
+		return err
+	}
+
+	_, err := p.db.Exec(`DELETE FROM community_directory_listings WHERE community_id = ?`, communityID.String())
+	return err
+}
+
+func (p *Persistence) IsDirectoryListed(communityID types.HexBytes) (bool, error) {
+	var listed bool
+	err := p.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM community_directory_listings WHERE community_id = ?)`, communityID.String()).Scan(&listed)
+	return listed, err
+}
+
+func (p *Persistence) GetDirectoryListings() ([]types.HexBytes, error) {
+	rows, err := p.db.Query(`SELECT community_id FROM community_directory_listings`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var communityIDs []types.HexBytes
+	for rows.Next() {
+		var communityID string
+		if err := rows.Scan(&communityID); err != nil {
+			return nil, err
+		}
+
+		id, err := types.DecodeHex(communityID)
+		if err != nil {
+			return nil, err
+		}
+		communityIDs = append(communityIDs, id)
+	}
+
+	return communityIDs, rows.Err()
+}
@@ -6,6 +6,15 @@ import (
 	"github.com/status-im/status-go/protocol/protobuf"
 )
 
+// Moderators handle members and content, but can't change the community itself
+var moderatorAuthorizedEventTypes = []protobuf.CommunityEvent_EventType{
+	protobuf.CommunityEvent_COMMUNITY_REQUEST_TO_JOIN_ACCEPT,
+	protobuf.CommunityEvent_COMMUNITY_REQUEST_TO_JOIN_REJECT,
+	protobuf.CommunityEvent_COMMUNITY_MEMBER_TIMEOUT,
+	protobuf.CommunityEvent_COMMUNITY_MESSAGE_DELETE,
+	protobuf.CommunityEvent_COMMUNITY_MEMBER_MESSAGES_DELETE,
+}
+
 var adminAuthorizedEventTypes = []protobuf.CommunityEvent_EventType{
 	protobuf.CommunityEvent_COMMUNITY_EDIT,
 	protobuf.CommunityEvent_COMMUNITY_MEMBER_TOKEN_PERMISSION_CHANGE,
@@ -23,6 +32,12 @@ var adminAuthorizedEventTypes = []protobuf.CommunityEvent_EventType{
 	protobuf.CommunityEvent_COMMUNITY_MEMBER_KICK,
 	protobuf.CommunityEvent_COMMUNITY_MEMBER_BAN,
 	protobuf.CommunityEvent_COMMUNITY_MEMBER_UNBAN,
+	protobuf.CommunityEvent_COMMUNITY_MEMBER_TIMEOUT,
+	protobuf.CommunityEvent_COMMUNITY_INVITE_REVOKE,
+	protobuf.CommunityEvent_COMMUNITY_CALENDAR_EVENT_CHANGE,
+	protobuf.CommunityEvent_COMMUNITY_CALENDAR_EVENT_DELETE,
+	protobuf.CommunityEvent_COMMUNITY_MESSAGE_DELETE,
+	protobuf.CommunityEvent_COMMUNITY_MEMBER_MESSAGES_DELETE,
 }
 
 var tokenMasterAuthorizedEventTypes = append(adminAuthorizedEventTypes, []protobuf.CommunityEvent_EventType{
@@ -36,6 +51,7 @@ var rolesToAuthorizedEventTypes = map[protobuf.CommunityMember_Roles][]protobuf.
 	protobuf.CommunityMember_ROLE_OWNER:        ownerAuthorizedEventTypes,
 	protobuf.CommunityMember_ROLE_ADMIN:        adminAuthorizedEventTypes,
 	protobuf.CommunityMember_ROLE_TOKEN_MASTER: tokenMasterAuthorizedEventTypes,
+	protobuf.CommunityMember_ROLE_MODERATOR:    moderatorAuthorizedEventTypes,
 }
 
 var adminAuthorizedPermissionTypes = []protobuf.CommunityTokenPermission_Type{
@@ -56,6 +72,7 @@ var rolesToAuthorizedPermissionTypes = map[protobuf.CommunityMember_Roles][]prot
 	protobuf.CommunityMember_ROLE_OWNER:        ownerAuthorizedPermissionTypes,
 	protobuf.CommunityMember_ROLE_ADMIN:        adminAuthorizedPermissionTypes,
 	protobuf.CommunityMember_ROLE_TOKEN_MASTER: tokenMasterAuthorizedPermissionTypes,
+	protobuf.CommunityMember_ROLE_MODERATOR:    []protobuf.CommunityTokenPermission_Type{},
 }
 
 func canRolesPerformEvent(roles []protobuf.CommunityMember_Roles, eventType protobuf.CommunityEvent_EventType) bool {
@@ -97,6 +114,15 @@ func canRolesKickOrBanMember(senderRoles []protobuf.CommunityMember_Roles, membe
 		return true
 	}
 
+	// Moderators can act on normal members only, kick and ban are not among their event types
+	if (slices.Contains(senderRoles, protobuf.CommunityMember_ROLE_MODERATOR)) &&
+		!(slices.Contains(memberRoles, protobuf.CommunityMember_ROLE_MODERATOR) ||
+			slices.Contains(memberRoles, protobuf.CommunityMember_ROLE_ADMIN) ||
+			slices.Contains(memberRoles, protobuf.CommunityMember_ROLE_TOKEN_MASTER) ||
+			slices.Contains(memberRoles, protobuf.CommunityMember_ROLE_OWNER)) {
+		return true
+	}
+
 	// Normal members can't kick anyone
 	return false
 }
@@ -112,7 +138,8 @@ func RolesAuthorizedToPerformEvent(senderRoles []protobuf.CommunityMember_Roles,
 	}
 
 	if event.Type == protobuf.CommunityEvent_COMMUNITY_MEMBER_BAN ||
-		event.Type == protobuf.CommunityEvent_COMMUNITY_MEMBER_KICK {
+		event.Type == protobuf.CommunityEvent_COMMUNITY_MEMBER_KICK ||
+		event.Type == protobuf.CommunityEvent_COMMUNITY_MEMBER_TIMEOUT {
 		return canRolesKickOrBanMember(senderRoles, memberRoles)
 	}
 
@@ -2,11 +2,13 @@ package communities
 
 import (
 	"fmt"
+	"math/big"
 	"strings"
 
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/services/wallet/thirdparty"
 )
 
 func CalculateRequestID(publicKey string, communityID types.HexBytes) types.HexBytes {
@@ -14,16 +16,18 @@ func CalculateRequestID(publicKey string, communityID types.HexBytes) types.HexB
 	return crypto.Keccak256([]byte(idString))
 }
 
-func ExtractTokenCriteria(permissions []*CommunityTokenPermission) (erc20TokenCriteria map[uint64]map[string]*protobuf.TokenCriteria, erc721TokenCriteria map[uint64]map[string]*protobuf.TokenCriteria, ensTokenCriteria []string) {
+func ExtractTokenCriteria(permissions []*CommunityTokenPermission) (erc20TokenCriteria map[uint64]map[string]*protobuf.TokenCriteria, erc721TokenCriteria map[uint64]map[string]*protobuf.TokenCriteria, erc1155TokenCriteria map[uint64]map[string][]*protobuf.TokenCriteria, ensTokenCriteria []string) {
 	erc20TokenCriteria = make(map[uint64]map[string]*protobuf.TokenCriteria)
 	erc721TokenCriteria = make(map[uint64]map[string]*protobuf.TokenCriteria)
+	erc1155TokenCriteria = make(map[uint64]map[string][]*protobuf.TokenCriteria)
 	ensTokenCriteria = make([]string, 0)
 
 	for _, tokenPermission := range permissions {
-		for _, tokenRequirement := range tokenPermission.TokenCriteria {
+		for _, tokenRequirement := range tokenPermission.CheckedTokenCriteria() {
 
 			isERC721 := tokenRequirement.Type == protobuf.CommunityTokenType_ERC721
 			isERC20 := tokenRequirement.Type == protobuf.CommunityTokenType_ERC20
+			isERC1155 := tokenRequirement.Type == protobuf.CommunityTokenType_ERC1155
 			isENS := tokenRequirement.Type == protobuf.CommunityTokenType_ENS
 
 			for chainID, contractAddress := range tokenRequirement.ContractAddresses {
@@ -49,6 +53,15 @@ func ExtractTokenCriteria(permissions []*CommunityTokenPermission) (erc20TokenCr
 					erc20TokenCriteria[chainID][strings.ToLower(contractAddress)] = tokenRequirement
 				}
 
+				// ERC1155 criteria on the same contract can require different token IDs, all of them are kept
+				if isERC1155 {
+					if _, exists := erc1155TokenCriteria[chainID]; !exists {
+						erc1155TokenCriteria[chainID] = make(map[string][]*protobuf.TokenCriteria)
+					}
+					contractAddress = strings.ToLower(contractAddress)
+					erc1155TokenCriteria[chainID][contractAddress] = append(erc1155TokenCriteria[chainID][contractAddress], tokenRequirement)
+				}
+
 				if isENS {
 					ensTokenCriteria = append(ensTokenCriteria, tokenRequirement.EnsPattern)
 				}
@@ -57,3 +70,44 @@ func ExtractTokenCriteria(permissions []*CommunityTokenPermission) (erc20TokenCr
 	}
 	return
 }
+
+// erc1155TokenIDs returns the token IDs required by the criteria, without duplicates
+func erc1155TokenIDs(criteria []*protobuf.TokenCriteria) []*big.Int {
+	seen := make(map[uint64]bool)
+	tokenIDs := make([]*big.Int, 0)
+	for _, c := range criteria {
+		for _, tokenID := range c.TokenIds {
+			if !seen[tokenID] {
+				seen[tokenID] = true
+				tokenIDs = append(tokenIDs, new(big.Int).SetUint64(tokenID))
+			}
+		}
+	}
+	return tokenIDs
+}
+
+// erc1155RequiredAmount parses the amount of an ERC1155 criteria, an empty amount requires a single token
+func erc1155RequiredAmount(criteria *protobuf.TokenCriteria) (*big.Int, error) {
+	if criteria.Amount == "" {
+		return big.NewInt(1), nil
+	}
+	amount, ok := new(big.Int).SetString(criteria.Amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid ERC1155 amount: %s", criteria.Amount)
+	}
+	return amount, nil
+}
+
+// erc1155CriteriaBalance sums the balances of the token IDs required by the criteria
+func erc1155CriteriaBalance(tokenBalances []thirdparty.TokenBalance, criteria *protobuf.TokenCriteria) *big.Int {
+	sum := big.NewInt(0)
+	for _, tokenID := range criteria.TokenIds {
+		tokenIDBigInt := new(big.Int).SetUint64(tokenID)
+		for _, asset := range tokenBalances {
+			if asset.TokenID != nil && asset.Balance != nil && asset.TokenID.Cmp(tokenIDBigInt) == 0 {
+				sum.Add(sum, asset.Balance.Int)
+			}
+		}
+	}
+	return sum
+}
@@ -43,7 +43,7 @@ func iterateActions(community *communities.Community, keyActions *communities.En
 		}
 	}
 
-	return nil
+	return fn(community, community.ModeratorsHashRatchetGroupID(), &keyActions.ModeratorsKeyAction)
 }
 
 func (ckd *CommunitiesKeyDistributorImpl) generateKey(community *communities.Community, hashRatchetGroupID []byte, keyAction *communities.EncryptionKeyAction) error {
@@ -4096,3 +4096,279 @@ func (s *MessengerCommunitiesSuite) TestBanUserAndDeleteAllUserMessages() {
 	s.Require().False(community.Joined())
 	s.Require().True(community.Spectated())
 }
+
+func (s *MessengerCommunitiesSuite) TestAutomodDeletesMessageAndTimesOutMember() {
+	community, communityChat := s.createCommunity()
+
+	s.advertiseCommunityTo(community, s.owner, s.alice)
+	s.joinCommunity(community, s.owner, s.alice)
+
+	_, err := s.owner.SetCommunityAutomodRules(&requests.SetCommunityAutomodRules{
+		CommunityID: community.ID(),
+		Enabled:     true,
+		Rules: []*protobuf.CommunityAutomodRule{
+			{
+				Type:            protobuf.CommunityAutomodRule_KEYWORDS,
+				Patterns:        []string{"airdrop"},
+				Action:          protobuf.CommunityAutomodRule_TIMEOUT,
+				TimeoutDuration: 60,
+				Reason:          "spam",
+			},
+		},
+	})
+	s.Require().NoError(err)
+
+	ownerCommunity, err := s.owner.communitiesManager.GetByID(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(ownerCommunity.AutomodRules().Rules, 1)
+
+	// the rules are encrypted for moderating members only
+	_, err = WaitOnMessengerResponse(
+		s.alice,
+		func(r *MessengerResponse) bool {
+			return len(r.Communities()) > 0 && r.Communities()[0].Clock() == ownerCommunity.Clock()
+		},
+		"alice did not receive the community update",
+	)
+	s.Require().NoError(err)
+
+	aliceCommunity, err := s.alice.communitiesManager.GetByID(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(aliceCommunity.AutomodRules().Rules, 0)
+
+	inputMessage := buildTestMessage(*communityChat)
+	inputMessage.Text = "claim your free airdrop"
+
+	sendResponse, err := s.alice.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+	s.Require().Len(sendResponse.Messages(), 1)
+	messageID := sendResponse.Messages()[0].ID
+
+	response, err := WaitOnMessengerResponse(
+		s.owner,
+		func(r *MessengerResponse) bool {
+			return len(r.Communities()) > 0 && r.Communities()[0].IsTimedOut(&s.alice.identity.PublicKey)
+		},
+		"alice not timed out",
+	)
+	s.Require().NoError(err)
+	s.Require().Len(response.Messages(), 0)
+
+	_, err = s.owner.MessageByID(messageID)
+	s.Require().ErrorIs(err, common.ErrRecordNotFound)
+
+	_, err = WaitOnMessengerResponse(
+		s.alice,
+		func(r *MessengerResponse) bool {
+			for _, removed := range r.RemovedMessages() {
+				if removed.MessageID == messageID {
+					return true
+				}
+			}
+			return false
+		},
+		"message not deleted for alice",
+	)
+	s.Require().NoError(err)
+}
+
+func (s *MessengerCommunitiesSuite) TestAutomodRulesSharedWithModeratingMembers() {
+	community, communityChat := s.createCommunity()
+
+	s.advertiseCommunityTo(community, s.owner, s.alice)
+	s.joinCommunity(community, s.owner, s.alice)
+	s.advertiseCommunityTo(community, s.owner, s.bob)
+	s.joinCommunity(community, s.owner, s.bob)
+
+	grantPermission(&s.Suite, community, s.owner, s.bob, protobuf.CommunityMember_ROLE_ADMIN)
+
+	_, err := s.owner.SetCommunityAutomodRules(&requests.SetCommunityAutomodRules{
+		CommunityID: community.ID(),
+		Enabled:     true,
+		Rules: []*protobuf.CommunityAutomodRule{
+			{
+				Type:     protobuf.CommunityAutomodRule_KEYWORDS,
+				Patterns: []string{"airdrop"},
+				Action:   protobuf.CommunityAutomodRule_DELETE,
+			},
+		},
+	})
+	s.Require().NoError(err)
+
+	// The description may arrive before the moderators key, the rules are decrypted once both are received
+	_, err = WaitOnMessengerResponse(
+		s.bob,
+		func(r *MessengerResponse) bool {
+			bobCommunity, err := s.bob.communitiesManager.GetByID(community.ID())
+			return err == nil && len(bobCommunity.AutomodRules().Rules) == 1
+		},
+		"admin did not receive the automod rules",
+	)
+	s.Require().NoError(err)
+
+	rules, err := s.bob.CommunityAutomodRules(community.ID())
+	s.Require().NoError(err)
+	s.Require().True(rules.Enabled)
+	s.Require().Len(rules.Rules, 1)
+
+	_, err = s.alice.CommunityAutomodRules(community.ID())
+	s.Require().ErrorIs(err, communities.ErrNotAuthorized)
+
+	// The admin evaluates the rules too, its deletion is sent to the control node as a community event
+	inputMessage := buildTestMessage(*communityChat)
+	inputMessage.Text = "claim your free airdrop"
+
+	sendResponse, err := s.alice.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+	messageID := sendResponse.Messages()[0].ID
+
+	_, err = WaitOnMessengerResponse(
+		s.owner,
+		func(r *MessengerResponse) bool {
+			_, _ = s.bob.RetrieveAll()
+			entries, err := s.owner.communitiesManager.GetCommunityAuditLog(community.ID(), &communities.AuditLogFilter{
+				Actor:   common.PubkeyToHex(&s.bob.identity.PublicKey),
+				Actions: []protobuf.CommunityEvent_EventType{protobuf.CommunityEvent_COMMUNITY_MESSAGE_DELETE},
+			})
+			return err == nil && len(entries) == 1 && entries[0].Target == messageID
+		},
+		"control node did not receive the admin deletion",
+	)
+	s.Require().NoError(err)
+
+	_, err = s.bob.MessageByID(messageID)
+	s.Require().ErrorIs(err, common.ErrRecordNotFound)
+}
+
+func (s *MessengerCommunitiesSuite) TestCommunityCalendarEventRsvpAndReminder() {
+	community, communityChat := s.createCommunity()
+
+	s.advertiseCommunityTo(community, s.owner, s.alice)
+	s.joinCommunity(community, s.owner, s.alice)
+
+	startTime := uint64(time.Now().Unix()) + 300
+	response, err := s.owner.CreateCommunityCalendarEvent(&requests.CreateCommunityCalendarEvent{
+		CommunityID: community.ID(),
+		Title:       "AMA",
+		StartTime:   startTime,
+		EndTime:     startTime + 3600,
+		Location:    "https://status.app/ama",
+		ChatID:      strings.TrimPrefix(communityChat.ID, community.IDString()),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Communities(), 1)
+	s.Require().Len(response.Communities()[0].CalendarEvents(), 1)
+
+	var eventID string
+	for id := range response.Communities()[0].CalendarEvents() {
+		eventID = id
+	}
+
+	_, err = WaitOnMessengerResponse(
+		s.alice,
+		func(r *MessengerResponse) bool {
+			return len(r.Communities()) > 0 && r.Communities()[0].CalendarEvent(eventID) != nil
+		},
+		"alice did not receive the event",
+	)
+	s.Require().NoError(err)
+
+	_, err = s.alice.RsvpCommunityCalendarEvent(&requests.RsvpCommunityCalendarEvent{
+		CommunityID: community.ID(),
+		EventID:     eventID,
+		Status:      protobuf.CommunityCalendarEventRsvp_GOING,
+	})
+	s.Require().NoError(err)
+
+	// the counts are published periodically, not on every RSVP
+	_, err = WaitOnMessengerResponse(
+		s.owner,
+		func(r *MessengerResponse) bool {
+			s.owner.updateCommunityCalendarEventRsvpCounts(s.owner.logger)
+			ownerCommunity, err := s.owner.communitiesManager.GetByID(community.ID())
+			return err == nil && ownerCommunity.CalendarEvent(eventID).GoingCount == 1
+		},
+		"owner did not count the rsvp",
+	)
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(
+		s.alice,
+		func(r *MessengerResponse) bool {
+			return len(r.Communities()) > 0 && r.Communities()[0].CalendarEvent(eventID).GoingCount == 1
+		},
+		"alice did not receive the rsvp count",
+	)
+	s.Require().NoError(err)
+
+	calendarEvents, err := s.alice.CommunityCalendarEvents(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(calendarEvents, 1)
+	s.Require().Equal(protobuf.CommunityCalendarEventRsvp_GOING, calendarEvents[0].RsvpStatus)
+
+	// the event starts within the reminder time, alice is reminded once
+	s.Require().NoError(s.alice.processCommunityCalendarEventReminders())
+	s.Require().NoError(s.alice.processCommunityCalendarEventReminders())
+
+	notifications, err := s.alice.ActivityCenterNotifications(ActivityCenterNotificationsRequest{
+		Limit:         10,
+		ActivityTypes: []ActivityCenterType{ActivityCenterNotificationTypeCommunityEventReminder},
+		ReadType:      ActivityCenterQueryParamsReadAll,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(notifications.Notifications, 1)
+	s.Require().Equal(community.IDString(), notifications.Notifications[0].CommunityID)
+	s.Require().Equal(communityChat.ID, notifications.Notifications[0].ChatID)
+}
+
+func (s *MessengerCommunitiesSuite) TestCommunityDirectoryListing() {
+	community, _ := s.createCommunity()
+
+	err := s.alice.SetCommunityDirectoryEnabled(true)
+	s.Require().NoError(err)
+
+	_, err = s.alice.SetCommunityDirectoryListing(&requests.SetCommunityDirectoryListing{
+		CommunityID: community.ID(),
+		Listed:      true,
+	})
+	s.Require().ErrorIs(err, communities.ErrOrgNotFound)
+
+	_, err = s.owner.SetCommunityDirectoryListing(&requests.SetCommunityDirectoryListing{
+		CommunityID: community.ID(),
+		Listed:      true,
+	})
+	s.Require().NoError(err)
+
+	listed, err := s.owner.IsCommunityDirectoryListed(community.ID())
+	s.Require().NoError(err)
+	s.Require().True(listed)
+
+	var entries []*communities.DirectoryEntry
+	err = tt.RetryWithBackOff(func() error {
+		_, err := s.alice.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		entries, err = s.alice.SearchCommunityDirectory(&requests.SearchCommunityDirectory{Query: community.Name()})
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return errors.New("directory entry not received")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Require().Equal(community.ID(), entries[0].CommunityID)
+	s.Require().Equal(community.DescriptionText(), entries[0].Description)
+	s.Require().False(entries[0].Verified)
+
+	// Opting out of the directory drops the received entries
+	err = s.alice.SetCommunityDirectoryEnabled(false)
+	s.Require().NoError(err)
+
+	entries, err = s.alice.SearchCommunityDirectory(&requests.SearchCommunityDirectory{Query: community.Name()})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 0)
+}
@@ -2,7 +2,11 @@ package discord
 
 import (
 	"io/ioutil"
+	"mime"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
@@ -19,6 +23,11 @@ func DownloadAvatarAsset(url string) ([]byte, error) {
 }
 
 func DownloadAsset(url string) ([]byte, string, error) {
+	// Some exports ship their assets next to the exported messages
+	if strings.HasPrefix(url, "file://") {
+		return readLocalAsset(strings.TrimPrefix(url, "file://"))
+	}
+
 	client := http.Client{Timeout: time.Minute}
 	res, err := client.Get(url)
 	if err != nil {
@@ -34,3 +43,16 @@ func DownloadAsset(url string) ([]byte, string, error) {
 	bodyBytes, err := ioutil.ReadAll(res.Body)
 	return bodyBytes, contentType, err
 }
+
+func readLocalAsset(path string) ([]byte, string, error) {
+	bodyBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	contentType := mime.TypeByExtension(filepath.Ext(path))
+	if contentType == "" {
+		contentType = http.DetectContentType(bodyBytes)
+	}
+	return bodyBytes, contentType, nil
+}
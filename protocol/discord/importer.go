@@ -0,0 +1,159 @@
+package discord
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// TimestampLayout is the layout of message timestamps in `ExportedData`,
+// every importer converts the timestamps of its source to it
+const TimestampLayout = time.RFC3339
+
+type ImportSource string
+
+const (
+	ImportSourceDiscord  ImportSource = "discord"
+	ImportSourceSlack    ImportSource = "slack"
+	ImportSourceTelegram ImportSource = "telegram"
+)
+
+var ErrUnknownImportSource = errors.New("Unknown import source")
+
+func (s ImportSource) Valid() bool {
+	switch s {
+	case "", ImportSourceDiscord, ImportSourceSlack, ImportSourceTelegram:
+		return true
+	}
+	return false
+}
+
+// Importer turns the export files of a chat platform into data
+// the community import can process, regardless of where it comes from.
+// Each file is expected to hold the messages of a single channel.
+type Importer interface {
+	Extract(filesToImport []string) (*ExtractedData, map[string]*ImportError)
+}
+
+// NewImporter returns the importer of the given source, an empty source
+// stands for Discord, which used to be the only one
+func NewImporter(source ImportSource, logger *zap.Logger) (Importer, error) {
+	switch source {
+	case "", ImportSourceDiscord:
+		return &discordImporter{logger: logger}, nil
+	case ImportSourceSlack:
+		return &slackImporter{logger: logger}, nil
+	case ImportSourceTelegram:
+		return &telegramImporter{logger: logger}, nil
+	}
+	return nil, ErrUnknownImportSource
+}
+
+type discordImporter struct {
+	logger *zap.Logger
+}
+
+func (i *discordImporter) Extract(filesToImport []string) (*ExtractedData, map[string]*ImportError) {
+	return extract(i.logger, filesToImport, func(filePath string) ([]*ExportedData, error) {
+		bytes, err := readImportFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+
+		var exportedData ExportedData
+		err = json.Unmarshal(bytes, &exportedData)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*ExportedData{&exportedData}, nil
+	})
+}
+
+// extract runs `parse` on every file and collects the channels and
+// categories it finds, errors are reported per file
+func extract(logger *zap.Logger, filesToImport []string, parse func(filePath string) ([]*ExportedData, error)) (*ExtractedData, map[string]*ImportError) {
+	extractedData := &ExtractedData{
+		Categories:             map[string]*Category{},
+		ExportedData:           make([]*ExportedData, 0),
+		OldestMessageTimestamp: 0,
+		MessageCount:           0,
+	}
+
+	errs := map[string]*ImportError{}
+
+	for _, fileToImport := range filesToImport {
+		filePath := strings.Replace(fileToImport, "file://", "", -1)
+
+		exportedData, err := parse(filePath)
+		if err != nil {
+			errs[fileToImport] = Error(err.Error())
+			continue
+		}
+
+		for _, data := range exportedData {
+			if len(data.Messages) == 0 {
+				errs[fileToImport] = Error(ErrNoMessageData.Error())
+				continue
+			}
+
+			data.Channel.FilePath = filePath
+			categoryID := data.Channel.CategoryID
+
+			if _, ok := extractedData.Categories[categoryID]; !ok {
+				extractedData.Categories[categoryID] = &Category{
+					ID:   categoryID,
+					Name: data.Channel.CategoryName,
+				}
+			}
+
+			extractedData.MessageCount = extractedData.MessageCount + data.MessageCount
+			extractedData.ExportedData = append(extractedData.ExportedData, data)
+
+			// Exported channel data comes with `messages` being sorted,
+			// starting with the oldest, so we can safely rely on the first
+			// message
+			msgTime, err := time.Parse(TimestampLayout, data.Messages[0].Timestamp)
+			if err != nil {
+				logger.Error("failed to parse message timestamp", zap.Error(err))
+				continue
+			}
+
+			if extractedData.OldestMessageTimestamp == 0 || int(msgTime.Unix()) <= extractedData.OldestMessageTimestamp {
+				extractedData.OldestMessageTimestamp = int(msgTime.Unix())
+			}
+		}
+	}
+
+	return extractedData, errs
+}
+
+func readImportFile(filePath string) ([]byte, error) {
+	fileInfo, err := os.Stat(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if fileInfo.Size() > MaxImportFileSizeBytes {
+		return nil, ErrImportFileTooBig
+	}
+
+	return os.ReadFile(filePath)
+}
+
+func formatTimestamp(t time.Time) string {
+	return t.UTC().Format(TimestampLayout)
+}
+
+// sortMessagesByTimestamp keeps replies and pins after the messages they
+// refer to, the import only links messages it has already processed
+func sortMessagesByTimestamp(data *ExportedData) {
+	sort.SliceStable(data.Messages, func(i, j int) bool {
+		return data.Messages[i].Timestamp < data.Messages[j].Timestamp
+	})
+}
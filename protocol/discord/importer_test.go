@@ -0,0 +1,225 @@
+package discord
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func writeTestFile(t *testing.T, path string, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+}
+
+func TestNewImporter(t *testing.T) {
+	for _, source := range []ImportSource{"", ImportSourceDiscord, ImportSourceSlack, ImportSourceTelegram} {
+		require.True(t, source.Valid())
+		importer, err := NewImporter(source, zap.NewNop())
+		require.NoError(t, err)
+		require.NotNil(t, importer)
+	}
+
+	require.False(t, ImportSource("irc").Valid())
+	_, err := NewImporter("irc", zap.NewNop())
+	require.ErrorIs(t, err, ErrUnknownImportSource)
+}
+
+func TestDiscordImporter(t *testing.T) {
+	dir := t.TempDir()
+	channelFile := filepath.Join(dir, "channel.json")
+	emptyFile := filepath.Join(dir, "empty.json")
+
+	writeTestFile(t, channelFile, `{
+		"channel": {"id": "12345", "categoryId": "6789", "category": "test-category", "name": "test-channel", "topic": "topic"},
+		"messages": [{"id": "1234", "type": "Default", "timestamp": "2022-07-26T14:20:17.305+00:00", "content": "hello", "author": {"id": "123", "name": "TestAuthor"}}],
+		"messageCount": 1
+	}`)
+	writeTestFile(t, emptyFile, `{"channel": {"id": "1"}, "messages": []}`)
+
+	importer, err := NewImporter(ImportSourceDiscord, zap.NewNop())
+	require.NoError(t, err)
+
+	data, errs := importer.Extract([]string{"file://" + channelFile, emptyFile, filepath.Join(dir, "missing.json")})
+	require.Len(t, errs, 2)
+	require.Contains(t, errs, emptyFile)
+
+	require.Len(t, data.ExportedData, 1)
+	require.Equal(t, channelFile, data.ExportedData[0].Channel.FilePath)
+	require.Len(t, data.Categories, 1)
+	require.Equal(t, "test-category", data.Categories["6789"].Name)
+	require.Equal(t, 1, data.MessageCount)
+	require.Equal(t, 1658845217, data.OldestMessageTimestamp)
+}
+
+func TestSlackImporter(t *testing.T) {
+	dir := t.TempDir()
+
+	writeTestFile(t, filepath.Join(dir, "channels.json"), `[
+		{"id": "C01", "name": "general", "topic": {"value": "topic"}, "purpose": {"value": "Company wide announcements"}}
+	]`)
+	writeTestFile(t, filepath.Join(dir, "users.json"), `[
+		{"id": "U01", "name": "alice", "real_name": "Alice", "profile": {"display_name": "ali", "image_192": "https://example.com/alice.png"}},
+		{"id": "U02", "name": "bob", "real_name": "Bob", "profile": {}}
+	]`)
+	writeTestFile(t, filepath.Join(dir, "general", "2023-01-02.json"), `[
+		{"type": "message", "user": "U02", "text": "thanks <@U01>!", "ts": "1672660800.000100", "thread_ts": "1672574400.000100"}
+	]`)
+	writeTestFile(t, filepath.Join(dir, "general", "2023-01-01.json"), `[
+		{"type": "message", "subtype": "channel_join", "user": "U02", "text": "<@U02> has joined the channel", "ts": "1672574300.000100"},
+		{
+			"type": "message", "user": "U01", "text": "see <https://status.app|status.app> &amp; <#C01|general>", "ts": "1672574400.000100",
+			"thread_ts": "1672574400.000100", "edited": {"user": "U01", "ts": "1672574460.000000"},
+			"files": [{"id": "F01", "name": "report.pdf", "mimetype": "application/pdf", "size": 42, "url_private": "https://files.slack.com/report.pdf"}],
+			"reactions": [{"name": "thumbsup", "users": ["U02"], "count": 1}],
+			"pinned_to": ["C01"]
+		},
+		{"type": "message", "subtype": "bot_message", "bot_id": "B01", "username": "deploy-bot", "text": "deployed", "ts": "1672574500.000100"}
+	]`)
+
+	importer, err := NewImporter(ImportSourceSlack, zap.NewNop())
+	require.NoError(t, err)
+
+	data, errs := importer.Extract([]string{filepath.Join(dir, "general"), filepath.Join(dir, "random")})
+	require.Len(t, errs, 1)
+	require.Equal(t, ErrSlackChannelNotFound.Error(), errs[filepath.Join(dir, "random")].Message)
+
+	require.Len(t, data.ExportedData, 1)
+	require.Len(t, data.Categories, 1)
+	require.Equal(t, 1672574400, data.OldestMessageTimestamp)
+
+	channel := data.ExportedData[0]
+	require.Equal(t, "C01", channel.Channel.ID)
+	require.Equal(t, "general", channel.Channel.Name)
+	require.Equal(t, "Company wide announcements", channel.Channel.Description)
+	require.Equal(t, 4, channel.MessageCount)
+
+	messages := channel.Messages
+	require.Len(t, messages, 4)
+
+	first := messages[0]
+	require.Equal(t, "C01-1672574400.000100", first.Id)
+	require.Equal(t, string(MessageTypeDefault), first.Type)
+	require.Equal(t, "2023-01-01T12:00:00Z", first.Timestamp)
+	require.Equal(t, "2023-01-01T12:01:00Z", first.TimestampEdited)
+	require.Equal(t, "see https://status.app & #general", first.Content)
+	require.Equal(t, "U01", first.Author.Id)
+	require.Equal(t, "Alice", first.Author.Name)
+	require.Equal(t, "ali", first.Author.Nickname)
+	require.Equal(t, "https://example.com/alice.png", first.Author.AvatarUrl)
+	require.Len(t, first.Attachments, 1)
+	require.Equal(t, "https://files.slack.com/report.pdf", first.Attachments[0].Url)
+	require.Equal(t, "application/pdf", first.Attachments[0].ContentType)
+	require.Len(t, first.Reactions, 1)
+	require.Equal(t, ":thumbsup:", first.Reactions[0].Emoji)
+	require.Equal(t, uint32(1), first.Reactions[0].Count)
+
+	pin := messages[1]
+	require.Equal(t, string(MessageTypeChannelPinned), pin.Type)
+	require.Equal(t, first.Id, pin.Reference.MessageId)
+
+	bot := messages[2]
+	require.Equal(t, "B01", bot.Author.Id)
+	require.Equal(t, "deploy-bot", bot.Author.Name)
+
+	reply := messages[3]
+	require.Equal(t, string(MessageTypeReply), reply.Type)
+	require.Equal(t, first.Id, reply.Reference.MessageId)
+	require.Equal(t, "thanks @ali!", reply.Content)
+	require.Equal(t, "Bob", reply.Author.Name)
+}
+
+func TestTelegramImporter(t *testing.T) {
+	dir := t.TempDir()
+	resultFile := filepath.Join(dir, "result.json")
+
+	writeTestFile(t, resultFile, `{
+		"name": "Status Community",
+		"type": "public_supergroup",
+		"id": 1234567890,
+		"messages": [
+			{"id": 1, "type": "service", "date": "2023-01-01T12:00:00", "date_unixtime": "1672574400", "actor": "Alice", "actor_id": "user1", "action": "create_group", "title": "Status Community", "text": ""},
+			{
+				"id": 2, "type": "message", "date": "2023-01-01T12:01:00", "date_unixtime": "1672574460", "from": "Alice", "from_id": "user1",
+				"text": ["Welcome, read ", {"type": "text_link", "text": "the rules", "href": "https://status.app/rules"}, "!"],
+				"photo": "photos/photo_1.jpg", "width": 800, "height": 600,
+				"reactions": [{"type": "emoji", "count": 2, "emoji": "👍"}, {"type": "custom_emoji", "count": 1, "document_id": "1"}]
+			},
+			{"id": 3, "type": "message", "date": "2023-01-01T12:02:00", "date_unixtime": "1672574520", "edited": "2023-01-01T12:03:00", "edited_unixtime": "1672574580", "from": null, "from_id": "user2", "reply_to_message_id": 2, "text": "thanks", "file": "(File not included. Change data exporting settings to download.)", "mime_type": "video/mp4"},
+			{"id": 4, "type": "service", "date": "2023-01-01T12:04:00", "date_unixtime": "1672574640", "actor": "Alice", "actor_id": "user1", "action": "pin_message", "message_id": 2, "text": ""},
+			{"id": 5, "type": "message", "date": "2023-01-01T12:05:00", "from": "Alice", "from_id": "user1", "text": "", "file": "files/notes.pdf"}
+		]
+	}`)
+
+	importer, err := NewImporter(ImportSourceTelegram, zap.NewNop())
+	require.NoError(t, err)
+
+	data, errs := importer.Extract([]string{resultFile})
+	require.Len(t, errs, 0)
+	require.Len(t, data.ExportedData, 1)
+	require.Equal(t, 1672574460, data.OldestMessageTimestamp)
+
+	channel := data.ExportedData[0]
+	require.Equal(t, "1234567890", channel.Channel.ID)
+	require.Equal(t, "Status Community", channel.Channel.Name)
+	require.Equal(t, telegramCategoryID, channel.Channel.CategoryID)
+	require.Equal(t, 4, channel.MessageCount)
+
+	messages := channel.Messages
+	require.Len(t, messages, 4)
+
+	welcome := messages[0]
+	require.Equal(t, "1234567890-2", welcome.Id)
+	require.Equal(t, "2023-01-01T12:01:00Z", welcome.Timestamp)
+	require.Equal(t, "Welcome, read the rules (https://status.app/rules)!", welcome.Content)
+	require.Equal(t, "user1", welcome.Author.Id)
+	require.Equal(t, "Alice", welcome.Author.Name)
+	require.Len(t, welcome.Attachments, 1)
+	require.Equal(t, "file://"+filepath.Join(dir, "photos", "photo_1.jpg"), welcome.Attachments[0].Url)
+	require.Equal(t, "image/jpeg", welcome.Attachments[0].ContentType)
+	require.Len(t, welcome.Reactions, 1)
+	require.Equal(t, "👍", welcome.Reactions[0].Emoji)
+	require.Equal(t, uint32(2), welcome.Reactions[0].Count)
+
+	reply := messages[1]
+	require.Equal(t, string(MessageTypeReply), reply.Type)
+	require.Equal(t, welcome.Id, reply.Reference.MessageId)
+	require.Equal(t, "2023-01-01T12:03:00Z", reply.TimestampEdited)
+	require.Equal(t, telegramDeletedAccount, reply.Author.Name)
+	require.Len(t, reply.Attachments, 0)
+
+	pin := messages[2]
+	require.Equal(t, string(MessageTypeChannelPinned), pin.Type)
+	require.Equal(t, welcome.Id, pin.Reference.MessageId)
+
+	// Exports without unix timestamps use the local date
+	notes := messages[3]
+	require.Equal(t, "2023-01-01T12:05:00Z", notes.Timestamp)
+	require.Len(t, notes.Attachments, 1)
+	require.Equal(t, "notes.pdf", notes.Attachments[0].FileName)
+	require.Equal(t, "application/pdf", notes.Attachments[0].ContentType)
+}
+
+func TestTelegramImporterRejectsAccountExports(t *testing.T) {
+	resultFile := filepath.Join(t.TempDir(), "result.json")
+	writeTestFile(t, resultFile, `{"about": "", "chats": {"about": "", "list": []}}`)
+
+	importer, err := NewImporter(ImportSourceTelegram, zap.NewNop())
+	require.NoError(t, err)
+
+	_, errs := importer.Extract([]string{resultFile})
+	require.Len(t, errs, 1)
+	require.Equal(t, ErrTelegramFullExport.Error(), errs[resultFile].Message)
+}
+
+func TestDownloadLocalAsset(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "photo_1.jpg")
+	writeTestFile(t, path, "not really a jpeg")
+
+	payload, contentType, err := DownloadAsset("file://" + path)
+	require.NoError(t, err)
+	require.Equal(t, []byte("not really a jpeg"), payload)
+	require.Equal(t, "image/jpeg", contentType)
+}
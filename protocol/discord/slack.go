@@ -0,0 +1,377 @@
+package discord
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const slackCategoryID = "slack"
+const slackCategoryName = "Slack"
+
+var ErrSlackChannelNotFound = errors.New("Channel not found in Slack export")
+
+var slackMentionRegexp = regexp.MustCompile(`<([@#!])([^>|]+)(?:\|([^>]*))?>`)
+var slackLinkRegexp = regexp.MustCompile(`<([^@#!][^>|]*)(?:\|([^>]*))?>`)
+
+// Messages of these subtypes only record changes to the channel itself
+var slackSkippedSubtypes = map[string]bool{
+	"channel_join":    true,
+	"channel_leave":   true,
+	"channel_topic":   true,
+	"channel_purpose": true,
+	"channel_name":    true,
+	"channel_archive": true,
+	"pinned_item":     true,
+	"unpinned_item":   true,
+}
+
+type slackChannel struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Topic struct {
+		Value string `json:"value"`
+	} `json:"topic"`
+	Purpose struct {
+		Value string `json:"value"`
+	} `json:"purpose"`
+}
+
+type slackUserProfile struct {
+	RealName    string `json:"real_name"`
+	DisplayName string `json:"display_name"`
+	Image72     string `json:"image_72"`
+	Image192    string `json:"image_192"`
+}
+
+type slackUser struct {
+	ID       string           `json:"id"`
+	Name     string           `json:"name"`
+	RealName string           `json:"real_name"`
+	Profile  slackUserProfile `json:"profile"`
+}
+
+type slackFile struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Mimetype           string `json:"mimetype"`
+	Size               uint64 `json:"size"`
+	URLPrivate         string `json:"url_private"`
+	URLPrivateDownload string `json:"url_private_download"`
+}
+
+type slackReaction struct {
+	Name  string `json:"name"`
+	Count uint32 `json:"count"`
+}
+
+type slackMessage struct {
+	Type        string            `json:"type"`
+	Subtype     string            `json:"subtype"`
+	User        string            `json:"user"`
+	BotID       string            `json:"bot_id"`
+	Username    string            `json:"username"`
+	UserProfile *slackUserProfile `json:"user_profile"`
+	Text        string            `json:"text"`
+	TS          string            `json:"ts"`
+	ThreadTS    string            `json:"thread_ts"`
+	Edited      *struct {
+		TS string `json:"ts"`
+	} `json:"edited"`
+	Files     []slackFile     `json:"files"`
+	Reactions []slackReaction `json:"reactions"`
+	PinnedTo  []string        `json:"pinned_to"`
+}
+
+// slackImporter reads Slack workspace exports. Every file to import is the
+// directory of a channel, holding a JSON file of messages per day, while
+// `channels.json` and `users.json` are found in the root of the export.
+type slackImporter struct {
+	logger *zap.Logger
+}
+
+func (i *slackImporter) Extract(filesToImport []string) (*ExtractedData, map[string]*ImportError) {
+	return extract(i.logger, filesToImport, func(channelPath string) ([]*ExportedData, error) {
+		channelPath = filepath.Clean(channelPath)
+		exportPath := filepath.Dir(channelPath)
+
+		channel, err := findSlackChannel(exportPath, filepath.Base(channelPath))
+		if err != nil {
+			return nil, err
+		}
+
+		users, err := readSlackUsers(exportPath)
+		if err != nil {
+			return nil, err
+		}
+
+		dayFiles, err := filepath.Glob(filepath.Join(channelPath, "*.json"))
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(dayFiles)
+
+		exportedData := &ExportedData{
+			Channel: Channel{
+				ID:           channel.ID,
+				CategoryName: slackCategoryName,
+				CategoryID:   slackCategoryID,
+				Name:         channel.Name,
+				Description:  channel.Purpose.Value,
+			},
+			Messages: make([]*protobuf.DiscordMessage, 0),
+		}
+		if exportedData.Channel.Description == "" {
+			exportedData.Channel.Description = channel.Topic.Value
+		}
+
+		for _, dayFile := range dayFiles {
+			bytes, err := readImportFile(dayFile)
+			if err != nil {
+				return nil, err
+			}
+
+			var messages []*slackMessage
+			err = json.Unmarshal(bytes, &messages)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", filepath.Base(dayFile), err)
+			}
+
+			for _, message := range messages {
+				exportedData.Messages = append(exportedData.Messages, convertSlackMessage(channel.ID, message, users)...)
+			}
+		}
+
+		sortMessagesByTimestamp(exportedData)
+		exportedData.MessageCount = len(exportedData.Messages)
+
+		return []*ExportedData{exportedData}, nil
+	})
+}
+
+func findSlackChannel(exportPath string, name string) (*slackChannel, error) {
+	// Private channels are exported in `groups.json`
+	for _, fileName := range []string{"channels.json", "groups.json"} {
+		bytes, err := readImportFile(filepath.Join(exportPath, fileName))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var channels []*slackChannel
+		err = json.Unmarshal(bytes, &channels)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fileName, err)
+		}
+
+		for _, channel := range channels {
+			if channel.Name == name {
+				return channel, nil
+			}
+		}
+	}
+	return nil, ErrSlackChannelNotFound
+}
+
+func readSlackUsers(exportPath string) (map[string]*slackUser, error) {
+	users := make(map[string]*slackUser)
+
+	bytes, err := readImportFile(filepath.Join(exportPath, "users.json"))
+	if os.IsNotExist(err) {
+		// Authors are then named after the profile attached to their messages
+		return users, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*slackUser
+	err = json.Unmarshal(bytes, &list)
+	if err != nil {
+		return nil, fmt.Errorf("users.json: %w", err)
+	}
+
+	for _, user := range list {
+		users[user.ID] = user
+	}
+	return users, nil
+}
+
+func parseSlackTimestamp(ts string) (time.Time, error) {
+	seconds, err := strconv.ParseFloat(ts, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(int64(seconds), 0), nil
+}
+
+func slackMessageID(channelID string, ts string) string {
+	return channelID + "-" + ts
+}
+
+// convertSlackMessage returns the message, followed by a pin
+// message if it has been pinned to the channel
+func convertSlackMessage(channelID string, message *slackMessage, users map[string]*slackUser) []*protobuf.DiscordMessage {
+	if message.Type != "message" || slackSkippedSubtypes[message.Subtype] {
+		return nil
+	}
+
+	timestamp, err := parseSlackTimestamp(message.TS)
+	if err != nil {
+		return nil
+	}
+
+	discordMessage := &protobuf.DiscordMessage{
+		Id:        slackMessageID(channelID, message.TS),
+		Type:      string(MessageTypeDefault),
+		Timestamp: formatTimestamp(timestamp),
+		Content:   convertSlackText(message.Text, users),
+		Author:    slackAuthor(message, users),
+	}
+
+	if message.Edited != nil {
+		if edited, err := parseSlackTimestamp(message.Edited.TS); err == nil {
+			discordMessage.TimestampEdited = formatTimestamp(edited)
+		}
+	}
+
+	// Thread replies point to the message that started the thread
+	if message.ThreadTS != "" && message.ThreadTS != message.TS {
+		discordMessage.Type = string(MessageTypeReply)
+		discordMessage.Reference = &protobuf.DiscordMessageReference{
+			MessageId: slackMessageID(channelID, message.ThreadTS),
+			ChannelId: channelID,
+		}
+	}
+
+	for _, file := range message.Files {
+		url := file.URLPrivateDownload
+		if url == "" {
+			url = file.URLPrivate
+		}
+		if url == "" {
+			continue
+		}
+		discordMessage.Attachments = append(discordMessage.Attachments, &protobuf.DiscordMessageAttachment{
+			Id:            file.ID,
+			Url:           url,
+			FileName:      file.Name,
+			FileSizeBytes: file.Size,
+			ContentType:   file.Mimetype,
+		})
+	}
+
+	for _, reaction := range message.Reactions {
+		discordMessage.Reactions = append(discordMessage.Reactions, &protobuf.DiscordMessageReaction{
+			Emoji: ":" + reaction.Name + ":",
+			Count: reaction.Count,
+		})
+	}
+
+	messages := []*protobuf.DiscordMessage{discordMessage}
+
+	for _, pinnedTo := range message.PinnedTo {
+		if pinnedTo == channelID {
+			messages = append(messages, &protobuf.DiscordMessage{
+				Id:        discordMessage.Id + "-pin",
+				Type:      string(MessageTypeChannelPinned),
+				Timestamp: discordMessage.Timestamp,
+				Author:    discordMessage.Author,
+				Reference: &protobuf.DiscordMessageReference{
+					MessageId: discordMessage.Id,
+					ChannelId: channelID,
+				},
+			})
+			break
+		}
+	}
+
+	return messages
+}
+
+func slackAuthor(message *slackMessage, users map[string]*slackUser) *protobuf.DiscordMessageAuthor {
+	author := &protobuf.DiscordMessageAuthor{
+		Id:   message.User,
+		Name: message.Username,
+	}
+	if author.Id == "" {
+		author.Id = message.BotID
+	}
+
+	profile := message.UserProfile
+	if user, ok := users[message.User]; ok {
+		author.Name = user.Name
+		if user.RealName != "" {
+			author.Name = user.RealName
+		}
+		profile = &user.Profile
+	}
+
+	if profile != nil {
+		if author.Name == "" {
+			author.Name = profile.RealName
+		}
+		author.Nickname = profile.DisplayName
+		author.AvatarUrl = profile.Image192
+		if author.AvatarUrl == "" {
+			author.AvatarUrl = profile.Image72
+		}
+	}
+
+	if author.Name == "" {
+		author.Name = author.Id
+	}
+
+	return author
+}
+
+// convertSlackText replaces Slack's markup for mentions and links with plain text
+func convertSlackText(text string, users map[string]*slackUser) string {
+	text = slackMentionRegexp.ReplaceAllStringFunc(text, func(match string) string {
+		parts := slackMentionRegexp.FindStringSubmatch(match)
+		kind, id, label := parts[1], parts[2], parts[3]
+
+		switch kind {
+		case "@":
+			if label == "" {
+				if user, ok := users[id]; ok {
+					label = user.Name
+					if user.Profile.DisplayName != "" {
+						label = user.Profile.DisplayName
+					}
+				} else {
+					label = id
+				}
+			}
+			return "@" + label
+		case "#":
+			if label == "" {
+				label = id
+			}
+			return "#" + label
+		}
+
+		// Special mentions such as <!here> or <!channel>
+		if label != "" {
+			return "@" + label
+		}
+		return "@" + id
+	})
+
+	text = slackLinkRegexp.ReplaceAllString(text, "$1")
+
+	return strings.NewReplacer("&lt;", "<", "&gt;", ">", "&amp;", "&").Replace(text)
+}
@@ -0,0 +1,286 @@
+package discord
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const telegramCategoryID = "telegram"
+const telegramCategoryName = "Telegram"
+
+// Telegram Desktop exports dates in the local time of the exporting machine,
+// recent versions add a unix timestamp next to them
+const telegramDateLayout = "2006-01-02T15:04:05"
+
+const telegramDeletedAccount = "Deleted Account"
+
+var ErrTelegramFullExport = errors.New("Telegram account exports are not supported, please export a single chat")
+var ErrTelegramNoChat = errors.New("No Telegram chat found in file")
+
+type telegramReaction struct {
+	Type  string `json:"type"`
+	Count uint32 `json:"count"`
+	Emoji string `json:"emoji"`
+}
+
+type telegramMessage struct {
+	ID               int64              `json:"id"`
+	Type             string             `json:"type"`
+	Date             string             `json:"date"`
+	DateUnixtime     string             `json:"date_unixtime"`
+	Edited           string             `json:"edited"`
+	EditedUnixtime   string             `json:"edited_unixtime"`
+	From             *string            `json:"from"`
+	FromID           string             `json:"from_id"`
+	Actor            *string            `json:"actor"`
+	ActorID          string             `json:"actor_id"`
+	Action           string             `json:"action"`
+	MessageID        int64              `json:"message_id"`
+	ReplyToMessageID int64              `json:"reply_to_message_id"`
+	Text             json.RawMessage    `json:"text"`
+	Photo            string             `json:"photo"`
+	PhotoFileSize    uint64             `json:"photo_file_size"`
+	File             string             `json:"file"`
+	FileName         string             `json:"file_name"`
+	FileSize         uint64             `json:"file_size"`
+	MimeType         string             `json:"mime_type"`
+	Reactions        []telegramReaction `json:"reactions"`
+}
+
+type telegramChat struct {
+	ID       int64              `json:"id"`
+	Name     string             `json:"name"`
+	Type     string             `json:"type"`
+	Messages []*telegramMessage `json:"messages"`
+	Chats    *json.RawMessage   `json:"chats"`
+}
+
+// telegramImporter reads the `result.json` file of a chat exported with Telegram Desktop,
+// photos and files are referenced relatively to it
+type telegramImporter struct {
+	logger *zap.Logger
+}
+
+func (i *telegramImporter) Extract(filesToImport []string) (*ExtractedData, map[string]*ImportError) {
+	return extract(i.logger, filesToImport, func(filePath string) ([]*ExportedData, error) {
+		bytes, err := readImportFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+
+		var chat telegramChat
+		err = json.Unmarshal(bytes, &chat)
+		if err != nil {
+			return nil, err
+		}
+
+		if chat.Chats != nil {
+			return nil, ErrTelegramFullExport
+		}
+
+		if chat.ID == 0 {
+			return nil, ErrTelegramNoChat
+		}
+
+		channelID := strconv.FormatInt(chat.ID, 10)
+		exportPath := filepath.Dir(filePath)
+
+		exportedData := &ExportedData{
+			Channel: Channel{
+				ID:           channelID,
+				CategoryName: telegramCategoryName,
+				CategoryID:   telegramCategoryID,
+				Name:         chat.Name,
+			},
+			Messages: make([]*protobuf.DiscordMessage, 0),
+		}
+
+		for _, message := range chat.Messages {
+			discordMessage, err := convertTelegramMessage(channelID, exportPath, message)
+			if err != nil {
+				return nil, fmt.Errorf("message %d: %w", message.ID, err)
+			}
+			if discordMessage != nil {
+				exportedData.Messages = append(exportedData.Messages, discordMessage)
+			}
+		}
+
+		sortMessagesByTimestamp(exportedData)
+		exportedData.MessageCount = len(exportedData.Messages)
+
+		return []*ExportedData{exportedData}, nil
+	})
+}
+
+func parseTelegramDate(date string, unixtime string) (time.Time, error) {
+	if unixtime != "" {
+		seconds, err := strconv.ParseInt(unixtime, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(seconds, 0), nil
+	}
+	return time.Parse(telegramDateLayout, date)
+}
+
+func telegramMessageID(channelID string, id int64) string {
+	return fmt.Sprintf("%s-%d", channelID, id)
+}
+
+func telegramAuthor(id string, name *string) *protobuf.DiscordMessageAuthor {
+	author := &protobuf.DiscordMessageAuthor{
+		Id:   id,
+		Name: telegramDeletedAccount,
+	}
+	if name != nil && *name != "" {
+		author.Name = *name
+	}
+	return author
+}
+
+// convertTelegramMessage returns nil for service messages other than pins
+func convertTelegramMessage(channelID string, exportPath string, message *telegramMessage) (*protobuf.DiscordMessage, error) {
+	timestamp, err := parseTelegramDate(message.Date, message.DateUnixtime)
+	if err != nil {
+		return nil, err
+	}
+
+	discordMessage := &protobuf.DiscordMessage{
+		Id:        telegramMessageID(channelID, message.ID),
+		Type:      string(MessageTypeDefault),
+		Timestamp: formatTimestamp(timestamp),
+	}
+
+	if message.Type == "service" {
+		if message.Action != "pin_message" || message.MessageID == 0 {
+			return nil, nil
+		}
+		discordMessage.Type = string(MessageTypeChannelPinned)
+		discordMessage.Author = telegramAuthor(message.ActorID, message.Actor)
+		discordMessage.Reference = &protobuf.DiscordMessageReference{
+			MessageId: telegramMessageID(channelID, message.MessageID),
+			ChannelId: channelID,
+		}
+		return discordMessage, nil
+	}
+
+	if message.Type != "message" {
+		return nil, nil
+	}
+
+	discordMessage.Author = telegramAuthor(message.FromID, message.From)
+
+	discordMessage.Content, err = convertTelegramText(message.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	if message.Edited != "" || message.EditedUnixtime != "" {
+		if edited, err := parseTelegramDate(message.Edited, message.EditedUnixtime); err == nil {
+			discordMessage.TimestampEdited = formatTimestamp(edited)
+		}
+	}
+
+	if message.ReplyToMessageID != 0 {
+		discordMessage.Type = string(MessageTypeReply)
+		discordMessage.Reference = &protobuf.DiscordMessageReference{
+			MessageId: telegramMessageID(channelID, message.ReplyToMessageID),
+			ChannelId: channelID,
+		}
+	}
+
+	if attachment := telegramAttachment(discordMessage.Id+"-photo", exportPath, message.Photo, "", message.PhotoFileSize, "image/jpeg"); attachment != nil {
+		discordMessage.Attachments = append(discordMessage.Attachments, attachment)
+	}
+	if attachment := telegramAttachment(discordMessage.Id+"-file", exportPath, message.File, message.FileName, message.FileSize, message.MimeType); attachment != nil {
+		discordMessage.Attachments = append(discordMessage.Attachments, attachment)
+	}
+
+	for _, reaction := range message.Reactions {
+		if reaction.Type != "emoji" {
+			continue
+		}
+		discordMessage.Reactions = append(discordMessage.Reactions, &protobuf.DiscordMessageReaction{
+			Emoji: reaction.Emoji,
+			Count: reaction.Count,
+		})
+	}
+
+	return discordMessage, nil
+}
+
+// telegramAttachment points to the file next to the export, files
+// that haven't been exported are described in parentheses instead
+func telegramAttachment(id string, exportPath string, path string, fileName string, size uint64, contentType string) *protobuf.DiscordMessageAttachment {
+	if path == "" || strings.HasPrefix(path, "(") {
+		return nil
+	}
+
+	if fileName == "" {
+		fileName = filepath.Base(path)
+	}
+	if contentType == "" {
+		contentType = mime.TypeByExtension(filepath.Ext(fileName))
+	}
+
+	return &protobuf.DiscordMessageAttachment{
+		Id:            id,
+		Url:           "file://" + filepath.Join(exportPath, filepath.FromSlash(path)),
+		FileName:      fileName,
+		FileSizeBytes: size,
+		ContentType:   contentType,
+	}
+}
+
+// convertTelegramText flattens rich text, which is exported as a list
+// of plain strings and formatted entities
+func convertTelegramText(text json.RawMessage) (string, error) {
+	if len(text) == 0 {
+		return "", nil
+	}
+
+	var plain string
+	if err := json.Unmarshal(text, &plain); err == nil {
+		return plain, nil
+	}
+
+	var parts []json.RawMessage
+	err := json.Unmarshal(text, &parts)
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+	for _, part := range parts {
+		if err := json.Unmarshal(part, &plain); err == nil {
+			builder.WriteString(plain)
+			continue
+		}
+
+		var entity struct {
+			Type string `json:"type"`
+			Text string `json:"text"`
+			Href string `json:"href"`
+		}
+		err := json.Unmarshal(part, &entity)
+		if err != nil {
+			return "", err
+		}
+
+		builder.WriteString(entity.Text)
+		if entity.Type == "text_link" && entity.Href != "" && entity.Href != entity.Text {
+			builder.WriteString(" (" + entity.Href + ")")
+		}
+	}
+	return builder.String(), nil
+}
@@ -0,0 +1,59 @@
+package files
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"mime"
+	"net/http"
+	"path/filepath"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// MaxSize is the maximum size of the payload of a file message.
+// Files larger than a single waku message are sent segmented by the message sender,
+// each segment being encrypted as any other message.
+const MaxSize = 20 * 1024 * 1024
+
+var (
+	ErrTooLarge       = errors.New("file is too large")
+	ErrSizeMismatch   = errors.New("file size mismatch")
+	ErrHashMismatch   = errors.New("file hash mismatch")
+	ErrNameMissing    = errors.New("file name missing")
+	ErrPayloadMissing = errors.New("file payload missing")
+)
+
+// Hash returns the content hash of a file payload
+func Hash(payload []byte) []byte {
+	hash := sha256.Sum256(payload)
+	return hash[:]
+}
+
+// MIME returns the MIME type of a file, from its extension or else its content
+func MIME(name string, payload []byte) string {
+	if mimeType := mime.TypeByExtension(filepath.Ext(name)); mimeType != "" {
+		return mimeType
+	}
+	return http.DetectContentType(payload)
+}
+
+// Verify checks the integrity of a received file
+func Verify(file *protobuf.FileMessage) error {
+	if len(file.Payload) == 0 {
+		return ErrPayloadMissing
+	}
+	if len(file.Payload) > MaxSize {
+		return ErrTooLarge
+	}
+	if len(file.Name) == 0 {
+		return ErrNameMissing
+	}
+	if uint64(len(file.Payload)) != file.Size {
+		return ErrSizeMismatch
+	}
+	if !bytes.Equal(Hash(file.Payload), file.Hash) {
+		return ErrHashMismatch
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package files
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestVerify(t *testing.T) {
+	payload := []byte("%PDF-1.4 some content")
+	file := &protobuf.FileMessage{
+		Payload: payload,
+		Name:    "document.pdf",
+		Size:    uint64(len(payload)),
+		Hash:    Hash(payload),
+	}
+	require.NoError(t, Verify(file))
+	require.Equal(t, "application/pdf", MIME(file.Name, payload))
+
+	file.Size++
+	require.Equal(t, ErrSizeMismatch, Verify(file))
+	file.Size--
+
+	file.Payload = []byte("%PDF-1.4 same length!")
+	require.Equal(t, ErrHashMismatch, Verify(file))
+
+	file.Name = ""
+	require.Equal(t, ErrNameMissing, Verify(file))
+}
@@ -8,14 +8,16 @@ import (
 	"github.com/status-im/status-go/multiaccounts/settings"
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
 	localnotifications "github.com/status-im/status-go/services/local-notifications"
 )
 
 type NotificationBody struct {
-	Message   *common.Message        `json:"message"`
-	Contact   *Contact               `json:"contact"`
-	Chat      *Chat                  `json:"chat"`
-	Community *communities.Community `json:"community"`
+	Message       *common.Message                  `json:"message"`
+	Contact       *Contact                         `json:"contact"`
+	Chat          *Chat                            `json:"chat"`
+	Community     *communities.Community           `json:"community"`
+	CalendarEvent *protobuf.CommunityCalendarEvent `json:"calendarEvent,omitempty"`
 }
 
 func showMessageNotification(publicKey ecdsa.PublicKey, message *common.Message, chat *Chat, responseTo *common.Message) bool {
@@ -75,6 +77,15 @@ func NewCommunityRequestToJoinNotification(id string, community *communities.Com
 	return body.toCommunityRequestToJoinNotification(id)
 }
 
+func NewCommunityCalendarEventReminderNotification(id string, community *communities.Community, calendarEvent *protobuf.CommunityCalendarEvent) *localnotifications.Notification {
+	body := &NotificationBody{
+		Community:     community,
+		CalendarEvent: calendarEvent,
+	}
+
+	return body.toCommunityCalendarEventReminderNotification(id)
+}
+
 func NewPrivateGroupInviteNotification(id string, chat *Chat, contact *Contact, profilePicturesVisibility int) *localnotifications.Notification {
 	body := &NotificationBody{
 		Chat:    chat,
@@ -160,3 +171,17 @@ func (n NotificationBody) toCommunityRequestToJoinNotification(id string) *local
 		Image:    "",
 	}
 }
+
+func (n NotificationBody) toCommunityCalendarEventReminderNotification(id string) *localnotifications.Notification {
+	return &localnotifications.Notification{
+		ID:        gethcommon.HexToHash(id),
+		Body:      n,
+		Title:     n.CalendarEvent.Title,
+		Message:   "Starting soon in " + n.Community.Name(),
+		BodyType:  localnotifications.TypeMessage,
+		Category:  localnotifications.CategoryCommunityEventReminder,
+		Deeplink:  "status-app://cr/" + n.Community.IDString(),
+		Timestamp: n.CalendarEvent.StartTime * 1000,
+		Image:     "",
+	}
+}
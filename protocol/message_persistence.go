@@ -83,6 +83,19 @@ func (db sqlitePersistence) tableUserMessagesAllFields() string {
 		audio_type,
 		audio_duration_ms,
 		audio_base64,
+		video_payload,
+		video_format,
+		video_codec,
+		video_duration_ms,
+		video_width,
+		video_height,
+		video_thumbnail,
+		video_thumbnail_format,
+		file_payload,
+		file_name,
+		file_mime_type,
+		file_size,
+		file_hash,
 		community_id,
 		mentions,
 		links,
@@ -138,6 +151,16 @@ func (db sqlitePersistence) tableUserMessagesAllFieldsJoin() string {
 		COALESCE(m1.image_width, 0),
 		COALESCE(m1.image_height, 0),
 		COALESCE(m1.audio_duration_ms,0),
+		COALESCE(m1.video_format,0),
+		COALESCE(m1.video_codec,""),
+		COALESCE(m1.video_duration_ms,0),
+		COALESCE(m1.video_width,0),
+		COALESCE(m1.video_height,0),
+		COALESCE(m1.video_thumbnail_format,0),
+		COALESCE(m1.file_name,""),
+		COALESCE(m1.file_mime_type,""),
+		COALESCE(m1.file_size,0),
+		m1.file_hash,
 		m1.community_id,
 		m1.mentions,
 		m1.links,
@@ -182,6 +205,7 @@ func (db sqlitePersistence) tableUserMessagesAllFieldsJoin() string {
     COALESCE(dm_attachment.url, ""),
     COALESCE(dm_attachment.file_name, ""),
     COALESCE(dm_attachment.content_type, ""),
+    dm.reactions,
 		m2.source,
 		m2.text,
 		m2.parsed_text,
@@ -231,6 +255,7 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 	var quotedDeletedForMe sql.NullBool
 	var serializedMentions []byte
 	var serializedLinks []byte
+	var serializedDiscordReactions []byte
 	var serializedUnfurledLinks []byte
 	var serializedUnfurledStatusLinks []byte
 	var alias sql.NullString
@@ -248,6 +273,8 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 	sticker := &protobuf.StickerMessage{}
 	command := &common.CommandParameters{}
 	audio := &protobuf.AudioMessage{}
+	video := &protobuf.VideoMessage{}
+	file := &protobuf.FileMessage{}
 	image := &protobuf.ImageMessage{}
 	discordMessage := &protobuf.DiscordMessage{
 		Author:      &protobuf.DiscordMessageAuthor{},
@@ -286,6 +313,16 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 		&image.Width,
 		&image.Height,
 		&audio.DurationMs,
+		&video.Format,
+		&video.Codec,
+		&video.DurationMs,
+		&video.Width,
+		&video.Height,
+		&video.ThumbnailFormat,
+		&file.Name,
+		&file.MimeType,
+		&file.Size,
+		&file.Hash,
 		&communityID,
 		&serializedMentions,
 		&serializedLinks,
@@ -330,6 +367,7 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 		&attachment.Url,
 		&attachment.FileName,
 		&attachment.ContentType,
+		&serializedDiscordReactions,
 		&quotedFrom,
 		&quotedText,
 		&quotedParsedText,
@@ -458,6 +496,13 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 		discordMessage.Attachments = append(discordMessage.Attachments, attachment)
 	}
 
+	if serializedDiscordReactions != nil {
+		err = json.Unmarshal(serializedDiscordReactions, &discordMessage.Reactions)
+		if err != nil {
+			return err
+		}
+	}
+
 	switch message.ContentType {
 	case protobuf.ChatMessage_STICKER:
 		message.Payload = &protobuf.ChatMessage_Sticker{Sticker: sticker}
@@ -465,6 +510,12 @@ func (db sqlitePersistence) tableUserMessagesScanAllFields(row scanner, message
 	case protobuf.ChatMessage_AUDIO:
 		message.Payload = &protobuf.ChatMessage_Audio{Audio: audio}
 
+	case protobuf.ChatMessage_VIDEO:
+		message.Payload = &protobuf.ChatMessage_Video{Video: video}
+
+	case protobuf.ChatMessage_FILE:
+		message.Payload = &protobuf.ChatMessage_File{File: file}
+
 	case protobuf.ChatMessage_TRANSACTION_COMMAND:
 		message.CommandParameters = command
 
@@ -508,6 +559,16 @@ func (db sqlitePersistence) tableUserMessagesAllValues(message *common.Message)
 		audio = &protobuf.AudioMessage{}
 	}
 
+	video := message.GetVideo()
+	if video == nil {
+		video = &protobuf.VideoMessage{}
+	}
+
+	file := message.GetFile()
+	if file == nil {
+		file = &protobuf.FileMessage{}
+	}
+
 	command := message.CommandParameters
 	if command == nil {
 		command = &common.CommandParameters{}
@@ -590,6 +651,19 @@ func (db sqlitePersistence) tableUserMessagesAllValues(message *common.Message)
 		audio.Type,
 		audio.DurationMs,
 		message.Base64Audio,
+		video.Payload,
+		video.Format,
+		video.Codec,
+		video.DurationMs,
+		video.Width,
+		video.Height,
+		video.Thumbnail,
+		video.ThumbnailFormat,
+		file.Payload,
+		file.Name,
+		file.MimeType,
+		file.Size,
+		file.Hash,
 		message.CommunityID,
 		serializedMentions,
 		serializedLinks,
@@ -2225,7 +2299,12 @@ func (db sqlitePersistence) GetDiscordMessageAuthorByID(id string) (*protobuf.Di
 }
 
 func (db sqlitePersistence) SaveDiscordMessage(message *protobuf.DiscordMessage) (err error) {
-	query := "INSERT OR REPLACE INTO discord_messages(id,type,timestamp,timestamp_edited,content,author_id, reference_message_id, reference_channel_id, reference_guild_id) VALUES (?,?,?,?,?,?,?,?,?)"
+	reactions, err := serializeDiscordMessageReactions(message)
+	if err != nil {
+		return
+	}
+
+	query := "INSERT OR REPLACE INTO discord_messages(id,type,timestamp,timestamp_edited,content,author_id, reference_message_id, reference_channel_id, reference_guild_id, reactions) VALUES (?,?,?,?,?,?,?,?,?,?)"
 	stmt, err := db.db.Prepare(query)
 	if err != nil {
 		return
@@ -2241,6 +2320,7 @@ func (db sqlitePersistence) SaveDiscordMessage(message *protobuf.DiscordMessage)
 		message.Reference.GetMessageId(),
 		message.Reference.GetChannelId(),
 		message.Reference.GetGuildId(),
+		reactions,
 	)
 	return
 }
@@ -2259,7 +2339,7 @@ func (db sqlitePersistence) SaveDiscordMessages(messages []*protobuf.DiscordMess
 		_ = tx.Rollback()
 	}()
 
-	query := "INSERT OR REPLACE INTO discord_messages(id, author_id, type, timestamp, timestamp_edited, content, reference_message_id, reference_channel_id, reference_guild_id) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	query := "INSERT OR REPLACE INTO discord_messages(id, author_id, type, timestamp, timestamp_edited, content, reference_message_id, reference_channel_id, reference_guild_id, reactions) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
 	stmt, err := tx.Prepare(query)
 	if err != nil {
 		return
@@ -2267,6 +2347,12 @@ func (db sqlitePersistence) SaveDiscordMessages(messages []*protobuf.DiscordMess
 	defer stmt.Close()
 
 	for _, msg := range messages {
+		var reactions []byte
+		reactions, err = serializeDiscordMessageReactions(msg)
+		if err != nil {
+			return
+		}
+
 		_, err = stmt.Exec(
 			msg.GetId(),
 			msg.Author.GetId(),
@@ -2277,6 +2363,7 @@ func (db sqlitePersistence) SaveDiscordMessages(messages []*protobuf.DiscordMess
 			msg.Reference.GetMessageId(),
 			msg.Reference.GetChannelId(),
 			msg.Reference.GetGuildId(),
+			reactions,
 		)
 		if err != nil {
 			return
@@ -2285,6 +2372,13 @@ func (db sqlitePersistence) SaveDiscordMessages(messages []*protobuf.DiscordMess
 	return
 }
 
+func serializeDiscordMessageReactions(message *protobuf.DiscordMessage) ([]byte, error) {
+	if len(message.GetReactions()) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(message.GetReactions())
+}
+
 func (db sqlitePersistence) HasDiscordMessageAttachmentPayload(id string, messageID string) (hasPayload bool, err error) {
 	err = db.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM discord_message_attachments WHERE id = ? AND discord_message_id = ? AND payload NOT NULL)`, id, messageID).Scan(&hasPayload)
 	return hasPayload, err
@@ -2907,3 +3001,28 @@ func (db sqlitePersistence) GetCommunityMemberAllMessagesID(member string, commu
 
 	return result, nil
 }
+
+// MediaStorageUsage is the space taken by the media of the messages, in bytes
+type MediaStorageUsage struct {
+	Images int64 `json:"images"`
+	Audio  int64 `json:"audio"`
+	Video  int64 `json:"video"`
+	Files  int64 `json:"files"`
+	Total  int64 `json:"total"`
+}
+
+func (db sqlitePersistence) MediaStorageUsage() (*MediaStorageUsage, error) {
+	usage := &MediaStorageUsage{}
+	err := db.db.QueryRow(`
+		SELECT
+			COALESCE(SUM(LENGTH(image_payload)), 0),
+			COALESCE(SUM(LENGTH(audio_payload)), 0),
+			COALESCE(SUM(LENGTH(video_payload)), 0) + COALESCE(SUM(LENGTH(video_thumbnail)), 0),
+			COALESCE(SUM(LENGTH(file_payload)), 0)
+		FROM user_messages`).Scan(&usage.Images, &usage.Audio, &usage.Video, &usage.Files)
+	if err != nil {
+		return nil, err
+	}
+	usage.Total = usage.Images + usage.Audio + usage.Video + usage.Files
+	return usage, nil
+}
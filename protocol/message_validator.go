@@ -6,8 +6,10 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/status-im/status-go/protocol/files"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/v1"
+	"github.com/status-im/status-go/protocol/video"
 )
 
 const maxChatMessageTextLength = 4096
@@ -333,6 +335,37 @@ func ValidateReceivedChatMessage(message *protobuf.ChatMessage, whisperTimestamp
 		}
 	}
 
+	if message.ContentType == protobuf.ChatMessage_VIDEO {
+		if message.Payload == nil {
+			return errors.New("no video content")
+		}
+		videoMessage := message.GetVideo()
+		if videoMessage == nil {
+			return errors.New("no video content")
+		}
+		if len(videoMessage.Payload) == 0 {
+			return errors.New("video payload empty")
+		}
+		if len(videoMessage.Payload) > video.MaxSize {
+			return video.ErrTooLarge
+		}
+
+		if videoMessage.Format == protobuf.VideoMessage_UNKNOWN_VIDEO_FORMAT {
+			return errors.New("video format unknown")
+		}
+	}
+
+	if message.ContentType == protobuf.ChatMessage_FILE {
+		file := message.GetFile()
+		if file == nil {
+			return errors.New("no file content")
+		}
+		// the segments of large files are reassembled at this point
+		if err := files.Verify(file); err != nil {
+			return err
+		}
+	}
+
 	if message.ContentType == protobuf.ChatMessage_SYSTEM_MESSAGE_CONTENT_PRIVATE_GROUP {
 		return errors.New("private group system message content type not allowed")
 	}
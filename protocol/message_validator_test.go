@@ -6,7 +6,9 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	"github.com/status-im/status-go/protocol/files"
 	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/video"
 )
 
 type MessageValidatorSuite struct {
@@ -480,6 +482,104 @@ func (s *MessageValidatorSuite) TestValidatePlainTextMessage() {
 				ContentType: protobuf.ChatMessage_AUDIO,
 			},
 		},
+		{
+			Name:             "Valid video message",
+			WhisperTimestamp: 2,
+			Valid:            true,
+			Message: &protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "valid",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Video{
+					Video: &protobuf.VideoMessage{
+						Format:  protobuf.VideoMessage_MP4,
+						Payload: []byte("some-payload"),
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_VIDEO,
+			},
+		},
+		{
+			Name:             "Invalid video message, format unknown",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: &protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "valid",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Video{
+					Video: &protobuf.VideoMessage{
+						Payload: []byte("some-payload"),
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_VIDEO,
+			},
+		},
+		{
+			Name:             "Invalid video message, too large",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: &protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "valid",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_Video{
+					Video: &protobuf.VideoMessage{
+						Format:  protobuf.VideoMessage_MP4,
+						Payload: make([]byte, video.MaxSize+1),
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_VIDEO,
+			},
+		},
+		{
+			Name:             "Valid file message",
+			WhisperTimestamp: 2,
+			Valid:            true,
+			Message: &protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "valid",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_File{
+					File: &protobuf.FileMessage{
+						Payload: []byte("some-payload"),
+						Name:    "file.zip",
+						Size:    uint64(len("some-payload")),
+						Hash:    files.Hash([]byte("some-payload")),
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_FILE,
+			},
+		},
+		{
+			Name:             "Invalid file message, corrupted payload",
+			WhisperTimestamp: 2,
+			Valid:            false,
+			Message: &protobuf.ChatMessage{
+				ChatId:    "a",
+				Text:      "valid",
+				Clock:     2,
+				Timestamp: 3,
+				Payload: &protobuf.ChatMessage_File{
+					File: &protobuf.FileMessage{
+						Payload: []byte("some-payloae"),
+						Name:    "file.zip",
+						Size:    uint64(len("some-payload")),
+						Hash:    files.Hash([]byte("some-payload")),
+					},
+				},
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_FILE,
+			},
+		},
 		{
 			Name:             "Valid bridge message",
 			WhisperTimestamp: 2,
@@ -71,6 +71,7 @@ import (
 	"github.com/status-im/status-go/services/wallet/token"
 	"github.com/status-im/status-go/signal"
 	"github.com/status-im/status-go/telemetry"
+	"github.com/status-im/status-go/tracing"
 )
 
 // todo: kozieiev: get rid of wakutransp word
@@ -157,6 +158,8 @@ type Messenger struct {
 		once sync.Once
 	}
 
+	communityMetricsCache *communityMetricsCache
+
 	connectionState       connection.State
 	telemetryClient       *telemetry.Client
 	contractMaker         *contracts.ContractMaker
@@ -557,8 +560,9 @@ func NewMessenger(
 			wait chan struct{}
 			once sync.Once
 		}{wait: make(chan struct{})},
-		browserDatabase: c.browserDatabase,
-		httpServer:      c.httpServer,
+		communityMetricsCache: newCommunityMetricsCache(),
+		browserDatabase:       c.browserDatabase,
+		httpServer:            c.httpServer,
 		shutdownTasks: []func() error{
 			ensVerifier.Stop,
 			pushNotificationClient.Stop,
@@ -832,6 +836,9 @@ func (m *Messenger) Start() (*MessengerResponse, error) {
 	m.startCommunityRekeyLoop()
 	m.startCuratedCommunitiesUpdateLoop()
 	m.startMessageSegmentsCleanupLoop()
+	m.startCommunityCalendarEventRemindersLoop()
+	m.startCommunityCalendarEventRsvpCountsLoop()
+	m.startCommunityDirectoryLoop()
 
 	if err := m.cleanTopics(); err != nil {
 		return nil, err
@@ -2175,7 +2182,7 @@ func (m *Messenger) dispatchPairInstallationMessage(ctx context.Context, spec co
 func (m *Messenger) dispatchMessage(ctx context.Context, rawMessage common.RawMessage) (common.RawMessage, error) {
 	var err error
 	var id []byte
-	logger := m.logger.With(zap.String("site", "dispatchMessage"), zap.String("chatID", rawMessage.LocalChatID))
+	logger := m.logger.With(zap.String("site", "dispatchMessage"), zap.String("chatID", rawMessage.LocalChatID)).With(tracing.LogFields(ctx)...)
 	chat, ok := m.allChats.Load(rawMessage.LocalChatID)
 	if !ok {
 		return rawMessage, errors.New("no chat found")
@@ -2318,7 +2325,15 @@ func (m *Messenger) dispatchMessage(ctx context.Context, rawMessage common.RawMe
 
 // SendChatMessage takes a minimal message and sends it based on the corresponding chat
 func (m *Messenger) SendChatMessage(ctx context.Context, message *common.Message) (*MessengerResponse, error) {
-	return m.sendChatMessage(ctx, message)
+	ctx, span := tracing.StartSpan(ctx, "Messenger.SendChatMessage",
+		tracing.String("chatID", message.ChatId),
+		tracing.String("contentType", message.ContentType.String()),
+	)
+	defer span.End()
+
+	response, err := m.sendChatMessage(ctx, message)
+	span.RecordError(err)
+	return response, err
 }
 
 // SendChatMessages takes a array of messages and sends it based on the corresponding chats
@@ -2401,6 +2416,16 @@ func (m *Messenger) sendChatMessage(ctx context.Context, message *common.Message
 		if err != nil {
 			return nil, err
 		}
+	} else if len(message.VideoPath) != 0 {
+		err := message.LoadVideo()
+		if err != nil {
+			return nil, err
+		}
+	} else if len(message.FilePath) != 0 {
+		err := message.LoadFile()
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// We consider link previews non-critical data, so we do not want to block
@@ -3787,7 +3812,18 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 
 	messageState := m.buildMessageState()
 
-	logger := m.logger.With(zap.String("site", "RetrieveAll"))
+	// no span is started for the ticks which didn't retrieve any message
+	ctx := m.ctx
+	if len(chatWithMessages) > 0 {
+		var span *tracing.Span
+		ctx, span = tracing.StartSpanWithKind(m.ctx, "Messenger.handleRetrievedMessages", tracing.SpanKindConsumer,
+			tracing.Bool("fromArchive", fromArchive),
+		)
+		defer span.End()
+	}
+
+	siteLogger := m.logger.With(zap.String("site", "RetrieveAll"))
+	logger := siteLogger.With(tracing.LogFields(ctx)...)
 
 	controlledCommunitiesChatIDs, err := m.communitiesManager.GetOwnedCommunitiesChatIDs()
 	if err != nil {
@@ -3800,7 +3836,7 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 
 		var processedMessages []string
 		for _, shhMessage := range messages {
-			logger := logger.With(zap.String("hash", types.EncodeHex(shhMessage.Hash)))
+			logger := siteLogger.With(zap.String("hash", types.EncodeHex(shhMessage.Hash)))
 			// Indicates tha all messages in the batch have been processed correctly
 			allMessagesProcessed := true
 
@@ -3812,8 +3848,17 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 				}
 			}
 
+			envelopeCtx, envelopeSpan := tracing.StartSpanWithKind(ctx, "MessageSender.HandleMessages", tracing.SpanKindConsumer,
+				tracing.String("envelopeHash", types.EncodeHex(shhMessage.Hash)),
+				tracing.String("chatID", filter.ChatID),
+			)
+			logger = logger.With(tracing.LogFields(envelopeCtx)...)
+
 			handleMessagesResponse, err := m.sender.HandleMessages(shhMessage)
+			envelopeSpan.RecordError(err)
+			envelopeSpan.End()
 			if err != nil {
+				common.MessagesDecodingFailuresCounter.Inc()
 				if m.telemetryClient != nil {
 					go m.telemetryClient.UpdateEnvelopeProcessingError(shhMessage, err)
 				}
@@ -3901,8 +3946,15 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 
 				if msg.ApplicationLayer.Payload != nil {
 
+					_, dispatchSpan := tracing.StartSpan(envelopeCtx, "Messenger.dispatchToHandler",
+						tracing.String("messageType", common.MessageTypeLabel(msg.ApplicationLayer.Type)),
+						tracing.String("messageID", messageID),
+					)
 					err := m.dispatchToHandler(messageState, msg.ApplicationLayer.Payload, msg, filter, fromArchive)
+					dispatchSpan.RecordError(err)
+					dispatchSpan.End()
 					if err != nil {
+						common.MessagesHandlingFailuresCounter.WithLabelValues(common.MessageTypeLabel(msg.ApplicationLayer.Type)).Inc()
 						allMessagesProcessed = false
 						logger.Warn("failed to process protobuf", zap.Error(err))
 						if m.unhandledMessagesTracker != nil {
@@ -3910,6 +3962,7 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 						}
 						continue
 					}
+					common.MessagesReceivedCounter.WithLabelValues(common.MessageTypeLabel(msg.ApplicationLayer.Type)).Inc()
 					logger.Debug("Handled parsed message")
 
 				} else {
@@ -4285,6 +4338,15 @@ func (m *Messenger) prepareMessage(msg *common.Message, s *server.MediaServer) e
 	if msg.ContentType == protobuf.ChatMessage_AUDIO {
 		msg.AudioLocalURL = s.MakeAudioURL(msg.ID)
 	}
+	if msg.ContentType == protobuf.ChatMessage_VIDEO {
+		msg.VideoLocalURL = s.MakeVideoURL(msg.ID)
+		if msg.GetVideo().GetThumbnailFormat() != protobuf.ImageFormat_UNKNOWN_IMAGE_FORMAT {
+			msg.VideoThumbnailLocalURL = s.MakeVideoThumbnailURL(msg.ID)
+		}
+	}
+	if msg.ContentType == protobuf.ChatMessage_FILE {
+		msg.FileLocalURL = s.MakeFileURL(msg.ID)
+	}
 	if msg.ContentType == protobuf.ChatMessage_STICKER {
 		msg.StickerLocalURL = s.MakeStickerURL(msg.GetSticker().Hash)
 	}
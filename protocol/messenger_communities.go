@@ -1,6 +1,7 @@
 package protocol
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
 	"database/sql"
@@ -48,7 +49,7 @@ var messageArchiveInterval = 7 * 24 * time.Hour
 // 1 day interval
 var updateActiveMembersInterval = 24 * time.Hour
 
-const discordTimestampLayout = time.RFC3339
+const discordTimestampLayout = discord.TimestampLayout
 
 const (
 	importSlowRate          = time.Second / 1
@@ -1104,6 +1105,17 @@ func (m *Messenger) RequestToJoinCommunity(request *requests.RequestToJoinCommun
 		RevealedAccounts: requestToJoin.RevealedAccounts,
 	}
 
+	if request.Invite != "" {
+		invite, err := decodeCommunityInvite(request.Invite)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(invite.CommunityID, community.ID()) {
+			return nil, communities.ErrInviteInvalid
+		}
+		requestToJoinProto.Invite = invite.ToSignedProtobuf()
+	}
+
 	community, _, err = m.communitiesManager.SaveRequestToJoinAndCommunity(requestToJoin, community)
 	if err != nil {
 		return nil, err
@@ -1155,10 +1167,12 @@ func (m *Messenger) RequestToJoinCommunity(request *requests.RequestToJoinCommun
 		}
 		rawMessage.Payload = payload
 
-		for _, member := range privilegedMembers[protobuf.CommunityMember_ROLE_ADMIN] {
-			_, err := m.sender.SendPrivate(context.Background(), member, &rawMessage)
-			if err != nil {
-				return nil, err
+		for _, role := range []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_ADMIN, protobuf.CommunityMember_ROLE_MODERATOR} {
+			for _, member := range privilegedMembers[role] {
+				_, err := m.sender.SendPrivate(context.Background(), member, &rawMessage)
+				if err != nil {
+					return nil, err
+				}
 			}
 		}
 	}
@@ -2684,6 +2698,89 @@ func (m *Messenger) BanUserFromCommunity(ctx context.Context, request *requests.
 	return response, nil
 }
 
+func (m *Messenger) TimeoutCommunityMember(request *requests.TimeoutCommunityMember) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	community, err := m.communitiesManager.TimeoutMember(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+func (m *Messenger) CommunityAuditLog(request *requests.GetCommunityAuditLog) ([]*communities.AuditLogEntry, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	return m.communitiesManager.GetCommunityAuditLog(request.CommunityID, &communities.AuditLogFilter{
+		Actor:   request.Actor,
+		Target:  request.Target,
+		Actions: request.Actions,
+		Cursor:  request.Cursor,
+		Limit:   request.Limit,
+	})
+}
+
+func (m *Messenger) ExportCommunityAuditLog(communityID types.HexBytes) (*communities.AuditLogExport, error) {
+	return m.communitiesManager.ExportCommunityAuditLog(communityID)
+}
+
+func (m *Messenger) CreateCommunityInvite(request *requests.CreateCommunityInvite) (*CommunityInviteLink, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	invite, err := m.communitiesManager.CreateInvite(request)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := shareCommunityInviteURL(invite)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CommunityInviteLink{Invite: invite, URL: url}, nil
+}
+
+func (m *Messenger) RevokeCommunityInvite(request *requests.RevokeCommunityInvite) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, err := m.communitiesManager.RevokeInvite(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+func (m *Messenger) CommunityInvites(communityID types.HexBytes) ([]*CommunityInviteLink, error) {
+	invites, err := m.communitiesManager.GetInvites(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]*CommunityInviteLink, 0, len(invites))
+	for _, invite := range invites {
+		url, err := shareCommunityInviteURL(invite)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, &CommunityInviteLink{Invite: invite, URL: url})
+	}
+
+	return links, nil
+}
+
 func (m *Messenger) AddRoleToMember(request *requests.AddRoleToMember) (*MessengerResponse, error) {
 	if err := request.Validate(); err != nil {
 		return nil, err
@@ -3118,6 +3215,13 @@ func (m *Messenger) handleCommunityPrivilegedUserSyncMessage(state *ReceivedMess
 			return nil
 		}
 		state.Response.AddRequestsToJoinCommunity(nonAcceptedRequestsToJoin)
+
+	case protobuf.CommunityPrivilegedUserSyncMessage_CONTROL_NODE_AUDIT_LOG:
+		_, err := m.communitiesManager.HandleAuditLogPrivilegedUserSyncMessage(message, community.ID())
+		if err != nil {
+			m.logger.Warn("failed to handle audit log", zap.Error(err))
+			return nil
+		}
 	}
 
 	return nil
@@ -3737,6 +3841,46 @@ func (m *Messenger) DisableCommunityHistoryArchiveProtocol() error {
 	return nil
 }
 
+// HistoryArchiveSeedingStatus describes whether the history archive of a controlled community is being seeded
+type HistoryArchiveSeedingStatus struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	Name        string         `json:"name"`
+	Seeding     bool           `json:"seeding"`
+	Magnetlink  string         `json:"magnetlink,omitempty"`
+}
+
+// HistoryArchivesSeedingStatus returns whether the torrent client is running
+// and the seeding status of the history archives of the controlled communities
+func (m *Messenger) HistoryArchivesSeedingStatus() (bool, []*HistoryArchiveSeedingStatus, error) {
+	if !m.communitiesManager.TorrentClientStarted() {
+		return false, nil, nil
+	}
+
+	controlledCommunities, err := m.communitiesManager.Controlled()
+	if err != nil {
+		return true, nil, err
+	}
+
+	statuses := make([]*HistoryArchiveSeedingStatus, 0, len(controlledCommunities))
+	for _, community := range controlledCommunities {
+		status := &HistoryArchiveSeedingStatus{
+			CommunityID: community.ID(),
+			Name:        community.Name(),
+			Seeding:     m.communitiesManager.IsSeedingHistoryArchiveTorrent(community.ID()),
+		}
+		if status.Seeding {
+			magnetlink, err := m.communitiesManager.GetHistoryArchiveMagnetlink(community.ID())
+			if err != nil {
+				m.logger.Warn("failed to get history archive magnetlink", zap.String("communityID", community.IDString()), zap.Error(err))
+			}
+			status.Magnetlink = magnetlink
+		}
+		statuses = append(statuses, status)
+	}
+
+	return true, statuses, nil
+}
+
 func (m *Messenger) GetCommunitiesSettings() ([]communities.CommunitySettings, error) {
 	settings, err := m.communitiesManager.GetCommunitiesSettings()
 	if err != nil {
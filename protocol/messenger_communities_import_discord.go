@@ -1,10 +1,8 @@
 package protocol
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
-	"os"
 	"strings"
 	"sync"
 	"time"
@@ -26,89 +24,36 @@ import (
 )
 
 func (m *Messenger) ExtractDiscordDataFromImportFiles(filesToImport []string) (*discord.ExtractedData, map[string]*discord.ImportError) {
+	return m.ExtractImportDataFromFiles(discord.ImportSourceDiscord, filesToImport)
+}
 
-	extractedData := &discord.ExtractedData{
-		Categories:             map[string]*discord.Category{},
-		ExportedData:           make([]*discord.ExportedData, 0),
-		OldestMessageTimestamp: 0,
-		MessageCount:           0,
-	}
-
-	errors := map[string]*discord.ImportError{}
-
-	for _, fileToImport := range filesToImport {
-		filePath := strings.Replace(fileToImport, "file://", "", -1)
-
-		fileInfo, err := os.Stat(filePath)
-		if err != nil {
-			errors[fileToImport] = discord.Error(err.Error())
-			continue
-		}
-
-		fileSize := fileInfo.Size()
-		if fileSize > discord.MaxImportFileSizeBytes {
-			errors[fileToImport] = discord.Error(discord.ErrImportFileTooBig.Error())
-			continue
-		}
-
-		bytes, err := os.ReadFile(filePath)
-		if err != nil {
-			errors[fileToImport] = discord.Error(err.Error())
-			continue
-		}
-
-		var discordExportedData discord.ExportedData
-
-		err = json.Unmarshal(bytes, &discordExportedData)
-		if err != nil {
+// ExtractImportDataFromFiles parses the export files of any supported source
+// into the channels and messages the community import works with
+func (m *Messenger) ExtractImportDataFromFiles(source discord.ImportSource, filesToImport []string) (*discord.ExtractedData, map[string]*discord.ImportError) {
+	importer, err := discord.NewImporter(source, m.logger)
+	if err != nil {
+		errors := map[string]*discord.ImportError{}
+		for _, fileToImport := range filesToImport {
 			errors[fileToImport] = discord.Error(err.Error())
-			continue
-		}
-
-		if len(discordExportedData.Messages) == 0 {
-			errors[fileToImport] = discord.Error(discord.ErrNoMessageData.Error())
-			continue
-		}
-
-		discordExportedData.Channel.FilePath = filePath
-		categoryID := discordExportedData.Channel.CategoryID
-
-		discordCategory := discord.Category{
-			ID:   categoryID,
-			Name: discordExportedData.Channel.CategoryName,
-		}
-
-		_, ok := extractedData.Categories[categoryID]
-		if !ok {
-			extractedData.Categories[categoryID] = &discordCategory
-		}
-
-		extractedData.MessageCount = extractedData.MessageCount + discordExportedData.MessageCount
-		extractedData.ExportedData = append(extractedData.ExportedData, &discordExportedData)
-
-		if len(discordExportedData.Messages) > 0 {
-			msgTime, err := time.Parse(discordTimestampLayout, discordExportedData.Messages[0].Timestamp)
-			if err != nil {
-				m.logger.Error("failed to parse discord message timestamp", zap.Error(err))
-				continue
-			}
-
-			if extractedData.OldestMessageTimestamp == 0 || int(msgTime.Unix()) <= extractedData.OldestMessageTimestamp {
-				// Exported discord channel data already comes with `messages` being
-				// sorted, starting with the oldest, so we can safely rely on the first
-				// message
-				extractedData.OldestMessageTimestamp = int(msgTime.Unix())
-			}
 		}
+		return &discord.ExtractedData{
+			Categories:   map[string]*discord.Category{},
+			ExportedData: make([]*discord.ExportedData, 0),
+		}, errors
 	}
-	return extractedData, errors
+
+	return importer.Extract(filesToImport)
 }
 
 func (m *Messenger) ExtractDiscordChannelsAndCategories(filesToImport []string) (*MessengerResponse, map[string]*discord.ImportError) {
+	return m.ExtractImportChannelsAndCategories(discord.ImportSourceDiscord, filesToImport)
+}
+
+func (m *Messenger) ExtractImportChannelsAndCategories(source discord.ImportSource, filesToImport []string) (*MessengerResponse, map[string]*discord.ImportError) {
 
 	response := &MessengerResponse{}
 
-	extractedData, errs := m.ExtractDiscordDataFromImportFiles(filesToImport)
+	extractedData, errs := m.ExtractImportDataFromFiles(source, filesToImport)
 
 	for _, category := range extractedData.Categories {
 		response.AddDiscordCategory(category)
@@ -124,8 +69,12 @@ func (m *Messenger) ExtractDiscordChannelsAndCategories(filesToImport []string)
 }
 
 func (m *Messenger) RequestExtractDiscordChannelsAndCategories(filesToImport []string) {
+	m.RequestExtractImportChannelsAndCategories(discord.ImportSourceDiscord, filesToImport)
+}
+
+func (m *Messenger) RequestExtractImportChannelsAndCategories(source discord.ImportSource, filesToImport []string) {
 	go func() {
-		response, errors := m.ExtractDiscordChannelsAndCategories(filesToImport)
+		response, errors := m.ExtractImportChannelsAndCategories(source, filesToImport)
 		m.config.messengerSignalsHandler.DiscordCategoriesAndChannelsExtracted(
 			response.DiscordCategories,
 			response.DiscordChannels,
@@ -133,6 +82,7 @@ func (m *Messenger) RequestExtractDiscordChannelsAndCategories(filesToImport []s
 			errors)
 	}()
 }
+
 func (m *Messenger) saveDiscordAuthorIfNotExists(discordAuthor *protobuf.DiscordMessageAuthor) *discord.ImportError {
 	exists, err := m.persistence.HasDiscordMessageAuthor(discordAuthor.GetId())
 	if err != nil {
@@ -245,7 +195,8 @@ func (m *Messenger) processDiscordMessages(discordChannel *discord.ExportedData,
 			continue
 		}
 
-		if !hasPayload {
+		// Not every export comes with avatars, Telegram's doesn't
+		if !hasPayload && discordMessage.Author.AvatarUrl != "" {
 			authorProfilesToSave[discordMessage.Author.Id] = discordMessage.Author
 		}
 
@@ -560,7 +511,7 @@ func (m *Messenger) RequestImportDiscordChannel(request *requests.ImportDiscordC
 		for i, importFile := range request.FilesToImport {
 			m.importingChannels[request.DiscordChannelID] = false
 
-			exportData, errs := m.ExtractDiscordDataFromImportFiles([]string{importFile})
+			exportData, errs := m.ExtractImportDataFromFiles(request.Source, []string{importFile})
 			if len(errs) > 0 {
 				for _, err := range errs {
 					importProgress.AddTaskError(discord.ChannelsCreationTask, err)
@@ -1038,6 +989,13 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 		// initial progress immediately
 		m.publishImportProgress(importProgress)
 
+		if !request.Source.Valid() {
+			importProgress.AddTaskError(discord.CommunityCreationTask, discord.Error(requests.ErrImportDiscordCommunityInvalidSource.Error()))
+			importProgress.StopTask(discord.CommunityCreationTask)
+			progressUpdates <- importProgress
+			return
+		}
+
 		createCommunityRequest := request.ToCreateCommunityRequest()
 
 		// We're calling `CreateCommunity` on `communitiesManager` directly, instead of
@@ -1097,7 +1055,7 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 
 		for i, importFile := range request.FilesToImport {
 
-			exportData, errs := m.ExtractDiscordDataFromImportFiles([]string{importFile})
+			exportData, errs := m.ExtractImportDataFromFiles(request.Source, []string{importFile})
 			if len(errs) > 0 {
 				for _, err := range errs {
 					importProgress.AddTaskError(discord.CommunityCreationTask, err)
@@ -1284,7 +1242,8 @@ func (m *Messenger) RequestImportDiscordCommunity(request *requests.ImportDiscor
 					continue
 				}
 
-				if !hasPayload {
+				// Not every export comes with avatars, Telegram's doesn't
+				if !hasPayload && discordMessage.Author.AvatarUrl != "" {
 					authorProfilesToSave[discordMessage.Author.Id] = discordMessage.Author
 				}
 
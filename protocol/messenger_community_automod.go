@@ -0,0 +1,118 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+func (m *Messenger) SetCommunityAutomodRules(request *requests.SetCommunityAutomodRules) (*protobuf.CommunityAutomodRules, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	return m.communitiesManager.SetAutomodRules(request)
+}
+
+func (m *Messenger) CommunityAutomodRules(communityID types.HexBytes) (*protobuf.CommunityAutomodRules, error) {
+	community, err := m.communitiesManager.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !community.IsControlNode() && !community.IsModeratingMember(&m.identity.PublicKey) {
+		return nil, communities.ErrNotAuthorized
+	}
+
+	return m.communitiesManager.GetAutomodRules(communityID)
+}
+
+// applyAutomod checks a message received in a community channel against the automod rules.
+// The rules are only known to the control node and the moderating members, the latter
+// act through community events validated by the control node.
+// Returns whether the message has been blocked.
+func (m *Messenger) applyAutomod(community *communities.Community, chat *Chat, author *ecdsa.PublicKey, message *common.Message, response *MessengerResponse) (bool, error) {
+	if !community.IsControlNode() && !community.IsModeratingMember(&m.identity.PublicKey) {
+		return false, nil
+	}
+
+	// Privileged members are trusted
+	if community.IsModeratingMember(author) {
+		return false, nil
+	}
+
+	verdict, err := m.communitiesManager.EvaluateAutomod(community, author, &communities.AutomodMessage{
+		Text:      message.Text,
+		Links:     message.Links,
+		Timestamp: message.WhisperTimestamp,
+	})
+	if err != nil || verdict == nil {
+		return false, err
+	}
+
+	m.logger.Info("message blocked by automod",
+		zap.String("messageID", message.ID),
+		zap.String("from", message.From),
+		zap.String("communityID", community.IDString()),
+		zap.String("rule", verdict.RuleID))
+
+	err = m.sendAutomodDeleteMessage(chat, message.ID)
+	if err != nil {
+		return true, err
+	}
+
+	// Deleting an already deleted message is harmless, the control node and
+	// every moderating member online may act on the same message
+	err = m.communitiesManager.DeleteMessageForEveryone(community.ID(), message.From, chat.ID, message.ID)
+	if err != nil {
+		return true, err
+	}
+
+	if verdict.Action != protobuf.CommunityAutomodRule_DELETE {
+		// The deletion may have added a community event
+		community, err = m.communitiesManager.GetByID(community.ID())
+		if err != nil {
+			return true, err
+		}
+
+		community, err = m.communitiesManager.ApplyAutomodVerdict(community, author, verdict)
+		if err != nil {
+			return true, err
+		}
+		response.AddCommunity(community)
+	}
+
+	return true, nil
+}
+
+// sendAutomodDeleteMessage deletes a message we never stored for everyone
+func (m *Messenger) sendAutomodDeleteMessage(chat *Chat, messageID string) error {
+	clock, _ := chat.NextClockAndTimestamp(m.getTimesource())
+
+	deleteMessage := NewDeleteMessage()
+	deleteMessage.ChatId = chat.ID
+	deleteMessage.MessageId = messageID
+	deleteMessage.Clock = clock
+	deleteMessage.DeletedBy = contactIDFromPublicKey(m.IdentityPublicKey())
+
+	encodedMessage, err := m.encodeChatEntity(chat, deleteMessage)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.dispatchMessage(context.Background(), common.RawMessage{
+		LocalChatID:          chat.ID,
+		Payload:              encodedMessage,
+		MessageType:          protobuf.ApplicationMetadataMessage_DELETE_MESSAGE,
+		SkipGroupMessageWrap: true,
+		ResendAutomatically:  true,
+	})
+	return err
+}
@@ -0,0 +1,306 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/common/shard"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	v1protocol "github.com/status-im/status-go/protocol/v1"
+)
+
+// Members are reminded of the events they RSVP'd to this long before they start
+const communityCalendarEventReminderTime = 15 * time.Minute
+const communityCalendarEventRemindersInterval = 1 * time.Minute
+
+// The control node publishes the RSVP counts of its events at most this often
+const communityCalendarEventRsvpCountsInterval = 1 * time.Minute
+
+type CommunityCalendarEvent struct {
+	*protobuf.CommunityCalendarEvent
+	// RsvpStatus is the answer of the user to the event
+	RsvpStatus protobuf.CommunityCalendarEventRsvp_Status `json:"rsvpStatus"`
+}
+
+func (m *Messenger) CreateCommunityCalendarEvent(request *requests.CreateCommunityCalendarEvent) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, _, err := m.communitiesManager.CreateCalendarEvent(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+func (m *Messenger) EditCommunityCalendarEvent(request *requests.EditCommunityCalendarEvent) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, err := m.communitiesManager.EditCalendarEvent(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+func (m *Messenger) DeleteCommunityCalendarEvent(request *requests.DeleteCommunityCalendarEvent) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, err := m.communitiesManager.DeleteCalendarEvent(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+// CommunityCalendarEvents returns the events of the community sorted by start time,
+// along with the RSVP of the user
+func (m *Messenger) CommunityCalendarEvents(communityID types.HexBytes) ([]*CommunityCalendarEvent, error) {
+	community, err := m.communitiesManager.GetByID(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	calendarEvents := make([]*CommunityCalendarEvent, 0)
+	for _, calendarEvent := range community.CalendarEvents() {
+		rsvp, err := m.communitiesManager.GetCalendarEventRsvp(communityID, calendarEvent.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		item := &CommunityCalendarEvent{CommunityCalendarEvent: calendarEvent}
+		if rsvp != nil {
+			item.RsvpStatus = rsvp.Status
+		}
+		calendarEvents = append(calendarEvents, item)
+	}
+
+	sort.Slice(calendarEvents, func(i, j int) bool {
+		return calendarEvents[i].StartTime < calendarEvents[j].StartTime
+	})
+
+	return calendarEvents, nil
+}
+
+// RsvpCommunityCalendarEvent sends the answer of the user to the control node,
+// which aggregates the RSVPs of all members
+func (m *Messenger) RsvpCommunityCalendarEvent(request *requests.RsvpCommunityCalendarEvent) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, rsvpProto, err := m.communitiesManager.CreateCalendarEventRsvp(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if !community.IsControlNode() {
+		payload, err := proto.Marshal(rsvpProto)
+		if err != nil {
+			return nil, err
+		}
+
+		rawMessage := common.RawMessage{
+			Payload:             payload,
+			CommunityID:         community.ID(),
+			SkipEncryptionLayer: true,
+			MessageType:         protobuf.ApplicationMetadataMessage_COMMUNITY_CALENDAR_EVENT_RSVP,
+			PubsubTopic:         community.PubsubTopic(),
+		}
+
+		_, err = m.SendMessageToControlNode(community, rawMessage)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+func (m *Messenger) HandleCommunityCalendarEventRsvp(state *ReceivedMessageState, rsvpProto *protobuf.CommunityCalendarEventRsvp, statusMessage *v1protocol.StatusMessage) error {
+	if rsvpProto.CommunityId == nil {
+		return ErrInvalidCommunityID
+	}
+
+	signer := state.CurrentMessageState.PublicKey
+	err := m.communitiesManager.HandleCommunityCalendarEventRsvp(signer, rsvpProto)
+	if err == communities.ErrCalendarEventAttendanceNotAllowed {
+		return m.sendCommunityCalendarEventRsvpRejected(signer, rsvpProto)
+	}
+	return err
+}
+
+// sendCommunityCalendarEventRsvpRejected lets the member know we didn't accept their RSVP
+func (m *Messenger) sendCommunityCalendarEventRsvpRejected(member *ecdsa.PublicKey, rsvpProto *protobuf.CommunityCalendarEventRsvp) error {
+	community, err := m.communitiesManager.GetByID(rsvpProto.CommunityId)
+	if err != nil {
+		return err
+	}
+
+	payload, err := proto.Marshal(&protobuf.CommunityCalendarEventRsvpRejected{
+		Clock:       rsvpProto.Clock,
+		CommunityId: rsvpProto.CommunityId,
+		EventId:     rsvpProto.EventId,
+	})
+	if err != nil {
+		return err
+	}
+
+	rawMessage := &common.RawMessage{
+		Payload:             payload,
+		Sender:              community.PrivateKey(),
+		SkipEncryptionLayer: true,
+		MessageType:         protobuf.ApplicationMetadataMessage_COMMUNITY_CALENDAR_EVENT_RSVP_REJECTED,
+		PubsubTopic:         shard.DefaultNonProtectedPubsubTopic(),
+	}
+
+	_, err = m.sender.SendPrivate(context.Background(), member, rawMessage)
+	return err
+}
+
+func (m *Messenger) HandleCommunityCalendarEventRsvpRejected(state *ReceivedMessageState, rejected *protobuf.CommunityCalendarEventRsvpRejected, statusMessage *v1protocol.StatusMessage) error {
+	if rejected.CommunityId == nil {
+		return ErrInvalidCommunityID
+	}
+
+	community, deleted, err := m.communitiesManager.HandleCommunityCalendarEventRsvpRejected(state.CurrentMessageState.PublicKey, rejected)
+	if err != nil {
+		return err
+	}
+
+	if deleted {
+		m.logger.Info("community calendar event rsvp rejected", zap.String("communityID", community.IDString()), zap.String("eventID", rejected.EventId))
+		state.Response.AddCommunity(community)
+	}
+
+	return nil
+}
+
+func (m *Messenger) startCommunityCalendarEventRsvpCountsLoop() {
+	logger := m.logger.Named("communityCalendarEventRsvpCountsLoop")
+
+	go func() {
+		for {
+			select {
+			case <-time.After(communityCalendarEventRsvpCountsInterval):
+				m.updateCommunityCalendarEventRsvpCounts(logger)
+
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// updateCommunityCalendarEventRsvpCounts publishes the RSVPs received since the last update
+// of the controlled communities, at once for each community
+func (m *Messenger) updateCommunityCalendarEventRsvpCounts(logger *zap.Logger) {
+	controlledCommunities, err := m.communitiesManager.Controlled()
+	if err != nil {
+		logger.Error("failed to retrieve controlled communities", zap.Error(err))
+		return
+	}
+
+	for _, community := range controlledCommunities {
+		err := m.communitiesManager.UpdateCalendarEventRsvpCounts(community.ID())
+		if err != nil {
+			logger.Error("failed to update community calendar event rsvp counts", zap.Error(err), zap.String("communityID", community.IDString()))
+		}
+	}
+}
+
+func (m *Messenger) startCommunityCalendarEventRemindersLoop() {
+	logger := m.logger.Named("communityCalendarEventRemindersLoop")
+
+	go func() {
+		for {
+			select {
+			case <-time.After(communityCalendarEventRemindersInterval):
+				err := m.processCommunityCalendarEventReminders()
+				if err != nil {
+					logger.Error("failed to process community calendar event reminders", zap.Error(err))
+				}
+
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// processCommunityCalendarEventReminders notifies the user of the upcoming events
+// they RSVP'd to, with a local notification and an activity center entry
+func (m *Messenger) processCommunityCalendarEventReminders() error {
+	reminders, err := m.communitiesManager.CalendarEventReminders(communityCalendarEventReminderTime)
+	if err != nil {
+		return err
+	}
+
+	if len(reminders) == 0 {
+		return nil
+	}
+
+	response := &MessengerResponse{}
+
+	for _, reminder := range reminders {
+		community := reminder.Community
+		calendarEvent := reminder.CalendarEvent
+
+		// The reminder of a rescheduled event gets a new id
+		id := types.EncodeHex(crypto.Keccak256([]byte(fmt.Sprintf("%s-%s-%d", community.IDString(), calendarEvent.Id, calendarEvent.StartTime))))
+
+		notification := &ActivityCenterNotification{
+			ID:          types.FromHex(id),
+			Type:        ActivityCenterNotificationTypeCommunityEventReminder,
+			Name:        calendarEvent.Title,
+			CommunityID: community.IDString(),
+			Timestamp:   m.getTimesource().GetCurrentTime(),
+			UpdatedAt:   m.GetCurrentTimeInMillis(),
+		}
+		if calendarEvent.ChatId != "" {
+			notification.ChatID = community.IDString() + calendarEvent.ChatId
+		}
+
+		err = m.addActivityCenterNotification(response, notification, nil)
+		if err != nil {
+			return err
+		}
+
+		response.AddNotification(NewCommunityCalendarEventReminderNotification(id, community, calendarEvent))
+
+		err = m.communitiesManager.SetCalendarEventReminded(community.ID(), calendarEvent.Id, calendarEvent.StartTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	m.PublishMessengerResponse(response)
+	return nil
+}
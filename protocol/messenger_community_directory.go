@@ -0,0 +1,186 @@
+package protocol
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/settings"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/transport"
+	v1protocol "github.com/status-im/status-go/protocol/v1"
+)
+
+const (
+	communityDirectoryAnnounceInterval = 6 * time.Hour
+	// Entries that were not announced again for this long are evicted from the directory
+	communityDirectoryEntryTTL = 3 * 24 * time.Hour
+)
+
+// Regularly announces the listed communities in the directory and evicts the stale entries.
+// The directory topic is only joined when the user opted in to browsing the directory.
+func (m *Messenger) startCommunityDirectoryLoop() {
+	logger := m.logger.Named("startCommunityDirectoryLoop")
+
+	enabled, err := m.communityDirectoryEnabled()
+	if err != nil {
+		logger.Error("failed to read community directory setting", zap.Error(err))
+		return
+	}
+
+	if enabled {
+		_, err = m.transport.JoinPublic(transport.CommunityDirectoryTopic())
+		if err != nil {
+			logger.Error("failed to join community directory topic", zap.Error(err))
+			return
+		}
+	}
+
+	go func() {
+		// Initialize interval to 0 for immediate execution
+		var interval time.Duration = 0
+
+		for {
+			select {
+			case <-time.After(interval):
+				interval = communityDirectoryAnnounceInterval
+
+				err := m.announceListedCommunities()
+				if err != nil {
+					logger.Error("failed to announce listed communities", zap.Error(err))
+				}
+
+				err = m.communitiesManager.EvictStaleDirectoryEntries(communityDirectoryEntryTTL)
+				if err != nil {
+					logger.Error("failed to evict stale community directory entries", zap.Error(err))
+				}
+
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+func (m *Messenger) announceListedCommunities() error {
+	listedCommunities, err := m.communitiesManager.DirectoryListedCommunities()
+	if err != nil {
+		return err
+	}
+
+	for _, community := range listedCommunities {
+		err = m.announceCommunityInDirectory(community)
+		if err != nil {
+			m.logger.Warn("failed to announce community in directory", zap.String("communityID", community.IDString()), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// announceCommunityInDirectory publishes the summary of the community on the directory topic,
+// signed with the community key when we have it so that anyone can verify it
+func (m *Messenger) announceCommunityInDirectory(community *communities.Community) error {
+	payload, err := proto.Marshal(community.ToDirectoryEntry(m.getTimesource().GetCurrentTime()))
+	if err != nil {
+		return err
+	}
+
+	directoryTopic := transport.CommunityDirectoryTopic()
+	rawMessage := common.RawMessage{
+		LocalChatID: directoryTopic,
+		Sender:      community.PrivateKey(),
+		MessageType: protobuf.ApplicationMetadataMessage_COMMUNITY_DIRECTORY_ENTRY,
+		Payload:     payload,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err = m.sender.SendPublic(ctx, directoryTopic, rawMessage)
+	return err
+}
+
+// SetCommunityDirectoryListing opts the community in or out of the directory.
+// Unlisted communities stop being announced and expire from the directory of other users.
+func (m *Messenger) SetCommunityDirectoryListing(request *requests.SetCommunityDirectoryListing) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, err := m.communitiesManager.SetDirectoryListing(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Listed {
+		err = m.announceCommunityInDirectory(community)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response := &MessengerResponse{}
+	response.AddCommunity(community)
+	return response, nil
+}
+
+func (m *Messenger) communityDirectoryEnabled() (bool, error) {
+	s, err := m.settings.GetSettings()
+	if err != nil {
+		return false, err
+	}
+	return s.CommunityDirectoryEnabled, nil
+}
+
+// SetCommunityDirectoryEnabled opts in or out of browsing the community directory.
+// Opting out leaves the directory topic and drops the entries received so far.
+func (m *Messenger) SetCommunityDirectoryEnabled(enabled bool) error {
+	err := m.settings.SaveSettingField(settings.CommunityDirectoryEnabled, enabled)
+	if err != nil {
+		return err
+	}
+
+	if enabled {
+		_, err = m.transport.JoinPublic(transport.CommunityDirectoryTopic())
+		return err
+	}
+
+	_, err = m.transport.RemoveFilterByChatID(transport.CommunityDirectoryTopic())
+	if err != nil {
+		return err
+	}
+
+	return m.communitiesManager.EvictStaleDirectoryEntries(0)
+}
+
+func (m *Messenger) IsCommunityDirectoryListed(communityID types.HexBytes) (bool, error) {
+	return m.communitiesManager.IsDirectoryListed(communityID)
+}
+
+func (m *Messenger) SearchCommunityDirectory(request *requests.SearchCommunityDirectory) ([]*communities.DirectoryEntry, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	return m.communitiesManager.SearchDirectory(request)
+}
+
+func (m *Messenger) HandleCommunityDirectoryEntry(state *ReceivedMessageState, entryProto *protobuf.CommunityDirectoryEntry, statusMessage *v1protocol.StatusMessage) error {
+	enabled, err := m.communityDirectoryEnabled()
+	if err != nil {
+		return err
+	}
+	// The topic can still be received when announcing our own communities
+	if !enabled {
+		return nil
+	}
+
+	_, err = m.communitiesManager.HandleCommunityDirectoryEntry(state.CurrentMessageState.PublicKey, entryProto)
+	return err
+}
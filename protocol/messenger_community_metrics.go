@@ -3,16 +3,66 @@ package protocol
 import (
 	"fmt"
 	"sort"
+	"sync"
+	"time"
 
 	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/communities"
 	"github.com/status-im/status-go/protocol/requests"
 )
 
+// Analytics are computed from the whole history, so they're kept for a while
+const communityMetricsCacheTTL = 5 * time.Minute
+
+const (
+	metricsDayMs   = uint64(24 * time.Hour / time.Millisecond)
+	metricsWeekMs  = 7 * metricsDayMs
+	metricsMonthMs = 30 * metricsDayMs
+)
+
+type MetricsActiveMembersResponse struct {
+	Daily   int `json:"daily"`
+	Weekly  int `json:"weekly"`
+	Monthly int `json:"monthly"`
+}
+
+type MetricsMembersFunnelResponse struct {
+	Requested int `json:"requested"`
+	Pending   int `json:"pending"`
+	Accepted  int `json:"accepted"`
+	Declined  int `json:"declined"`
+	Canceled  int `json:"canceled"`
+	Left      int `json:"left"`
+}
+
+// MetricsHeatmap holds message counts indexed by weekday, starting on sunday, and hour
+type MetricsHeatmap [7][24]int
+
+type MetricsChannelHeatmapResponse struct {
+	ChatID string          `json:"chatId"`
+	Count  int             `json:"count"`
+	Hours  *MetricsHeatmap `json:"hours"`
+}
+
+type MetricsPosterResponse struct {
+	PublicKey string `json:"publicKey"`
+	Count     int    `json:"count"`
+}
+
+// MetricsIntervalResponse carries the metrics of one interval. For paginated
+// metrics, top posters and channels heatmaps, `Count` is the total number of items.
 type MetricsIntervalResponse struct {
-	StartTimestamp uint64   `json:"startTimestamp"`
-	EndTimestamp   uint64   `json:"endTimestamp"`
-	Timestamps     []uint64 `json:"timestamps"`
-	Count          int      `json:"count"`
+	StartTimestamp uint64                          `json:"startTimestamp"`
+	EndTimestamp   uint64                          `json:"endTimestamp"`
+	Timestamps     []uint64                        `json:"timestamps"`
+	Count          int                             `json:"count"`
+	ActiveMembers  *MetricsActiveMembersResponse   `json:"activeMembers,omitempty"`
+	Funnel         *MetricsMembersFunnelResponse   `json:"funnel,omitempty"`
+	Heatmaps       []MetricsChannelHeatmapResponse `json:"heatmaps,omitempty"`
+	TopPosters     []MetricsPosterResponse         `json:"topPosters,omitempty"`
+	// Retention counts the members of the cohort that posted in this
+	// interval and each of the following ones
+	Retention []int `json:"retention,omitempty"`
 }
 
 type CommunityMetricsResponse struct {
@@ -102,7 +152,330 @@ func (m *Messenger) CollectCommunityMetrics(request *requests.CommunityMetricsRe
 		return m.collectCommunityMessagesTimestamps(request)
 	case requests.CommunityMetricsRequestMessagesCount:
 		return m.collectCommunityMessagesCount(request)
+	case requests.CommunityMetricsRequestActiveMembers:
+		return m.collectCachedCommunityMetrics(request, m.collectCommunityActiveMembers)
+	case requests.CommunityMetricsRequestMembersFunnel:
+		return m.collectCachedCommunityMetrics(request, m.collectCommunityMembersFunnel)
+	case requests.CommunityMetricsRequestChannelsHeatmap:
+		return m.collectCachedCommunityMetrics(request, m.collectCommunityChannelsHeatmap)
+	case requests.CommunityMetricsRequestTopPosters:
+		return m.collectCachedCommunityMetrics(request, m.collectCommunityTopPosters)
+	case requests.CommunityMetricsRequestRetention:
+		return m.collectCachedCommunityMetrics(request, m.collectCommunityRetention)
 	default:
 		return nil, fmt.Errorf("metrics for %d is not implemented yet", request.Type)
 	}
 }
+
+func newCommunityMetricsResponse(request *requests.CommunityMetricsRequest) *CommunityMetricsResponse {
+	intervals := make([]MetricsIntervalResponse, len(request.Intervals))
+	for i, sourceInterval := range request.Intervals {
+		intervals[i] = MetricsIntervalResponse{
+			StartTimestamp: sourceInterval.StartTimestamp,
+			EndTimestamp:   sourceInterval.EndTimestamp,
+		}
+	}
+
+	return &CommunityMetricsResponse{
+		Type:        request.Type,
+		CommunityID: request.CommunityID,
+		Intervals:   intervals,
+	}
+}
+
+func (m *Messenger) collectCommunityActiveMembers(request *requests.CommunityMetricsRequest) (*CommunityMetricsResponse, error) {
+	chatIDs, err := m.getChatIdsForCommunity(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := newCommunityMetricsResponse(request)
+	if len(chatIDs) == 0 {
+		return response, nil
+	}
+
+	// Members who posted within the window preceding end
+	countActiveMembers := func(window uint64, end uint64) (int, error) {
+		start := uint64(0)
+		if end > window {
+			start = end - window
+		}
+		return m.persistence.SelectActiveMembersCountForChatsByPeriod(chatIDs, start, end)
+	}
+
+	for i := range response.Intervals {
+		interval := &response.Intervals[i]
+
+		interval.Count, err = m.persistence.SelectActiveMembersCountForChatsByPeriod(chatIDs, interval.StartTimestamp, interval.EndTimestamp)
+		if err != nil {
+			return nil, err
+		}
+
+		activeMembers := &MetricsActiveMembersResponse{}
+		if activeMembers.Daily, err = countActiveMembers(metricsDayMs, interval.EndTimestamp); err != nil {
+			return nil, err
+		}
+		if activeMembers.Weekly, err = countActiveMembers(metricsWeekMs, interval.EndTimestamp); err != nil {
+			return nil, err
+		}
+		if activeMembers.Monthly, err = countActiveMembers(metricsMonthMs, interval.EndTimestamp); err != nil {
+			return nil, err
+		}
+		interval.ActiveMembers = activeMembers
+	}
+
+	return response, nil
+}
+
+// collectCommunityMembersFunnel relies on the requests to join and to leave,
+// which are only all known by the control node and the privileged members
+func (m *Messenger) collectCommunityMembersFunnel(request *requests.CommunityMetricsRequest) (*CommunityMetricsResponse, error) {
+	response := newCommunityMetricsResponse(request)
+
+	for i := range response.Intervals {
+		interval := &response.Intervals[i]
+		// Requests clocks are in seconds
+		from, to := interval.StartTimestamp/1000, interval.EndTimestamp/1000
+
+		counts, err := m.communitiesManager.RequestsToJoinCountByState(request.CommunityID, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		funnel := &MetricsMembersFunnelResponse{}
+		for state, count := range counts {
+			funnel.Requested += count
+			switch state {
+			case communities.RequestToJoinStateAccepted:
+				funnel.Accepted += count
+			case communities.RequestToJoinStateDeclined:
+				funnel.Declined += count
+			case communities.RequestToJoinStateCanceled:
+				funnel.Canceled += count
+			default:
+				funnel.Pending += count
+			}
+		}
+
+		funnel.Left, err = m.communitiesManager.RequestsToLeaveCount(request.CommunityID, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		interval.Count = funnel.Requested
+		interval.Funnel = funnel
+	}
+
+	return response, nil
+}
+
+func (m *Messenger) collectCommunityChannelsHeatmap(request *requests.CommunityMetricsRequest) (*CommunityMetricsResponse, error) {
+	chatIDs, err := m.getChatIdsForCommunity(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := newCommunityMetricsResponse(request)
+	if len(chatIDs) == 0 {
+		return response, nil
+	}
+
+	for i := range response.Intervals {
+		interval := &response.Intervals[i]
+
+		heatmaps, err := m.persistence.SelectMessagesHeatmapForChatsByPeriod(chatIDs, interval.StartTimestamp, interval.EndTimestamp, request.TimezoneOffset)
+		if err != nil {
+			return nil, err
+		}
+
+		for chatID, hours := range heatmaps {
+			count := 0
+			for _, weekday := range hours {
+				for _, c := range weekday {
+					count += c
+				}
+			}
+			interval.Heatmaps = append(interval.Heatmaps, MetricsChannelHeatmapResponse{ChatID: chatID, Count: count, Hours: hours})
+		}
+
+		// The busiest channels first
+		sort.Slice(interval.Heatmaps, func(i, j int) bool {
+			if interval.Heatmaps[i].Count != interval.Heatmaps[j].Count {
+				return interval.Heatmaps[i].Count > interval.Heatmaps[j].Count
+			}
+			return interval.Heatmaps[i].ChatID < interval.Heatmaps[j].ChatID
+		})
+		interval.Count = len(interval.Heatmaps)
+	}
+
+	return response, nil
+}
+
+func (m *Messenger) collectCommunityTopPosters(request *requests.CommunityMetricsRequest) (*CommunityMetricsResponse, error) {
+	chatIDs, err := m.getChatIdsForCommunity(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := newCommunityMetricsResponse(request)
+	if len(chatIDs) == 0 {
+		return response, nil
+	}
+
+	for i := range response.Intervals {
+		interval := &response.Intervals[i]
+
+		interval.TopPosters, err = m.persistence.SelectPostersForChatsByPeriod(chatIDs, interval.StartTimestamp, interval.EndTimestamp)
+		if err != nil {
+			return nil, err
+		}
+		interval.Count = len(interval.TopPosters)
+	}
+
+	return response, nil
+}
+
+// collectCommunityRetention makes a cohort of the members accepted in each interval
+// and counts how many of them posted in that interval and in each of the following ones
+func (m *Messenger) collectCommunityRetention(request *requests.CommunityMetricsRequest) (*CommunityMetricsResponse, error) {
+	chatIDs, err := m.getChatIdsForCommunity(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := newCommunityMetricsResponse(request)
+
+	activeMembers := make([]map[string]bool, len(response.Intervals))
+	for i, interval := range response.Intervals {
+		activeMembers[i] = make(map[string]bool)
+		if len(chatIDs) == 0 {
+			continue
+		}
+
+		members, err := m.persistence.SelectActiveMembersForChatsByPeriod(chatIDs, interval.StartTimestamp, interval.EndTimestamp)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			activeMembers[i][member] = true
+		}
+	}
+
+	for i := range response.Intervals {
+		interval := &response.Intervals[i]
+
+		// Requests clocks are in seconds
+		cohort, err := m.communitiesManager.AcceptedRequestsToJoinPublicKeys(request.CommunityID, interval.StartTimestamp/1000, interval.EndTimestamp/1000)
+		if err != nil {
+			return nil, err
+		}
+
+		interval.Count = len(cohort)
+		interval.Retention = make([]int, len(response.Intervals)-i)
+		for j := i; j < len(response.Intervals); j++ {
+			for _, member := range cohort {
+				if activeMembers[j][member] {
+					interval.Retention[j-i]++
+				}
+			}
+		}
+	}
+
+	return response, nil
+}
+
+func (m *Messenger) collectCachedCommunityMetrics(request *requests.CommunityMetricsRequest, collect func(*requests.CommunityMetricsRequest) (*CommunityMetricsResponse, error)) (*CommunityMetricsResponse, error) {
+	key := communityMetricsCacheKey(request)
+	now := time.Now()
+
+	response := m.communityMetricsCache.get(key, now)
+	if response == nil {
+		var err error
+		response, err = collect(request)
+		if err != nil {
+			return nil, err
+		}
+		m.communityMetricsCache.set(key, response, now)
+	}
+
+	return paginateCommunityMetrics(response, request.Limit, request.Offset), nil
+}
+
+// paginateCommunityMetrics returns a copy of the response holding the requested
+// page of top posters and channels heatmaps, the cached response is left untouched
+func paginateCommunityMetrics(response *CommunityMetricsResponse, limit int, offset int) *CommunityMetricsResponse {
+	page := func(count int) (int, int) {
+		start := offset
+		if start > count {
+			start = count
+		}
+		end := count
+		if limit > 0 && start+limit < count {
+			end = start + limit
+		}
+		return start, end
+	}
+
+	paginated := *response
+	paginated.Intervals = make([]MetricsIntervalResponse, len(response.Intervals))
+	for i, interval := range response.Intervals {
+		if interval.TopPosters != nil {
+			start, end := page(len(interval.TopPosters))
+			interval.TopPosters = interval.TopPosters[start:end]
+		}
+		if interval.Heatmaps != nil {
+			start, end := page(len(interval.Heatmaps))
+			interval.Heatmaps = interval.Heatmaps[start:end]
+		}
+		paginated.Intervals[i] = interval
+	}
+
+	return &paginated
+}
+
+func communityMetricsCacheKey(request *requests.CommunityMetricsRequest) string {
+	return fmt.Sprintf("%s-%d-%d-%v", request.CommunityID.String(), request.Type, request.TimezoneOffset, request.Intervals)
+}
+
+type communityMetricsCacheEntry struct {
+	response  *CommunityMetricsResponse
+	expiresAt time.Time
+}
+
+type communityMetricsCache struct {
+	mutex   sync.Mutex
+	entries map[string]communityMetricsCacheEntry
+}
+
+func newCommunityMetricsCache() *communityMetricsCache {
+	return &communityMetricsCache{
+		entries: make(map[string]communityMetricsCacheEntry),
+	}
+}
+
+func (c *communityMetricsCache) get(key string, now time.Time) *CommunityMetricsResponse {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || now.After(entry.expiresAt) {
+		return nil
+	}
+	return entry.response
+}
+
+func (c *communityMetricsCache) set(key string, response *CommunityMetricsResponse, now time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for k, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+
+	c.entries[key] = communityMetricsCacheEntry{
+		response:  response,
+		expiresAt: now.Add(communityMetricsCacheTTL),
+	}
+}
@@ -233,3 +233,253 @@ func (s *MessengerCommunityMetricsSuite) TestCollectCommunityMessagesCount() {
 	s.Require().Equal(resp.Intervals[1].Count, 2)
 	s.Require().Equal(resp.Intervals[2].Count, 1)
 }
+
+// 2023-11-14 00:00:00 UTC, a tuesday
+const metricsTestDay = uint64(1699920000000)
+
+func (s *MessengerCommunityMetricsSuite) generateMessagesFrom(chatID string, communityID string, from string, timestamps []uint64) {
+	var messages []*common.Message
+	for i, timestamp := range timestamps {
+		message := &common.Message{
+			ChatMessage: &protobuf.ChatMessage{
+				ChatId:      chatID,
+				Text:        fmt.Sprintf("Test message %d", i),
+				MessageType: protobuf.MessageType_COMMUNITY_CHAT,
+				Clock:       timestamp,
+				Timestamp:   timestamp,
+			},
+			WhisperTimestamp: timestamp,
+			From:             from,
+			LocalChatID:      chatID,
+			CommunityID:      communityID,
+			ID:               types.EncodeHex(crypto.Keccak256([]byte(fmt.Sprintf("%s%s%s%d", chatID, communityID, from, timestamp)))),
+		}
+
+		err := message.PrepareContent(common.PubkeyToHex(&s.m.identity.PublicKey))
+		s.Require().NoError(err)
+
+		messages = append(messages, message)
+	}
+	err := s.m.persistence.SaveMessages(messages)
+	s.Require().NoError(err)
+}
+
+func (s *MessengerCommunityMetricsSuite) generateMembers(count int) []string {
+	var members []string
+	for i := 0; i < count; i++ {
+		key, err := crypto.GenerateKey()
+		s.Require().NoError(err)
+		members = append(members, common.PubkeyToHex(&key.PublicKey))
+	}
+	return members
+}
+
+func (s *MessengerCommunityMetricsSuite) saveRequestToJoin(community *communities.Community, member string, clock uint64, state communities.RequestToJoinState) {
+	err := s.m.communitiesManager.SaveRequestToJoin(&communities.RequestToJoin{
+		ID:          communities.CalculateRequestID(member, community.ID()),
+		PublicKey:   member,
+		Clock:       clock,
+		CommunityID: community.ID(),
+		State:       state,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *MessengerCommunityMetricsSuite) TestCollectCommunityMetricsInvalidPagination() {
+	community, _ := s.prepareCommunityAndChatIDs()
+
+	_, err := s.m.CollectCommunityMetrics(&requests.CommunityMetricsRequest{
+		CommunityID: community.ID(),
+		Type:        requests.CommunityMetricsRequestTopPosters,
+		Limit:       -1,
+	})
+	s.Require().Equal(requests.ErrInvalidMetricsPagination, err)
+
+	_, err = s.m.CollectCommunityMetrics(&requests.CommunityMetricsRequest{
+		CommunityID: community.ID(),
+		Type:        requests.CommunityMetricsRequestRetention,
+		Intervals: []requests.MetricsIntervalRequest{
+			{StartTimestamp: metricsTestDay, EndTimestamp: metricsTestDay + 1000},
+			{StartTimestamp: metricsTestDay + 500, EndTimestamp: metricsTestDay + 2000},
+		},
+	})
+	s.Require().Equal(requests.ErrInvalidTimestampIntervals, err)
+}
+
+func (s *MessengerCommunityMetricsSuite) TestCollectCommunityActiveMembers() {
+	community, chatIDs := s.prepareCommunityAndChatIDs()
+	members := s.generateMembers(4)
+
+	hour := uint64(60 * 60 * 1000)
+	s.generateMessagesFrom(chatIDs[0], community.IDString(), members[0], []uint64{metricsTestDay + 10*hour})
+	s.generateMessagesFrom(chatIDs[1], community.IDString(), members[1], []uint64{metricsTestDay - 3*metricsDayMs})
+	s.generateMessagesFrom(chatIDs[0], community.IDString(), members[2], []uint64{metricsTestDay - 20*metricsDayMs})
+	s.generateMessagesFrom(chatIDs[1], community.IDString(), members[3], []uint64{metricsTestDay - 40*metricsDayMs})
+
+	resp, err := s.m.CollectCommunityMetrics(&requests.CommunityMetricsRequest{
+		CommunityID: community.ID(),
+		Type:        requests.CommunityMetricsRequestActiveMembers,
+		Intervals: []requests.MetricsIntervalRequest{
+			{StartTimestamp: metricsTestDay, EndTimestamp: metricsTestDay + metricsDayMs - 1},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Intervals, 1)
+	s.Require().Equal(1, resp.Intervals[0].Count)
+	s.Require().Equal(&MetricsActiveMembersResponse{Daily: 1, Weekly: 2, Monthly: 3}, resp.Intervals[0].ActiveMembers)
+}
+
+func (s *MessengerCommunityMetricsSuite) TestCollectCommunityMembersFunnel() {
+	community, _ := s.prepareCommunityAndChatIDs()
+	members := s.generateMembers(5)
+
+	clock := metricsTestDay / 1000
+	s.saveRequestToJoin(community, members[0], clock+1, communities.RequestToJoinStateAccepted)
+	s.saveRequestToJoin(community, members[1], clock+2, communities.RequestToJoinStateDeclined)
+	s.saveRequestToJoin(community, members[2], clock+3, communities.RequestToJoinStatePending)
+	s.saveRequestToJoin(community, members[3], clock+4, communities.RequestToJoinStateCanceled)
+	// Out of the interval
+	s.saveRequestToJoin(community, members[4], clock-10, communities.RequestToJoinStateAccepted)
+
+	leaver, err := common.HexToPubkey(members[0])
+	s.Require().NoError(err)
+	err = s.m.communitiesManager.HandleCommunityRequestToLeave(leaver, &protobuf.CommunityRequestToLeave{
+		Clock:       clock + 60,
+		CommunityId: community.ID(),
+	})
+	s.Require().NoError(err)
+
+	resp, err := s.m.CollectCommunityMetrics(&requests.CommunityMetricsRequest{
+		CommunityID: community.ID(),
+		Type:        requests.CommunityMetricsRequestMembersFunnel,
+		Intervals: []requests.MetricsIntervalRequest{
+			{StartTimestamp: metricsTestDay, EndTimestamp: metricsTestDay + metricsDayMs},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Intervals, 1)
+	s.Require().Equal(4, resp.Intervals[0].Count)
+	s.Require().Equal(&MetricsMembersFunnelResponse{
+		Requested: 4,
+		Pending:   1,
+		Accepted:  1,
+		Declined:  1,
+		Canceled:  1,
+		Left:      1,
+	}, resp.Intervals[0].Funnel)
+}
+
+func (s *MessengerCommunityMetricsSuite) TestCollectCommunityHeatmapAndTopPosters() {
+	community, chatIDs := s.prepareCommunityAndChatIDs()
+	members := s.generateMembers(2)
+
+	hour := uint64(60 * 60 * 1000)
+	// tuesday, 10 UTC
+	s.generateMessagesFrom(chatIDs[0], community.IDString(), members[0], []uint64{
+		metricsTestDay + 10*hour,
+		metricsTestDay + 10*hour + 1000,
+		metricsTestDay + 10*hour + 2000,
+	})
+	// wednesday, 23:30 UTC
+	s.generateMessagesFrom(chatIDs[1], community.IDString(), members[1], []uint64{metricsTestDay + metricsDayMs + 23*hour + hour/2})
+
+	intervals := []requests.MetricsIntervalRequest{
+		{StartTimestamp: metricsTestDay, EndTimestamp: metricsTestDay + metricsWeekMs},
+	}
+
+	resp, err := s.m.CollectCommunityMetrics(&requests.CommunityMetricsRequest{
+		CommunityID:    community.ID(),
+		Type:           requests.CommunityMetricsRequestChannelsHeatmap,
+		Intervals:      intervals,
+		TimezoneOffset: 60 * 60,
+		Limit:          1,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Intervals, 1)
+	s.Require().Equal(2, resp.Intervals[0].Count)
+	s.Require().Len(resp.Intervals[0].Heatmaps, 1)
+	s.Require().Equal(chatIDs[0], resp.Intervals[0].Heatmaps[0].ChatID)
+	s.Require().Equal(3, resp.Intervals[0].Heatmaps[0].Count)
+	s.Require().Equal(3, resp.Intervals[0].Heatmaps[0].Hours[2][11])
+
+	resp, err = s.m.CollectCommunityMetrics(&requests.CommunityMetricsRequest{
+		CommunityID:    community.ID(),
+		Type:           requests.CommunityMetricsRequestChannelsHeatmap,
+		Intervals:      intervals,
+		TimezoneOffset: 60 * 60,
+		Limit:          1,
+		Offset:         1,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Intervals[0].Heatmaps, 1)
+	s.Require().Equal(chatIDs[1], resp.Intervals[0].Heatmaps[0].ChatID)
+	// thursday, 0:30 in UTC+1
+	s.Require().Equal(1, resp.Intervals[0].Heatmaps[0].Hours[4][0])
+
+	request := &requests.CommunityMetricsRequest{
+		CommunityID: community.ID(),
+		Type:        requests.CommunityMetricsRequestTopPosters,
+		Intervals:   intervals,
+	}
+	resp, err = s.m.CollectCommunityMetrics(request)
+	s.Require().NoError(err)
+	s.Require().Equal(2, resp.Intervals[0].Count)
+	s.Require().Equal([]MetricsPosterResponse{
+		{PublicKey: members[0], Count: 3},
+		{PublicKey: members[1], Count: 1},
+	}, resp.Intervals[0].TopPosters)
+
+	// Results are cached, pages are taken from the cached results
+	s.generateMessagesFrom(chatIDs[1], community.IDString(), members[1], []uint64{metricsTestDay + 1000, metricsTestDay + 2000, metricsTestDay + 3000})
+
+	request.Limit = 1
+	request.Offset = 1
+	resp, err = s.m.CollectCommunityMetrics(request)
+	s.Require().NoError(err)
+	s.Require().Equal(2, resp.Intervals[0].Count)
+	s.Require().Equal([]MetricsPosterResponse{{PublicKey: members[1], Count: 1}}, resp.Intervals[0].TopPosters)
+
+	request.Offset = 5
+	resp, err = s.m.CollectCommunityMetrics(request)
+	s.Require().NoError(err)
+	s.Require().Len(resp.Intervals[0].TopPosters, 0)
+}
+
+func (s *MessengerCommunityMetricsSuite) TestCollectCommunityRetention() {
+	community, chatIDs := s.prepareCommunityAndChatIDs()
+	members := s.generateMembers(4)
+
+	clock := metricsTestDay / 1000
+	weekClock := metricsWeekMs / 1000
+
+	// 1st cohort, the pending member is not counted
+	s.saveRequestToJoin(community, members[0], clock+1, communities.RequestToJoinStateAccepted)
+	s.saveRequestToJoin(community, members[1], clock+2, communities.RequestToJoinStateAccepted)
+	s.saveRequestToJoin(community, members[2], clock+3, communities.RequestToJoinStatePending)
+	// 2nd cohort
+	s.saveRequestToJoin(community, members[3], clock+weekClock+1, communities.RequestToJoinStateAccepted)
+
+	s.generateMessagesFrom(chatIDs[0], community.IDString(), members[0], []uint64{metricsTestDay + 1000, metricsTestDay + 2*metricsWeekMs + 1000})
+	s.generateMessagesFrom(chatIDs[1], community.IDString(), members[1], []uint64{metricsTestDay + metricsWeekMs + 1000})
+	s.generateMessagesFrom(chatIDs[0], community.IDString(), members[2], []uint64{metricsTestDay + 1000})
+	s.generateMessagesFrom(chatIDs[1], community.IDString(), members[3], []uint64{metricsTestDay + metricsWeekMs + 2000})
+
+	resp, err := s.m.CollectCommunityMetrics(&requests.CommunityMetricsRequest{
+		CommunityID: community.ID(),
+		Type:        requests.CommunityMetricsRequestRetention,
+		Intervals: []requests.MetricsIntervalRequest{
+			{StartTimestamp: metricsTestDay, EndTimestamp: metricsTestDay + metricsWeekMs - 1},
+			{StartTimestamp: metricsTestDay + metricsWeekMs, EndTimestamp: metricsTestDay + 2*metricsWeekMs - 1},
+			{StartTimestamp: metricsTestDay + 2*metricsWeekMs, EndTimestamp: metricsTestDay + 3*metricsWeekMs - 1},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Intervals, 3)
+
+	s.Require().Equal(2, resp.Intervals[0].Count)
+	s.Require().Equal([]int{1, 1, 1}, resp.Intervals[0].Retention)
+	s.Require().Equal(1, resp.Intervals[1].Count)
+	s.Require().Equal([]int{1, 0}, resp.Intervals[1].Retention)
+	s.Require().Equal(0, resp.Intervals[2].Count)
+	s.Require().Equal([]int{0}, resp.Intervals[2].Retention)
+}
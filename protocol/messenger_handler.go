@@ -2176,6 +2176,17 @@ func (m *Messenger) handleChatMessage(state *ReceivedMessageState, forceSeen boo
 				zap.String("communityID", chat.CommunityID))
 			return errors.New("received a messaged from banned user")
 		}
+
+		// Archived messages are old, they would only trip rate and duplicate rules
+		if !isSyncMessage && !forceSeen {
+			blocked, err := m.applyAutomod(community, chat, pk, receivedMessage, state.Response)
+			if err != nil {
+				logger.Warn("failed to apply automod", zap.Error(err))
+			}
+			if blocked {
+				return nil
+			}
+		}
 	}
 
 	// It looks like status-mobile created profile chats as public chats
@@ -2186,6 +2197,10 @@ func (m *Messenger) handleChatMessage(state *ReceivedMessageState, forceSeen boo
 			return errors.New("images are not allowed in public chats")
 		case protobuf.ChatMessage_AUDIO:
 			return errors.New("audio messages are not allowed in public chats")
+		case protobuf.ChatMessage_VIDEO:
+			return errors.New("video messages are not allowed in public chats")
+		case protobuf.ChatMessage_FILE:
+			return errors.New("files are not allowed in public chats")
 		}
 	}
 
@@ -2769,7 +2784,7 @@ func (m *Messenger) matchChatEntity(chatEntity common.ChatEntity) (*Chat, error)
 				return nil, err
 			}
 
-			hasPermission := community.IsPrivilegedMember(chatEntity.GetSigPubKey())
+			hasPermission := community.IsModeratingMember(chatEntity.GetSigPubKey())
 			pinMessageAllowed := community.AllowsAllMembersToPinMessage()
 			if !hasPermission && !pinMessageAllowed {
 				return nil, errors.New("user can't pin message")
@@ -250,6 +250,15 @@ func (m *Messenger) dispatchToHandler(messageState *ReceivedMessageState, protoB
            case protobuf.ApplicationMetadataMessage_COMMUNITY_PUBLIC_STORENODES_INFO:
 		return m.handleCommunityPublicStorenodesInfoProtobuf(messageState, protoBytes, msg, filter)
         
+           case protobuf.ApplicationMetadataMessage_COMMUNITY_CALENDAR_EVENT_RSVP:
+		return m.handleCommunityCalendarEventRsvpProtobuf(messageState, protoBytes, msg, filter)
+        
+           case protobuf.ApplicationMetadataMessage_COMMUNITY_DIRECTORY_ENTRY:
+		return m.handleCommunityDirectoryEntryProtobuf(messageState, protoBytes, msg, filter)
+        
+           case protobuf.ApplicationMetadataMessage_COMMUNITY_CALENDAR_EVENT_RSVP_REJECTED:
+		return m.handleCommunityCalendarEventRsvpRejectedProtobuf(messageState, protoBytes, msg, filter)
+        
 	default:
 		m.logger.Info("protobuf type not found", zap.String("type", string(msg.ApplicationLayer.Type)))
                 return errors.New("protobuf type not found")
@@ -1796,3 +1805,57 @@ func (m *Messenger) handleCommunityPublicStorenodesInfoProtobuf(messageState *Re
 }
 
 
+func (m *Messenger) handleCommunityCalendarEventRsvpProtobuf(messageState *ReceivedMessageState, protoBytes []byte, msg *v1protocol.StatusMessage, filter transport.Filter) error {
+	m.logger.Info("handling CommunityCalendarEventRsvp")
+	
+
+	
+	p := &protobuf.CommunityCalendarEventRsvp{}
+	err := proto.Unmarshal(protoBytes, p)
+	if err != nil {
+		return err
+	}
+
+	m.outputToCSV(msg.TransportLayer.Message.Timestamp, msg.ApplicationLayer.ID, messageState.CurrentMessageState.Contact.ID, filter.ContentTopic, filter.ChatID, msg.ApplicationLayer.Type, p)
+
+	return m.HandleCommunityCalendarEventRsvp(messageState, p, msg)
+	
+}
+
+
+func (m *Messenger) handleCommunityDirectoryEntryProtobuf(messageState *ReceivedMessageState, protoBytes []byte, msg *v1protocol.StatusMessage, filter transport.Filter) error {
+	m.logger.Info("handling CommunityDirectoryEntry")
+	
+
+	
+	p := &protobuf.CommunityDirectoryEntry{}
+	err := proto.Unmarshal(protoBytes, p)
+	if err != nil {
+		return err
+	}
+
+	m.outputToCSV(msg.TransportLayer.Message.Timestamp, msg.ApplicationLayer.ID, messageState.CurrentMessageState.Contact.ID, filter.ContentTopic, filter.ChatID, msg.ApplicationLayer.Type, p)
+
+	return m.HandleCommunityDirectoryEntry(messageState, p, msg)
+	
+}
+
+
+func (m *Messenger) handleCommunityCalendarEventRsvpRejectedProtobuf(messageState *ReceivedMessageState, protoBytes []byte, msg *v1protocol.StatusMessage, filter transport.Filter) error {
+	m.logger.Info("handling CommunityCalendarEventRsvpRejected")
+	
+
+	
+	p := &protobuf.CommunityCalendarEventRsvpRejected{}
+	err := proto.Unmarshal(protoBytes, p)
+	if err != nil {
+		return err
+	}
+
+	m.outputToCSV(msg.TransportLayer.Message.Timestamp, msg.ApplicationLayer.ID, messageState.CurrentMessageState.Contact.ID, filter.ContentTopic, filter.ChatID, msg.ApplicationLayer.Type, p)
+
+	return m.HandleCommunityCalendarEventRsvpRejected(messageState, p, msg)
+	
+}
+
+
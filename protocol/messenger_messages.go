@@ -7,6 +7,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 
+	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/requests"
@@ -203,7 +204,9 @@ func (m *Messenger) DeleteMessageAndSend(ctx context.Context, messageID string)
 		message.ContentType != protobuf.ChatMessage_STICKER &&
 		message.ContentType != protobuf.ChatMessage_EMOJI &&
 		message.ContentType != protobuf.ChatMessage_IMAGE &&
-		message.ContentType != protobuf.ChatMessage_AUDIO {
+		message.ContentType != protobuf.ChatMessage_AUDIO &&
+		message.ContentType != protobuf.ChatMessage_VIDEO &&
+		message.ContentType != protobuf.ChatMessage_FILE {
 		return nil, ErrInvalidDeleteTypeAuthor
 	}
 
@@ -234,6 +237,18 @@ func (m *Messenger) DeleteMessageAndSend(ctx context.Context, messageID string)
 		ResendAutomatically:  true,
 	}
 
+	if len(deletedBy) > 0 && message.MessageType == protobuf.MessageType_COMMUNITY_CHAT {
+		// Moderation deletions are kept in the community audit log
+		communityID, err := types.DecodeHex(chat.CommunityID)
+		if err != nil {
+			return nil, err
+		}
+		err = m.communitiesManager.DeleteMessageForEveryone(communityID, message.From, chat.ID, messageID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	_, err = m.dispatchMessage(ctx, rawMessage)
 	if err != nil {
 		return nil, err
@@ -293,7 +308,9 @@ func (m *Messenger) DeleteMessageForMeAndSync(ctx context.Context, localChatID s
 		message.ContentType != protobuf.ChatMessage_STICKER &&
 		message.ContentType != protobuf.ChatMessage_EMOJI &&
 		message.ContentType != protobuf.ChatMessage_IMAGE &&
-		message.ContentType != protobuf.ChatMessage_AUDIO {
+		message.ContentType != protobuf.ChatMessage_AUDIO &&
+		message.ContentType != protobuf.ChatMessage_VIDEO &&
+		message.ContentType != protobuf.ChatMessage_FILE {
 		return nil, ErrInvalidDeleteTypeAuthor
 	}
 
@@ -553,3 +570,8 @@ func (m *Messenger) DeleteAllCommunityMemberMessages(member string, communityID
 
 	return response, nil
 }
+
+// MediaStorageUsage returns the space taken by the images, audio, video and files of the stored messages
+func (m *Messenger) MediaStorageUsage() (*MediaStorageUsage, error) {
+	return m.persistence.MediaStorageUsage()
+}
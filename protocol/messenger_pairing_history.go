@@ -0,0 +1,26 @@
+package protocol
+
+import (
+	"time"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// PairingHistoryChunk returns the chunk of the message history following the cursor of the request,
+// to be transferred to a paired device. The chunk is about maxSize bytes at most.
+func (m *Messenger) PairingHistoryChunk(request *protobuf.PairingHistoryRequest, maxSize int) (*protobuf.PairingHistoryChunk, error) {
+	var since uint64
+	if request.Days > 0 {
+		now := m.GetCurrentTimeInMillis()
+		period := uint64(request.Days) * uint64(24*time.Hour/time.Millisecond)
+		if period < now {
+			since = now - period
+		}
+	}
+	return m.persistence.PairingHistoryChunk(since, request.Cursor, maxSize)
+}
+
+// SavePairingHistoryChunk stores a chunk of the message history received from a paired device
+func (m *Messenger) SavePairingHistoryChunk(chunk *protobuf.PairingHistoryChunk) error {
+	return m.persistence.SavePairingHistoryChunk(chunk)
+}
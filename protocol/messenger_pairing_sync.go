@@ -0,0 +1,21 @@
+package protocol
+
+import (
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// InstallationID returns the installation ID of this device
+func (m *Messenger) InstallationID() string {
+	return m.installationID
+}
+
+// PairingSyncWatermarks returns the watermarks of the sync messages received through local pairing,
+// for every installation we paired with
+func (m *Messenger) PairingSyncWatermarks() ([]*protobuf.PairingSyncWatermark, error) {
+	return m.persistence.PairingSyncWatermarks()
+}
+
+// SavePairingSyncWatermarks replaces the watermarks of the sync messages received from the installation
+func (m *Messenger) SavePairingSyncWatermarks(installationID string, watermarks []*protobuf.PairingSyncWatermark) error {
+	return m.persistence.SavePairingSyncWatermarks(installationID, watermarks)
+}
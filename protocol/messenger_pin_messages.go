@@ -37,7 +37,7 @@ func (m *Messenger) sendPinMessage(ctx context.Context, message *common.PinMessa
 			return nil, err
 		}
 
-		hasPermission := community.IsPrivilegedMember(&m.identity.PublicKey)
+		hasPermission := community.IsModeratingMember(&m.identity.PublicKey)
 		pinMessageAllowed := community.AllowsAllMembersToPinMessage()
 		canPost, err := community.CanPost(&m.identity.PublicKey, chat.CommunityChatID())
 		if err != nil {
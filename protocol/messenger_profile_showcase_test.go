@@ -38,6 +38,11 @@ func (m *CollectiblesManagerMock) FetchBalancesByOwnerAndContractAddress(ctx con
 	return nil, errors.New("FetchBalancesByOwnerAndContractAddress is not implemented for testCollectiblesManager")
 }
 
+func (m *CollectiblesManagerMock) FetchERC1155Balances(ctx context.Context, chainID walletCommon.ChainID,
+	ownerAddress gethcommon.Address, contractAddress gethcommon.Address, tokenIDs []*big.Int) ([]thirdparty.TokenBalance, error) {
+	return nil, errors.New("FetchERC1155Balances is not implemented for testCollectiblesManager")
+}
+
 func (m *CollectiblesManagerMock) GetCollectibleOwnership(requestedID thirdparty.CollectibleUniqueID) ([]thirdparty.AccountBalance, error) {
 	// NOTE: TokenID inside of thirdparty.CollectibleUniqueID is a pointer so m.response[id] is now working
 	for id, balances := range m.response {
@@ -36,6 +36,68 @@ func (m *Messenger) GetSavedAddresses(ctx context.Context) ([]*wallet.SavedAddre
 	return m.savedAddressesManager.GetSavedAddresses()
 }
 
+// LinkSavedAddressToContact links the saved address to a messenger contact, an empty contactID removes the link
+func (m *Messenger) LinkSavedAddressToContact(ctx context.Context, address gethcommon.Address, isTest bool, contactID string) error {
+	sa, err := m.savedAddressesManager.GetSavedAddress(address, isTest)
+	if err != nil {
+		return err
+	}
+	if sa == nil {
+		return wallet.ErrSavedAddressNotFound
+	}
+
+	if contactID != "" {
+		contact, ok := m.allContacts.Load(contactID)
+		if !ok {
+			return ErrContactNotFound
+		}
+		// Link the contact ENS name too unless the entry already has one
+		if sa.ENSName == "" && contact.ENSVerified {
+			sa.ENSName = contact.EnsName
+		}
+	}
+	sa.ContactID = contactID
+
+	return m.UpsertSavedAddress(ctx, *sa)
+}
+
+// ExportSavedAddresses serializes the saved addresses as csv or json
+func (m *Messenger) ExportSavedAddresses(format wallet.SavedAddressesFormat) (string, error) {
+	data, err := m.savedAddressesManager.ExportSavedAddresses(format)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ImportSavedAddresses saves and syncs the valid entries of csv or json serialized saved addresses.
+// Invalid entries and the ones conflicting with already saved addresses are reported in the result.
+func (m *Messenger) ImportSavedAddresses(ctx context.Context, format wallet.SavedAddressesFormat, data string, resolution wallet.SavedAddressConflictResolution) (*wallet.SavedAddressesImportResult, error) {
+	savedAddresses, invalid, err := wallet.DecodeSavedAddresses(format, []byte(data))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := m.savedAddressesManager.ResolveImportConflicts(savedAddresses, resolution)
+	if err != nil {
+		return nil, err
+	}
+	result.Skipped = append(invalid, result.Skipped...)
+
+	for _, sa := range result.Imported {
+		if sa.ContactID != "" {
+			if _, ok := m.allContacts.Load(sa.ContactID); !ok {
+				sa.ContactID = ""
+			}
+		}
+		if err = m.UpsertSavedAddress(ctx, *sa); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
 func (m *Messenger) garbageCollectRemovedSavedAddresses() error {
 	return m.savedAddressesManager.DeleteSoftRemovedSavedAddresses(uint64(time.Now().AddDate(0, 0, -30).Unix()))
 }
@@ -78,6 +140,7 @@ func (m *Messenger) syncNewSavedAddress(ctx context.Context, savedAddress *walle
 		Ens:             savedAddress.ENSName,
 		IsTest:          savedAddress.IsTest,
 		Color:           string(savedAddress.ColorID),
+		ContactId:       savedAddress.ContactID,
 	}, rawMessageHandler)
 }
 
@@ -122,6 +185,7 @@ func (m *Messenger) HandleSyncSavedAddress(state *ReceivedMessageState, syncMess
 			ENSName:         syncMessage.Ens,
 			IsTest:          syncMessage.IsTest,
 			ColorID:         multiAccCommon.CustomizationColor(syncMessage.Color),
+			ContactID:       syncMessage.ContactId,
 		}
 		sa.UpdateClock = syncMessage.UpdateClock
 
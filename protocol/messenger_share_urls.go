@@ -1,6 +1,7 @@
 package protocol
 
 import (
+	"bytes"
 	"fmt"
 	"regexp"
 	"strings"
@@ -42,25 +43,43 @@ type ContactURLData struct {
 	PublicKey   string `json:"publicKey"`
 }
 
+type CommunityInviteURLData struct {
+	InviteID   string `json:"inviteId"`
+	ExpiresAt  uint64 `json:"expiresAt"`
+	MaxUses    uint32 `json:"maxUses"`
+	ChannelID  string `json:"channelId,omitempty"`
+	AutoAccept bool   `json:"autoAccept"`
+	// Data is passed back in RequestToJoinCommunity to redeem the invite
+	Data string `json:"data"`
+}
+
 type URLDataResponse struct {
 	Community *CommunityURLData        `json:"community"`
 	Channel   *CommunityChannelURLData `json:"channel"`
 	Contact   *ContactURLData          `json:"contact"`
+	Invite    *CommunityInviteURLData  `json:"invite,omitempty"`
 	Shard     *shard.Shard             `json:"shard,omitempty"`
 }
 
+type CommunityInviteLink struct {
+	Invite *communities.Invite `json:"invite"`
+	URL    string              `json:"url"`
+}
+
 const baseShareURL = "https://status.app"
 const userPath = "u#"
 const userWithDataPath = "u/"
 const communityPath = "c#"
 const communityWithDataPath = "c/"
 const channelPath = "cc/"
+const communityInvitePath = "ci/"
 
 const sharedURLUserPrefix = baseShareURL + "/" + userPath
 const sharedURLUserPrefixWithData = baseShareURL + "/" + userWithDataPath
 const sharedURLCommunityPrefix = baseShareURL + "/" + communityPath
 const sharedURLCommunityPrefixWithData = baseShareURL + "/" + communityWithDataPath
 const sharedURLChannelPrefixWithData = baseShareURL + "/" + channelPath
+const sharedURLCommunityInvitePrefix = baseShareURL + "/" + communityInvitePath
 
 const channelUUIDRegExp = "^[0-9a-f]{8}-[0-9a-f]{4}-[0-5][0-9a-f]{3}-[089ab][0-9a-f]{3}-[0-9a-f]{12}$"
 
@@ -205,6 +224,75 @@ func parseCommunityURLWithData(data string, chatKey string) (*URLDataResponse, e
 	}, nil
 }
 
+func encodeCommunityInvite(invite *communities.Invite) (string, error) {
+	inviteData, err := proto.Marshal(invite.ToSignedProtobuf())
+	if err != nil {
+		return "", err
+	}
+
+	return urls.EncodeDataURL(inviteData)
+}
+
+func decodeCommunityInvite(data string) (*communities.Invite, error) {
+	inviteData, err := urls.DecodeDataURL(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var signedInvite protobuf.SignedCommunityInvite
+	err = proto.Unmarshal(inviteData, &signedInvite)
+	if err != nil {
+		return nil, err
+	}
+
+	return communities.NewInviteFromProtobuf(&signedInvite)
+}
+
+func shareCommunityInviteURL(invite *communities.Invite) (string, error) {
+	data, err := encodeCommunityInvite(invite)
+	if err != nil {
+		return "", err
+	}
+
+	shortKey, err := serializePublicKey(invite.CommunityID)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/ci/%s#%s", baseShareURL, data, shortKey), nil
+}
+
+func parseCommunityInviteURL(data string, chatKey string) (*URLDataResponse, error) {
+	communityID, err := deserializePublicKey(chatKey)
+	if err != nil {
+		return nil, err
+	}
+
+	invite, err := decodeCommunityInvite(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(invite.CommunityID, communityID) {
+		return nil, communities.ErrInviteInvalid
+	}
+
+	return &URLDataResponse{
+		Community: &CommunityURLData{
+			CommunityID: types.EncodeHex(communityID),
+			TagIndices:  []uint32{},
+		},
+		Invite: &CommunityInviteURLData{
+			InviteID:   invite.ID,
+			ExpiresAt:  invite.ExpiresAt,
+			MaxUses:    invite.MaxUses,
+			ChannelID:  invite.ChannelID,
+			AutoAccept: invite.AutoAccept,
+			Data:       data,
+		},
+	}, nil
+}
+
 func (m *Messenger) ShareCommunityChannelURLWithChatKey(request *requests.CommunityChannelShareURL) (string, error) {
 	if err := request.Validate(); err != nil {
 		return "", err
@@ -515,7 +603,8 @@ func IsStatusSharedURL(url string) bool {
 		strings.HasPrefix(url, sharedURLUserPrefixWithData) ||
 		strings.HasPrefix(url, sharedURLCommunityPrefix) ||
 		strings.HasPrefix(url, sharedURLCommunityPrefixWithData) ||
-		strings.HasPrefix(url, sharedURLChannelPrefixWithData)
+		strings.HasPrefix(url, sharedURLChannelPrefixWithData) ||
+		strings.HasPrefix(url, sharedURLCommunityInvitePrefix)
 }
 
 func splitSharedURLData(data string) (string, string, error) {
@@ -573,5 +662,14 @@ func ParseSharedURL(url string) (*URLDataResponse, error) {
 		return parseCommunityChannelURLWithData(encodedData, chatKey)
 	}
 
+	if strings.HasPrefix(url, sharedURLCommunityInvitePrefix) {
+		trimmedURL := strings.TrimPrefix(url, sharedURLCommunityInvitePrefix)
+		encodedData, chatKey, err := splitSharedURLData(trimmedURL)
+		if err != nil {
+			return nil, err
+		}
+		return parseCommunityInviteURL(encodedData, chatKey)
+	}
+
 	return nil, fmt.Errorf("not a status shared url")
 }
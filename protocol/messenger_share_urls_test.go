@@ -307,6 +307,39 @@ func (s *MessengerShareUrlsSuite) TestShareAndParseCommunityURLWithData() {
 	s.Require().Equal(community.TagsIndices(), urlData.Community.TagIndices)
 }
 
+func (s *MessengerShareUrlsSuite) TestShareAndParseCommunityInviteURL() {
+	community := s.createCommunity()
+
+	link, err := s.m.CreateCommunityInvite(&requests.CreateCommunityInvite{
+		CommunityID: community.ID(),
+		Duration:    3600,
+		MaxUses:     5,
+		AutoAccept:  true,
+	})
+	s.Require().NoError(err)
+	s.Require().True(IsStatusSharedURL(link.URL))
+
+	urlData, err := ParseSharedURL(link.URL)
+	s.Require().NoError(err)
+
+	s.Require().NotNil(urlData.Community)
+	s.Require().Equal(community.IDString(), urlData.Community.CommunityID)
+	s.Require().NotNil(urlData.Invite)
+	s.Require().Equal(link.Invite.ID, urlData.Invite.InviteID)
+	s.Require().Equal(link.Invite.ExpiresAt, urlData.Invite.ExpiresAt)
+	s.Require().Equal(uint32(5), urlData.Invite.MaxUses)
+	s.Require().True(urlData.Invite.AutoAccept)
+
+	invite, err := decodeCommunityInvite(urlData.Invite.Data)
+	s.Require().NoError(err)
+	s.Require().Equal(link.Invite.Creator, invite.Creator)
+
+	links, err := s.m.CommunityInvites(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(links, 1)
+	s.Require().Equal(link.URL, links[0].URL)
+}
+
 func (s *MessengerShareUrlsSuite) TestShareCommunityChannelURLWithChatKey() {
 	community := s.createCommunity()
 	channelID := "003cdcd5-e065-48f9-b166-b1a94ac75a11"
@@ -119,7 +119,8 @@ func haveSameElements[T comparable](a []T, b []T, isEqual func(T, T) bool) bool
 
 func savedAddressDataIsEqual(a, b *wallet.SavedAddress) bool {
 	return a.Address == b.Address && a.IsTest == b.IsTest && a.Name == b.Name &&
-		a.ENSName == b.ENSName && a.ChainShortNames == b.ChainShortNames && a.ColorID == b.ColorID
+		a.ENSName == b.ENSName && a.ChainShortNames == b.ChainShortNames && a.ColorID == b.ColorID &&
+		a.ContactID == b.ContactID
 }
 
 func (s *MessengerSyncSavedAddressesSuite) TestSyncExistingSavedAddresses() {
@@ -129,9 +130,10 @@ func (s *MessengerSyncSavedAddressesSuite) TestSyncExistingSavedAddresses() {
 
 	// Add saved addresses to main device
 	sa1 := wallet.SavedAddress{
-		Address: testAddress1,
-		Name:    "TestC1A1",
-		IsTest:  isTestChain1,
+		Address:   testAddress1,
+		Name:      "TestC1A1",
+		IsTest:    isTestChain1,
+		ContactID: s.other.IdentityPublicKeyString(),
 	}
 	sa2 := wallet.SavedAddress{
 		ENSName: "test.ens.eth",
@@ -0,0 +1,52 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// By default the /metrics endpoint is not available.
+// It is exposed only if -metrics flag is set.
+
+const (
+	storenodeRequestSucceeded = "success"
+	storenodeRequestTimedOut  = "timeout"
+	storenodeRequestCanceled  = "canceled"
+	storenodeRequestFailed    = "failure"
+)
+
+var (
+	storenodeRequestsCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "status_storenode_requests_total",
+		Help: "Number of requests sent to storenodes, by outcome.",
+	}, []string{"outcome"})
+	storenodeRequestDuration = prom.NewHistogramVec(prom.HistogramOpts{
+		Name:    "status_storenode_request_duration_seconds",
+		Help:    "The time it took storenodes to answer a request, by outcome.",
+		Buckets: prom.ExponentialBuckets(0.05, 2, 10),
+	}, []string{"outcome"})
+)
+
+func init() {
+	prom.MustRegister(storenodeRequestsCounter)
+	prom.MustRegister(storenodeRequestDuration)
+}
+
+func observeStorenodeRequest(start time.Time, err error) {
+	outcome := storenodeRequestSucceeded
+	switch {
+	case err == nil:
+	case errors.Is(err, context.DeadlineExceeded):
+		outcome = storenodeRequestTimedOut
+	case errors.Is(err, context.Canceled):
+		outcome = storenodeRequestCanceled
+	default:
+		outcome = storenodeRequestFailed
+	}
+
+	storenodeRequestsCounter.WithLabelValues(outcome).Inc()
+	storenodeRequestDuration.WithLabelValues(outcome).Observe(time.Since(start).Seconds())
+}
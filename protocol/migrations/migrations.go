@@ -125,6 +125,14 @@
 // 1707841194_add_profile_showcase_preferences.up.sql (132B)
 // 1708062699_activity_data.up.sql (82B)
 // 1708423707_applied_community_events.up.sql (201B)
+// 1708600000_community_audit_log.up.sql (559B)
+// 1708700000_community_invites.up.sql (694B)
+// 1708900000_discord_message_reactions.up.sql (56B)
+// 1709000000_community_calendar_event_rsvps.up.sql (281B)
+// 1709100000_community_directory.up.sql (887B)
+// 1709300000_pairing_sync_watermarks.up.sql (214B)
+// 1709400000_add_video_data.up.sql (460B)
+// 1709500000_add_file_data.up.sql (272B)
 // README.md (554B)
 // doc.go (850B)
 
@@ -2694,6 +2702,166 @@ func _1708423707_applied_community_eventsUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1708600000_community_audit_logUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\xd1\x6a\x83\x30\x18\x85\xef\xf3\x14\xe7\xae\x15\xfa\x06\x5e\xe9\x9a\x81\x2c\x8b\x9b\x44\x68\xaf\xe4\x27\x8a\x0d\x53\x23\x1a\xc7\xfa\xf6\x63\xcd\x56\x3a\xc9\x46\x6f\xfd\xce\x39\x86\xef\x7f\x28\x78\xa2\x38\x54\x92\x0a\x0e\x6d\xfb\x7e\x19\x8c\x3b\x57\xb4\xd4\xc6\x55\x9d\x6d\xb1\x65\x00\x6e\x88\xa9\xa1\xf8\x41\x41\xe6\x0a\xb2\x14\x62\x77\xe1\xa3\x9d\x8d\x33\x76\x40\x26\xd7\xe8\x44\xf3\x09\xa9\xc8\xd3\x75\x65\x6a\xde\xab\x2b\xf4\xdf\x48\x3b\x3b\x85\xf6\x49\xff\xb1\xee\x68\x6a\x1b\xf7\xbb\x82\x3d\x7f\x4c\x4a\xa1\xb0\xd9\xf8\x90\xee\xac\x7e\x0b\x95\x4d\xdf\xcc\x8e\xfa\x31\xc0\x46\x3a\x77\x96\xea\xd0\xcb\x67\xd3\x0e\xe4\x96\xa9\x09\xc1\x97\x22\x7b\x4e\x8a\x23\x9e\xf8\x11\xdb\x5b\x6d\xbb\xab\xa4\x88\x45\x31\x63\xdf\xe6\x4b\x99\xbd\x96\x1c\x99\xdc\xf3\x03\x4c\xfd\x51\x05\x8e\xe0\x35\xe5\x32\x74\xa0\xd5\x3f\xbe\x92\x51\xfc\x33\xfe\xff\xaa\xb7\x7d\xd7\xec\x25\x1a\xc5\xec\x33\x00\x00\xff\xff\x72\xcc\xd6\x3f\x2f\x02\x00\x00")
+
+func _1708600000_community_audit_logUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1708600000_community_audit_logUpSql,
+		"1708600000_community_audit_log.up.sql",
+	)
+}
+
+func _1708600000_community_audit_logUpSql() (*asset, error) {
+	bytes, err := _1708600000_community_audit_logUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1708600000_community_audit_log.up.sql", size: 559, mode: os.FileMode(0644), modTime: time.Unix(1792394409, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6, 0x3f, 0x85, 0x9c, 0x75, 0x34, 0xb6, 0x60, 0x9f, 0xe, 0x70, 0x56, 0x6d, 0xcb, 0xe8, 0x56, 0x7b, 0xe2, 0x42, 0x48, 0x26, 0x67, 0xf5, 0xbb, 0x75, 0x63, 0xbe, 0xdf, 0xc5, 0x86, 0x7e, 0xc}}
+	return a, nil
+}
+
+var __1708700000_community_invitesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x41\x6b\xc2\x30\x14\xc7\xef\xfd\x14\xef\xa6\x42\x0f\xbb\xef\x94\x6e\x11\x64\x59\x3b\xba\x14\xe6\x29\x64\xc9\x63\x0b\x36\x49\x49\x52\xd1\x6f\x3f\xb0\x3a\x9c\x96\x4e\xaf\xef\xf7\x0b\xf9\xbf\xf7\x7f\xaa\x29\xe1\x14\x38\x29\x18\x05\xe5\xad\xed\x9d\x49\x7b\x61\xdc\xd6\x24\x8c\x30\xcf\x00\x00\x8c\x06\x4e\x3f\x38\x94\x15\x87\xb2\x61\x2c\x3f\x4c\xcf\xec\x71\x1e\x50\x26\x1f\x46\x51\xeb\xd5\x06\x56\xe5\xe5\x1c\x77\x9d\x09\x18\x85\x4c\x7f\x20\x3c\xd3\x25\x69\x18\x87\x87\x41\xb3\x72\x27\xfa\x88\x71\x52\x52\xdf\xd2\x39\x6c\xaf\xc2\xfd\x7a\xb3\xd9\x20\xca\x3e\x79\x21\x95\xc2\x2e\x41\x51\x55\x8c\x92\xf2\x5a\x5e\x12\xf6\x4e\x07\xbf\x93\xfb\xd6\x4b\x0d\x05\xab\x8a\x8b\xfc\xd1\x7c\x39\x99\xfa\x80\x63\x30\xe0\xd6\x6f\x50\xdf\xf4\xc7\x5b\xbd\x7a\x25\xf5\x1a\x5e\xe8\x1a\xe6\xe7\x87\xce\xc1\xe8\x45\xb6\x78\xcc\xb2\xc9\xe6\x44\x40\x8d\xb6\x4b\xc6\xbb\x53\x89\xff\xd5\x75\x7c\x38\x0e\x2d\xda\x4f\xbc\xab\xca\xe1\xa0\x53\xeb\xf2\xba\xb9\x61\xdb\x53\xaa\xfc\x98\xe1\xb0\xfc\x4f\x00\x00\x00\xff\xff\x40\x72\x55\x55\xb6\x02\x00\x00")
+
+func _1708700000_community_invitesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1708700000_community_invitesUpSql,
+		"1708700000_community_invites.up.sql",
+	)
+}
+
+func _1708700000_community_invitesUpSql() (*asset, error) {
+	bytes, err := _1708700000_community_invitesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1708700000_community_invites.up.sql", size: 694, mode: os.FileMode(0644), modTime: time.Unix(1792422899, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd5, 0x6, 0x79, 0xf2, 0x3e, 0x13, 0x11, 0xe6, 0x1e, 0xbf, 0x11, 0x6a, 0xc8, 0xe8, 0xc, 0xf6, 0x9f, 0x6c, 0xc9, 0x93, 0x80, 0x50, 0xc, 0x99, 0x36, 0x19, 0x43, 0x6e, 0xe5, 0x9b, 0x2f, 0x8f}}
+	return a, nil
+}
+
+var __1708900000_discord_message_reactionsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x72\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x48\xc9\x2c\x4e\xce\x2f\x4a\x89\xcf\x4d\x2d\x2e\x4e\x4c\x4f\x2d\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x28\x4a\x4d\x4c\x2e\xc9\xcc\xcf\x2b\x56\x70\xf2\xf1\x77\xb2\xe6\x02\x04\x00\x00\xff\xff\xe0\x9d\xa9\xca\x38\x00\x00\x00")
+
+func _1708900000_discord_message_reactionsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1708900000_discord_message_reactionsUpSql,
+		"1708900000_discord_message_reactions.up.sql",
+	)
+}
+
+func _1708900000_discord_message_reactionsUpSql() (*asset, error) {
+	bytes, err := _1708900000_discord_message_reactionsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1708900000_discord_message_reactions.up.sql", size: 56, mode: os.FileMode(0644), modTime: time.Unix(1792398238, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9e, 0x56, 0x61, 0x4f, 0xad, 0xc1, 0xb4, 0x9b, 0x16, 0x2b, 0xbc, 0x69, 0x3c, 0x2c, 0xa3, 0x74, 0xa5, 0x7c, 0xa9, 0x69, 0xcc, 0x63, 0xa0, 0xc4, 0x8d, 0xaf, 0x3a, 0xcd, 0x5f, 0xfc, 0x7d, 0x77}}
+	return a, nil
+}
+
+var __1709000000_community_calendar_event_rsvpsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\x31\xeb\xc2\x30\x10\x47\xf7\x7e\x8a\x1b\x5b\xe8\xf0\xdf\xff\x53\xd4\x08\xc5\x58\xa5\xa4\x60\xa7\x10\x93\x1b\x82\x4d\x2a\xc9\xb5\xe0\xb7\x17\xac\x48\x95\xae\xf7\xde\xc1\xfb\x6d\x1b\xce\x24\x07\xc9\x36\x82\x83\x19\xbc\x1f\x83\xa3\x87\x32\xba\xc7\x60\x75\x54\x38\x61\x20\x15\xd3\x74\x4f\x90\x67\x00\xb0\x90\x9c\x05\xc9\x2f\x12\xea\x93\x84\xba\x15\xa2\x7c\xf1\xf9\x63\x9d\x79\xf4\x57\x8c\x6b\x24\x91\xa6\x31\x41\x55\xff\x02\xd3\x0f\xe6\xb6\x72\x8f\xe8\x5d\xb0\x68\x55\x22\x1d\x49\x91\xf3\xf8\x65\xc1\x8e\xef\x59\x2b\x24\xfc\xcd\xfe\xb9\xa9\x8e\xac\xe9\xe0\xc0\x3b\xc8\x97\x1b\xca\x4f\x71\xf9\xee\x2b\xb2\xe2\x3f\x7b\x06\x00\x00\xff\xff\x82\x2f\x5f\x4b\x19\x01\x00\x00")
+
+func _1709000000_community_calendar_event_rsvpsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1709000000_community_calendar_event_rsvpsUpSql,
+		"1709000000_community_calendar_event_rsvps.up.sql",
+	)
+}
+
+func _1709000000_community_calendar_event_rsvpsUpSql() (*asset, error) {
+	bytes, err := _1709000000_community_calendar_event_rsvpsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1709000000_community_calendar_event_rsvps.up.sql", size: 281, mode: os.FileMode(0644), modTime: time.Unix(1792399070, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x19, 0xf4, 0x52, 0x29, 0x68, 0x23, 0x4, 0xe5, 0xa4, 0xa1, 0x9, 0x41, 0x27, 0xdf, 0x12, 0x4a, 0x59, 0x7a, 0x67, 0x9, 0x3, 0x9e, 0xcc, 0x24, 0x9a, 0xad, 0xdc, 0x10, 0x2, 0x49, 0xed, 0x83}}
+	return a, nil
+}
+
+var __1709100000_community_directoryUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\x4d\x6b\xdc\x30\x10\xbd\xfb\x57\xcc\x2d\x1b\x70\xa0\x85\xd2\x4b\xc9\xc1\x75\x94\x62\xea\xca\xc1\x95\x4b\x72\x32\xaa\x34\x89\x45\xec\x51\x91\xe4\xa6\xdb\x5f\x5f\x6c\x2f\x5b\x79\xd9\x8f\xeb\x9b\x37\x6f\x9e\xde\x43\x79\xcd\x32\xc1\x40\x64\x9f\x4b\x06\xca\x0e\xc3\x48\x26\x6c\x5b\x6d\x1c\xaa\x60\xdd\x16\x36\x09\x00\x44\x13\xa3\x41\xb0\x47\x01\x0f\x75\xf1\x2d\xab\x9f\xe0\x2b\x7b\x82\x8a\x43\x5e\xf1\xfb\xb2\xc8\x05\xd4\xec\xa1\xcc\x72\x96\x2e\x6b\xbd\x55\xaf\x50\x70\x01\xbc\x12\xc0\x9b\xb2\x5c\x70\x92\x03\x2e\x32\x6b\x5c\xa3\x57\xce\xfc\x0a\xc6\xd2\x7a\x0c\x77\xec\x3e\x6b\x4a\x01\x57\x57\x0b\x73\xc0\xe1\x27\x3a\xdf\x2a\x3b\x52\x58\x5d\xd8\x53\xdf\xed\x3c\xd8\xde\xba\x0b\x6a\xbe\x93\x4e\xb7\xaa\x1f\x7d\x40\x37\xa9\xc5\xb0\x21\x8d\x7f\xfe\x83\x0e\x15\x9a\xdf\xa8\x5b\xb9\xbe\x9b\x5c\x7f\x4a\x92\x5d\x9c\x05\xbf\x63\x8f\xc7\xe2\x6c\xe3\xed\x8a\x1f\xa3\x6c\x22\x4a\x24\x79\xb2\xa1\x36\xc8\x17\x7f\xb2\xa6\x75\xbe\x41\xbe\x1c\x83\xe3\x2e\x37\xb1\x46\x3a\x6d\x5c\xc7\x2f\xfb\x51\xd4\xa2\xc9\xca\x33\x76\x3c\x4a\xa7\x3a\x68\xbe\x17\xfc\x0b\x3c\x07\xff\xe1\x40\x71\xea\x3e\x8d\x9b\x9e\x8f\xf8\x14\xc8\x86\x39\x6a\xd4\xb7\x07\x1e\xec\x2b\x92\xf9\x8b\xb7\x23\x19\x65\x35\x7e\x7c\x3f\x19\xba\xb9\x81\x7c\x47\x33\xe8\xc1\x3e\xc3\x5b\x67\x54\x07\x6f\x08\xd2\x21\x84\x0e\x41\x59\x0a\xce\xf6\x40\x56\x23\x48\xd2\x10\x3a\x19\xe6\xa9\x24\xb2\x23\x29\xd4\x60\x68\xa6\xee\xfd\x5f\xce\xbb\x37\x3e\x18\x3a\x93\xf9\x85\xaf\x31\xc5\xf9\x2f\x00\x00\xff\xff\x8c\x1f\x07\x6f\x77\x03\x00\x00")
+
+func _1709100000_community_directoryUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1709100000_community_directoryUpSql,
+		"1709100000_community_directory.up.sql",
+	)
+}
+
+func _1709100000_community_directoryUpSql() (*asset, error) {
+	bytes, err := _1709100000_community_directoryUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1709100000_community_directory.up.sql", size: 887, mode: os.FileMode(0644), modTime: time.Unix(1792400386, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x34, 0xff, 0x6c, 0xc0, 0xac, 0x2f, 0x21, 0x65, 0x49, 0x6c, 0x55, 0x1b, 0xa8, 0x94, 0xe9, 0x5f, 0xd3, 0x67, 0xab, 0xf6, 0x26, 0x13, 0x9f, 0xcf, 0x7a, 0xa3, 0x6, 0x66, 0xf7, 0x18, 0x3, 0xad}}
+	return a, nil
+}
+
+var __1709300000_pairing_sync_watermarksUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcd\x41\xaa\x83\x30\x18\x04\xe0\xbd\xa7\x98\xa5\x82\x37\x78\xab\x18\xfe\x07\xd2\x34\x91\x90\x42\x5d\x49\x50\x91\x1f\x6d\x2c\x26\x50\xbc\x7d\xa1\x74\x15\xba\x9d\x6f\x86\x91\x96\x84\x23\x38\xd1\x28\xc2\xd3\xf3\xc1\x61\x19\xe2\x19\xc6\xe1\xe5\xd3\x7c\x3c\xfc\xb1\x46\x94\x05\x00\x70\x88\xc9\x6f\x9b\x4f\xbc\x87\x81\x27\x38\xba\x3b\x68\xe3\xa0\x6f\x4a\xd5\x9f\xca\x1c\x12\xa7\x13\xad\xce\x61\xdc\xf6\x71\xfd\x91\x4f\xbc\xcc\x31\xa1\x51\xa6\xc9\xa4\xb3\xed\x55\xd8\x1e\x17\xea\x51\x66\xd7\xf5\x77\x57\xc1\x68\x48\xa3\xff\x55\x2b\x1d\x2c\x75\x4a\x48\x2a\xaa\xbf\xe2\x1d\x00\x00\xff\xff\x74\x36\x75\xc0\xd6\x00\x00\x00")
+
+func _1709300000_pairing_sync_watermarksUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1709300000_pairing_sync_watermarksUpSql,
+		"1709300000_pairing_sync_watermarks.up.sql",
+	)
+}
+
+func _1709300000_pairing_sync_watermarksUpSql() (*asset, error) {
+	bytes, err := _1709300000_pairing_sync_watermarksUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1709300000_pairing_sync_watermarks.up.sql", size: 214, mode: os.FileMode(0644), modTime: time.Unix(1792403784, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc7, 0xf2, 0x8e, 0x75, 0xa0, 0x6d, 0x18, 0xf1, 0x5, 0x3a, 0xaa, 0x94, 0xbe, 0x89, 0xe7, 0x4a, 0x21, 0xa, 0x3b, 0x7d, 0x1c, 0x36, 0xe3, 0x18, 0xb4, 0xa4, 0x8f, 0x4c, 0x11, 0xf3, 0x2a, 0x1d}}
+	return a, nil
+}
+
+var __1709400000_add_video_dataUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\xd0\x41\x0a\xc2\x30\x10\x85\xe1\xbd\xa7\x98\x7b\xb8\x4a\x6c\x16\x42\x6c\x41\x22\xb8\x0b\x63\x33\x36\x81\xa6\x91\x4c\xa2\x78\x7b\x77\x42\x97\x73\x80\x8f\xc7\xfb\x95\x75\xe6\x0a\x4e\x69\x6b\xa0\x33\x55\x9f\x89\x19\x17\x62\x50\xc3\x00\xa7\xc9\xde\x2e\x23\xbc\x53\xa0\xe2\x5f\xf8\x5d\x0b\x06\xd0\x76\xd2\xc7\x83\x00\x3e\x4b\xcd\xd8\xe0\x3c\x3a\x11\x9b\x4b\xa0\x19\x9c\xb9\xcb\x58\xe8\x15\x5b\x2a\x9b\xcf\x2c\x9e\xfc\xa4\xd0\xa2\x58\x45\x4a\x4b\x94\xff\x6b\xb1\xe7\xc7\x86\x69\x95\x17\xfd\xd3\x5d\xdb\x5f\x00\x00\x00\xff\xff\x6e\x03\xb8\xa0\xcc\x01\x00\x00")
+
+func _1709400000_add_video_dataUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1709400000_add_video_dataUpSql,
+		"1709400000_add_video_data.up.sql",
+	)
+}
+
+func _1709400000_add_video_dataUpSql() (*asset, error) {
+	bytes, err := _1709400000_add_video_dataUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1709400000_add_video_data.up.sql", size: 460, mode: os.FileMode(0644), modTime: time.Unix(1792406549, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x74, 0x73, 0xd, 0x2c, 0x32, 0xf, 0x84, 0x7f, 0xbd, 0xf, 0x58, 0x32, 0xd, 0x80, 0x72, 0x1e, 0x2f, 0x42, 0x40, 0xc0, 0xc8, 0x92, 0x29, 0x42, 0xad, 0x2a, 0x49, 0xf5, 0x6a, 0x56, 0x71, 0x16}}
+	return a, nil
+}
+
+var __1709500000_add_file_dataUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x72\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x2d\x4e\x2d\x8a\xcf\x4d\x2d\x2e\x4e\x4c\x4f\x2d\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x48\xcb\xcc\x49\x8d\x2f\x48\xac\xcc\xc9\x4f\x4c\x51\x70\xf2\xf1\x77\xb2\xe6\x22\x5e\x5f\x5e\x62\x6e\xaa\x42\x88\x6b\x44\x08\x29\x9a\x72\x33\x73\x53\xe3\x4b\x2a\x0b\x48\xd7\x59\x9c\x59\x95\xaa\xe0\xe9\x47\x92\x9e\x8c\xc4\xe2\x0c\xa8\xbf\x00\x01\x00\x00\xff\xff\x76\x48\xfe\x58\x10\x01\x00\x00")
+
+func _1709500000_add_file_dataUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1709500000_add_file_dataUpSql,
+		"1709500000_add_file_data.up.sql",
+	)
+}
+
+func _1709500000_add_file_dataUpSql() (*asset, error) {
+	bytes, err := _1709500000_add_file_dataUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1709500000_add_file_data.up.sql", size: 272, mode: os.FileMode(0644), modTime: time.Unix(1792407032, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7d, 0xf9, 0x99, 0x1a, 0x7f, 0x7a, 0x83, 0xb, 0x6c, 0xe0, 0x5d, 0xac, 0x7a, 0xfc, 0x6c, 0xaa, 0x91, 0x41, 0x78, 0x7b, 0x15, 0xde, 0xec, 0xb0, 0xb1, 0xdb, 0xe3, 0xd6, 0x8c, 0xa0, 0xe7, 0x67}}
+	return a, nil
+}
+
 var _readmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\xc1\xce\xd3\x30\x10\x84\xef\x7e\x8a\x91\x7a\x01\xa9\x2a\x8f\xc0\x0d\x71\x82\x03\x48\x1c\xc9\x36\x9e\x36\x96\x1c\x6f\xf0\xae\x93\xe6\xed\x91\xa3\xc2\xdf\xff\x66\xed\xd8\x33\xdf\x78\x4f\xa7\x13\xbe\xea\x06\x57\x6c\x35\x39\x31\xa7\x7b\x15\x4f\x5a\xec\x73\x08\xbf\x08\x2d\x79\x7f\x4a\x43\x5b\x86\x17\xfd\x8c\x21\xea\x56\x5e\x47\x90\x4a\x14\x75\x48\xde\x64\x37\x2c\x6a\x96\xae\x99\x48\x05\xf6\x27\x77\x13\xad\x08\xae\x8a\x51\xe7\x25\xf3\xf1\xa9\x9f\xf9\x58\x58\x2c\xad\xbc\xe0\x8b\x56\xf0\x21\x5d\xeb\x4c\x95\xb3\xae\x84\x60\xd4\xdc\xe6\x82\x5d\x1b\x36\x6d\x39\x62\x92\xf5\xb8\x11\xdb\x92\xd3\x28\xce\xe0\x13\xe1\x72\xcd\x3c\x63\xd4\x65\x87\xae\xac\xe8\xc3\x28\x2e\x67\x44\x66\x3a\x21\x25\xa2\x72\xac\x14\x67\xbc\x84\x9f\x53\x32\x8c\x52\x70\x25\x56\xd6\xfd\x8d\x05\x37\xad\x30\x9d\x9f\xa6\x86\x0f\xcd\x58\x7f\xcf\x34\x93\x3b\xed\x90\x9f\xa4\x1f\xcf\x30\x85\x4d\x07\x58\xaf\x7f\x25\xc4\x9d\xf3\x72\x64\x84\xd0\x7f\xf9\x9b\x3a\x2d\x84\xef\x85\x48\x66\x8d\xd8\x88\x9b\x8c\x8c\x98\x5b\xf6\x74\x14\x4e\x33\x0d\xc9\xe0\x93\x38\xda\x12\xc5\x69\xbd\xe4\xf0\x2e\x7a\x78\x07\x1c\xfe\x13\x9f\x91\x29\x31\x95\x7b\x7f\x62\x59\x37\xb4\xe5\x5e\x25\xfe\x33\xee\xd5\x53\x71\xd6\xda\x3a\xd8\xcb\xde\x2e\xf8\xa1\x90\x55\x53\x0c\xc7\xaa\x0d\xe9\x76\x14\x29\x1c\x7b\x68\xdd\x2f\xe1\x6f\x00\x00\x00\xff\xff\x3c\x0a\xc2\xfe\x2a\x02\x00\x00")
 
 func readmeMdBytes() ([]byte, error) {
@@ -2950,8 +3118,16 @@ var _bindata = map[string]func() (*asset, error){
 	"1707841194_add_profile_showcase_preferences.up.sql":                          _1707841194_add_profile_showcase_preferencesUpSql,
 	"1708062699_activity_data.up.sql":                                             _1708062699_activity_dataUpSql,
 	"1708423707_applied_community_events.up.sql":                                  _1708423707_applied_community_eventsUpSql,
-	"README.md": readmeMd,
-	"doc.go":    docGo,
+	"1708600000_community_audit_log.up.sql":                                       _1708600000_community_audit_logUpSql,
+	"1708700000_community_invites.up.sql":                                         _1708700000_community_invitesUpSql,
+	"1708900000_discord_message_reactions.up.sql":                                 _1708900000_discord_message_reactionsUpSql,
+	"1709000000_community_calendar_event_rsvps.up.sql":                            _1709000000_community_calendar_event_rsvpsUpSql,
+	"1709100000_community_directory.up.sql":                                       _1709100000_community_directoryUpSql,
+	"1709300000_pairing_sync_watermarks.up.sql":                                   _1709300000_pairing_sync_watermarksUpSql,
+	"1709400000_add_video_data.up.sql":                                            _1709400000_add_video_dataUpSql,
+	"1709500000_add_file_data.up.sql":                                             _1709500000_add_file_dataUpSql,
+	"README.md":                                                                   readmeMd,
+	"doc.go":                                                                      docGo,
 }
 
 // AssetDebug is true if the assets were built with the debug flag enabled.
@@ -3125,8 +3301,16 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1707841194_add_profile_showcase_preferences.up.sql":                          {_1707841194_add_profile_showcase_preferencesUpSql, map[string]*bintree{}},
 	"1708062699_activity_data.up.sql":                                             {_1708062699_activity_dataUpSql, map[string]*bintree{}},
 	"1708423707_applied_community_events.up.sql":                                  {_1708423707_applied_community_eventsUpSql, map[string]*bintree{}},
-	"README.md": {readmeMd, map[string]*bintree{}},
-	"doc.go":    {docGo, map[string]*bintree{}},
+	"1708600000_community_audit_log.up.sql":                                       {_1708600000_community_audit_logUpSql, map[string]*bintree{}},
+	"1708700000_community_invites.up.sql":                                         {_1708700000_community_invitesUpSql, map[string]*bintree{}},
+	"1708900000_discord_message_reactions.up.sql":                                 {_1708900000_discord_message_reactionsUpSql, map[string]*bintree{}},
+	"1709000000_community_calendar_event_rsvps.up.sql":                            {_1709000000_community_calendar_event_rsvpsUpSql, map[string]*bintree{}},
+	"1709100000_community_directory.up.sql":                                       {_1709100000_community_directoryUpSql, map[string]*bintree{}},
+	"1709300000_pairing_sync_watermarks.up.sql":                                   {_1709300000_pairing_sync_watermarksUpSql, map[string]*bintree{}},
+	"1709400000_add_video_data.up.sql":                                            {_1709400000_add_video_dataUpSql, map[string]*bintree{}},
+	"1709500000_add_file_data.up.sql":                                             {_1709500000_add_file_dataUpSql, map[string]*bintree{}},
+	"README.md":                                                                   {readmeMd, map[string]*bintree{}},
+	"doc.go":                                                                      {docGo, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory.
@@ -0,0 +1,17 @@
+CREATE TABLE community_audit_log (
+    community_id TEXT NOT NULL,
+    position INT NOT NULL,
+    hash BLOB NOT NULL,
+    prev_hash BLOB,
+    actor TEXT NOT NULL,
+    action INT NOT NULL,
+    target TEXT NOT NULL DEFAULT '',
+    clock INT NOT NULL,
+    timestamp INT NOT NULL,
+    payload BLOB NOT NULL,
+    signature BLOB NOT NULL,
+    PRIMARY KEY (community_id, position)
+);
+
+CREATE UNIQUE INDEX idx_community_audit_log_hash ON community_audit_log(community_id, hash);
+CREATE INDEX idx_community_audit_log_actor ON community_audit_log(community_id, actor);
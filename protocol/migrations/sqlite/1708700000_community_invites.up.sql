@@ -0,0 +1,23 @@
+CREATE TABLE community_invites (
+    id TEXT NOT NULL,
+    community_id TEXT NOT NULL,
+    creator TEXT NOT NULL,
+    clock INT NOT NULL,
+    expires_at INT NOT NULL DEFAULT 0,
+    max_uses INT NOT NULL DEFAULT 0,
+    channel_id TEXT NOT NULL DEFAULT '',
+    auto_accept BOOLEAN NOT NULL DEFAULT FALSE,
+    payload BLOB NOT NULL,
+    signature BLOB NOT NULL,
+    revoked BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (community_id, id)
+);
+
+CREATE TABLE community_invite_redemptions (
+    community_id TEXT NOT NULL,
+    invite_id TEXT NOT NULL,
+    member TEXT NOT NULL,
+    clock INT NOT NULL,
+    accepted BOOLEAN NOT NULL DEFAULT TRUE,
+    PRIMARY KEY (community_id, invite_id, member)
+);
@@ -0,0 +1 @@
+ALTER TABLE discord_messages ADD COLUMN reactions BLOB;
@@ -0,0 +1,9 @@
+CREATE TABLE community_calendar_event_rsvps (
+    community_id TEXT NOT NULL,
+    event_id TEXT NOT NULL,
+    member TEXT NOT NULL,
+    status INT NOT NULL,
+    clock INT NOT NULL,
+    reminded_start_time INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (community_id, event_id, member)
+);
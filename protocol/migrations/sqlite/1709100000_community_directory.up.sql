@@ -0,0 +1,26 @@
+CREATE TABLE community_directory (
+    community_id TEXT PRIMARY KEY ON CONFLICT REPLACE,
+    clock INT NOT NULL,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    members_count INT NOT NULL DEFAULT 0,
+    color TEXT NOT NULL DEFAULT '',
+    shard_cluster INT,
+    shard_index INT,
+    received_at INT NOT NULL
+);
+
+CREATE INDEX community_directory_received_at ON community_directory(received_at);
+
+CREATE TABLE community_directory_tags (
+    community_id TEXT NOT NULL,
+    tag TEXT NOT NULL,
+    PRIMARY KEY (community_id, tag)
+);
+
+CREATE VIRTUAL TABLE community_directory_search USING fts4(community_id, name, description, tags, notindexed=community_id, tokenize=unicode61);
+
+-- Communities of which we are the control node and that are announced in the directory
+CREATE TABLE community_directory_listings (
+    community_id TEXT PRIMARY KEY ON CONFLICT REPLACE
+);
@@ -0,0 +1,7 @@
+CREATE TABLE pairing_sync_watermarks (
+    installation_id TEXT NOT NULL,
+    entity INT NOT NULL,
+    clock INT NOT NULL,
+    digest BLOB NOT NULL,
+    PRIMARY KEY (installation_id, digest) ON CONFLICT REPLACE
+);
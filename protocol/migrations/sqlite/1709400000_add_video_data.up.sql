@@ -0,0 +1,8 @@
+ALTER TABLE user_messages ADD COLUMN video_payload BLOB;
+ALTER TABLE user_messages ADD COLUMN video_format INT;
+ALTER TABLE user_messages ADD COLUMN video_codec TEXT;
+ALTER TABLE user_messages ADD COLUMN video_duration_ms INT;
+ALTER TABLE user_messages ADD COLUMN video_width INT;
+ALTER TABLE user_messages ADD COLUMN video_height INT;
+ALTER TABLE user_messages ADD COLUMN video_thumbnail BLOB;
+ALTER TABLE user_messages ADD COLUMN video_thumbnail_format INT;
@@ -0,0 +1,5 @@
+ALTER TABLE user_messages ADD COLUMN file_payload BLOB;
+ALTER TABLE user_messages ADD COLUMN file_name TEXT;
+ALTER TABLE user_messages ADD COLUMN file_mime_type TEXT;
+ALTER TABLE user_messages ADD COLUMN file_size INT;
+ALTER TABLE user_messages ADD COLUMN file_hash BLOB;
@@ -61,3 +61,105 @@ func (db sqlitePersistence) SelectMessagesCountForChatsByPeriod(chatIDs []string
 
 	return count, nil
 }
+
+const selectActiveMembersCountQuery = "SELECT COUNT(DISTINCT source) FROM user_messages WHERE %s whisper_timestamp >= ? AND whisper_timestamp <= ?"
+const selectActiveMembersQuery = "SELECT DISTINCT source FROM user_messages WHERE %s whisper_timestamp >= ? AND whisper_timestamp <= ?"
+const selectPostersQuery = "SELECT source, COUNT(*) AS messages_count FROM user_messages WHERE %s whisper_timestamp >= ? AND whisper_timestamp <= ? GROUP BY source ORDER BY messages_count DESC, source"
+
+// Weekdays start on sunday, as in `strftime`
+const selectHeatmapQuery = `SELECT local_chat_id,
+	CAST(strftime('%%w', whisper_timestamp / 1000 + ?, 'unixepoch') AS INTEGER),
+	CAST(strftime('%%H', whisper_timestamp / 1000 + ?, 'unixepoch') AS INTEGER),
+	COUNT(*)
+	FROM user_messages WHERE %s whisper_timestamp >= ? AND whisper_timestamp <= ?
+	GROUP BY 1, 2, 3`
+
+func (db sqlitePersistence) SelectActiveMembersCountForChatsByPeriod(chatIDs []string, startTimestamp uint64, endTimestamp uint64) (int, error) {
+	query := fmt.Sprintf(selectActiveMembersCountQuery, querySeveralChats(chatIDs))
+
+	var count int
+	if err := db.db.QueryRow(query, startTimestamp, endTimestamp).Scan(&count); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (db sqlitePersistence) SelectActiveMembersForChatsByPeriod(chatIDs []string, startTimestamp uint64, endTimestamp uint64) ([]string, error) {
+	query := fmt.Sprintf(selectActiveMembersQuery, querySeveralChats(chatIDs))
+
+	rows, err := db.db.Query(query, startTimestamp, endTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []string
+	for rows.Next() {
+		var member string
+		err := rows.Scan(&member)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+
+	return members, rows.Err()
+}
+
+// SelectPostersForChatsByPeriod returns the authors of messages, the most active first
+func (db sqlitePersistence) SelectPostersForChatsByPeriod(chatIDs []string, startTimestamp uint64, endTimestamp uint64) ([]MetricsPosterResponse, error) {
+	query := fmt.Sprintf(selectPostersQuery, querySeveralChats(chatIDs))
+
+	rows, err := db.db.Query(query, startTimestamp, endTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var posters []MetricsPosterResponse
+	for rows.Next() {
+		var poster MetricsPosterResponse
+		err := rows.Scan(&poster.PublicKey, &poster.Count)
+		if err != nil {
+			return nil, err
+		}
+		posters = append(posters, poster)
+	}
+
+	return posters, rows.Err()
+}
+
+// SelectMessagesHeatmapForChatsByPeriod counts the messages of each chat by weekday and hour,
+// timezoneOffset is added to the timestamps, in seconds
+func (db sqlitePersistence) SelectMessagesHeatmapForChatsByPeriod(chatIDs []string, startTimestamp uint64, endTimestamp uint64, timezoneOffset int64) (map[string]*MetricsHeatmap, error) {
+	query := fmt.Sprintf(selectHeatmapQuery, querySeveralChats(chatIDs))
+
+	rows, err := db.db.Query(query, timezoneOffset, timezoneOffset, startTimestamp, endTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	heatmaps := make(map[string]*MetricsHeatmap)
+	for rows.Next() {
+		var chatID string
+		var weekday, hour, count int
+		err := rows.Scan(&chatID, &weekday, &hour, &count)
+		if err != nil {
+			return nil, err
+		}
+
+		heatmap, ok := heatmaps[chatID]
+		if !ok {
+			heatmap = &MetricsHeatmap{}
+			heatmaps[chatID] = heatmap
+		}
+		heatmap[weekday][hour] = count
+	}
+
+	return heatmaps, rows.Err()
+}
@@ -0,0 +1,339 @@
+package protocol
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	pairingHistoryMessagesTable  = "user_messages"
+	pairingHistoryPinsTable      = "pin_messages"
+	pairingHistoryReactionsTable = "emoji_reactions"
+
+	// pairingHistoryMaxChunkMessages bounds the number of messages of a chunk, whatever their size
+	pairingHistoryMaxChunkMessages = 500
+)
+
+// pairingHistoryMediaColumns are transferred as PairingHistoryMedia, apart from the rows of the messages
+var pairingHistoryMediaColumns = map[string]protobuf.PairingHistoryMedia_Type{
+	"image_payload":   protobuf.PairingHistoryMedia_IMAGE,
+	"audio_payload":   protobuf.PairingHistoryMedia_AUDIO,
+	"video_payload":   protobuf.PairingHistoryMedia_VIDEO,
+	"video_thumbnail": protobuf.PairingHistoryMedia_VIDEO_THUMBNAIL,
+	"file_payload":    protobuf.PairingHistoryMedia_FILE,
+}
+
+// pairingHistoryTables are the only tables a history chunk may write to
+var pairingHistoryTables = map[string]bool{
+	pairingHistoryMessagesTable:  true,
+	pairingHistoryPinsTable:      true,
+	pairingHistoryReactionsTable: true,
+}
+
+func toPairingHistoryValue(value interface{}) (*protobuf.PairingHistoryValue, error) {
+	switch v := value.(type) {
+	case nil:
+		return &protobuf.PairingHistoryValue{}, nil
+	case int64:
+		return &protobuf.PairingHistoryValue{Value: &protobuf.PairingHistoryValue_Integer{Integer: v}}, nil
+	case bool:
+		var integer int64
+		if v {
+			integer = 1
+		}
+		return &protobuf.PairingHistoryValue{Value: &protobuf.PairingHistoryValue_Integer{Integer: integer}}, nil
+	case float64:
+		return &protobuf.PairingHistoryValue{Value: &protobuf.PairingHistoryValue_Real{Real: v}}, nil
+	case string:
+		return &protobuf.PairingHistoryValue{Value: &protobuf.PairingHistoryValue_Text{Text: v}}, nil
+	case []byte:
+		return &protobuf.PairingHistoryValue{Value: &protobuf.PairingHistoryValue_Blob{Blob: v}}, nil
+	case time.Time:
+		return &protobuf.PairingHistoryValue{Value: &protobuf.PairingHistoryValue_Integer{Integer: v.UnixMilli()}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+func fromPairingHistoryValue(value *protobuf.PairingHistoryValue) interface{} {
+	switch v := value.Value.(type) {
+	case *protobuf.PairingHistoryValue_Integer:
+		return v.Integer
+	case *protobuf.PairingHistoryValue_Real:
+		return v.Real
+	case *protobuf.PairingHistoryValue_Text:
+		return v.Text
+	case *protobuf.PairingHistoryValue_Blob:
+		return v.Blob
+	default:
+		return nil
+	}
+}
+
+// scanPairingHistoryRow scans the current row into generic values
+func scanPairingHistoryRow(rows *sql.Rows, columnsCount int) ([]interface{}, error) {
+	values := make([]interface{}, columnsCount)
+	pointers := make([]interface{}, columnsCount)
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	return values, rows.Scan(pointers...)
+}
+
+func (db sqlitePersistence) pairingHistoryTable(name string, messageIDs []interface{}) (*protobuf.PairingHistoryTable, error) {
+	inVector := strings.Repeat("?, ", len(messageIDs)-1) + "?"
+	rows, err := db.db.Query("SELECT * FROM "+name+" WHERE message_id IN ("+inVector+")", messageIDs...) // nolint: gosec
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	table := &protobuf.PairingHistoryTable{Name: name, Columns: columns}
+	for rows.Next() {
+		values, err := scanPairingHistoryRow(rows, len(columns))
+		if err != nil {
+			return nil, err
+		}
+
+		row := new(protobuf.PairingHistoryRow)
+		for _, value := range values {
+			v, err := toPairingHistoryValue(value)
+			if err != nil {
+				return nil, err
+			}
+			row.Values = append(row.Values, v)
+		}
+		table.Rows = append(table.Rows, row)
+	}
+
+	return table, rows.Err()
+}
+
+// PairingHistoryChunk returns the messages with a timestamp after since that follow the cursor,
+// along with their pins, reactions and media. Messages are added to the chunk until it reaches maxSize bytes.
+func (db sqlitePersistence) PairingHistoryChunk(since uint64, cursor uint64, maxSize int) (*protobuf.PairingHistoryChunk, error) {
+	chunk := &protobuf.PairingHistoryChunk{Cursor: cursor}
+
+	if cursor == 0 {
+		err := db.db.QueryRow(`SELECT COUNT(*) FROM user_messages WHERE timestamp >= ?`, since).Scan(&chunk.TotalMessagesCount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := db.db.Query(`
+  SELECT
+    rowid,
+    *
+  FROM
+    user_messages
+  WHERE
+    rowid > ? AND timestamp >= ?
+  ORDER BY
+    rowid
+  LIMIT ?
+  `, cursor, since, pairingHistoryMaxChunkMessages)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	messages := &protobuf.PairingHistoryTable{Name: pairingHistoryMessagesTable}
+	idIndex := -1
+	for i, column := range columns[1:] {
+		if column == "id" {
+			idIndex = i + 1
+		}
+		if _, ok := pairingHistoryMediaColumns[column]; !ok {
+			messages.Columns = append(messages.Columns, column)
+		}
+	}
+	if idIndex == -1 {
+		return nil, fmt.Errorf("no id column in user_messages")
+	}
+
+	var messageIDs []interface{}
+	size := 0
+	full := false
+	for rows.Next() {
+		if size >= maxSize {
+			full = true
+			break
+		}
+
+		values, err := scanPairingHistoryRow(rows, len(columns))
+		if err != nil {
+			return nil, err
+		}
+
+		messageID, ok := values[idIndex].(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected message id type %T", values[idIndex])
+		}
+
+		row := new(protobuf.PairingHistoryRow)
+		for i, value := range values[1:] {
+			if mediaType, ok := pairingHistoryMediaColumns[columns[i+1]]; ok {
+				if payload, ok := value.([]byte); ok && len(payload) > 0 {
+					media := &protobuf.PairingHistoryMedia{MessageId: messageID, Type: mediaType, Payload: payload}
+					chunk.Media = append(chunk.Media, media)
+					size += proto.Size(media)
+				}
+				continue
+			}
+
+			v, err := toPairingHistoryValue(value)
+			if err != nil {
+				return nil, err
+			}
+			row.Values = append(row.Values, v)
+		}
+		messages.Rows = append(messages.Rows, row)
+		size += proto.Size(row)
+
+		chunk.Cursor = uint64(values[0].(int64))
+		messageIDs = append(messageIDs, messageID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// the pins and reactions are queried once the messages are read
+	rows.Close()
+
+	chunk.MessagesCount = uint64(len(messageIDs))
+	chunk.Last = !full && len(messageIDs) < pairingHistoryMaxChunkMessages
+	if len(messageIDs) == 0 {
+		return chunk, nil
+	}
+
+	chunk.Tables = append(chunk.Tables, messages)
+	for _, name := range []string{pairingHistoryPinsTable, pairingHistoryReactionsTable} {
+		table, err := db.pairingHistoryTable(name, messageIDs)
+		if err != nil {
+			return nil, err
+		}
+		if len(table.Rows) > 0 {
+			chunk.Tables = append(chunk.Tables, table)
+		}
+	}
+
+	return chunk, nil
+}
+
+func (db sqlitePersistence) tableColumns(tx *sql.Tx, name string) (map[string]bool, error) {
+	rows, err := tx.Query("SELECT name FROM pragma_table_info(?)", name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns := make(map[string]bool)
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, err
+		}
+		columns[column] = true
+	}
+	return columns, rows.Err()
+}
+
+// SavePairingHistoryChunk stores the rows and media of the chunk, the messages we already have are kept as they are.
+// Columns we don't know about, as the sender runs a newer version, are dropped.
+func (db sqlitePersistence) SavePairingHistoryChunk(chunk *protobuf.PairingHistoryChunk) (err error) {
+	tx, err := db.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	for _, table := range chunk.Tables {
+		if !pairingHistoryTables[table.Name] {
+			return fmt.Errorf("unexpected history table %s", table.Name)
+		}
+
+		var known map[string]bool
+		known, err = db.tableColumns(tx, table.Name)
+		if err != nil {
+			return err
+		}
+
+		var columns []string
+		var indexes []int
+		for i, column := range table.Columns {
+			if known[column] {
+				columns = append(columns, column)
+				indexes = append(indexes, i)
+			}
+		}
+		if len(columns) == 0 {
+			continue
+		}
+
+		valuesVector := strings.Repeat("?, ", len(columns)-1) + "?"
+		query := "INSERT OR IGNORE INTO " + table.Name + " (" + strings.Join(columns, ", ") + ") VALUES (" + valuesVector + ")" // nolint: gosec
+		var stmt *sql.Stmt
+		stmt, err = tx.Prepare(query)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for _, row := range table.Rows {
+			if len(row.Values) != len(table.Columns) {
+				return fmt.Errorf("unexpected number of values in a %s row", table.Name)
+			}
+
+			values := make([]interface{}, len(indexes))
+			for i, index := range indexes {
+				values[i] = fromPairingHistoryValue(row.Values[index])
+			}
+			_, err = stmt.Exec(values...)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, media := range chunk.Media {
+		var column string
+		for c, mediaType := range pairingHistoryMediaColumns {
+			if mediaType == media.Type {
+				column = c
+			}
+		}
+		if column == "" {
+			return fmt.Errorf("unexpected media type %s", media.Type)
+		}
+
+		_, err = tx.Exec("UPDATE user_messages SET "+column+" = ? WHERE id = ? AND "+column+" IS NULL", media.Payload, media.MessageId) // nolint: gosec
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
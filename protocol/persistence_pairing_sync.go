@@ -0,0 +1,89 @@
+package protocol
+
+import (
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// PairingSyncWatermarks returns the watermarks of the sync messages received from every paired installation
+func (db *sqlitePersistence) PairingSyncWatermarks() ([]*protobuf.PairingSyncWatermark, error) {
+	rows, err := db.db.Query(`
+  SELECT
+    installation_id,
+    entity,
+    clock,
+    digest
+  FROM
+    pairing_sync_watermarks
+  ORDER BY
+    installation_id, entity
+  `)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var watermarks []*protobuf.PairingSyncWatermark
+	var current *protobuf.PairingSyncWatermark
+	for rows.Next() {
+		var installationID string
+		var entity protobuf.PairingSyncWatermark_Entity
+		var clock uint64
+		var digest []byte
+		err = rows.Scan(&installationID, &entity, &clock, &digest)
+		if err != nil {
+			return nil, err
+		}
+
+		if current == nil || current.InstallationId != installationID || current.Entity != entity {
+			current = &protobuf.PairingSyncWatermark{
+				InstallationId: installationID,
+				Entity:         entity,
+			}
+			watermarks = append(watermarks, current)
+		}
+		if clock > current.Clock {
+			current.Clock = clock
+		}
+		current.Digests = append(current.Digests, digest)
+	}
+
+	return watermarks, nil
+}
+
+// SavePairingSyncWatermarks replaces the watermarks of the installation
+func (db *sqlitePersistence) SavePairingSyncWatermarks(installationID string, watermarks []*protobuf.PairingSyncWatermark) (err error) {
+	tx, err := db.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(`DELETE FROM pairing_sync_watermarks WHERE installation_id = ?`, installationID)
+	if err != nil {
+		return err
+	}
+
+	stmt, err := tx.Prepare(`INSERT INTO pairing_sync_watermarks (installation_id, entity, clock, digest) VALUES (?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, watermark := range watermarks {
+		for _, digest := range watermark.Digests {
+			_, err = stmt.Exec(installationID, watermark.Entity, watermark.Clock, digest)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
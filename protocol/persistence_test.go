@@ -11,12 +11,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/require"
 
 	"github.com/status-im/status-go/appdatabase"
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/files"
 	"github.com/status-im/status-go/protocol/protobuf"
 	"github.com/status-im/status-go/protocol/sqlite"
 	"github.com/status-im/status-go/t/helpers"
@@ -142,6 +144,64 @@ func TestMessageByID_WithDiscordMessageAttachmentPayload(t *testing.T) {
 	require.Len(t, dm.Attachments, 2)
 }
 
+func TestMessageByID_WithDiscordMessageReactions(t *testing.T) {
+
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+	id := "1"
+	discordMessageID := "2"
+
+	discordMessage := &protobuf.DiscordMessage{
+		Id:        discordMessageID,
+		Type:      "Default",
+		Timestamp: "123456",
+		Content:   "This is the message",
+		Author: &protobuf.DiscordMessageAuthor{
+			Id: "2",
+		},
+		Reference: &protobuf.DiscordMessageReference{},
+		Reactions: []*protobuf.DiscordMessageReaction{
+			{Emoji: ":thumbsup:", Count: 3},
+			{Emoji: "🔥", Count: 1},
+		},
+	}
+
+	err = p.SaveDiscordMessages([]*protobuf.DiscordMessage{discordMessage})
+	require.NoError(t, err)
+
+	err = p.SaveMessages([]*common.Message{{
+		ID:          id,
+		LocalChatID: testPublicChatID,
+		From:        testPK,
+		ChatMessage: &protobuf.ChatMessage{
+			ContentType: protobuf.ChatMessage_DISCORD_MESSAGE,
+			ChatId:      testPublicChatID,
+			Payload: &protobuf.ChatMessage_DiscordMessage{
+				DiscordMessage: discordMessage,
+			},
+		},
+	}})
+	require.NoError(t, err)
+
+	err = p.SaveDiscordMessageAttachments([]*protobuf.DiscordMessageAttachment{
+		{Id: "1", MessageId: discordMessageID, Url: "https://does-not-exist.com"},
+		{Id: "2", MessageId: discordMessageID, Url: "https://does-not-exist.com"},
+	})
+	require.NoError(t, err)
+
+	m, err := p.MessageByID(id)
+	require.NoError(t, err)
+
+	dm := m.GetDiscordMessage()
+	require.NotNil(t, dm)
+	require.Len(t, dm.Attachments, 2)
+	require.Len(t, dm.Reactions, 2)
+	require.Equal(t, ":thumbsup:", dm.Reactions[0].Emoji)
+	require.Equal(t, uint32(3), dm.Reactions[0].Count)
+	require.Equal(t, "🔥", dm.Reactions[1].Emoji)
+}
+
 func TestMessagesExist(t *testing.T) {
 	db, err := openTestDB()
 	require.NoError(t, err)
@@ -1018,6 +1078,99 @@ func TestMessagesAudioDurationMsNull(t *testing.T) {
 	require.Len(t, m, 1)
 }
 
+func TestSaveVideoMessage(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	message := &common.Message{
+		ID:          "message-id-1",
+		LocalChatID: testPublicChatID,
+		ChatMessage: &protobuf.ChatMessage{
+			Text:        "some-text",
+			ContentType: protobuf.ChatMessage_VIDEO,
+			Payload: &protobuf.ChatMessage_Video{
+				Video: &protobuf.VideoMessage{
+					Payload:         []byte("video-payload"),
+					Format:          protobuf.VideoMessage_MP4,
+					Codec:           "avc1.42E01E",
+					DurationMs:      1500,
+					Width:           640,
+					Height:          480,
+					Thumbnail:       []byte("thumbnail"),
+					ThumbnailFormat: protobuf.ImageFormat_JPEG,
+				},
+			},
+		},
+		From: "me",
+	}
+	err = p.SaveMessages([]*common.Message{message})
+	require.NoError(t, err)
+
+	m, err := p.MessageByID(message.ID)
+	require.NoError(t, err)
+	video := m.GetVideo()
+	require.NotNil(t, video)
+	// the payload and the thumbnail are served by the media server
+	require.Empty(t, video.Payload)
+	require.Empty(t, video.Thumbnail)
+	require.Equal(t, protobuf.VideoMessage_MP4, video.Format)
+	require.Equal(t, "avc1.42E01E", video.Codec)
+	require.Equal(t, uint64(1500), video.DurationMs)
+	require.Equal(t, uint32(640), video.Width)
+	require.Equal(t, uint32(480), video.Height)
+	require.Equal(t, protobuf.ImageFormat_JPEG, video.ThumbnailFormat)
+
+	usage, err := p.MediaStorageUsage()
+	require.NoError(t, err)
+	require.Equal(t, int64(len("video-payload")+len("thumbnail")), usage.Video)
+	require.Equal(t, usage.Video, usage.Total)
+}
+
+func TestSaveFileMessage(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	payload := []byte("file-payload")
+	message := &common.Message{
+		ID:          "message-id-1",
+		LocalChatID: testPublicChatID,
+		ChatMessage: &protobuf.ChatMessage{
+			Text:        "some-text",
+			ContentType: protobuf.ChatMessage_FILE,
+			Payload: &protobuf.ChatMessage_File{
+				File: &protobuf.FileMessage{
+					Payload:  payload,
+					Name:     "notes.txt",
+					MimeType: "text/plain",
+					Size:     uint64(len(payload)),
+					Hash:     files.Hash(payload),
+				},
+			},
+		},
+		From: "me",
+	}
+	err = p.SaveMessages([]*common.Message{message})
+	require.NoError(t, err)
+
+	m, err := p.MessageByID(message.ID)
+	require.NoError(t, err)
+	file := m.GetFile()
+	require.NotNil(t, file)
+	// the payload is downloaded from the media server
+	require.Empty(t, file.Payload)
+	require.Equal(t, "notes.txt", file.Name)
+	require.Equal(t, "text/plain", file.MimeType)
+	require.Equal(t, uint64(len(payload)), file.Size)
+	require.Equal(t, files.Hash(payload), file.Hash)
+
+	usage, err := p.MediaStorageUsage()
+	require.NoError(t, err)
+	require.Equal(t, int64(len(payload)), usage.Files)
+	require.Equal(t, usage.Files, usage.Total)
+}
+
 func TestSaveChat(t *testing.T) {
 	db, err := openTestDB()
 	require.NoError(t, err)
@@ -1971,3 +2124,128 @@ func TestGetCommunityMemberAllNonDeletedMessages(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, messages, 2)
 }
+
+func TestPairingSyncWatermarks(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	chats := &protobuf.PairingSyncWatermark{
+		InstallationId: "installation-1",
+		Entity:         protobuf.PairingSyncWatermark_CHATS,
+		Clock:          2,
+		Digests:        [][]byte{{1}, {2}},
+	}
+	contacts := &protobuf.PairingSyncWatermark{
+		InstallationId: "installation-1",
+		Entity:         protobuf.PairingSyncWatermark_CONTACTS,
+		Clock:          2,
+		Digests:        [][]byte{{3}},
+	}
+	require.NoError(t, p.SavePairingSyncWatermarks("installation-1", []*protobuf.PairingSyncWatermark{chats, contacts}))
+
+	other := &protobuf.PairingSyncWatermark{
+		InstallationId: "installation-2",
+		Entity:         protobuf.PairingSyncWatermark_SETTINGS,
+		Clock:          1,
+		Digests:        [][]byte{{4}},
+	}
+	require.NoError(t, p.SavePairingSyncWatermarks("installation-2", []*protobuf.PairingSyncWatermark{other}))
+
+	watermarks, err := p.PairingSyncWatermarks()
+	require.NoError(t, err)
+	require.Len(t, watermarks, 3)
+	require.True(t, proto.Equal(chats, watermarks[0]))
+	require.True(t, proto.Equal(contacts, watermarks[1]))
+	require.True(t, proto.Equal(other, watermarks[2]))
+
+	// watermarks are replaced
+	chats.Clock = 3
+	chats.Digests = [][]byte{{5}}
+	require.NoError(t, p.SavePairingSyncWatermarks("installation-1", []*protobuf.PairingSyncWatermark{chats}))
+
+	watermarks, err = p.PairingSyncWatermarks()
+	require.NoError(t, err)
+	require.Len(t, watermarks, 2)
+	require.True(t, proto.Equal(chats, watermarks[0]))
+	require.True(t, proto.Equal(other, watermarks[1]))
+}
+
+func TestPairingHistoryChunk(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	var messages []*common.Message
+	for i := 1; i <= 5; i++ {
+		messages = append(messages, &common.Message{
+			ID:          strconv.Itoa(i),
+			LocalChatID: testPublicChatID,
+			ChatMessage: &protobuf.ChatMessage{Text: "some-text", Timestamp: uint64(i * 1000), Clock: uint64(i)},
+			From:        testPK,
+		})
+	}
+	require.NoError(t, p.SaveMessages(messages))
+	_, err = db.Exec(`UPDATE user_messages SET image_payload = ? WHERE id = ?`, []byte{1, 2, 3}, "3")
+	require.NoError(t, err)
+
+	pinMessage := common.NewPinMessage()
+	pinMessage.ID = "pin"
+	pinMessage.LocalChatID = testPublicChatID
+	pinMessage.From = testPK
+	pinMessage.PinMessage = &protobuf.PinMessage{MessageId: "4", ChatId: testPublicChatID, Clock: 1, Pinned: true}
+	require.NoError(t, p.SavePinMessages([]*common.PinMessage{pinMessage}))
+
+	require.NoError(t, p.SaveEmojiReaction(&EmojiReaction{
+		EmojiReaction: &protobuf.EmojiReaction{Clock: 1, MessageId: "5", ChatId: testPublicChatID, Type: protobuf.EmojiReaction_LOVE},
+		LocalChatID:   testPublicChatID,
+		From:          testPK,
+	}))
+
+	receiverDB, err := openTestDB()
+	require.NoError(t, err)
+	receiver := newSQLitePersistence(receiverDB)
+
+	// messages older than 2 seconds are not transferred, and each chunk holds a single message
+	var cursor uint64
+	var chunks int
+	for {
+		chunk, err := p.PairingHistoryChunk(2000, cursor, 1)
+		require.NoError(t, err)
+		if cursor == 0 {
+			require.Equal(t, uint64(4), chunk.TotalMessagesCount)
+		}
+		require.NoError(t, receiver.SavePairingHistoryChunk(chunk))
+		// a chunk received twice is ignored
+		require.NoError(t, receiver.SavePairingHistoryChunk(chunk))
+
+		chunks++
+		cursor = chunk.Cursor
+		if chunk.Last {
+			break
+		}
+	}
+	require.Equal(t, 4, chunks)
+
+	_, err = receiver.MessageByID("1")
+	require.ErrorIs(t, err, common.ErrRecordNotFound)
+	for _, id := range []string{"2", "3", "4", "5"} {
+		message, err := receiver.MessageByID(id)
+		require.NoError(t, err)
+		require.Equal(t, "some-text", message.Text)
+	}
+
+	var image []byte
+	require.NoError(t, receiverDB.QueryRow(`SELECT image_payload FROM user_messages WHERE id = ?`, "3").Scan(&image))
+	require.Equal(t, []byte{1, 2, 3}, image)
+
+	var count int
+	require.NoError(t, receiverDB.QueryRow(`SELECT COUNT(*) FROM pin_messages WHERE message_id = ?`, "4").Scan(&count))
+	require.Equal(t, 1, count)
+	require.NoError(t, receiverDB.QueryRow(`SELECT COUNT(*) FROM emoji_reactions WHERE message_id = ?`, "5").Scan(&count))
+	require.Equal(t, 1, count)
+
+	// only the history tables can be written to
+	err = receiver.SavePairingHistoryChunk(&protobuf.PairingHistoryChunk{Tables: []*protobuf.PairingHistoryTable{{Name: "settings"}}})
+	require.Error(t, err)
+}
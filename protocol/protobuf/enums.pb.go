@@ -146,6 +146,7 @@ const (
 	CommunityTokenType_ERC20              CommunityTokenType = 1
 	CommunityTokenType_ERC721             CommunityTokenType = 2
 	CommunityTokenType_ENS                CommunityTokenType = 3
+	CommunityTokenType_ERC1155            CommunityTokenType = 4
 )
 
 // Enum value maps for CommunityTokenType.
@@ -155,12 +156,14 @@ var (
 		1: "ERC20",
 		2: "ERC721",
 		3: "ENS",
+		4: "ERC1155",
 	}
 	CommunityTokenType_value = map[string]int32{
 		"UNKNOWN_TOKEN_TYPE": 0,
 		"ERC20":              1,
 		"ERC721":             2,
 		"ENS":                3,
+		"ERC1155":            4,
 	}
 )
 
@@ -211,13 +214,14 @@ var file_enums_proto_rawDesc = []byte{
 	0x4d, 0x41, 0x47, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a,
 	0x03, 0x50, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x50, 0x45, 0x47, 0x10, 0x02,
 	0x12, 0x08, 0x0a, 0x04, 0x57, 0x45, 0x42, 0x50, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x49,
-	0x46, 0x10, 0x04, 0x2a, 0x4c, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x46, 0x10, 0x04, 0x2a, 0x59, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
 	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x4e, 0x4b,
 	0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10,
 	0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x43, 0x32, 0x30, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
 	0x45, 0x52, 0x43, 0x37, 0x32, 0x31, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x4e, 0x53, 0x10,
-	0x03, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x03, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x52, 0x43, 0x31, 0x31, 0x35, 0x35, 0x10, 0x04, 0x42, 0x0d,
+	0x5a, 0x0b, 0x2e, 0x2f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -216,6 +216,177 @@ func (SyncContactRequestDecision_DecisionStatus) EnumDescriptor() ([]byte, []int
 	return file_pairing_proto_rawDescGZIP(), []int{33, 0}
 }
 
+type PairingSyncWatermark_Entity int32
+
+const (
+	PairingSyncWatermark_UNKNOWN_ENTITY  PairingSyncWatermark_Entity = 0
+	PairingSyncWatermark_CHATS           PairingSyncWatermark_Entity = 1
+	PairingSyncWatermark_CONTACTS        PairingSyncWatermark_Entity = 2
+	PairingSyncWatermark_COMMUNITIES     PairingSyncWatermark_Entity = 3
+	PairingSyncWatermark_SETTINGS        PairingSyncWatermark_Entity = 4
+	PairingSyncWatermark_WALLET_ACCOUNTS PairingSyncWatermark_Entity = 5
+	PairingSyncWatermark_MESSAGES        PairingSyncWatermark_Entity = 6
+	PairingSyncWatermark_OTHER           PairingSyncWatermark_Entity = 7
+)
+
+// Enum value maps for PairingSyncWatermark_Entity.
+var (
+	PairingSyncWatermark_Entity_name = map[int32]string{
+		0: "UNKNOWN_ENTITY",
+		1: "CHATS",
+		2: "CONTACTS",
+		3: "COMMUNITIES",
+		4: "SETTINGS",
+		5: "WALLET_ACCOUNTS",
+		6: "MESSAGES",
+		7: "OTHER",
+	}
+	PairingSyncWatermark_Entity_value = map[string]int32{
+		"UNKNOWN_ENTITY":  0,
+		"CHATS":           1,
+		"CONTACTS":        2,
+		"COMMUNITIES":     3,
+		"SETTINGS":        4,
+		"WALLET_ACCOUNTS": 5,
+		"MESSAGES":        6,
+		"OTHER":           7,
+	}
+)
+
+func (x PairingSyncWatermark_Entity) Enum() *PairingSyncWatermark_Entity {
+	p := new(PairingSyncWatermark_Entity)
+	*p = x
+	return p
+}
+
+func (x PairingSyncWatermark_Entity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PairingSyncWatermark_Entity) Descriptor() protoreflect.EnumDescriptor {
+	return file_pairing_proto_enumTypes[4].Descriptor()
+}
+
+func (PairingSyncWatermark_Entity) Type() protoreflect.EnumType {
+	return &file_pairing_proto_enumTypes[4]
+}
+
+func (x PairingSyncWatermark_Entity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PairingSyncWatermark_Entity.Descriptor instead.
+func (PairingSyncWatermark_Entity) EnumDescriptor() ([]byte, []int) {
+	return file_pairing_proto_rawDescGZIP(), []int{37, 0}
+}
+
+type PairingRelayFrame_Type int32
+
+const (
+	PairingRelayFrame_UNKNOWN PairingRelayFrame_Type = 0
+	PairingRelayFrame_DATA    PairingRelayFrame_Type = 1
+	PairingRelayFrame_ACK     PairingRelayFrame_Type = 2
+)
+
+// Enum value maps for PairingRelayFrame_Type.
+var (
+	PairingRelayFrame_Type_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "DATA",
+		2: "ACK",
+	}
+	PairingRelayFrame_Type_value = map[string]int32{
+		"UNKNOWN": 0,
+		"DATA":    1,
+		"ACK":     2,
+	}
+)
+
+func (x PairingRelayFrame_Type) Enum() *PairingRelayFrame_Type {
+	p := new(PairingRelayFrame_Type)
+	*p = x
+	return p
+}
+
+func (x PairingRelayFrame_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PairingRelayFrame_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_pairing_proto_enumTypes[5].Descriptor()
+}
+
+func (PairingRelayFrame_Type) Type() protoreflect.EnumType {
+	return &file_pairing_proto_enumTypes[5]
+}
+
+func (x PairingRelayFrame_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PairingRelayFrame_Type.Descriptor instead.
+func (PairingRelayFrame_Type) EnumDescriptor() ([]byte, []int) {
+	return file_pairing_proto_rawDescGZIP(), []int{46, 0}
+}
+
+type PairingHistoryMedia_Type int32
+
+const (
+	PairingHistoryMedia_UNKNOWN         PairingHistoryMedia_Type = 0
+	PairingHistoryMedia_IMAGE           PairingHistoryMedia_Type = 1
+	PairingHistoryMedia_AUDIO           PairingHistoryMedia_Type = 2
+	PairingHistoryMedia_VIDEO           PairingHistoryMedia_Type = 3
+	PairingHistoryMedia_VIDEO_THUMBNAIL PairingHistoryMedia_Type = 4
+	PairingHistoryMedia_FILE            PairingHistoryMedia_Type = 5
+)
+
+// Enum value maps for PairingHistoryMedia_Type.
+var (
+	PairingHistoryMedia_Type_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "IMAGE",
+		2: "AUDIO",
+		3: "VIDEO",
+		4: "VIDEO_THUMBNAIL",
+		5: "FILE",
+	}
+	PairingHistoryMedia_Type_value = map[string]int32{
+		"UNKNOWN":         0,
+		"IMAGE":           1,
+		"AUDIO":           2,
+		"VIDEO":           3,
+		"VIDEO_THUMBNAIL": 4,
+		"FILE":            5,
+	}
+)
+
+func (x PairingHistoryMedia_Type) Enum() *PairingHistoryMedia_Type {
+	p := new(PairingHistoryMedia_Type)
+	*p = x
+	return p
+}
+
+func (x PairingHistoryMedia_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PairingHistoryMedia_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_pairing_proto_enumTypes[6].Descriptor()
+}
+
+func (PairingHistoryMedia_Type) Type() protoreflect.EnumType {
+	return &file_pairing_proto_enumTypes[6]
+}
+
+func (x PairingHistoryMedia_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PairingHistoryMedia_Type.Descriptor instead.
+func (PairingHistoryMedia_Type) EnumDescriptor() ([]byte, []int) {
+	return file_pairing_proto_rawDescGZIP(), []int{54, 0}
+}
+
 // `FetchingBackedUpDataDetails` is used to describe how many messages a single backup data structure consists of
 type FetchingBackedUpDataDetails struct {
 	state         protoimpl.MessageState
@@ -2773,6 +2944,7 @@ type SyncSavedAddress struct {
 	Ens             string `protobuf:"bytes,9,opt,name=ens,proto3" json:"ens,omitempty"`
 	IsTest          bool   `protobuf:"varint,10,opt,name=is_test,json=isTest,proto3" json:"is_test,omitempty"`
 	Color           string `protobuf:"bytes,11,opt,name=color,proto3" json:"color,omitempty"`
+	ContactId       string `protobuf:"bytes,12,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
 }
 
 func (x *SyncSavedAddress) Reset() {
@@ -2863,6 +3035,13 @@ func (x *SyncSavedAddress) GetColor() string {
 	return ""
 }
 
+func (x *SyncSavedAddress) GetContactId() string {
+	if x != nil {
+		return x.ContactId
+	}
+	return ""
+}
+
 type SyncCommunitySettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3323,6 +3502,8 @@ type SyncRawMessage struct {
 	// we need these to be able to login
 	SubAccountsJsonBytes []byte `protobuf:"bytes,2,opt,name=subAccountsJsonBytes,proto3" json:"subAccountsJsonBytes,omitempty"`
 	SettingsJsonBytes    []byte `protobuf:"bytes,3,opt,name=settingsJsonBytes,proto3" json:"settingsJsonBytes,omitempty"`
+	// the digests of all the sync messages of the sender, the rawMessages being only the ones the receiver didn't have
+	Watermarks []*PairingSyncWatermark `protobuf:"bytes,4,rep,name=watermarks,proto3" json:"watermarks,omitempty"`
 }
 
 func (x *SyncRawMessage) Reset() {
@@ -3378,6 +3559,134 @@ func (x *SyncRawMessage) GetSettingsJsonBytes() []byte {
 	return nil
 }
 
+func (x *SyncRawMessage) GetWatermarks() []*PairingSyncWatermark {
+	if x != nil {
+		return x.Watermarks
+	}
+	return nil
+}
+
+// PairingSyncWatermark is the set of the sync messages of an entity
+// a device received from another one during the last local pairing
+type PairingSyncWatermark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// installation_id of the device sending the sync messages
+	InstallationId string                      `protobuf:"bytes,1,opt,name=installation_id,json=installationId,proto3" json:"installation_id,omitempty"`
+	Entity         PairingSyncWatermark_Entity `protobuf:"varint,2,opt,name=entity,proto3,enum=protobuf.PairingSyncWatermark_Entity" json:"entity,omitempty"`
+	Clock          uint64                      `protobuf:"varint,3,opt,name=clock,proto3" json:"clock,omitempty"`
+	Digests        [][]byte                    `protobuf:"bytes,4,rep,name=digests,proto3" json:"digests,omitempty"`
+}
+
+func (x *PairingSyncWatermark) Reset() {
+	*x = PairingSyncWatermark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pairing_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairingSyncWatermark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingSyncWatermark) ProtoMessage() {}
+
+func (x *PairingSyncWatermark) ProtoReflect() protoreflect.Message {
+	mi := &file_pairing_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingSyncWatermark.ProtoReflect.Descriptor instead.
+func (*PairingSyncWatermark) Descriptor() ([]byte, []int) {
+	return file_pairing_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PairingSyncWatermark) GetInstallationId() string {
+	if x != nil {
+		return x.InstallationId
+	}
+	return ""
+}
+
+func (x *PairingSyncWatermark) GetEntity() PairingSyncWatermark_Entity {
+	if x != nil {
+		return x.Entity
+	}
+	return PairingSyncWatermark_UNKNOWN_ENTITY
+}
+
+func (x *PairingSyncWatermark) GetClock() uint64 {
+	if x != nil {
+		return x.Clock
+	}
+	return 0
+}
+
+func (x *PairingSyncWatermark) GetDigests() [][]byte {
+	if x != nil {
+		return x.Digests
+	}
+	return nil
+}
+
+type PairingSyncWatermarks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Watermarks []*PairingSyncWatermark `protobuf:"bytes,1,rep,name=watermarks,proto3" json:"watermarks,omitempty"`
+}
+
+func (x *PairingSyncWatermarks) Reset() {
+	*x = PairingSyncWatermarks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pairing_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairingSyncWatermarks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingSyncWatermarks) ProtoMessage() {}
+
+func (x *PairingSyncWatermarks) ProtoReflect() protoreflect.Message {
+	mi := &file_pairing_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingSyncWatermarks.ProtoReflect.Descriptor instead.
+func (*PairingSyncWatermarks) Descriptor() ([]byte, []int) {
+	return file_pairing_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PairingSyncWatermarks) GetWatermarks() []*PairingSyncWatermark {
+	if x != nil {
+		return x.Watermarks
+	}
+	return nil
+}
+
 type SyncKeycard struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3394,7 +3703,7 @@ type SyncKeycard struct {
 func (x *SyncKeycard) Reset() {
 	*x = SyncKeycard{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pairing_proto_msgTypes[37]
+		mi := &file_pairing_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3407,7 +3716,7 @@ func (x *SyncKeycard) String() string {
 func (*SyncKeycard) ProtoMessage() {}
 
 func (x *SyncKeycard) ProtoReflect() protoreflect.Message {
-	mi := &file_pairing_proto_msgTypes[37]
+	mi := &file_pairing_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3420,7 +3729,7 @@ func (x *SyncKeycard) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncKeycard.ProtoReflect.Descriptor instead.
 func (*SyncKeycard) Descriptor() ([]byte, []int) {
-	return file_pairing_proto_rawDescGZIP(), []int{37}
+	return file_pairing_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *SyncKeycard) GetUid() string {
@@ -3477,7 +3786,7 @@ type SyncSocialLinks struct {
 func (x *SyncSocialLinks) Reset() {
 	*x = SyncSocialLinks{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pairing_proto_msgTypes[38]
+		mi := &file_pairing_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3490,7 +3799,7 @@ func (x *SyncSocialLinks) String() string {
 func (*SyncSocialLinks) ProtoMessage() {}
 
 func (x *SyncSocialLinks) ProtoReflect() protoreflect.Message {
-	mi := &file_pairing_proto_msgTypes[38]
+	mi := &file_pairing_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3503,7 +3812,7 @@ func (x *SyncSocialLinks) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncSocialLinks.ProtoReflect.Descriptor instead.
 func (*SyncSocialLinks) Descriptor() ([]byte, []int) {
-	return file_pairing_proto_rawDescGZIP(), []int{38}
+	return file_pairing_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *SyncSocialLinks) GetSocialLinks() []*SocialLink {
@@ -3533,7 +3842,7 @@ type SyncAccountCustomizationColor struct {
 func (x *SyncAccountCustomizationColor) Reset() {
 	*x = SyncAccountCustomizationColor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pairing_proto_msgTypes[39]
+		mi := &file_pairing_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3546,7 +3855,7 @@ func (x *SyncAccountCustomizationColor) String() string {
 func (*SyncAccountCustomizationColor) ProtoMessage() {}
 
 func (x *SyncAccountCustomizationColor) ProtoReflect() protoreflect.Message {
-	mi := &file_pairing_proto_msgTypes[39]
+	mi := &file_pairing_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3559,7 +3868,7 @@ func (x *SyncAccountCustomizationColor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncAccountCustomizationColor.ProtoReflect.Descriptor instead.
 func (*SyncAccountCustomizationColor) Descriptor() ([]byte, []int) {
-	return file_pairing_proto_rawDescGZIP(), []int{39}
+	return file_pairing_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *SyncAccountCustomizationColor) GetUpdatedAt() uint64 {
@@ -3598,7 +3907,7 @@ type TokenPreferences struct {
 func (x *TokenPreferences) Reset() {
 	*x = TokenPreferences{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pairing_proto_msgTypes[40]
+		mi := &file_pairing_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3611,7 +3920,7 @@ func (x *TokenPreferences) String() string {
 func (*TokenPreferences) ProtoMessage() {}
 
 func (x *TokenPreferences) ProtoReflect() protoreflect.Message {
-	mi := &file_pairing_proto_msgTypes[40]
+	mi := &file_pairing_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3624,7 +3933,7 @@ func (x *TokenPreferences) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenPreferences.ProtoReflect.Descriptor instead.
 func (*TokenPreferences) Descriptor() ([]byte, []int) {
-	return file_pairing_proto_rawDescGZIP(), []int{40}
+	return file_pairing_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *TokenPreferences) GetKey() string {
@@ -3675,7 +3984,7 @@ type SyncTokenPreferences struct {
 func (x *SyncTokenPreferences) Reset() {
 	*x = SyncTokenPreferences{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pairing_proto_msgTypes[41]
+		mi := &file_pairing_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3688,7 +3997,7 @@ func (x *SyncTokenPreferences) String() string {
 func (*SyncTokenPreferences) ProtoMessage() {}
 
 func (x *SyncTokenPreferences) ProtoReflect() protoreflect.Message {
-	mi := &file_pairing_proto_msgTypes[41]
+	mi := &file_pairing_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3701,7 +4010,7 @@ func (x *SyncTokenPreferences) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncTokenPreferences.ProtoReflect.Descriptor instead.
 func (*SyncTokenPreferences) Descriptor() ([]byte, []int) {
-	return file_pairing_proto_rawDescGZIP(), []int{41}
+	return file_pairing_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *SyncTokenPreferences) GetClock() uint64 {
@@ -3739,7 +4048,7 @@ type CollectiblePreferences struct {
 func (x *CollectiblePreferences) Reset() {
 	*x = CollectiblePreferences{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pairing_proto_msgTypes[42]
+		mi := &file_pairing_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3752,7 +4061,7 @@ func (x *CollectiblePreferences) String() string {
 func (*CollectiblePreferences) ProtoMessage() {}
 
 func (x *CollectiblePreferences) ProtoReflect() protoreflect.Message {
-	mi := &file_pairing_proto_msgTypes[42]
+	mi := &file_pairing_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3765,7 +4074,7 @@ func (x *CollectiblePreferences) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectiblePreferences.ProtoReflect.Descriptor instead.
 func (*CollectiblePreferences) Descriptor() ([]byte, []int) {
-	return file_pairing_proto_rawDescGZIP(), []int{42}
+	return file_pairing_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *CollectiblePreferences) GetType() int64 {
@@ -3809,7 +4118,7 @@ type SyncCollectiblePreferences struct {
 func (x *SyncCollectiblePreferences) Reset() {
 	*x = SyncCollectiblePreferences{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pairing_proto_msgTypes[43]
+		mi := &file_pairing_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3822,7 +4131,7 @@ func (x *SyncCollectiblePreferences) String() string {
 func (*SyncCollectiblePreferences) ProtoMessage() {}
 
 func (x *SyncCollectiblePreferences) ProtoReflect() protoreflect.Message {
-	mi := &file_pairing_proto_msgTypes[43]
+	mi := &file_pairing_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3835,7 +4144,7 @@ func (x *SyncCollectiblePreferences) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncCollectiblePreferences.ProtoReflect.Descriptor instead.
 func (*SyncCollectiblePreferences) Descriptor() ([]byte, []int) {
-	return file_pairing_proto_rawDescGZIP(), []int{43}
+	return file_pairing_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *SyncCollectiblePreferences) GetClock() uint64 {
@@ -3859,31 +4168,37 @@ func (x *SyncCollectiblePreferences) GetPreferences() []*CollectiblePreferences
 	return nil
 }
 
-type MultiAccount_ColorHash struct {
+// PairingRelayFrame carries a chunk of a pairing request or response,
+// or acknowledges one, when pairing over waku instead of the local network
+type PairingRelayFrame struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index []int64 `protobuf:"varint,1,rep,packed,name=index,proto3" json:"index,omitempty"`
+	Type        PairingRelayFrame_Type `protobuf:"varint,1,opt,name=type,proto3,enum=protobuf.PairingRelayFrame_Type" json:"type,omitempty"`
+	MessageId   []byte                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ChunkIndex  uint32                 `protobuf:"varint,3,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+	ChunksCount uint32                 `protobuf:"varint,4,opt,name=chunks_count,json=chunksCount,proto3" json:"chunks_count,omitempty"`
+	Data        []byte                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *MultiAccount_ColorHash) Reset() {
-	*x = MultiAccount_ColorHash{}
+func (x *PairingRelayFrame) Reset() {
+	*x = PairingRelayFrame{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pairing_proto_msgTypes[44]
+		mi := &file_pairing_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MultiAccount_ColorHash) String() string {
+func (x *PairingRelayFrame) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiAccount_ColorHash) ProtoMessage() {}
+func (*PairingRelayFrame) ProtoMessage() {}
 
-func (x *MultiAccount_ColorHash) ProtoReflect() protoreflect.Message {
-	mi := &file_pairing_proto_msgTypes[44]
+func (x *PairingRelayFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_pairing_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3894,50 +4209,745 @@ func (x *MultiAccount_ColorHash) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiAccount_ColorHash.ProtoReflect.Descriptor instead.
-func (*MultiAccount_ColorHash) Descriptor() ([]byte, []int) {
-	return file_pairing_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use PairingRelayFrame.ProtoReflect.Descriptor instead.
+func (*PairingRelayFrame) Descriptor() ([]byte, []int) {
+	return file_pairing_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *MultiAccount_ColorHash) GetIndex() []int64 {
+func (x *PairingRelayFrame) GetType() PairingRelayFrame_Type {
 	if x != nil {
-		return x.Index
+		return x.Type
 	}
-	return nil
+	return PairingRelayFrame_UNKNOWN
 }
 
-type MultiAccount_IdentityImage struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	KeyUid       string `protobuf:"bytes,1,opt,name=key_uid,json=keyUid,proto3" json:"key_uid,omitempty"`
-	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Payload      []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
-	Width        int64  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
-	Height       int64  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
-	Filesize     int64  `protobuf:"varint,6,opt,name=filesize,proto3" json:"filesize,omitempty"`
-	ResizeTarget int64  `protobuf:"varint,7,opt,name=resize_target,json=resizeTarget,proto3" json:"resize_target,omitempty"`
-	Clock        uint64 `protobuf:"varint,8,opt,name=clock,proto3" json:"clock,omitempty"`
+func (x *PairingRelayFrame) GetMessageId() []byte {
+	if x != nil {
+		return x.MessageId
+	}
+	return nil
 }
 
-func (x *MultiAccount_IdentityImage) Reset() {
-	*x = MultiAccount_IdentityImage{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pairing_proto_msgTypes[45]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PairingRelayFrame) GetChunkIndex() uint32 {
+	if x != nil {
+		return x.ChunkIndex
 	}
+	return 0
 }
 
-func (x *MultiAccount_IdentityImage) String() string {
+func (x *PairingRelayFrame) GetChunksCount() uint32 {
+	if x != nil {
+		return x.ChunksCount
+	}
+	return 0
+}
+
+func (x *PairingRelayFrame) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PairingRelayHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *PairingRelayHeader) Reset() {
+	*x = PairingRelayHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pairing_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairingRelayHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingRelayHeader) ProtoMessage() {}
+
+func (x *PairingRelayHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_pairing_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingRelayHeader.ProtoReflect.Descriptor instead.
+func (*PairingRelayHeader) Descriptor() ([]byte, []int) {
+	return file_pairing_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PairingRelayHeader) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PairingRelayHeader) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type PairingRelayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method  string                `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Path    string                `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Headers []*PairingRelayHeader `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
+	Body    []byte                `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *PairingRelayRequest) Reset() {
+	*x = PairingRelayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pairing_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairingRelayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingRelayRequest) ProtoMessage() {}
+
+func (x *PairingRelayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pairing_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingRelayRequest.ProtoReflect.Descriptor instead.
+func (*PairingRelayRequest) Descriptor() ([]byte, []int) {
+	return file_pairing_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PairingRelayRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PairingRelayRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PairingRelayRequest) GetHeaders() []*PairingRelayHeader {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *PairingRelayRequest) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type PairingRelayResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode uint32                `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Headers    []*PairingRelayHeader `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
+	Body       []byte                `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *PairingRelayResponse) Reset() {
+	*x = PairingRelayResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pairing_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairingRelayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingRelayResponse) ProtoMessage() {}
+
+func (x *PairingRelayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pairing_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingRelayResponse.ProtoReflect.Descriptor instead.
+func (*PairingRelayResponse) Descriptor() ([]byte, []int) {
+	return file_pairing_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PairingRelayResponse) GetStatusCode() uint32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *PairingRelayResponse) GetHeaders() []*PairingRelayHeader {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *PairingRelayResponse) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type PairingHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// only the messages of the last days are transferred, all of them when 0
+	Days uint32 `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	// cursor of the previous chunk, 0 for the first chunk
+	Cursor uint64 `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *PairingHistoryRequest) Reset() {
+	*x = PairingHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pairing_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingHistoryRequest) ProtoMessage() {}
+
+func (x *PairingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pairing_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*PairingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_pairing_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PairingHistoryRequest) GetDays() uint32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *PairingHistoryRequest) GetCursor() uint64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+type PairingHistoryValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// a value without any field set is NULL
+	//
+	// Types that are assignable to Value:
+	//
+	//	*PairingHistoryValue_Integer
+	//	*PairingHistoryValue_Real
+	//	*PairingHistoryValue_Text
+	//	*PairingHistoryValue_Blob
+	Value isPairingHistoryValue_Value `protobuf_oneof:"value"`
+}
+
+func (x *PairingHistoryValue) Reset() {
+	*x = PairingHistoryValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pairing_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairingHistoryValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingHistoryValue) ProtoMessage() {}
+
+func (x *PairingHistoryValue) ProtoReflect() protoreflect.Message {
+	mi := &file_pairing_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingHistoryValue.ProtoReflect.Descriptor instead.
+func (*PairingHistoryValue) Descriptor() ([]byte, []int) {
+	return file_pairing_proto_rawDescGZIP(), []int{51}
+}
+
+func (m *PairingHistoryValue) GetValue() isPairingHistoryValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *PairingHistoryValue) GetInteger() int64 {
+	if x, ok := x.GetValue().(*PairingHistoryValue_Integer); ok {
+		return x.Integer
+	}
+	return 0
+}
+
+func (x *PairingHistoryValue) GetReal() float64 {
+	if x, ok := x.GetValue().(*PairingHistoryValue_Real); ok {
+		return x.Real
+	}
+	return 0
+}
+
+func (x *PairingHistoryValue) GetText() string {
+	if x, ok := x.GetValue().(*PairingHistoryValue_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PairingHistoryValue) GetBlob() []byte {
+	if x, ok := x.GetValue().(*PairingHistoryValue_Blob); ok {
+		return x.Blob
+	}
+	return nil
+}
+
+type isPairingHistoryValue_Value interface {
+	isPairingHistoryValue_Value()
+}
+
+type PairingHistoryValue_Integer struct {
+	Integer int64 `protobuf:"varint,1,opt,name=integer,proto3,oneof"`
+}
+
+type PairingHistoryValue_Real struct {
+	Real float64 `protobuf:"fixed64,2,opt,name=real,proto3,oneof"`
+}
+
+type PairingHistoryValue_Text struct {
+	Text string `protobuf:"bytes,3,opt,name=text,proto3,oneof"`
+}
+
+type PairingHistoryValue_Blob struct {
+	Blob []byte `protobuf:"bytes,4,opt,name=blob,proto3,oneof"`
+}
+
+func (*PairingHistoryValue_Integer) isPairingHistoryValue_Value() {}
+
+func (*PairingHistoryValue_Real) isPairingHistoryValue_Value() {}
+
+func (*PairingHistoryValue_Text) isPairingHistoryValue_Value() {}
+
+func (*PairingHistoryValue_Blob) isPairingHistoryValue_Value() {}
+
+type PairingHistoryRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*PairingHistoryValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *PairingHistoryRow) Reset() {
+	*x = PairingHistoryRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pairing_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairingHistoryRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingHistoryRow) ProtoMessage() {}
+
+func (x *PairingHistoryRow) ProtoReflect() protoreflect.Message {
+	mi := &file_pairing_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingHistoryRow.ProtoReflect.Descriptor instead.
+func (*PairingHistoryRow) Descriptor() ([]byte, []int) {
+	return file_pairing_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PairingHistoryRow) GetValues() []*PairingHistoryValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type PairingHistoryTable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Columns []string             `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	Rows    []*PairingHistoryRow `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *PairingHistoryTable) Reset() {
+	*x = PairingHistoryTable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pairing_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairingHistoryTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingHistoryTable) ProtoMessage() {}
+
+func (x *PairingHistoryTable) ProtoReflect() protoreflect.Message {
+	mi := &file_pairing_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingHistoryTable.ProtoReflect.Descriptor instead.
+func (*PairingHistoryTable) Descriptor() ([]byte, []int) {
+	return file_pairing_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PairingHistoryTable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PairingHistoryTable) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *PairingHistoryTable) GetRows() []*PairingHistoryRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type PairingHistoryMedia struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId string                   `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Type      PairingHistoryMedia_Type `protobuf:"varint,2,opt,name=type,proto3,enum=protobuf.PairingHistoryMedia_Type" json:"type,omitempty"`
+	Payload   []byte                   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *PairingHistoryMedia) Reset() {
+	*x = PairingHistoryMedia{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pairing_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairingHistoryMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingHistoryMedia) ProtoMessage() {}
+
+func (x *PairingHistoryMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_pairing_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingHistoryMedia.ProtoReflect.Descriptor instead.
+func (*PairingHistoryMedia) Descriptor() ([]byte, []int) {
+	return file_pairing_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PairingHistoryMedia) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *PairingHistoryMedia) GetType() PairingHistoryMedia_Type {
+	if x != nil {
+		return x.Type
+	}
+	return PairingHistoryMedia_UNKNOWN
+}
+
+func (x *PairingHistoryMedia) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type PairingHistoryChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tables []*PairingHistoryTable `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	Media  []*PairingHistoryMedia `protobuf:"bytes,2,rep,name=media,proto3" json:"media,omitempty"`
+	// cursor to request the next chunk with
+	Cursor uint64 `protobuf:"varint,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Last   bool   `protobuf:"varint,4,opt,name=last,proto3" json:"last,omitempty"`
+	// number of messages in the chunk
+	MessagesCount uint64 `protobuf:"varint,5,opt,name=messages_count,json=messagesCount,proto3" json:"messages_count,omitempty"`
+	// number of messages to transfer, set in the first chunk only
+	TotalMessagesCount uint64 `protobuf:"varint,6,opt,name=total_messages_count,json=totalMessagesCount,proto3" json:"total_messages_count,omitempty"`
+}
+
+func (x *PairingHistoryChunk) Reset() {
+	*x = PairingHistoryChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pairing_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairingHistoryChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairingHistoryChunk) ProtoMessage() {}
+
+func (x *PairingHistoryChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_pairing_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairingHistoryChunk.ProtoReflect.Descriptor instead.
+func (*PairingHistoryChunk) Descriptor() ([]byte, []int) {
+	return file_pairing_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PairingHistoryChunk) GetTables() []*PairingHistoryTable {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *PairingHistoryChunk) GetMedia() []*PairingHistoryMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *PairingHistoryChunk) GetCursor() uint64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *PairingHistoryChunk) GetLast() bool {
+	if x != nil {
+		return x.Last
+	}
+	return false
+}
+
+func (x *PairingHistoryChunk) GetMessagesCount() uint64 {
+	if x != nil {
+		return x.MessagesCount
+	}
+	return 0
+}
+
+func (x *PairingHistoryChunk) GetTotalMessagesCount() uint64 {
+	if x != nil {
+		return x.TotalMessagesCount
+	}
+	return 0
+}
+
+type MultiAccount_ColorHash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index []int64 `protobuf:"varint,1,rep,packed,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *MultiAccount_ColorHash) Reset() {
+	*x = MultiAccount_ColorHash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pairing_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiAccount_ColorHash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiAccount_ColorHash) ProtoMessage() {}
+
+func (x *MultiAccount_ColorHash) ProtoReflect() protoreflect.Message {
+	mi := &file_pairing_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiAccount_ColorHash.ProtoReflect.Descriptor instead.
+func (*MultiAccount_ColorHash) Descriptor() ([]byte, []int) {
+	return file_pairing_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *MultiAccount_ColorHash) GetIndex() []int64 {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+type MultiAccount_IdentityImage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyUid       string `protobuf:"bytes,1,opt,name=key_uid,json=keyUid,proto3" json:"key_uid,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Payload      []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Width        int64  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height       int64  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Filesize     int64  `protobuf:"varint,6,opt,name=filesize,proto3" json:"filesize,omitempty"`
+	ResizeTarget int64  `protobuf:"varint,7,opt,name=resize_target,json=resizeTarget,proto3" json:"resize_target,omitempty"`
+	Clock        uint64 `protobuf:"varint,8,opt,name=clock,proto3" json:"clock,omitempty"`
+}
+
+func (x *MultiAccount_IdentityImage) Reset() {
+	*x = MultiAccount_IdentityImage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pairing_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiAccount_IdentityImage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*MultiAccount_IdentityImage) ProtoMessage() {}
 
 func (x *MultiAccount_IdentityImage) ProtoReflect() protoreflect.Message {
-	mi := &file_pairing_proto_msgTypes[45]
+	mi := &file_pairing_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4021,7 +5031,7 @@ type LocalPairingPayload_Key struct {
 func (x *LocalPairingPayload_Key) Reset() {
 	*x = LocalPairingPayload_Key{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pairing_proto_msgTypes[46]
+		mi := &file_pairing_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4034,7 +5044,7 @@ func (x *LocalPairingPayload_Key) String() string {
 func (*LocalPairingPayload_Key) ProtoMessage() {}
 
 func (x *LocalPairingPayload_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_pairing_proto_msgTypes[46]
+	mi := &file_pairing_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4526,7 +5536,7 @@ var file_pairing_proto_rawDesc = []byte{
 	0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x31, 0x0a, 0x08, 0x61, 0x63, 0x63,
 	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xf6, 0x01, 0x0a,
+	0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x95, 0x02, 0x0a,
 	0x10, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x61, 0x76, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
 	0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
@@ -4541,176 +5551,298 @@ var file_pairing_proto_rawDesc = []byte{
 	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73,
 	0x5f, 0x74, 0x65, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x54,
 	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a,
-	0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x97, 0x01, 0x0a, 0x15, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f,
-	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04,
+	0x08, 0x04, 0x10, 0x05, 0x22, 0x97, 0x01, 0x0a, 0x15, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x1f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x1c, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xb2,
+	0x01, 0x0a, 0x0f, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3a, 0x0a, 0x0b, 0x54, 0x72, 0x75, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x52, 0x55, 0x53, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x54, 0x52, 0x55, 0x53, 0x54, 0x57, 0x4f, 0x52, 0x54, 0x48,
+	0x59, 0x10, 0x02, 0x22, 0xa0, 0x03, 0x0a, 0x17, 0x53, 0x79, 0x6e, 0x63, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
-	0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
-	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x1f, 0x68, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x79, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x75, 0x70, 0x70,
-	0x6f, 0x72, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x1c, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22,
-	0xb2, 0x01, 0x0a, 0x0f, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3a, 0x0a, 0x0b, 0x54, 0x72, 0x75, 0x73,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x52, 0x55, 0x53, 0x54, 0x45, 0x44, 0x10,
-	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x54, 0x52, 0x55, 0x53, 0x54, 0x57, 0x4f, 0x52, 0x54,
-	0x48, 0x59, 0x10, 0x02, 0x22, 0xa0, 0x03, 0x0a, 0x17, 0x53, 0x79, 0x6e, 0x63, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68,
-	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70,
-	0x6c, 0x69, 0x65, 0x64, 0x41, 0x74, 0x12, 0x65, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x79, 0x6e, 0x63, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x58, 0x0a,
-	0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
-	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a,
-	0x08, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x44,
-	0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e,
-	0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x22, 0xdc, 0x01, 0x0a, 0x1a, 0x53, 0x79, 0x6e, 0x63,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65,
-	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x5c, 0x0a, 0x0f, 0x64, 0x65,
-	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2c, 0x0a, 0x0e, 0x44, 0x65, 0x63, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43,
-	0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x43, 0x4c,
-	0x49, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x22, 0xb2, 0x03, 0x0a, 0x0f, 0x42, 0x61, 0x63, 0x6b, 0x65,
-	0x64, 0x55, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6b, 0x65,
-	0x79, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x65, 0x79,
-	0x55, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x10, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x43,
-	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x69, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x52, 0x08, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x3c,
-	0x0a, 0x0c, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x79, 0x6e, 0x63, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52,
-	0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x51, 0x0a, 0x14,
-	0x65, 0x6e, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x64, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x6e, 0x73, 0x55, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x12, 0x65, 0x6e, 0x73,
-	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
-	0x6a, 0x0a, 0x1c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x63,
-	0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x68, 0x6f, 0x77,
-	0x63, 0x61, 0x73, 0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52,
-	0x1a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65,
-	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x73, 0x0a, 0x0a, 0x52,
-	0x61, 0x77, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x12, 0x4b, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x22, 0xaa, 0x01, 0x0a, 0x0e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x61, 0x77, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x72, 0x61, 0x77, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x52, 0x61, 0x77, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0b,
-	0x72, 0x61, 0x77, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x73,
-	0x75, 0x62, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x73, 0x75, 0x62, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
-	0x2c, 0x0a, 0x11, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x73, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x9e, 0x01,
-	0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x4b, 0x65, 0x79, 0x63, 0x61, 0x72, 0x64, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6b,
-	0x65, 0x79, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x65,
-	0x79, 0x55, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x60,
-	0x0a, 0x0f, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b,
-	0x73, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x6b,
+	0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x41, 0x74, 0x12, 0x65, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x34, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x79,
+	0x6e, 0x63, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x58, 0x0a, 0x12,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45,
+	0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43,
+	0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x22, 0xdc, 0x01, 0x0a, 0x1a, 0x53, 0x79, 0x6e, 0x63, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x5c, 0x0a, 0x0f, 0x64, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x79,
+	0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2c, 0x0a, 0x0e, 0x44, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x43,
+	0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x43, 0x4c, 0x49,
+	0x4e, 0x45, 0x44, 0x10, 0x01, 0x22, 0xb2, 0x03, 0x0a, 0x0f, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x55, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6b, 0x65, 0x79,
+	0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x65, 0x79, 0x55,
+	0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x08, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x3c, 0x0a,
+	0x0c, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x79, 0x6e, 0x63, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x0b,
+	0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x51, 0x0a, 0x14, 0x65,
+	0x6e, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x6e, 0x73, 0x55, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x12, 0x65, 0x6e, 0x73, 0x55,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x6a,
+	0x0a, 0x1c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x68, 0x6f, 0x77, 0x63, 0x61,
+	0x73, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x63,
+	0x61, 0x73, 0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x1a,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x68, 0x6f, 0x77, 0x63, 0x61, 0x73, 0x65, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x73, 0x0a, 0x0a, 0x52, 0x61,
+	0x77, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x4b, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0xea, 0x01, 0x0a, 0x0e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x61, 0x77, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x72, 0x61, 0x77, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0b, 0x73,
-	0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c,
-	0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b,
-	0x22, 0x88, 0x01, 0x0a, 0x1d, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x65, 0x79, 0x55, 0x69, 0x64, 0x22, 0xa2, 0x01, 0x0a, 0x10,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
-	0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x64,
-	0x22, 0x84, 0x01, 0x0a, 0x14, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f,
-	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x18, 0x0a, 0x07, 0x74, 0x65, 0x73, 0x74, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x74, 0x65, 0x73, 0x74, 0x6e, 0x65, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x70, 0x72, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50,
-	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x16, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x75, 0x66, 0x2e, 0x52, 0x61, 0x77, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x72,
+	0x61, 0x77, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x73, 0x75,
+	0x62, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x73, 0x75, 0x62, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2c,
+	0x0a, 0x11, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0a,
+	0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x61, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b,
+	0x52, 0x0a, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0xb3, 0x02, 0x0a,
+	0x14, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x57, 0x61, 0x74, 0x65,
+	0x72, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3d,
+	0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x53, 0x79, 0x6e, 0x63, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x22, 0x82, 0x01,
+	0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x43, 0x48, 0x41, 0x54, 0x53, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x54, 0x41,
+	0x43, 0x54, 0x53, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49,
+	0x54, 0x49, 0x45, 0x53, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e,
+	0x47, 0x53, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54, 0x5f, 0x41,
+	0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x53, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x45, 0x53,
+	0x53, 0x41, 0x47, 0x45, 0x53, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45, 0x52,
+	0x10, 0x07, 0x22, 0x57, 0x0a, 0x15, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x6e,
+	0x63, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x77,
+	0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x52,
+	0x0a, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0b,
+	0x53, 0x79, 0x6e, 0x63, 0x4b, 0x65, 0x79, 0x63, 0x61, 0x72, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6b, 0x65, 0x79,
+	0x5f, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x65, 0x79, 0x55,
+	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x0f,
+	0x53, 0x79, 0x6e, 0x63, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12,
+	0x37, 0x0a, 0x0c, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0b, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x88,
+	0x01, 0x0a, 0x1d, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x2f, 0x0a, 0x13, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x12, 0x17, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6b, 0x65, 0x79, 0x55, 0x69, 0x64, 0x22, 0xa2, 0x01, 0x0a, 0x10, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0x84,
+	0x01, 0x0a, 0x14, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x65, 0x73, 0x74, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x74, 0x65, 0x73, 0x74, 0x6e, 0x65, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x16, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x1a,
+	0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x18, 0x0a, 0x07, 0x74, 0x65, 0x73, 0x74, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x74, 0x65, 0x73, 0x74, 0x6e, 0x65, 0x74, 0x12, 0x42, 0x0a, 0x0b, 0x70, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
 	0x63, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x90, 0x01,
-	0x0a, 0x1a, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x62, 0x6c,
-	0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x65, 0x73, 0x74, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x65, 0x73, 0x74, 0x6e, 0x65, 0x74, 0x12, 0x42, 0x0a, 0x0b,
-	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
-	0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xe8,
+	0x01, 0x0a, 0x11, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x61,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x26, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x41, 0x54, 0x41, 0x10, 0x01,
+	0x12, 0x07, 0x0a, 0x03, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x22, 0x40, 0x0a, 0x12, 0x50, 0x61, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x13,
+	0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x36, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x61, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x83, 0x01, 0x0a, 0x14,
+	0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x22, 0x43, 0x0a, 0x15, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x7c, 0x0a, 0x13, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a,
+	0x07, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x04, 0x72, 0x65, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x04, 0x72, 0x65, 0x61, 0x6c, 0x12,
+	0x14, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x42, 0x07, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x4a, 0x0a, 0x11, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x6f, 0x77, 0x12, 0x35, 0x0a, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x22, 0x74, 0x0a, 0x13, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50,
+	0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x6f, 0x77,
+	0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x13, 0x50, 0x61, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22,
+	0x53, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12,
+	0x09, 0x0a, 0x05, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x49,
+	0x44, 0x45, 0x4f, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x5f, 0x54,
+	0x48, 0x55, 0x4d, 0x42, 0x4e, 0x41, 0x49, 0x4c, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49,
+	0x4c, 0x45, 0x10, 0x05, 0x22, 0x86, 0x02, 0x0a, 0x13, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x35, 0x0a, 0x06,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x61,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x6c, 0x61, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0d, 0x5a,
+	0x0b, 0x2e, 0x2f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4725,112 +5857,138 @@ func file_pairing_proto_rawDescGZIP() []byte {
 	return file_pairing_proto_rawDescData
 }
 
-var file_pairing_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_pairing_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_pairing_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_pairing_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_pairing_proto_goTypes = []interface{}{
 	(SyncActivityCenterCommunityRequestDecisionCommunityRequestDecision)(0), // 0: protobuf.SyncActivityCenterCommunityRequestDecision.community_request_decision
 	(SyncTrustedUser_TrustStatus)(0),                                        // 1: protobuf.SyncTrustedUser.TrustStatus
 	(SyncVerificationRequest_VerificationStatus)(0),                         // 2: protobuf.SyncVerificationRequest.VerificationStatus
 	(SyncContactRequestDecision_DecisionStatus)(0),                          // 3: protobuf.SyncContactRequestDecision.DecisionStatus
-	(*FetchingBackedUpDataDetails)(nil),                                     // 4: protobuf.FetchingBackedUpDataDetails
-	(*Backup)(nil),                                                          // 5: protobuf.Backup
-	(*MultiAccount)(nil),                                                    // 6: protobuf.MultiAccount
-	(*LocalPairingPayload)(nil),                                             // 7: protobuf.LocalPairingPayload
-	(*LocalPairingPeerHello)(nil),                                           // 8: protobuf.LocalPairingPeerHello
-	(*SyncPairInstallation)(nil),                                            // 9: protobuf.SyncPairInstallation
-	(*SyncInstallationContactV2)(nil),                                       // 10: protobuf.SyncInstallationContactV2
-	(*SyncInstallationAccount)(nil),                                         // 11: protobuf.SyncInstallationAccount
-	(*SyncInstallationCommunity)(nil),                                       // 12: protobuf.SyncInstallationCommunity
-	(*SyncCommunityRequestsToJoin)(nil),                                     // 13: protobuf.SyncCommunityRequestsToJoin
-	(*SyncCommunityControlNode)(nil),                                        // 14: protobuf.SyncCommunityControlNode
-	(*SyncChat)(nil),                                                        // 15: protobuf.SyncChat
-	(*MembershipUpdateEvents)(nil),                                          // 16: protobuf.MembershipUpdateEvents
-	(*SyncChatRemoved)(nil),                                                 // 17: protobuf.SyncChatRemoved
-	(*SyncChatMessagesRead)(nil),                                            // 18: protobuf.SyncChatMessagesRead
-	(*SyncActivityCenterRead)(nil),                                          // 19: protobuf.SyncActivityCenterRead
-	(*SyncActivityCenterAccepted)(nil),                                      // 20: protobuf.SyncActivityCenterAccepted
-	(*SyncActivityCenterDismissed)(nil),                                     // 21: protobuf.SyncActivityCenterDismissed
-	(*SyncActivityCenterDeleted)(nil),                                       // 22: protobuf.SyncActivityCenterDeleted
-	(*SyncActivityCenterUnread)(nil),                                        // 23: protobuf.SyncActivityCenterUnread
-	(*SyncActivityCenterCommunityRequestDecision)(nil),                      // 24: protobuf.SyncActivityCenterCommunityRequestDecision
-	(*SyncBookmark)(nil),                                                    // 25: protobuf.SyncBookmark
-	(*SyncEnsUsernameDetail)(nil),                                           // 26: protobuf.SyncEnsUsernameDetail
-	(*SyncClearHistory)(nil),                                                // 27: protobuf.SyncClearHistory
-	(*SyncProfilePicture)(nil),                                              // 28: protobuf.SyncProfilePicture
-	(*SyncProfilePictures)(nil),                                             // 29: protobuf.SyncProfilePictures
-	(*SyncAccount)(nil),                                                     // 30: protobuf.SyncAccount
-	(*SyncKeypair)(nil),                                                     // 31: protobuf.SyncKeypair
-	(*SyncAccountsPositions)(nil),                                           // 32: protobuf.SyncAccountsPositions
-	(*SyncSavedAddress)(nil),                                                // 33: protobuf.SyncSavedAddress
-	(*SyncCommunitySettings)(nil),                                           // 34: protobuf.SyncCommunitySettings
-	(*SyncTrustedUser)(nil),                                                 // 35: protobuf.SyncTrustedUser
-	(*SyncVerificationRequest)(nil),                                         // 36: protobuf.SyncVerificationRequest
-	(*SyncContactRequestDecision)(nil),                                      // 37: protobuf.SyncContactRequestDecision
-	(*BackedUpProfile)(nil),                                                 // 38: protobuf.BackedUpProfile
-	(*RawMessage)(nil),                                                      // 39: protobuf.RawMessage
-	(*SyncRawMessage)(nil),                                                  // 40: protobuf.SyncRawMessage
-	(*SyncKeycard)(nil),                                                     // 41: protobuf.SyncKeycard
-	(*SyncSocialLinks)(nil),                                                 // 42: protobuf.SyncSocialLinks
-	(*SyncAccountCustomizationColor)(nil),                                   // 43: protobuf.SyncAccountCustomizationColor
-	(*TokenPreferences)(nil),                                                // 44: protobuf.TokenPreferences
-	(*SyncTokenPreferences)(nil),                                            // 45: protobuf.SyncTokenPreferences
-	(*CollectiblePreferences)(nil),                                          // 46: protobuf.CollectiblePreferences
-	(*SyncCollectiblePreferences)(nil),                                      // 47: protobuf.SyncCollectiblePreferences
-	(*MultiAccount_ColorHash)(nil),                                          // 48: protobuf.MultiAccount.ColorHash
-	(*MultiAccount_IdentityImage)(nil),                                      // 49: protobuf.MultiAccount.IdentityImage
-	(*LocalPairingPayload_Key)(nil),                                         // 50: protobuf.LocalPairingPayload.Key
-	(*SyncSetting)(nil),                                                     // 51: protobuf.SyncSetting
-	(*RevealedAccount)(nil),                                                 // 52: protobuf.RevealedAccount
-	(*SyncProfileShowcasePreferences)(nil),                                  // 53: protobuf.SyncProfileShowcasePreferences
-	(ApplicationMetadataMessage_Type)(0),                                    // 54: protobuf.ApplicationMetadataMessage.Type
-	(*SocialLink)(nil),                                                      // 55: protobuf.SocialLink
+	(PairingSyncWatermark_Entity)(0),                                        // 4: protobuf.PairingSyncWatermark.Entity
+	(PairingRelayFrame_Type)(0),                                             // 5: protobuf.PairingRelayFrame.Type
+	(PairingHistoryMedia_Type)(0),                                           // 6: protobuf.PairingHistoryMedia.Type
+	(*FetchingBackedUpDataDetails)(nil),                                     // 7: protobuf.FetchingBackedUpDataDetails
+	(*Backup)(nil),                                                          // 8: protobuf.Backup
+	(*MultiAccount)(nil),                                                    // 9: protobuf.MultiAccount
+	(*LocalPairingPayload)(nil),                                             // 10: protobuf.LocalPairingPayload
+	(*LocalPairingPeerHello)(nil),                                           // 11: protobuf.LocalPairingPeerHello
+	(*SyncPairInstallation)(nil),                                            // 12: protobuf.SyncPairInstallation
+	(*SyncInstallationContactV2)(nil),                                       // 13: protobuf.SyncInstallationContactV2
+	(*SyncInstallationAccount)(nil),                                         // 14: protobuf.SyncInstallationAccount
+	(*SyncInstallationCommunity)(nil),                                       // 15: protobuf.SyncInstallationCommunity
+	(*SyncCommunityRequestsToJoin)(nil),                                     // 16: protobuf.SyncCommunityRequestsToJoin
+	(*SyncCommunityControlNode)(nil),                                        // 17: protobuf.SyncCommunityControlNode
+	(*SyncChat)(nil),                                                        // 18: protobuf.SyncChat
+	(*MembershipUpdateEvents)(nil),                                          // 19: protobuf.MembershipUpdateEvents
+	(*SyncChatRemoved)(nil),                                                 // 20: protobuf.SyncChatRemoved
+	(*SyncChatMessagesRead)(nil),                                            // 21: protobuf.SyncChatMessagesRead
+	(*SyncActivityCenterRead)(nil),                                          // 22: protobuf.SyncActivityCenterRead
+	(*SyncActivityCenterAccepted)(nil),                                      // 23: protobuf.SyncActivityCenterAccepted
+	(*SyncActivityCenterDismissed)(nil),                                     // 24: protobuf.SyncActivityCenterDismissed
+	(*SyncActivityCenterDeleted)(nil),                                       // 25: protobuf.SyncActivityCenterDeleted
+	(*SyncActivityCenterUnread)(nil),                                        // 26: protobuf.SyncActivityCenterUnread
+	(*SyncActivityCenterCommunityRequestDecision)(nil),                      // 27: protobuf.SyncActivityCenterCommunityRequestDecision
+	(*SyncBookmark)(nil),                                                    // 28: protobuf.SyncBookmark
+	(*SyncEnsUsernameDetail)(nil),                                           // 29: protobuf.SyncEnsUsernameDetail
+	(*SyncClearHistory)(nil),                                                // 30: protobuf.SyncClearHistory
+	(*SyncProfilePicture)(nil),                                              // 31: protobuf.SyncProfilePicture
+	(*SyncProfilePictures)(nil),                                             // 32: protobuf.SyncProfilePictures
+	(*SyncAccount)(nil),                                                     // 33: protobuf.SyncAccount
+	(*SyncKeypair)(nil),                                                     // 34: protobuf.SyncKeypair
+	(*SyncAccountsPositions)(nil),                                           // 35: protobuf.SyncAccountsPositions
+	(*SyncSavedAddress)(nil),                                                // 36: protobuf.SyncSavedAddress
+	(*SyncCommunitySettings)(nil),                                           // 37: protobuf.SyncCommunitySettings
+	(*SyncTrustedUser)(nil),                                                 // 38: protobuf.SyncTrustedUser
+	(*SyncVerificationRequest)(nil),                                         // 39: protobuf.SyncVerificationRequest
+	(*SyncContactRequestDecision)(nil),                                      // 40: protobuf.SyncContactRequestDecision
+	(*BackedUpProfile)(nil),                                                 // 41: protobuf.BackedUpProfile
+	(*RawMessage)(nil),                                                      // 42: protobuf.RawMessage
+	(*SyncRawMessage)(nil),                                                  // 43: protobuf.SyncRawMessage
+	(*PairingSyncWatermark)(nil),                                            // 44: protobuf.PairingSyncWatermark
+	(*PairingSyncWatermarks)(nil),                                           // 45: protobuf.PairingSyncWatermarks
+	(*SyncKeycard)(nil),                                                     // 46: protobuf.SyncKeycard
+	(*SyncSocialLinks)(nil),                                                 // 47: protobuf.SyncSocialLinks
+	(*SyncAccountCustomizationColor)(nil),                                   // 48: protobuf.SyncAccountCustomizationColor
+	(*TokenPreferences)(nil),                                                // 49: protobuf.TokenPreferences
+	(*SyncTokenPreferences)(nil),                                            // 50: protobuf.SyncTokenPreferences
+	(*CollectiblePreferences)(nil),                                          // 51: protobuf.CollectiblePreferences
+	(*SyncCollectiblePreferences)(nil),                                      // 52: protobuf.SyncCollectiblePreferences
+	(*PairingRelayFrame)(nil),                                               // 53: protobuf.PairingRelayFrame
+	(*PairingRelayHeader)(nil),                                              // 54: protobuf.PairingRelayHeader
+	(*PairingRelayRequest)(nil),                                             // 55: protobuf.PairingRelayRequest
+	(*PairingRelayResponse)(nil),                                            // 56: protobuf.PairingRelayResponse
+	(*PairingHistoryRequest)(nil),                                           // 57: protobuf.PairingHistoryRequest
+	(*PairingHistoryValue)(nil),                                             // 58: protobuf.PairingHistoryValue
+	(*PairingHistoryRow)(nil),                                               // 59: protobuf.PairingHistoryRow
+	(*PairingHistoryTable)(nil),                                             // 60: protobuf.PairingHistoryTable
+	(*PairingHistoryMedia)(nil),                                             // 61: protobuf.PairingHistoryMedia
+	(*PairingHistoryChunk)(nil),                                             // 62: protobuf.PairingHistoryChunk
+	(*MultiAccount_ColorHash)(nil),                                          // 63: protobuf.MultiAccount.ColorHash
+	(*MultiAccount_IdentityImage)(nil),                                      // 64: protobuf.MultiAccount.IdentityImage
+	(*LocalPairingPayload_Key)(nil),                                         // 65: protobuf.LocalPairingPayload.Key
+	(*SyncSetting)(nil),                                                     // 66: protobuf.SyncSetting
+	(*RevealedAccount)(nil),                                                 // 67: protobuf.RevealedAccount
+	(*SyncProfileShowcasePreferences)(nil),                                  // 68: protobuf.SyncProfileShowcasePreferences
+	(ApplicationMetadataMessage_Type)(0),                                    // 69: protobuf.ApplicationMetadataMessage.Type
+	(*SocialLink)(nil),                                                      // 70: protobuf.SocialLink
 }
 var file_pairing_proto_depIdxs = []int32{
-	10, // 0: protobuf.Backup.contacts:type_name -> protobuf.SyncInstallationContactV2
-	12, // 1: protobuf.Backup.communities:type_name -> protobuf.SyncInstallationCommunity
-	4,  // 2: protobuf.Backup.contactsDetails:type_name -> protobuf.FetchingBackedUpDataDetails
-	4,  // 3: protobuf.Backup.communitiesDetails:type_name -> protobuf.FetchingBackedUpDataDetails
-	38, // 4: protobuf.Backup.profile:type_name -> protobuf.BackedUpProfile
-	4,  // 5: protobuf.Backup.profileDetails:type_name -> protobuf.FetchingBackedUpDataDetails
-	51, // 6: protobuf.Backup.setting:type_name -> protobuf.SyncSetting
-	4,  // 7: protobuf.Backup.settingsDetails:type_name -> protobuf.FetchingBackedUpDataDetails
-	31, // 8: protobuf.Backup.keypair:type_name -> protobuf.SyncKeypair
-	4,  // 9: protobuf.Backup.keypairDetails:type_name -> protobuf.FetchingBackedUpDataDetails
-	30, // 10: protobuf.Backup.watchOnlyAccount:type_name -> protobuf.SyncAccount
-	4,  // 11: protobuf.Backup.watchOnlyAccountDetails:type_name -> protobuf.FetchingBackedUpDataDetails
-	15, // 12: protobuf.Backup.chats:type_name -> protobuf.SyncChat
-	4,  // 13: protobuf.Backup.chatsDetails:type_name -> protobuf.FetchingBackedUpDataDetails
-	48, // 14: protobuf.MultiAccount.color_hash:type_name -> protobuf.MultiAccount.ColorHash
-	49, // 15: protobuf.MultiAccount.images:type_name -> protobuf.MultiAccount.IdentityImage
-	50, // 16: protobuf.LocalPairingPayload.keys:type_name -> protobuf.LocalPairingPayload.Key
-	6,  // 17: protobuf.LocalPairingPayload.multiaccount:type_name -> protobuf.MultiAccount
-	13, // 18: protobuf.SyncInstallationCommunity.requests_to_join:type_name -> protobuf.SyncCommunityRequestsToJoin
-	34, // 19: protobuf.SyncInstallationCommunity.settings:type_name -> protobuf.SyncCommunitySettings
-	14, // 20: protobuf.SyncInstallationCommunity.control_node:type_name -> protobuf.SyncCommunityControlNode
-	52, // 21: protobuf.SyncCommunityRequestsToJoin.revealed_accounts:type_name -> protobuf.RevealedAccount
-	16, // 22: protobuf.SyncChat.membershipUpdateEvents:type_name -> protobuf.MembershipUpdateEvents
+	13, // 0: protobuf.Backup.contacts:type_name -> protobuf.SyncInstallationContactV2
+	15, // 1: protobuf.Backup.communities:type_name -> protobuf.SyncInstallationCommunity
+	7,  // 2: protobuf.Backup.contactsDetails:type_name -> protobuf.FetchingBackedUpDataDetails
+	7,  // 3: protobuf.Backup.communitiesDetails:type_name -> protobuf.FetchingBackedUpDataDetails
+	41, // 4: protobuf.Backup.profile:type_name -> protobuf.BackedUpProfile
+	7,  // 5: protobuf.Backup.profileDetails:type_name -> protobuf.FetchingBackedUpDataDetails
+	66, // 6: protobuf.Backup.setting:type_name -> protobuf.SyncSetting
+	7,  // 7: protobuf.Backup.settingsDetails:type_name -> protobuf.FetchingBackedUpDataDetails
+	34, // 8: protobuf.Backup.keypair:type_name -> protobuf.SyncKeypair
+	7,  // 9: protobuf.Backup.keypairDetails:type_name -> protobuf.FetchingBackedUpDataDetails
+	33, // 10: protobuf.Backup.watchOnlyAccount:type_name -> protobuf.SyncAccount
+	7,  // 11: protobuf.Backup.watchOnlyAccountDetails:type_name -> protobuf.FetchingBackedUpDataDetails
+	18, // 12: protobuf.Backup.chats:type_name -> protobuf.SyncChat
+	7,  // 13: protobuf.Backup.chatsDetails:type_name -> protobuf.FetchingBackedUpDataDetails
+	63, // 14: protobuf.MultiAccount.color_hash:type_name -> protobuf.MultiAccount.ColorHash
+	64, // 15: protobuf.MultiAccount.images:type_name -> protobuf.MultiAccount.IdentityImage
+	65, // 16: protobuf.LocalPairingPayload.keys:type_name -> protobuf.LocalPairingPayload.Key
+	9,  // 17: protobuf.LocalPairingPayload.multiaccount:type_name -> protobuf.MultiAccount
+	16, // 18: protobuf.SyncInstallationCommunity.requests_to_join:type_name -> protobuf.SyncCommunityRequestsToJoin
+	37, // 19: protobuf.SyncInstallationCommunity.settings:type_name -> protobuf.SyncCommunitySettings
+	17, // 20: protobuf.SyncInstallationCommunity.control_node:type_name -> protobuf.SyncCommunityControlNode
+	67, // 21: protobuf.SyncCommunityRequestsToJoin.revealed_accounts:type_name -> protobuf.RevealedAccount
+	19, // 22: protobuf.SyncChat.membershipUpdateEvents:type_name -> protobuf.MembershipUpdateEvents
 	0,  // 23: protobuf.SyncActivityCenterCommunityRequestDecision.decision:type_name -> protobuf.SyncActivityCenterCommunityRequestDecision.community_request_decision
-	28, // 24: protobuf.SyncProfilePictures.pictures:type_name -> protobuf.SyncProfilePicture
-	30, // 25: protobuf.SyncKeypair.accounts:type_name -> protobuf.SyncAccount
-	41, // 26: protobuf.SyncKeypair.keycards:type_name -> protobuf.SyncKeycard
-	30, // 27: protobuf.SyncAccountsPositions.accounts:type_name -> protobuf.SyncAccount
+	31, // 24: protobuf.SyncProfilePictures.pictures:type_name -> protobuf.SyncProfilePicture
+	33, // 25: protobuf.SyncKeypair.accounts:type_name -> protobuf.SyncAccount
+	46, // 26: protobuf.SyncKeypair.keycards:type_name -> protobuf.SyncKeycard
+	33, // 27: protobuf.SyncAccountsPositions.accounts:type_name -> protobuf.SyncAccount
 	1,  // 28: protobuf.SyncTrustedUser.status:type_name -> protobuf.SyncTrustedUser.TrustStatus
 	2,  // 29: protobuf.SyncVerificationRequest.verification_status:type_name -> protobuf.SyncVerificationRequest.VerificationStatus
 	3,  // 30: protobuf.SyncContactRequestDecision.decision_status:type_name -> protobuf.SyncContactRequestDecision.DecisionStatus
-	28, // 31: protobuf.BackedUpProfile.pictures:type_name -> protobuf.SyncProfilePicture
-	42, // 32: protobuf.BackedUpProfile.social_links:type_name -> protobuf.SyncSocialLinks
-	26, // 33: protobuf.BackedUpProfile.ens_username_details:type_name -> protobuf.SyncEnsUsernameDetail
-	53, // 34: protobuf.BackedUpProfile.profile_showcase_preferences:type_name -> protobuf.SyncProfileShowcasePreferences
-	54, // 35: protobuf.RawMessage.messageType:type_name -> protobuf.ApplicationMetadataMessage.Type
-	39, // 36: protobuf.SyncRawMessage.rawMessages:type_name -> protobuf.RawMessage
-	55, // 37: protobuf.SyncSocialLinks.social_links:type_name -> protobuf.SocialLink
-	44, // 38: protobuf.SyncTokenPreferences.preferences:type_name -> protobuf.TokenPreferences
-	46, // 39: protobuf.SyncCollectiblePreferences.preferences:type_name -> protobuf.CollectiblePreferences
-	40, // [40:40] is the sub-list for method output_type
-	40, // [40:40] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+	31, // 31: protobuf.BackedUpProfile.pictures:type_name -> protobuf.SyncProfilePicture
+	47, // 32: protobuf.BackedUpProfile.social_links:type_name -> protobuf.SyncSocialLinks
+	29, // 33: protobuf.BackedUpProfile.ens_username_details:type_name -> protobuf.SyncEnsUsernameDetail
+	68, // 34: protobuf.BackedUpProfile.profile_showcase_preferences:type_name -> protobuf.SyncProfileShowcasePreferences
+	69, // 35: protobuf.RawMessage.messageType:type_name -> protobuf.ApplicationMetadataMessage.Type
+	42, // 36: protobuf.SyncRawMessage.rawMessages:type_name -> protobuf.RawMessage
+	44, // 37: protobuf.SyncRawMessage.watermarks:type_name -> protobuf.PairingSyncWatermark
+	4,  // 38: protobuf.PairingSyncWatermark.entity:type_name -> protobuf.PairingSyncWatermark.Entity
+	44, // 39: protobuf.PairingSyncWatermarks.watermarks:type_name -> protobuf.PairingSyncWatermark
+	70, // 40: protobuf.SyncSocialLinks.social_links:type_name -> protobuf.SocialLink
+	49, // 41: protobuf.SyncTokenPreferences.preferences:type_name -> protobuf.TokenPreferences
+	51, // 42: protobuf.SyncCollectiblePreferences.preferences:type_name -> protobuf.CollectiblePreferences
+	5,  // 43: protobuf.PairingRelayFrame.type:type_name -> protobuf.PairingRelayFrame.Type
+	54, // 44: protobuf.PairingRelayRequest.headers:type_name -> protobuf.PairingRelayHeader
+	54, // 45: protobuf.PairingRelayResponse.headers:type_name -> protobuf.PairingRelayHeader
+	58, // 46: protobuf.PairingHistoryRow.values:type_name -> protobuf.PairingHistoryValue
+	59, // 47: protobuf.PairingHistoryTable.rows:type_name -> protobuf.PairingHistoryRow
+	6,  // 48: protobuf.PairingHistoryMedia.type:type_name -> protobuf.PairingHistoryMedia.Type
+	60, // 49: protobuf.PairingHistoryChunk.tables:type_name -> protobuf.PairingHistoryTable
+	61, // 50: protobuf.PairingHistoryChunk.media:type_name -> protobuf.PairingHistoryMedia
+	51, // [51:51] is the sub-list for method output_type
+	51, // [51:51] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_pairing_proto_init() }
@@ -5289,7 +6447,7 @@ func file_pairing_proto_init() {
 			}
 		}
 		file_pairing_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncKeycard); i {
+			switch v := v.(*PairingSyncWatermark); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5301,7 +6459,7 @@ func file_pairing_proto_init() {
 			}
 		}
 		file_pairing_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncSocialLinks); i {
+			switch v := v.(*PairingSyncWatermarks); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5313,7 +6471,7 @@ func file_pairing_proto_init() {
 			}
 		}
 		file_pairing_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncAccountCustomizationColor); i {
+			switch v := v.(*SyncKeycard); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5325,7 +6483,7 @@ func file_pairing_proto_init() {
 			}
 		}
 		file_pairing_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenPreferences); i {
+			switch v := v.(*SyncSocialLinks); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5337,7 +6495,7 @@ func file_pairing_proto_init() {
 			}
 		}
 		file_pairing_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncTokenPreferences); i {
+			switch v := v.(*SyncAccountCustomizationColor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5349,7 +6507,7 @@ func file_pairing_proto_init() {
 			}
 		}
 		file_pairing_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollectiblePreferences); i {
+			switch v := v.(*TokenPreferences); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5361,7 +6519,7 @@ func file_pairing_proto_init() {
 			}
 		}
 		file_pairing_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncCollectiblePreferences); i {
+			switch v := v.(*SyncTokenPreferences); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5373,7 +6531,7 @@ func file_pairing_proto_init() {
 			}
 		}
 		file_pairing_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiAccount_ColorHash); i {
+			switch v := v.(*CollectiblePreferences); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5385,7 +6543,7 @@ func file_pairing_proto_init() {
 			}
 		}
 		file_pairing_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiAccount_IdentityImage); i {
+			switch v := v.(*SyncCollectiblePreferences); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5397,6 +6555,150 @@ func file_pairing_proto_init() {
 			}
 		}
 		file_pairing_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairingRelayFrame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pairing_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairingRelayHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pairing_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairingRelayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pairing_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairingRelayResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pairing_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairingHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pairing_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairingHistoryValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pairing_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairingHistoryRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pairing_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairingHistoryTable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pairing_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairingHistoryMedia); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pairing_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairingHistoryChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pairing_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiAccount_ColorHash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pairing_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiAccount_IdentityImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pairing_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LocalPairingPayload_Key); i {
 			case 0:
 				return &v.state
@@ -5409,13 +6711,19 @@ func file_pairing_proto_init() {
 			}
 		}
 	}
+	file_pairing_proto_msgTypes[51].OneofWrappers = []interface{}{
+		(*PairingHistoryValue_Integer)(nil),
+		(*PairingHistoryValue_Real)(nil),
+		(*PairingHistoryValue_Text)(nil),
+		(*PairingHistoryValue_Blob)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pairing_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   47,
+			NumEnums:      7,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
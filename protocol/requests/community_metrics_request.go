@@ -8,6 +8,12 @@ import (
 
 var ErrNoCommunityID = errors.New("community metrics request has no community id")
 var ErrInvalidTimestampIntervals = errors.New("community metrics request invalid time intervals")
+var ErrInvalidMetricsPagination = errors.New("community metrics request invalid pagination")
+var ErrInvalidTimezoneOffset = errors.New("community metrics request invalid timezone offset")
+
+// Timezones range from UTC-12 to UTC+14
+const minTimezoneOffset = -12 * 60 * 60
+const maxTimezoneOffset = 14 * 60 * 60
 
 type CommunityMetricsRequestType uint
 
@@ -16,6 +22,15 @@ const (
 	CommunityMetricsRequestMessagesCount
 	CommunityMetricsRequestMembers
 	CommunityMetricsRequestControlNodeUptime
+	// Daily, weekly and monthly active members as of the end of each interval
+	CommunityMetricsRequestActiveMembers
+	// Requests to join by state and requests to leave made in each interval
+	CommunityMetricsRequestMembersFunnel
+	// Messages per hour and weekday of each channel
+	CommunityMetricsRequestChannelsHeatmap
+	CommunityMetricsRequestTopPosters
+	// Members who joined in an interval and posted in the following ones
+	CommunityMetricsRequestRetention
 )
 
 type MetricsIntervalRequest struct {
@@ -27,6 +42,12 @@ type CommunityMetricsRequest struct {
 	CommunityID types.HexBytes              `json:"communityId"`
 	Type        CommunityMetricsRequestType `json:"type"`
 	Intervals   []MetricsIntervalRequest    `json:"intervals"`
+	// TimezoneOffset in seconds east of UTC, used to bucket the heatmaps
+	TimezoneOffset int64 `json:"timezoneOffset"`
+	// Limit and Offset paginate the top posters and the channels heatmaps,
+	// no limit returns everything
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
 }
 
 func (r *CommunityMetricsRequest) Validate() error {
@@ -40,5 +61,22 @@ func (r *CommunityMetricsRequest) Validate() error {
 		}
 	}
 
+	// Cohorts are compared with the intervals that follow them
+	if r.Type == CommunityMetricsRequestRetention {
+		for i := 1; i < len(r.Intervals); i++ {
+			if r.Intervals[i].StartTimestamp < r.Intervals[i-1].EndTimestamp {
+				return ErrInvalidTimestampIntervals
+			}
+		}
+	}
+
+	if r.Limit < 0 || r.Offset < 0 {
+		return ErrInvalidMetricsPagination
+	}
+
+	if r.TimezoneOffset < minTimezoneOffset || r.TimezoneOffset > maxTimezoneOffset {
+		return ErrInvalidTimezoneOffset
+	}
+
 	return nil
 }
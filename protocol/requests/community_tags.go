@@ -81,13 +81,15 @@ func RemoveUnknownAndDeduplicateTags(input []string) []string {
 	return unique(result)
 }
 
+// unique removes the duplicates, keeping the order of the first occurrences
 func unique(slice []string) []string {
 	uniqMap := make(map[string]struct{})
+	uniqSlice := make([]string, 0, len(slice))
 	for _, v := range slice {
+		if _, ok := uniqMap[v]; ok {
+			continue
+		}
 		uniqMap[v] = struct{}{}
-	}
-	uniqSlice := make([]string, 0, len(uniqMap))
-	for v := range uniqMap {
 		uniqSlice = append(uniqSlice, v)
 	}
 	return uniqSlice
@@ -0,0 +1,79 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrCreateCommunityCalendarEventInvalidCommunityID = errors.New("create-community-calendar-event: invalid community id")
+var ErrCreateCommunityCalendarEventInvalidTitle = errors.New("create-community-calendar-event: invalid title")
+var ErrCreateCommunityCalendarEventInvalidTimeRange = errors.New("create-community-calendar-event: invalid time range")
+var ErrCreateCommunityCalendarEventInvalidTokenCriteria = errors.New("create-community-calendar-event: invalid token criteria")
+
+const maxCommunityCalendarEventTitleLength = 100
+const maxCommunityCalendarEventDescriptionLength = 1000
+
+type CreateCommunityCalendarEvent struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	// StartTime and EndTime are unix timestamps in seconds, EndTime is optional
+	StartTime uint64 `json:"startTime"`
+	EndTime   uint64 `json:"endTime"`
+	// Location is a physical place or a link to the call
+	Location string `json:"location"`
+	// ChatID optionally points to the channel the event takes place in
+	ChatID string `json:"chatId"`
+	// AttendanceTokenCriteria must all be satisfied by the shared addresses
+	// of members going to the event, anyone can attend if empty
+	AttendanceTokenCriteria []*protobuf.TokenCriteria `json:"attendanceTokenCriteria"`
+}
+
+func (c *CreateCommunityCalendarEvent) Validate() error {
+	if len(c.CommunityID) == 0 {
+		return ErrCreateCommunityCalendarEventInvalidCommunityID
+	}
+
+	if len(c.Title) == 0 || len(c.Title) > maxCommunityCalendarEventTitleLength || len(c.Description) > maxCommunityCalendarEventDescriptionLength {
+		return ErrCreateCommunityCalendarEventInvalidTitle
+	}
+
+	if c.StartTime == 0 || (c.EndTime != 0 && c.EndTime < c.StartTime) {
+		return ErrCreateCommunityCalendarEventInvalidTimeRange
+	}
+
+	if len(c.AttendanceTokenCriteria) > 0 {
+		permission := &CreateCommunityTokenPermission{
+			CommunityID:   c.CommunityID,
+			Type:          protobuf.CommunityTokenPermission_BECOME_MEMBER,
+			TokenCriteria: c.AttendanceTokenCriteria,
+		}
+		if permission.Validate() != nil {
+			return ErrCreateCommunityCalendarEventInvalidTokenCriteria
+		}
+	}
+
+	return nil
+}
+
+// ToCalendarEvent leaves the id, clock and creator to be set by the caller
+func (c *CreateCommunityCalendarEvent) ToCalendarEvent() *protobuf.CommunityCalendarEvent {
+	calendarEvent := &protobuf.CommunityCalendarEvent{
+		Title:       c.Title,
+		Description: c.Description,
+		StartTime:   c.StartTime,
+		EndTime:     c.EndTime,
+		Location:    c.Location,
+		ChatId:      c.ChatID,
+	}
+
+	if len(c.AttendanceTokenCriteria) > 0 {
+		calendarEvent.AttendancePermissions = []*protobuf.CommunityTokenPermission{{
+			TokenCriteria: c.AttendanceTokenCriteria,
+		}}
+	}
+
+	return calendarEvent
+}
@@ -0,0 +1,37 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrCreateCommunityInviteInvalidCommunityID = errors.New("create-community-invite: invalid community id")
+var ErrCreateCommunityInviteInvalidDuration = errors.New("create-community-invite: invalid duration")
+
+// Invites can't outlive a year, permanent access is granted by sharing the community itself
+const maxCommunityInviteDuration = 365 * 24 * 60 * 60
+
+type CreateCommunityInvite struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	// Duration of the invite in seconds, 0 means the invite never expires
+	Duration uint64 `json:"duration"`
+	// MaxUses is the number of members that can redeem the invite, 0 means unlimited
+	MaxUses uint32 `json:"maxUses"`
+	// ChannelID optionally points the invite to a specific channel
+	ChannelID string `json:"channelId"`
+	// AutoAccept lets the invitees skip the manual approval of their request to join
+	AutoAccept bool `json:"autoAccept"`
+}
+
+func (c *CreateCommunityInvite) Validate() error {
+	if len(c.CommunityID) == 0 {
+		return ErrCreateCommunityInviteInvalidCommunityID
+	}
+
+	if c.Duration > maxCommunityInviteDuration {
+		return ErrCreateCommunityInviteInvalidDuration
+	}
+
+	return nil
+}
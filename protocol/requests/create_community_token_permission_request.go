@@ -9,12 +9,14 @@ import (
 )
 
 const maxTokenCriteriaPerPermission = 5
+const maxTokenCriteriaExpressionDepth = 4
 
 var (
 	ErrCreateCommunityTokenPermissionInvalidCommunityID    = errors.New("create community token permission needs a valid community id")
 	ErrCreateCommunityTokenPermissionTooManyTokenCriteria  = errors.New("too many token criteria")
 	ErrCreateCommunityTokenPermissionInvalidPermissionType = errors.New("invalid community token permission type")
 	ErrCreateCommunityTokenPermissionInvalidTokenCriteria  = errors.New("invalid community permission token criteria data")
+	ErrCreateCommunityTokenPermissionInvalidExpression     = errors.New("invalid community permission token criteria expression")
 )
 
 type CreateCommunityTokenPermission struct {
@@ -23,6 +25,7 @@ type CreateCommunityTokenPermission struct {
 	TokenCriteria []*protobuf.TokenCriteria              `json:"tokenCriteria"`
 	IsPrivate     bool                                   `json:"isPrivate"`
 	ChatIds       []string                               `json:"chat_ids"`
+	Expression    *protobuf.TokenCriteriaExpression      `json:"expression,omitempty"`
 }
 
 func (p *CreateCommunityTokenPermission) Validate() error {
@@ -43,20 +46,114 @@ func (p *CreateCommunityTokenPermission) Validate() error {
 			return ErrCreateCommunityTokenPermissionInvalidTokenCriteria
 		}
 
+		if c.Type == protobuf.CommunityTokenType_ERC1155 {
+			// ERC1155 criteria need the token IDs to look up and a whole amount of them
+			amount, err := strconv.ParseUint(c.Amount, 10, 64)
+			if len(c.ContractAddresses) == 0 || len(c.TokenIds) == 0 || err != nil || amount == 0 {
+				return ErrCreateCommunityTokenPermissionInvalidTokenCriteria
+			}
+			continue
+		}
+
 		floatAmount, _ := strconv.ParseFloat(c.Amount, 32)
 		if len(c.ContractAddresses) > 0 && floatAmount == 0 {
 			return ErrCreateCommunityTokenPermissionInvalidTokenCriteria
 		}
 	}
 
+	if p.Expression != nil {
+		referenced := make(map[uint32]bool)
+		if !validateTokenCriteriaExpression(p.Expression, len(p.TokenCriteria), 1, referenced) {
+			return ErrCreateCommunityTokenPermissionInvalidExpression
+		}
+		if len(referenced) != len(p.TokenCriteria) {
+			return ErrCreateCommunityTokenPermissionInvalidExpression
+		}
+		// Clients unaware of expressions only check the criteria required by every way
+		// of meeting the expression, without any they would let everyone in.
+		// Alternatives without a common criteria have to be separate permissions.
+		if len(requiredTokenCriteriaIndexes(p.Expression)) == 0 {
+			return ErrCreateCommunityTokenPermissionInvalidExpression
+		}
+	}
+
 	return nil
 }
 
+func validateTokenCriteriaExpression(expression *protobuf.TokenCriteriaExpression, criteriaCount int, depth int, referenced map[uint32]bool) bool {
+	if expression == nil || depth > maxTokenCriteriaExpressionDepth {
+		return false
+	}
+
+	switch expression.Operator {
+	case protobuf.TokenCriteriaExpression_CRITERIA:
+		if len(expression.Operands) != 0 || int(expression.CriteriaIndex) >= criteriaCount {
+			return false
+		}
+		referenced[expression.CriteriaIndex] = true
+		return true
+	case protobuf.TokenCriteriaExpression_NOT:
+		if len(expression.Operands) != 1 {
+			return false
+		}
+	case protobuf.TokenCriteriaExpression_AND, protobuf.TokenCriteriaExpression_OR:
+		if len(expression.Operands) == 0 {
+			return false
+		}
+	default:
+		return false
+	}
+
+	for _, operand := range expression.Operands {
+		if !validateTokenCriteriaExpression(operand, criteriaCount, depth+1, referenced) {
+			return false
+		}
+	}
+	return true
+}
+
+// requiredTokenCriteriaIndexes returns the criteria that have to be met for
+// the expression to be satisfied, those only reachable through AND clauses.
+func requiredTokenCriteriaIndexes(expression *protobuf.TokenCriteriaExpression) []uint32 {
+	switch expression.Operator {
+	case protobuf.TokenCriteriaExpression_CRITERIA:
+		return []uint32{expression.CriteriaIndex}
+	case protobuf.TokenCriteriaExpression_AND:
+		var indexes []uint32
+		for _, operand := range expression.Operands {
+			indexes = append(indexes, requiredTokenCriteriaIndexes(operand)...)
+		}
+		return indexes
+	}
+	return nil
+}
+
+// permissionTokenCriteria returns the flat criteria list and the criteria
+// referred to by the expression. With an expression, the flat list only keeps
+// the required criteria for clients unaware of expressions.
+func (p *CreateCommunityTokenPermission) permissionTokenCriteria() (tokenCriteria []*protobuf.TokenCriteria, expressionTokenCriteria []*protobuf.TokenCriteria) {
+	if p.Expression == nil {
+		return p.TokenCriteria, nil
+	}
+
+	added := make(map[uint32]bool)
+	for _, index := range requiredTokenCriteriaIndexes(p.Expression) {
+		if !added[index] {
+			added[index] = true
+			tokenCriteria = append(tokenCriteria, p.TokenCriteria[index])
+		}
+	}
+	return tokenCriteria, p.TokenCriteria
+}
+
 func (p *CreateCommunityTokenPermission) ToCommunityTokenPermission() protobuf.CommunityTokenPermission {
+	tokenCriteria, expressionTokenCriteria := p.permissionTokenCriteria()
 	return protobuf.CommunityTokenPermission{
-		Type:          p.Type,
-		TokenCriteria: p.TokenCriteria,
-		IsPrivate:     p.IsPrivate,
-		ChatIds:       p.ChatIds,
+		Type:                    p.Type,
+		TokenCriteria:           tokenCriteria,
+		IsPrivate:               p.IsPrivate,
+		ChatIds:                 p.ChatIds,
+		Expression:              p.Expression,
+		ExpressionTokenCriteria: expressionTokenCriteria,
 	}
 }
@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrDeleteCommunityCalendarEventInvalidCommunityID = errors.New("delete-community-calendar-event: invalid community id")
+var ErrDeleteCommunityCalendarEventInvalidID = errors.New("delete-community-calendar-event: invalid event id")
+
+type DeleteCommunityCalendarEvent struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	EventID     string         `json:"eventId"`
+}
+
+func (d *DeleteCommunityCalendarEvent) Validate() error {
+	if len(d.CommunityID) == 0 {
+		return ErrDeleteCommunityCalendarEventInvalidCommunityID
+	}
+
+	if len(d.EventID) == 0 {
+		return ErrDeleteCommunityCalendarEventInvalidID
+	}
+
+	return nil
+}
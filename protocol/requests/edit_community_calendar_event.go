@@ -0,0 +1,20 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrEditCommunityCalendarEventInvalidID = errors.New("edit-community-calendar-event: invalid event id")
+
+type EditCommunityCalendarEvent struct {
+	EventID string `json:"eventId"`
+	CreateCommunityCalendarEvent
+}
+
+func (e *EditCommunityCalendarEvent) Validate() error {
+	if len(e.EventID) == 0 {
+		return ErrEditCommunityCalendarEventInvalidID
+	}
+
+	return e.CreateCommunityCalendarEvent.Validate()
+}
@@ -24,11 +24,14 @@ func (u *EditCommunityTokenPermission) Validate() error {
 }
 
 func (u *EditCommunityTokenPermission) ToCommunityTokenPermission() protobuf.CommunityTokenPermission {
+	tokenCriteria, expressionTokenCriteria := u.permissionTokenCriteria()
 	return protobuf.CommunityTokenPermission{
-		Id:            u.PermissionID,
-		Type:          u.Type,
-		TokenCriteria: u.TokenCriteria,
-		ChatIds:       u.ChatIds,
-		IsPrivate:     u.IsPrivate,
+		Id:                      u.PermissionID,
+		Type:                    u.Type,
+		TokenCriteria:           tokenCriteria,
+		ChatIds:                 u.ChatIds,
+		IsPrivate:               u.IsPrivate,
+		Expression:              u.Expression,
+		ExpressionTokenCriteria: expressionTokenCriteria,
 	}
 }
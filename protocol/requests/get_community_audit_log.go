@@ -0,0 +1,32 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrGetCommunityAuditLogInvalidCommunityID = errors.New("get-community-audit-log: invalid community id")
+var ErrGetCommunityAuditLogInvalidLimit = errors.New("get-community-audit-log: invalid limit")
+
+type GetCommunityAuditLog struct {
+	CommunityID types.HexBytes                      `json:"communityId"`
+	Actor       string                              `json:"actor"`
+	Target      string                              `json:"target"`
+	Actions     []protobuf.CommunityEvent_EventType `json:"actions"`
+	Cursor      uint64                              `json:"cursor"`
+	Limit       int                                 `json:"limit"`
+}
+
+func (g *GetCommunityAuditLog) Validate() error {
+	if len(g.CommunityID) == 0 {
+		return ErrGetCommunityAuditLogInvalidCommunityID
+	}
+
+	if g.Limit < 0 {
+		return ErrGetCommunityAuditLogInvalidLimit
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/discord"
 )
 
 // errrors
@@ -11,6 +12,7 @@ var (
 	ErrImportDiscordChannelMissingFilesToImport = errors.New("import-discord-channel: missing files to import")
 	ErrImportDiscordChannelChannelIDIsEmpty     = errors.New("import-discord-channel: discord channel id is empty")
 	ErrImportDiscordChannelCommunityIDIsEmpty   = errors.New("import-discord-channel: community id is empty")
+	ErrImportDiscordChannelInvalidSource        = errors.New("import-discord-channel: invalid import source")
 )
 
 type ImportDiscordChannel struct {
@@ -22,6 +24,8 @@ type ImportDiscordChannel struct {
 	Emoji            string         `json:"emoji"`
 	FilesToImport    []string       `json:"filesToImport"`
 	From             int64          `json:"from"`
+	// Source is the platform the files were exported from, Discord if empty
+	Source discord.ImportSource `json:"source"`
 }
 
 func (r *ImportDiscordChannel) Validate() error {
@@ -37,5 +41,9 @@ func (r *ImportDiscordChannel) Validate() error {
 		return ErrImportDiscordChannelCommunityIDIsEmpty
 	}
 
+	if !r.Source.Valid() {
+		return ErrImportDiscordChannelInvalidSource
+	}
+
 	return nil
 }
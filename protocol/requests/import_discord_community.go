@@ -2,16 +2,21 @@ package requests
 
 import (
 	"errors"
+
+	"github.com/status-im/status-go/protocol/discord"
 )
 
 var (
 	ErrImportDiscordCommunityMissingFilesToImport = errors.New("import-discord-community: missing files to import")
+	ErrImportDiscordCommunityInvalidSource        = errors.New("import-discord-community: invalid import source")
 )
 
 type ImportDiscordCommunity struct {
 	CreateCommunity
 	FilesToImport []string
 	From          int64
+	// Source is the platform the files were exported from, Discord if empty
+	Source discord.ImportSource
 }
 
 func (u *ImportDiscordCommunity) Validate() error {
@@ -19,6 +24,10 @@ func (u *ImportDiscordCommunity) Validate() error {
 		return ErrImportDiscordCommunityMissingFilesToImport
 	}
 
+	if !u.Source.Valid() {
+		return ErrImportDiscordCommunityInvalidSource
+	}
+
 	return u.CreateCommunity.Validate()
 }
 
@@ -20,6 +20,8 @@ type RequestToJoinCommunity struct {
 	AddressesToReveal []string         `json:"addressesToReveal"`
 	Signatures        []types.HexBytes `json:"signatures"` // the order of signatures should match the order of addresses
 	AirdropAddress    string           `json:"airdropAddress"`
+	// Invite is the encoded invite data taken from a community invite link
+	Invite string `json:"invite"`
 }
 
 func (j *RequestToJoinCommunity) Validate(full bool) error {
@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrRevokeCommunityInviteInvalidCommunityID = errors.New("revoke-community-invite: invalid community id")
+var ErrRevokeCommunityInviteInvalidInviteID = errors.New("revoke-community-invite: invalid invite id")
+
+type RevokeCommunityInvite struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	InviteID    string         `json:"inviteId"`
+}
+
+func (r *RevokeCommunityInvite) Validate() error {
+	if len(r.CommunityID) == 0 {
+		return ErrRevokeCommunityInviteInvalidCommunityID
+	}
+
+	if len(r.InviteID) == 0 {
+		return ErrRevokeCommunityInviteInvalidInviteID
+	}
+
+	return nil
+}
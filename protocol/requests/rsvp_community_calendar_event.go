@@ -0,0 +1,38 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrRsvpCommunityCalendarEventInvalidCommunityID = errors.New("rsvp-community-calendar-event: invalid community id")
+var ErrRsvpCommunityCalendarEventInvalidID = errors.New("rsvp-community-calendar-event: invalid event id")
+var ErrRsvpCommunityCalendarEventInvalidStatus = errors.New("rsvp-community-calendar-event: invalid status")
+
+type RsvpCommunityCalendarEvent struct {
+	CommunityID types.HexBytes                             `json:"communityId"`
+	EventID     string                                     `json:"eventId"`
+	Status      protobuf.CommunityCalendarEventRsvp_Status `json:"status"`
+}
+
+func (r *RsvpCommunityCalendarEvent) Validate() error {
+	if len(r.CommunityID) == 0 {
+		return ErrRsvpCommunityCalendarEventInvalidCommunityID
+	}
+
+	if len(r.EventID) == 0 {
+		return ErrRsvpCommunityCalendarEventInvalidID
+	}
+
+	switch r.Status {
+	case protobuf.CommunityCalendarEventRsvp_GOING,
+		protobuf.CommunityCalendarEventRsvp_INTERESTED,
+		protobuf.CommunityCalendarEventRsvp_NOT_GOING:
+	default:
+		return ErrRsvpCommunityCalendarEventInvalidStatus
+	}
+
+	return nil
+}
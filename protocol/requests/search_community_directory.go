@@ -0,0 +1,51 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrSearchCommunityDirectoryInvalidTags = errors.New("search-community-directory: invalid tags")
+var ErrSearchCommunityDirectoryInvalidSortBy = errors.New("search-community-directory: invalid sort by")
+var ErrSearchCommunityDirectoryInvalidLimit = errors.New("search-community-directory: invalid limit")
+var ErrSearchCommunityDirectoryInvalidOffset = errors.New("search-community-directory: invalid offset")
+
+type CommunityDirectorySortBy int
+
+const (
+	// CommunityDirectorySortByVerified lists the verified communities first, then the
+	// most recently announced ones. Member counts are self-reported and not used for ranking.
+	CommunityDirectorySortByVerified CommunityDirectorySortBy = iota
+	CommunityDirectorySortByName
+	CommunityDirectorySortByRecent
+)
+
+type SearchCommunityDirectory struct {
+	// Query is matched against the name, description and tags of the communities
+	Query string `json:"query"`
+	// Tags the communities must all have
+	Tags         []string                 `json:"tags"`
+	VerifiedOnly bool                     `json:"verifiedOnly"`
+	SortBy       CommunityDirectorySortBy `json:"sortBy"`
+	Limit        int                      `json:"limit"`
+	Offset       int                      `json:"offset"`
+}
+
+func (s *SearchCommunityDirectory) Validate() error {
+	if !ValidateTags(s.Tags) {
+		return ErrSearchCommunityDirectoryInvalidTags
+	}
+
+	if s.SortBy < CommunityDirectorySortByVerified || s.SortBy > CommunityDirectorySortByRecent {
+		return ErrSearchCommunityDirectoryInvalidSortBy
+	}
+
+	if s.Limit < 0 {
+		return ErrSearchCommunityDirectoryInvalidLimit
+	}
+
+	if s.Offset < 0 {
+		return ErrSearchCommunityDirectoryInvalidOffset
+	}
+
+	return nil
+}
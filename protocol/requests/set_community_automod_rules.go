@@ -0,0 +1,105 @@
+package requests
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrSetCommunityAutomodRulesInvalidCommunityID = errors.New("set-community-automod-rules: invalid community id")
+var ErrSetCommunityAutomodRulesInvalidType = errors.New("set-community-automod-rules: invalid rule type")
+var ErrSetCommunityAutomodRulesInvalidAction = errors.New("set-community-automod-rules: invalid rule action")
+var ErrSetCommunityAutomodRulesNoPatterns = errors.New("set-community-automod-rules: rule has no patterns")
+var ErrSetCommunityAutomodRulesInvalidRegex = errors.New("set-community-automod-rules: invalid regular expression")
+var ErrSetCommunityAutomodRulesInvalidThreshold = errors.New("set-community-automod-rules: invalid threshold")
+var ErrSetCommunityAutomodRulesInvalidWindow = errors.New("set-community-automod-rules: invalid window")
+var ErrSetCommunityAutomodRulesInvalidTimeout = errors.New("set-community-automod-rules: invalid timeout duration")
+var ErrSetCommunityAutomodRulesDuplicatedID = errors.New("set-community-automod-rules: duplicated rule id")
+
+// Rate and duplicate rules only keep recent messages in memory
+const maxCommunityAutomodWindow = 24 * 60 * 60
+
+// New member restrictions can't last longer than a month
+const maxCommunityAutomodNewMemberWindow = 30 * 24 * 60 * 60
+
+type SetCommunityAutomodRules struct {
+	CommunityID types.HexBytes                   `json:"communityId"`
+	Enabled     bool                             `json:"enabled"`
+	Rules       []*protobuf.CommunityAutomodRule `json:"rules"`
+}
+
+func (s *SetCommunityAutomodRules) Validate() error {
+	if len(s.CommunityID) == 0 {
+		return ErrSetCommunityAutomodRulesInvalidCommunityID
+	}
+
+	ids := make(map[string]struct{})
+	for _, rule := range s.Rules {
+		if rule.Id != "" {
+			if _, ok := ids[rule.Id]; ok {
+				return ErrSetCommunityAutomodRulesDuplicatedID
+			}
+			ids[rule.Id] = struct{}{}
+		}
+
+		if err := validateCommunityAutomodRule(rule); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateCommunityAutomodRule(rule *protobuf.CommunityAutomodRule) error {
+	switch rule.Type {
+	case protobuf.CommunityAutomodRule_KEYWORDS:
+		if len(rule.Patterns) == 0 {
+			return ErrSetCommunityAutomodRulesNoPatterns
+		}
+
+	case protobuf.CommunityAutomodRule_REGEX:
+		if len(rule.Patterns) == 0 {
+			return ErrSetCommunityAutomodRulesNoPatterns
+		}
+		for _, pattern := range rule.Patterns {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return ErrSetCommunityAutomodRulesInvalidRegex
+			}
+		}
+
+	case protobuf.CommunityAutomodRule_LINK_DOMAINS:
+		if len(rule.Patterns) == 0 && len(rule.AllowedDomains) == 0 {
+			return ErrSetCommunityAutomodRulesNoPatterns
+		}
+
+	case protobuf.CommunityAutomodRule_MESSAGE_RATE, protobuf.CommunityAutomodRule_DUPLICATE_MESSAGES:
+		if rule.Threshold == 0 {
+			return ErrSetCommunityAutomodRulesInvalidThreshold
+		}
+		if rule.Window == 0 || rule.Window > maxCommunityAutomodWindow {
+			return ErrSetCommunityAutomodRulesInvalidWindow
+		}
+
+	case protobuf.CommunityAutomodRule_NEW_MEMBER:
+		if rule.Window == 0 || rule.Window > maxCommunityAutomodNewMemberWindow {
+			return ErrSetCommunityAutomodRulesInvalidWindow
+		}
+
+	default:
+		return ErrSetCommunityAutomodRulesInvalidType
+	}
+
+	switch rule.Action {
+	case protobuf.CommunityAutomodRule_DELETE, protobuf.CommunityAutomodRule_BAN:
+	case protobuf.CommunityAutomodRule_TIMEOUT:
+		if rule.TimeoutDuration == 0 || rule.TimeoutDuration > maxCommunityMemberTimeout {
+			return ErrSetCommunityAutomodRulesInvalidTimeout
+		}
+	default:
+		return ErrSetCommunityAutomodRulesInvalidAction
+	}
+
+	return nil
+}
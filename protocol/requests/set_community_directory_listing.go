@@ -0,0 +1,22 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrSetCommunityDirectoryListingInvalidCommunityID = errors.New("set-community-directory-listing: invalid community id")
+
+type SetCommunityDirectoryListing struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	Listed      bool           `json:"listed"`
+}
+
+func (s *SetCommunityDirectoryListing) Validate() error {
+	if len(s.CommunityID) == 0 {
+		return ErrSetCommunityDirectoryListingInvalidCommunityID
+	}
+
+	return nil
+}
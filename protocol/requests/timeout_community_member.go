@@ -0,0 +1,38 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrTimeoutCommunityMemberInvalidCommunityID = errors.New("timeout-community-member: invalid community id")
+var ErrTimeoutCommunityMemberInvalidUser = errors.New("timeout-community-member: invalid user id")
+var ErrTimeoutCommunityMemberInvalidDuration = errors.New("timeout-community-member: invalid duration")
+
+// Longest timeout a member can get, bans are meant for anything longer
+const maxCommunityMemberTimeout = 28 * 24 * 60 * 60
+
+type TimeoutCommunityMember struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	User        types.HexBytes `json:"user"`
+	// Duration of the timeout in seconds, 0 lifts the timeout
+	Duration uint64 `json:"duration"`
+	Reason   string `json:"reason"`
+}
+
+func (t *TimeoutCommunityMember) Validate() error {
+	if len(t.CommunityID) == 0 {
+		return ErrTimeoutCommunityMemberInvalidCommunityID
+	}
+
+	if len(t.User) == 0 {
+		return ErrTimeoutCommunityMemberInvalidUser
+	}
+
+	if t.Duration > maxCommunityMemberTimeout {
+		return ErrTimeoutCommunityMemberInvalidDuration
+	}
+
+	return nil
+}
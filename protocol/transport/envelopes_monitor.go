@@ -138,6 +138,7 @@ func (m *EnvelopesMonitor) Add(identifiers [][]byte, envelopeHashes []types.Hash
 				message:     messages[i],
 				identifiers: identifiers,
 			}
+			envelopesStateCounter.WithLabelValues(envelopeStatePosted).Inc()
 		}
 	}
 
@@ -224,6 +225,7 @@ func (m *EnvelopesMonitor) handleEventEnvelopeSent(event types.EnvelopeEvent) {
 	} else {
 		m.logger.Debug("confirmation not expected, marking as sent")
 		envelope.state = EnvelopeSent
+		envelopesStateCounter.WithLabelValues(envelopeStateSent).Inc()
 		m.processIdentifiers(envelope.identifiers)
 	}
 }
@@ -252,6 +254,7 @@ func (m *EnvelopesMonitor) handleAcknowledgedBatch(event types.EnvelopeEvent) {
 		_, exist := m.envelopes[envelopeError.Hash]
 		if exist {
 			m.logger.Warn("envelope that was posted by us is discarded", zap.String("hash", envelopeError.Hash.String()), zap.String("peer", event.Peer.String()), zap.String("error", envelopeError.Description))
+			envelopesStateCounter.WithLabelValues(envelopeStateDiscarded).Inc()
 			var err error
 			switch envelopeError.Code {
 			case types.EnvelopeTimeNotSynced:
@@ -271,6 +274,7 @@ func (m *EnvelopesMonitor) handleAcknowledgedBatch(event types.EnvelopeEvent) {
 			continue
 		}
 		envelope.state = EnvelopeSent
+		envelopesStateCounter.WithLabelValues(envelopeStateSent).Inc()
 		m.processIdentifiers(envelope.identifiers)
 	}
 	delete(m.batches, event.Batch)
@@ -292,9 +296,11 @@ func (m *EnvelopesMonitor) handleEnvelopeFailure(hash types.Hash, err error) {
 		}
 		if envelope.attempts < m.maxAttempts {
 			m.logger.Debug("retrying to send a message", zap.String("hash", hash.String()), zap.Int("attempt", envelope.attempts+1))
+			envelopesStateCounter.WithLabelValues(envelopeStateRetried).Inc()
 			hex, err := m.api.Post(context.TODO(), *envelope.message)
 			if err != nil {
 				m.logger.Error("failed to retry sending message", zap.String("hash", hash.String()), zap.Int("attempt", envelope.attempts+1), zap.Error(err))
+				envelopesStateCounter.WithLabelValues(envelopeStateFailed).Inc()
 				if m.handler != nil {
 					m.handler.EnvelopeExpired(envelope.identifiers, err)
 				}
@@ -309,6 +315,7 @@ func (m *EnvelopesMonitor) handleEnvelopeFailure(hash types.Hash, err error) {
 			}
 		} else {
 			m.logger.Debug("envelope expired", zap.String("hash", hash.String()))
+			envelopesStateCounter.WithLabelValues(envelopeStateExpired).Inc()
 			if m.handler != nil {
 				m.handler.EnvelopeExpired(envelope.identifiers, err)
 			}
@@ -328,6 +335,7 @@ func (m *EnvelopesMonitor) handleEventEnvelopeReceived(event types.EnvelopeEvent
 	}
 	m.logger.Debug("expected envelope received", zap.String("hash", event.Hash.String()), zap.String("peer", event.Peer.String()))
 	envelope.state = EnvelopeSent
+	envelopesStateCounter.WithLabelValues(envelopeStateSent).Inc()
 	m.processIdentifiers(envelope.identifiers)
 }
 
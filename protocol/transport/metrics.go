@@ -0,0 +1,28 @@
+package transport
+
+import (
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// By default the /metrics endpoint is not available.
+// It is exposed only if -metrics flag is set.
+
+const (
+	envelopeStatePosted    = "posted"
+	envelopeStateSent      = "sent"
+	envelopeStateDiscarded = "discarded"
+	envelopeStateRetried   = "retried"
+	envelopeStateExpired   = "expired"
+	envelopeStateFailed    = "failed"
+)
+
+var (
+	envelopesStateCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "status_envelopes_state_changes_total",
+		Help: "Number of envelopes tracked by the envelopes monitor that reached a state.",
+	}, []string{"state"})
+)
+
+func init() {
+	prom.MustRegister(envelopesStateCounter)
+}
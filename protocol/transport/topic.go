@@ -11,6 +11,7 @@ import (
 )
 
 const discoveryTopic = "contact-discovery"
+const communityDirectoryTopic = "community-directory"
 
 var (
 	// The number of partitions.
@@ -58,6 +59,11 @@ func DiscoveryTopic() string {
 	return discoveryTopic
 }
 
+// CommunityDirectoryTopic is where the listed communities announce themselves
+func CommunityDirectoryTopic() string {
+	return communityDirectoryTopic
+}
+
 func CommunityShardInfoTopic(communityID string) string {
 	return communityID + CommunityShardInfoTopicPrefix()
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/status-im/status-go/connection"
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/tracing"
 )
 
 var (
@@ -288,7 +289,7 @@ func (t *Transport) SendPublic(ctx context.Context, newMessage *types.NewMessage
 	newMessage.Topic = filter.ContentTopic
 	newMessage.PubsubTopic = filter.PubsubTopic
 
-	return t.api.Post(ctx, *newMessage)
+	return t.post(ctx, "Transport.SendPublic", newMessage)
 }
 
 func (t *Transport) SendPrivateWithSharedSecret(ctx context.Context, newMessage *types.NewMessage, publicKey *ecdsa.PublicKey, secret []byte) ([]byte, error) {
@@ -309,7 +310,7 @@ func (t *Transport) SendPrivateWithSharedSecret(ctx context.Context, newMessage
 	newMessage.PubsubTopic = filter.PubsubTopic
 	newMessage.PublicKey = nil
 
-	return t.api.Post(ctx, *newMessage)
+	return t.post(ctx, "Transport.SendPrivateWithSharedSecret", newMessage)
 }
 
 func (t *Transport) SendPrivateWithPartitioned(ctx context.Context, newMessage *types.NewMessage, publicKey *ecdsa.PublicKey) ([]byte, error) {
@@ -326,7 +327,7 @@ func (t *Transport) SendPrivateWithPartitioned(ctx context.Context, newMessage *
 	newMessage.Topic = filter.ContentTopic
 	newMessage.PublicKey = crypto.FromECDSAPub(publicKey)
 
-	return t.api.Post(ctx, *newMessage)
+	return t.post(ctx, "Transport.SendPrivateWithPartitioned", newMessage)
 }
 
 func (t *Transport) SendPrivateOnPersonalTopic(ctx context.Context, newMessage *types.NewMessage, publicKey *ecdsa.PublicKey) ([]byte, error) {
@@ -343,7 +344,7 @@ func (t *Transport) SendPrivateOnPersonalTopic(ctx context.Context, newMessage *
 	newMessage.Topic = filter.ContentTopic
 	newMessage.PublicKey = crypto.FromECDSAPub(publicKey)
 
-	return t.api.Post(ctx, *newMessage)
+	return t.post(ctx, "Transport.SendPrivateOnPersonalTopic", newMessage)
 }
 
 func (t *Transport) PersonalTopicFilter() *Filter {
@@ -371,7 +372,23 @@ func (t *Transport) SendCommunityMessage(ctx context.Context, newMessage *types.
 
 	t.logger.Debug("SENDING message", zap.Binary("topic", filter.ContentTopic[:]))
 
-	return t.api.Post(ctx, *newMessage)
+	return t.post(ctx, "Transport.SendCommunityMessage", newMessage)
+}
+
+// post hands the message to waku, within a span named after the sending method
+func (t *Transport) post(ctx context.Context, spanName string, newMessage *types.NewMessage) ([]byte, error) {
+	ctx, span := tracing.StartSpanWithKind(ctx, spanName, tracing.SpanKindProducer,
+		tracing.String("pubsubTopic", newMessage.PubsubTopic),
+		tracing.String("contentTopic", newMessage.Topic.String()),
+	)
+	defer span.End()
+
+	hash, err := t.api.Post(ctx, *newMessage)
+	span.RecordError(err)
+	if err == nil {
+		span.SetAttributes(tracing.String("envelopeHash", types.EncodeHex(hash)))
+	}
+	return hash, err
 }
 
 func (t *Transport) cleanFilters() error {
@@ -0,0 +1,47 @@
+package video
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func mp4(buf []byte) bool {
+	return len(buf) > 11 &&
+		buf[4] == 0x66 && buf[5] == 0x74 &&
+		buf[6] == 0x79 && buf[7] == 0x70
+}
+
+func webm(buf []byte) bool {
+	return len(buf) > 3 &&
+		buf[0] == 0x1A && buf[1] == 0x45 &&
+		buf[2] == 0xDF && buf[3] == 0xA3
+}
+
+func Type(buf []byte) protobuf.VideoMessage_VideoFormat {
+	switch {
+	case mp4(buf):
+		return protobuf.VideoMessage_MP4
+	case webm(buf):
+		return protobuf.VideoMessage_WEBM
+	default:
+		return protobuf.VideoMessage_UNKNOWN_VIDEO_FORMAT
+	}
+}
+
+func MIME(format protobuf.VideoMessage_VideoFormat) (string, error) {
+	switch format {
+	case protobuf.VideoMessage_MP4:
+		return "video/mp4", nil
+	case protobuf.VideoMessage_WEBM:
+		return "video/webm", nil
+	}
+
+	return "", errors.New("video format not supported")
+}
+
+// MaxSize is the maximum size of the payload of a video message.
+// Videos larger than a single waku message are sent segmented by the message sender.
+const MaxSize = 10 * 1024 * 1024
+
+var ErrTooLarge = errors.New("video is too large")
@@ -18,7 +18,6 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
-	"github.com/status-im/status-go/services/rpcstats"
 )
 
 type FeeHistory struct {
@@ -229,6 +228,7 @@ func (c *ClientWithFallback) makeCallNoReturn(main func() error, fallback func()
 		}
 		return nil
 	case err := <-errChan:
+		c.countFailure()
 		return err
 	}
 }
@@ -282,6 +282,7 @@ func (c *ClientWithFallback) makeCallSingleReturn(main func() (any, error), fall
 		}
 		return result.res1, nil
 	case err := <-errChan:
+		c.countFailure()
 		return nil, err
 	}
 }
@@ -328,12 +329,13 @@ func (c *ClientWithFallback) makeCallDoubleReturn(main func() (any, any, error),
 		}
 		return result.res1, result.res2, nil
 	case err := <-errChan:
+		c.countFailure()
 		return nil, nil, err
 	}
 }
 
 func (c *ClientWithFallback) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
-	rpcstats.CountCall("eth_BlockByHash")
+	c.countCall("eth_BlockByHash")
 
 	block, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.BlockByHash(ctx, hash) },
@@ -349,7 +351,7 @@ func (c *ClientWithFallback) BlockByHash(ctx context.Context, hash common.Hash)
 }
 
 func (c *ClientWithFallback) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
-	rpcstats.CountCall("eth_BlockByNumber")
+	c.countCall("eth_BlockByNumber")
 	block, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.BlockByNumber(ctx, number) },
 		func() (any, error) { return c.fallback.BlockByNumber(ctx, number) },
@@ -364,7 +366,7 @@ func (c *ClientWithFallback) BlockByNumber(ctx context.Context, number *big.Int)
 }
 
 func (c *ClientWithFallback) BlockNumber(ctx context.Context) (uint64, error) {
-	rpcstats.CountCall("eth_BlockNumber")
+	c.countCall("eth_BlockNumber")
 
 	number, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.BlockNumber(ctx) },
@@ -380,7 +382,7 @@ func (c *ClientWithFallback) BlockNumber(ctx context.Context) (uint64, error) {
 }
 
 func (c *ClientWithFallback) PeerCount(ctx context.Context) (uint64, error) {
-	rpcstats.CountCall("eth_PeerCount")
+	c.countCall("eth_PeerCount")
 
 	peerCount, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.PeerCount(ctx) },
@@ -396,7 +398,7 @@ func (c *ClientWithFallback) PeerCount(ctx context.Context) (uint64, error) {
 }
 
 func (c *ClientWithFallback) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
-	rpcstats.CountCall("eth_HeaderByHash")
+	c.countCall("eth_HeaderByHash")
 	header, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.HeaderByHash(ctx, hash) },
 		func() (any, error) { return c.fallback.HeaderByHash(ctx, hash) },
@@ -411,7 +413,7 @@ func (c *ClientWithFallback) HeaderByHash(ctx context.Context, hash common.Hash)
 }
 
 func (c *ClientWithFallback) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
-	rpcstats.CountCall("eth_HeaderByNumber")
+	c.countCall("eth_HeaderByNumber")
 	header, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.HeaderByNumber(ctx, number) },
 		func() (any, error) { return c.fallback.HeaderByNumber(ctx, number) },
@@ -426,7 +428,7 @@ func (c *ClientWithFallback) HeaderByNumber(ctx context.Context, number *big.Int
 }
 
 func (c *ClientWithFallback) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
-	rpcstats.CountCall("eth_TransactionByHash")
+	c.countCall("eth_TransactionByHash")
 
 	tx, isPending, err := c.makeCallDoubleReturn(
 		func() (any, any, error) { return c.main.TransactionByHash(ctx, hash) },
@@ -441,7 +443,7 @@ func (c *ClientWithFallback) TransactionByHash(ctx context.Context, hash common.
 }
 
 func (c *ClientWithFallback) TransactionSender(ctx context.Context, tx *types.Transaction, block common.Hash, index uint) (common.Address, error) {
-	rpcstats.CountCall("eth_TransactionSender")
+	c.countCall("eth_TransactionSender")
 
 	address, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.TransactionSender(ctx, tx, block, index) },
@@ -453,7 +455,7 @@ func (c *ClientWithFallback) TransactionSender(ctx context.Context, tx *types.Tr
 }
 
 func (c *ClientWithFallback) TransactionCount(ctx context.Context, blockHash common.Hash) (uint, error) {
-	rpcstats.CountCall("eth_TransactionCount")
+	c.countCall("eth_TransactionCount")
 
 	count, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.TransactionCount(ctx, blockHash) },
@@ -469,7 +471,7 @@ func (c *ClientWithFallback) TransactionCount(ctx context.Context, blockHash com
 }
 
 func (c *ClientWithFallback) TransactionInBlock(ctx context.Context, blockHash common.Hash, index uint) (*types.Transaction, error) {
-	rpcstats.CountCall("eth_TransactionInBlock")
+	c.countCall("eth_TransactionInBlock")
 
 	transactions, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.TransactionInBlock(ctx, blockHash, index) },
@@ -485,7 +487,7 @@ func (c *ClientWithFallback) TransactionInBlock(ctx context.Context, blockHash c
 }
 
 func (c *ClientWithFallback) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
-	rpcstats.CountCall("eth_TransactionReceipt")
+	c.countCall("eth_TransactionReceipt")
 
 	receipt, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.TransactionReceipt(ctx, txHash) },
@@ -501,7 +503,7 @@ func (c *ClientWithFallback) TransactionReceipt(ctx context.Context, txHash comm
 }
 
 func (c *ClientWithFallback) SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error) {
-	rpcstats.CountCall("eth_SyncProgress")
+	c.countCall("eth_SyncProgress")
 
 	progress, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.SyncProgress(ctx) },
@@ -517,7 +519,7 @@ func (c *ClientWithFallback) SyncProgress(ctx context.Context) (*ethereum.SyncPr
 }
 
 func (c *ClientWithFallback) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
-	rpcstats.CountCall("eth_SubscribeNewHead")
+	c.countCall("eth_SubscribeNewHead")
 
 	sub, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.SubscribeNewHead(ctx, ch) },
@@ -537,7 +539,7 @@ func (c *ClientWithFallback) NetworkID() uint64 {
 }
 
 func (c *ClientWithFallback) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
-	rpcstats.CountCall("eth_BalanceAt")
+	c.countCall("eth_BalanceAt")
 
 	balance, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.BalanceAt(ctx, account, blockNumber) },
@@ -553,7 +555,7 @@ func (c *ClientWithFallback) BalanceAt(ctx context.Context, account common.Addre
 }
 
 func (c *ClientWithFallback) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
-	rpcstats.CountCall("eth_StorageAt")
+	c.countCall("eth_StorageAt")
 
 	storage, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.StorageAt(ctx, account, key, blockNumber) },
@@ -569,7 +571,7 @@ func (c *ClientWithFallback) StorageAt(ctx context.Context, account common.Addre
 }
 
 func (c *ClientWithFallback) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
-	rpcstats.CountCall("eth_CodeAt")
+	c.countCall("eth_CodeAt")
 
 	code, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.CodeAt(ctx, account, blockNumber) },
@@ -585,7 +587,7 @@ func (c *ClientWithFallback) CodeAt(ctx context.Context, account common.Address,
 }
 
 func (c *ClientWithFallback) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
-	rpcstats.CountCall("eth_NonceAt")
+	c.countCall("eth_NonceAt")
 
 	nonce, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.NonceAt(ctx, account, blockNumber) },
@@ -601,7 +603,7 @@ func (c *ClientWithFallback) NonceAt(ctx context.Context, account common.Address
 }
 
 func (c *ClientWithFallback) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
-	rpcstats.CountCall("eth_FilterLogs")
+	c.countCall("eth_FilterLogs")
 
 	logs, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.FilterLogs(ctx, q) },
@@ -617,7 +619,7 @@ func (c *ClientWithFallback) FilterLogs(ctx context.Context, q ethereum.FilterQu
 }
 
 func (c *ClientWithFallback) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
-	rpcstats.CountCall("eth_SubscribeFilterLogs")
+	c.countCall("eth_SubscribeFilterLogs")
 
 	sub, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.SubscribeFilterLogs(ctx, q, ch) },
@@ -633,7 +635,7 @@ func (c *ClientWithFallback) SubscribeFilterLogs(ctx context.Context, q ethereum
 }
 
 func (c *ClientWithFallback) PendingBalanceAt(ctx context.Context, account common.Address) (*big.Int, error) {
-	rpcstats.CountCall("eth_PendingBalanceAt")
+	c.countCall("eth_PendingBalanceAt")
 
 	balance, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.PendingBalanceAt(ctx, account) },
@@ -649,7 +651,7 @@ func (c *ClientWithFallback) PendingBalanceAt(ctx context.Context, account commo
 }
 
 func (c *ClientWithFallback) PendingStorageAt(ctx context.Context, account common.Address, key common.Hash) ([]byte, error) {
-	rpcstats.CountCall("eth_PendingStorageAt")
+	c.countCall("eth_PendingStorageAt")
 
 	storage, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.PendingStorageAt(ctx, account, key) },
@@ -665,7 +667,7 @@ func (c *ClientWithFallback) PendingStorageAt(ctx context.Context, account commo
 }
 
 func (c *ClientWithFallback) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
-	rpcstats.CountCall("eth_PendingCodeAt")
+	c.countCall("eth_PendingCodeAt")
 
 	code, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.PendingCodeAt(ctx, account) },
@@ -681,7 +683,7 @@ func (c *ClientWithFallback) PendingCodeAt(ctx context.Context, account common.A
 }
 
 func (c *ClientWithFallback) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
-	rpcstats.CountCall("eth_PendingNonceAt")
+	c.countCall("eth_PendingNonceAt")
 
 	nonce, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.PendingNonceAt(ctx, account) },
@@ -697,7 +699,7 @@ func (c *ClientWithFallback) PendingNonceAt(ctx context.Context, account common.
 }
 
 func (c *ClientWithFallback) PendingTransactionCount(ctx context.Context) (uint, error) {
-	rpcstats.CountCall("eth_PendingTransactionCount")
+	c.countCall("eth_PendingTransactionCount")
 
 	count, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.PendingTransactionCount(ctx) },
@@ -713,7 +715,7 @@ func (c *ClientWithFallback) PendingTransactionCount(ctx context.Context) (uint,
 }
 
 func (c *ClientWithFallback) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
-	rpcstats.CountCall("eth_CallContract_" + msg.To.String())
+	c.countCall("eth_CallContract_" + msg.To.String())
 
 	data, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.CallContract(ctx, msg, blockNumber) },
@@ -729,7 +731,7 @@ func (c *ClientWithFallback) CallContract(ctx context.Context, msg ethereum.Call
 }
 
 func (c *ClientWithFallback) CallContractAtHash(ctx context.Context, msg ethereum.CallMsg, blockHash common.Hash) ([]byte, error) {
-	rpcstats.CountCall("eth_CallContractAtHash")
+	c.countCall("eth_CallContractAtHash")
 
 	data, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.CallContractAtHash(ctx, msg, blockHash) },
@@ -745,7 +747,7 @@ func (c *ClientWithFallback) CallContractAtHash(ctx context.Context, msg ethereu
 }
 
 func (c *ClientWithFallback) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
-	rpcstats.CountCall("eth_PendingCallContract")
+	c.countCall("eth_PendingCallContract")
 
 	data, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.PendingCallContract(ctx, msg) },
@@ -761,7 +763,7 @@ func (c *ClientWithFallback) PendingCallContract(ctx context.Context, msg ethere
 }
 
 func (c *ClientWithFallback) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
-	rpcstats.CountCall("eth_SuggestGasPrice")
+	c.countCall("eth_SuggestGasPrice")
 
 	gasPrice, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.SuggestGasPrice(ctx) },
@@ -777,7 +779,7 @@ func (c *ClientWithFallback) SuggestGasPrice(ctx context.Context) (*big.Int, err
 }
 
 func (c *ClientWithFallback) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
-	rpcstats.CountCall("eth_SuggestGasTipCap")
+	c.countCall("eth_SuggestGasTipCap")
 
 	tip, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.SuggestGasTipCap(ctx) },
@@ -793,7 +795,7 @@ func (c *ClientWithFallback) SuggestGasTipCap(ctx context.Context) (*big.Int, er
 }
 
 func (c *ClientWithFallback) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
-	rpcstats.CountCall("eth_FeeHistory")
+	c.countCall("eth_FeeHistory")
 
 	feeHistory, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles) },
@@ -809,7 +811,7 @@ func (c *ClientWithFallback) FeeHistory(ctx context.Context, blockCount uint64,
 }
 
 func (c *ClientWithFallback) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
-	rpcstats.CountCall("eth_EstimateGas")
+	c.countCall("eth_EstimateGas")
 
 	estimate, err := c.makeCallSingleReturn(
 		func() (any, error) { return c.main.EstimateGas(ctx, msg) },
@@ -825,7 +827,7 @@ func (c *ClientWithFallback) EstimateGas(ctx context.Context, msg ethereum.CallM
 }
 
 func (c *ClientWithFallback) SendTransaction(ctx context.Context, tx *types.Transaction) error {
-	rpcstats.CountCall("eth_SendTransaction")
+	c.countCall("eth_SendTransaction")
 
 	return c.makeCallNoReturn(
 		func() error { return c.main.SendTransaction(ctx, tx) },
@@ -834,7 +836,7 @@ func (c *ClientWithFallback) SendTransaction(ctx context.Context, tx *types.Tran
 }
 
 func (c *ClientWithFallback) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
-	rpcstats.CountCall("eth_CallContext")
+	c.countCall("eth_CallContext")
 
 	return c.makeCallNoReturn(
 		func() error { return c.mainRPC.CallContext(ctx, result, method, args...) },
@@ -843,7 +845,7 @@ func (c *ClientWithFallback) CallContext(ctx context.Context, result interface{}
 }
 
 func (c *ClientWithFallback) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
-	rpcstats.CountCall("eth_BatchCallContext")
+	c.countCall("eth_BatchCallContext")
 
 	return c.makeCallNoReturn(
 		func() error { return c.mainRPC.BatchCallContext(ctx, b) },
@@ -856,7 +858,7 @@ func (c *ClientWithFallback) ToBigInt() *big.Int {
 }
 
 func (c *ClientWithFallback) GetBaseFeeFromBlock(blockNumber *big.Int) (string, error) {
-	rpcstats.CountCall("eth_GetBaseFeeFromBlock")
+	c.countCall("eth_GetBaseFeeFromBlock")
 	var feeHistory FeeHistory
 	err := c.mainRPC.Call(&feeHistory, "eth_feeHistory", "0x1", (*hexutil.Big)(blockNumber), nil)
 	if err != nil {
@@ -878,7 +880,7 @@ func (c *ClientWithFallback) GetBaseFeeFromBlock(blockNumber *big.Int) (string,
 // This function preserves the additional data. This is the cheapest way to obtain
 // the block hash for a given block number.
 func (c *ClientWithFallback) CallBlockHashByTransaction(ctx context.Context, blockNumber *big.Int, index uint) (common.Hash, error) {
-	rpcstats.CountCall("eth_FullTransactionByBlockNumberAndIndex")
+	c.countCall("eth_FullTransactionByBlockNumberAndIndex")
 
 	tx, err := c.makeCallSingleReturn(
 		func() (any, error) {
@@ -0,0 +1,39 @@
+package chain
+
+import (
+	"strconv"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+
+	"github.com/status-im/status-go/services/rpcstats"
+)
+
+// By default the /metrics endpoint is not available.
+// It is exposed only if -metrics flag is set.
+
+var (
+	rpcRequestsCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "status_wallet_rpc_requests_total",
+		Help: "Number of RPC calls made by the wallet, by chain and method.",
+	}, []string{"chain_id", "method"})
+	rpcFailuresCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "status_wallet_rpc_failures_total",
+		Help: "Number of RPC calls made by the wallet which failed on every provider, by chain.",
+	}, []string{"chain_id"})
+)
+
+func init() {
+	prom.MustRegister(rpcRequestsCounter)
+	prom.MustRegister(rpcFailuresCounter)
+}
+
+// countCall counts the call in the RPC usage stats and in the metrics,
+// method is always a constant so the cardinality stays bounded by the configured chains
+func (c *ClientWithFallback) countCall(method string) {
+	rpcstats.CountCall(method)
+	rpcRequestsCounter.WithLabelValues(strconv.FormatUint(c.ChainID, 10), method).Inc()
+}
+
+func (c *ClientWithFallback) countFailure() {
+	rpcFailuresCounter.WithLabelValues(strconv.FormatUint(c.ChainID, 10)).Inc()
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"database/sql"
+	"errors"
+	"io"
+)
+
+// blobReaderWindowSize is the size of the slices of the blob loaded from the database at once
+const blobReaderWindowSize = 256 * 1024
+
+var errBlobReaderNegativePosition = errors.New("blob reader: negative position")
+
+// blobReader reads a blob of the database a window at a time, so that http.ServeContent
+// can serve range requests of large media without loading it all in memory.
+// The query selects substr(<blob>, ?, ?) of the row identified by the last argument.
+type blobReader struct {
+	db    *sql.DB
+	query string
+	id    string
+	size  int64
+
+	offset       int64
+	window       []byte
+	windowOffset int64
+}
+
+func newBlobReader(db *sql.DB, query string, id string, size int64) *blobReader {
+	return &blobReader{db: db, query: query, id: id, size: size}
+}
+
+func (r *blobReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+
+	if r.offset < r.windowOffset || r.offset >= r.windowOffset+int64(len(r.window)) {
+		var window []byte
+		// substr is 1-indexed
+		err := r.db.QueryRow(r.query, r.offset+1, blobReaderWindowSize, r.id).Scan(&window)
+		if err != nil {
+			return 0, err
+		}
+		if len(window) == 0 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		r.window = window
+		r.windowOffset = r.offset
+	}
+
+	n := copy(p, r.window[r.offset-r.windowOffset:])
+	r.offset += int64(n)
+	return n, nil
+}
+
+func (r *blobReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("blob reader: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errBlobReaderNegativePosition
+	}
+	r.offset = offset
+	return offset, nil
+}
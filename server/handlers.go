@@ -3,10 +3,13 @@ package server
 import (
 	"bytes"
 	"database/sql"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"image"
 	"image/color"
 	"math/big"
+	"mime"
 	"net/http"
 	"net/url"
 	"os"
@@ -23,6 +26,8 @@ import (
 	"github.com/status-im/status-go/multiaccounts"
 	"github.com/status-im/status-go/protocol/identity/colorhash"
 	"github.com/status-im/status-go/protocol/identity/ring"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/video"
 	"github.com/status-im/status-go/services/wallet/bigint"
 )
 
@@ -30,6 +35,9 @@ const (
 	basePath                       = "/messages"
 	imagesPath                     = basePath + "/images"
 	audioPath                      = basePath + "/audio"
+	videoPath                      = basePath + "/video"
+	videoThumbnailPath             = basePath + "/video/thumbnail"
+	filesPath                      = basePath + "/files"
 	ipfsPath                       = "/ipfs"
 	discordAuthorsPath             = "/discord/authors"
 	discordAttachmentsPath         = basePath + "/discord/attachments"
@@ -51,6 +59,32 @@ const (
 
 type HandlerPatternMap map[string]http.HandlerFunc
 
+// safeFileMimeTypes are the types of the shared files that are served as is,
+// any other file is served as application/octet-stream so that it can't be rendered as html or script
+var safeFileMimeTypes = map[string]bool{
+	"application/pdf": true,
+	"audio/aac":       true,
+	"audio/mpeg":      true,
+	"audio/ogg":       true,
+	"audio/wav":       true,
+	"image/gif":       true,
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/webp":      true,
+	"text/plain":      true,
+	"video/mp4":       true,
+	"video/webm":      true,
+}
+
+// fileContentType returns the content type a shared file is served with
+func fileContentType(mimeType string) string {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil || !safeFileMimeTypes[mediaType] {
+		return "application/octet-stream"
+	}
+	return mediaType
+}
+
 func handleRequestDBMissing(logger *zap.Logger) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		logger.Error("can't handle media request without appdb")
@@ -614,7 +648,7 @@ func handleAccountInitials(multiaccountsDB *multiaccounts.Database, logger *zap.
 }
 
 // handleContactImages render contacts custom profile image
-func handleContactImages(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
+func handleContactImages(db *sql.DB, thumbnails *thumbnailCache, logger *zap.Logger) http.HandlerFunc {
 	if db == nil {
 		return handleRequestDBMissing(logger)
 	}
@@ -645,6 +679,21 @@ func handleContactImages(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
 			return
 		}
 
+		// the ring and the status indicator are drawn over the thumbnail
+		size, requested, err := requestedThumbnailSize(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if requested {
+			thumbnail, err := thumbnails.thumbnail("contact/"+parsed.PublicKey+"/"+parsed.ImageName, payload, size, "png")
+			if err != nil {
+				logger.Error("failed to generate contact image thumbnail.", zap.String("contact id", parsed.PublicKey), zap.Error(err))
+			} else {
+				payload = thumbnail.payload
+			}
+		}
+
 		img, _, err := image.Decode(bytes.NewReader(payload))
 		if err != nil {
 			logger.Error("failed to decode config.", zap.String("contact id", parsed.PublicKey), zap.String("image type", parsed.ImageName), zap.Error(err))
@@ -809,7 +858,7 @@ func handleDiscordAttachment(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
 	}
 }
 
-func handleImage(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
+func handleImage(db *sql.DB, thumbnails *thumbnailCache, logger *zap.Logger) http.HandlerFunc {
 	if db == nil {
 		return handleRequestDBMissing(logger)
 	}
@@ -833,6 +882,9 @@ func handleImage(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
 			logger.Error("empty image")
 			return
 		}
+		if serveThumbnail(w, r, thumbnails, logger, "image/"+parsed.MessageID, image) {
+			return
+		}
 		mime, err := images.GetProtobufImageMime(image)
 		if err != nil {
 			logger.Error("failed to get mime", zap.Error(err))
@@ -883,6 +935,136 @@ func handleAudio(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
 	}
 }
 
+// handleVideo serves the video of a message, range requests are supported so that clients can seek
+func handleVideo(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
+	if db == nil {
+		return handleRequestDBMissing(logger)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+		parsed := ParseImageParams(logger, params)
+
+		if parsed.MessageID == "" {
+			logger.Error("no messageID")
+			http.Error(w, "no messageID", http.StatusBadRequest)
+			return
+		}
+
+		var format protobuf.VideoMessage_VideoFormat
+		var size sql.NullInt64
+		err := db.QueryRow(`SELECT COALESCE(video_format, 0), LENGTH(video_payload) FROM user_messages WHERE id = ?`, parsed.MessageID).Scan(&format, &size)
+		if err != nil {
+			logger.Error("failed to find video", zap.Error(err))
+			http.NotFound(w, r)
+			return
+		}
+		if !size.Valid || size.Int64 == 0 {
+			logger.Error("empty video")
+			http.NotFound(w, r)
+			return
+		}
+
+		mime, err := video.MIME(format)
+		if err != nil {
+			logger.Error("failed to get mime", zap.Error(err))
+		} else {
+			w.Header().Set("Content-Type", mime)
+		}
+		w.Header().Set("Cache-Control", "no-store")
+
+		reader := newBlobReader(db, `SELECT substr(video_payload, ?, ?) FROM user_messages WHERE id = ?`, parsed.MessageID, size.Int64)
+		http.ServeContent(w, r, "", time.Time{}, reader)
+	}
+}
+
+func handleVideoThumbnail(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
+	if db == nil {
+		return handleRequestDBMissing(logger)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+		parsed := ParseImageParams(logger, params)
+
+		if parsed.MessageID == "" {
+			logger.Error("no messageID")
+			return
+		}
+
+		var thumbnail []byte
+		err := db.QueryRow(`SELECT video_thumbnail FROM user_messages WHERE id = ?`, parsed.MessageID).Scan(&thumbnail)
+		if err != nil {
+			logger.Error("failed to find video thumbnail", zap.Error(err))
+			return
+		}
+		if len(thumbnail) == 0 {
+			logger.Error("empty video thumbnail")
+			return
+		}
+		mime, err := images.GetProtobufImageMime(thumbnail)
+		if err != nil {
+			logger.Error("failed to get mime", zap.Error(err))
+		}
+
+		w.Header().Set("Content-Type", mime)
+		w.Header().Set("Cache-Control", "no-store")
+
+		_, err = w.Write(thumbnail)
+		if err != nil {
+			logger.Error("failed to write video thumbnail", zap.Error(err))
+		}
+	}
+}
+
+// handleFile serves the file of a message as a download, range requests are supported
+// so that interrupted downloads can be resumed
+func handleFile(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
+	if db == nil {
+		return handleRequestDBMissing(logger)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+		parsed := ParseImageParams(logger, params)
+
+		if parsed.MessageID == "" {
+			logger.Error("no messageID")
+			http.Error(w, "no messageID", http.StatusBadRequest)
+			return
+		}
+
+		var name, mimeType string
+		var hash []byte
+		var size sql.NullInt64
+		err := db.QueryRow(`SELECT COALESCE(file_name, ""), COALESCE(file_mime_type, ""), file_hash, LENGTH(file_payload) FROM user_messages WHERE id = ?`, parsed.MessageID).Scan(&name, &mimeType, &hash, &size)
+		if err != nil {
+			logger.Error("failed to find file", zap.Error(err))
+			http.NotFound(w, r)
+			return
+		}
+		if !size.Valid || size.Int64 == 0 {
+			logger.Error("empty file")
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", fileContentType(mimeType))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		if name != "" {
+			w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name}))
+		}
+		// the content hash lets clients resume a download with If-Range
+		if len(hash) != 0 {
+			w.Header().Set("ETag", `"`+hex.EncodeToString(hash)+`"`)
+		}
+		w.Header().Set("Cache-Control", "no-store")
+
+		reader := newBlobReader(db, `SELECT substr(file_payload, ?, ?) FROM user_messages WHERE id = ?`, parsed.MessageID, size.Int64)
+		http.ServeContent(w, r, "", time.Time{}, reader)
+	}
+}
+
 func handleIPFS(downloader *ipfs.Downloader, logger *zap.Logger) http.HandlerFunc {
 	if downloader == nil {
 		return handleRequestDownloaderMissing(logger)
@@ -935,7 +1117,7 @@ func handleQRCodeGeneration(multiaccountsDB *multiaccounts.Database, logger *zap
 	}
 }
 
-func handleCommunityTokenImages(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
+func handleCommunityTokenImages(db *sql.DB, thumbnails *thumbnailCache, logger *zap.Logger) http.HandlerFunc {
 	if db == nil {
 		return handleRequestDBMissing(logger)
 	}
@@ -977,6 +1159,10 @@ func handleCommunityTokenImages(db *sql.DB, logger *zap.Logger) http.HandlerFunc
 			logger.Error("failed to get community token image payload", zap.Error(err))
 			return
 		}
+		key := fmt.Sprintf("community-token/%s/%d/%s", params["communityID"][0], chainID, params["symbol"][0])
+		if serveThumbnail(w, r, thumbnails, logger, key, imagePayload) {
+			return
+		}
 		mime, err := images.GetProtobufImageMime(imagePayload)
 		if err != nil {
 			logger.Error("failed to get community token image mime", zap.Error(err))
@@ -1086,7 +1272,7 @@ func handleWalletCollectionImages(db *sql.DB, logger *zap.Logger) http.HandlerFu
 	}
 }
 
-func handleWalletCollectibleImages(db *sql.DB, logger *zap.Logger) http.HandlerFunc {
+func handleWalletCollectibleImages(db *sql.DB, thumbnails *thumbnailCache, logger *zap.Logger) http.HandlerFunc {
 	if db == nil {
 		return handleRequestDBMissing(logger)
 	}
@@ -1138,6 +1324,10 @@ func handleWalletCollectibleImages(db *sql.DB, logger *zap.Logger) http.HandlerF
 			logger.Error("empty image")
 			return
 		}
+		key := fmt.Sprintf("collectible/%d/%s/%s", chainID, contractAddress.Hex(), tokenID.String())
+		if serveThumbnail(w, r, thumbnails, logger, key, image) {
+			return
+		}
 		mime, err := images.GetProtobufImageMime(image)
 		if err != nil {
 			logger.Error("failed to get wallet collectible image mime", zap.Error(err))
@@ -1,11 +1,14 @@
 package server
 
 import (
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -545,3 +548,89 @@ func (s *HandlersSuite) TestHandleStatusLinkPreviewThumbnail() {
 		})
 	}
 }
+
+func (s *HandlersSuite) TestHandleVideo() {
+	msg := common.Message{ID: "1", ChatMessage: &protobuf.ChatMessage{}}
+	s.saveUserMessage(&msg)
+
+	// spans several windows of the blob reader
+	payload := make([]byte, 2*blobReaderWindowSize+100)
+	copy(payload, []byte{0x00, 0x00, 0x00, 0x20, 0x66, 0x74, 0x79, 0x70, 0x69, 0x73, 0x6f, 0x6d})
+	for i := 12; i < len(payload); i++ {
+		payload[i] = byte(i)
+	}
+	_, err := s.db.Exec(`UPDATE user_messages SET video_payload = ?, video_format = ? WHERE id = ?`, payload, protobuf.VideoMessage_MP4, msg.ID)
+	s.Require().NoError(err)
+
+	handler := handleVideo(s.db, s.logger)
+
+	rr := s.httpGetReqRecorder(handler, "/dummy?"+url.Values{"messageId": {msg.ID}}.Encode())
+	s.Require().Equal(http.StatusOK, rr.Code)
+	s.Require().Equal(payload, rr.Body.Bytes())
+	s.Require().Equal("video/mp4", rr.HeaderMap.Get("Content-Type"))
+	s.Require().Equal("bytes", rr.HeaderMap.Get("Accept-Ranges"))
+
+	start := blobReaderWindowSize - 10
+	req, err := http.NewRequest("GET", "/dummy?"+url.Values{"messageId": {msg.ID}}.Encode(), nil)
+	s.Require().NoError(err)
+	req.Header.Set("Range", "bytes="+strconv.Itoa(start)+"-"+strconv.Itoa(start+99))
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	s.Require().Equal(http.StatusPartialContent, rr.Code)
+	s.Require().Equal(payload[start:start+100], rr.Body.Bytes())
+
+	rr = s.httpGetReqRecorder(handler, "/dummy?"+url.Values{"messageId": {"unknown"}}.Encode())
+	s.Require().Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *HandlersSuite) TestHandleFile() {
+	msg := common.Message{ID: "1", ChatMessage: &protobuf.ChatMessage{}}
+	s.saveUserMessage(&msg)
+
+	payload := make([]byte, blobReaderWindowSize+100)
+	for i := range payload {
+		payload[i] = byte(i)
+	}
+	hash := sha256.Sum256(payload)
+	_, err := s.db.Exec(`UPDATE user_messages SET file_payload = ?, file_name = ?, file_mime_type = ?, file_size = ?, file_hash = ? WHERE id = ?`,
+		payload, "report 2024.pdf", "application/pdf", len(payload), hash[:], msg.ID)
+	s.Require().NoError(err)
+
+	handler := handleFile(s.db, s.logger)
+	requestURL := "/dummy?" + url.Values{"messageId": {msg.ID}}.Encode()
+
+	rr := s.httpGetReqRecorder(handler, requestURL)
+	s.Require().Equal(http.StatusOK, rr.Code)
+	s.Require().Equal(payload, rr.Body.Bytes())
+	s.Require().Equal("application/pdf", rr.HeaderMap.Get("Content-Type"))
+	s.Require().Equal("nosniff", rr.HeaderMap.Get("X-Content-Type-Options"))
+	s.Require().Equal(`attachment; filename="report 2024.pdf"`, rr.HeaderMap.Get("Content-Disposition"))
+	etag := rr.HeaderMap.Get("ETag")
+	s.Require().Equal(`"`+hex.EncodeToString(hash[:])+`"`, etag)
+
+	// resume the download
+	req, err := http.NewRequest("GET", requestURL, nil)
+	s.Require().NoError(err)
+	req.Header.Set("Range", "bytes=1000-")
+	req.Header.Set("If-Range", etag)
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	s.Require().Equal(http.StatusPartialContent, rr.Code)
+	s.Require().Equal(payload[1000:], rr.Body.Bytes())
+
+	// the file changed, the whole file is sent again
+	req.Header.Set("If-Range", `"other"`)
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	s.Require().Equal(http.StatusOK, rr.Code)
+	s.Require().Equal(payload, rr.Body.Bytes())
+
+	// files which could be rendered by a browser are not served with their type
+	_, err = s.db.Exec(`UPDATE user_messages SET file_mime_type = ? WHERE id = ?`, "text/html; charset=utf-8", msg.ID)
+	s.Require().NoError(err)
+
+	rr = s.httpGetReqRecorder(handler, requestURL)
+	s.Require().Equal(http.StatusOK, rr.Code)
+	s.Require().Equal("application/octet-stream", rr.HeaderMap.Get("Content-Type"))
+	s.Require().Equal("nosniff", rr.HeaderMap.Get("X-Content-Type-Options"))
+}
@@ -37,6 +37,16 @@ type BaseClient struct {
 	serverCert     *x509.Certificate
 	baseAddress    *url.URL
 	challengeTaker *ChallengeTaker
+	// relay is set when the requests are sent over the relay instead of the local network
+	relay *relayEndpoint
+}
+
+// closeRelay stops the relay of the client, if any
+func (c *BaseClient) closeRelay() error {
+	if c.relay == nil {
+		return nil
+	}
+	return c.relay.close()
 }
 
 func findServerCert(c *ConnectionParams, reachableIPs []net.IP) (*url.URL, *x509.Certificate, error) {
@@ -87,8 +97,8 @@ func findServerCert(c *ConnectionParams, reachableIPs []net.IP) (*url.URL, *x509
 	}
 }
 
-// NewBaseClient returns a fully qualified BaseClient from the given ConnectionParams
-func NewBaseClient(c *ConnectionParams, logger *zap.Logger) (*BaseClient, error) {
+// dialServer finds the address of the server among the reachable addresses of the ConnectionParams
+func dialServer(c *ConnectionParams, logger *zap.Logger) (*url.URL, *x509.Certificate, error) {
 	var baseAddress *url.URL
 	var serverCert *x509.Certificate
 	var certErrs error
@@ -96,14 +106,12 @@ func NewBaseClient(c *ConnectionParams, logger *zap.Logger) (*BaseClient, error)
 	netIPs, err := server.FindReachableAddressesForPairingClient(c.netIPs)
 	if err != nil {
 		logger.Error("[local pair client] failed to find reachable addresses", zap.Error(err), zap.Any("netIPs", netIPs))
-		signal.SendLocalPairingEvent(Event{Type: EventConnectionError, Error: err.Error(), Action: ActionConnect})
-		return nil, err
+		return nil, nil, err
 	}
 	// if client and server aren't on the same network, netIPs maybe empty, we should check it before invoking findServerCert
 	if len(netIPs) == 0 {
 		logger.Error("[local pair client] no reachable addresses found")
-		signal.SendLocalPairingEvent(Event{Type: EventConnectionError, Error: "no reachable addresses found", Action: ActionConnect})
-		return nil, fmt.Errorf("no reachable addresses found")
+		return nil, nil, fmt.Errorf("no reachable addresses found")
 	}
 
 	maxRetries := 3
@@ -118,15 +126,56 @@ func NewBaseClient(c *ConnectionParams, logger *zap.Logger) (*BaseClient, error)
 	}
 
 	if serverCert == nil {
-		certErrs = fmt.Errorf("failed to connect to any of given addresses. %w", certErrs)
-		signal.SendLocalPairingEvent(Event{Type: EventConnectionError, Error: certErrs.Error(), Action: ActionConnect})
-		return nil, certErrs
+		return nil, nil, fmt.Errorf("failed to connect to any of given addresses. %w", certErrs)
 	}
 
+	return baseAddress, serverCert, nil
+}
+
+// NewBaseClient returns a fully qualified BaseClient from the given ConnectionParams
+func NewBaseClient(c *ConnectionParams, logger *zap.Logger) (*BaseClient, error) {
+	baseAddress, serverCert, err := dialServer(c, logger)
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventConnectionError, Error: err.Error(), Action: ActionConnect})
+		return nil, err
+	}
+
+	return newLocalBaseClient(c, baseAddress, serverCert)
+}
+
+// newReceiverBaseClient returns a BaseClient connected to the server on the local network if it is reachable,
+// falling back to the relay over waku otherwise
+func newReceiverBaseClient(backend *api.GethStatusBackend, c *ConnectionParams, config *ReceiverConfig, logger *zap.Logger) (*BaseClient, error) {
+	baseAddress, serverCert, err := dialServer(c, logger)
+	if err == nil {
+		return newLocalBaseClient(c, baseAddress, serverCert)
+	}
+
+	logger.Warn("[local pair client] server unreachable, falling back to the relay", zap.Error(err))
+
+	transport, relayErr := newBackendRelayTransport(backend, config.NodeConfig, c.aesKey, logger)
+	if relayErr == nil {
+		var bc *BaseClient
+		bc, relayErr = newRelayBaseClient(transport, c, logger)
+		if relayErr == nil {
+			signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionConnect})
+			return bc, nil
+		}
+		if closeErr := transport.Close(); closeErr != nil {
+			logger.Error("failed to close the pairing relay transport", zap.Error(closeErr))
+		}
+	}
+
+	err = fmt.Errorf("%w, relay: %s", err, relayErr)
+	signal.SendLocalPairingEvent(Event{Type: EventConnectionError, Error: err.Error(), Action: ActionConnect})
+	return nil, err
+}
+
+func newLocalBaseClient(c *ConnectionParams, baseAddress *url.URL, serverCert *x509.Certificate) (*BaseClient, error) {
 	// No error on the dial out then the URL.Host is accessible
 	signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionConnect})
 
-	err = verifyCert(serverCert, c.publicKey)
+	err := verifyCert(serverCert, c.publicKey)
 	if err != nil {
 		return nil, err
 	}
@@ -191,8 +240,9 @@ func (c *BaseClient) getChallenge() error {
 type SenderClient struct {
 	*BaseClient
 	accountMounter      PayloadMounter
-	rawMessageMounter   PayloadMounter
+	rawMessageMounter   PayloadMounterReceiver
 	installationMounter PayloadMounterReceiver
+	historySender       *HistorySender
 }
 
 // NewSenderClient returns a fully qualified SenderClient created with the incoming parameters
@@ -215,6 +265,7 @@ func NewSenderClient(backend *api.GethStatusBackend, c *ConnectionParams, config
 		accountMounter:      am,
 		rawMessageMounter:   rmm,
 		installationMounter: imr,
+		historySender:       NewHistorySender(backend, pe),
 	}, nil
 }
 
@@ -243,6 +294,39 @@ func (c *SenderClient) sendAccountData() error {
 	return nil
 }
 
+// receiveSyncWatermarks gets the watermarks of the receiver, so that only the sync messages it doesn't have are sent
+func (c *SenderClient) receiveSyncWatermarks() error {
+	c.baseAddress.Path = pairingSendSyncWatermarks
+	req, err := http.NewRequest(http.MethodGet, c.baseAddress.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	err = c.challengeTaker.DoChallenge(req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+
+	// receivers without watermarks get all the sync messages
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("[client] status not ok when receiving sync watermarks, received '%s'", resp.Status)
+	}
+
+	payload, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	return c.rawMessageMounter.Receive(payload)
+}
+
 func (c *SenderClient) sendSyncDeviceData() error {
 	err := c.rawMessageMounter.Mount()
 	if err != nil {
@@ -307,6 +391,84 @@ func (c *SenderClient) receiveInstallationData() error {
 	return nil
 }
 
+// sendHistory sends the message history chunk by chunk, if the receiver opted in
+func (c *SenderClient) sendHistory() error {
+	err := c.getChallenge()
+	if err != nil {
+		return err
+	}
+
+	c.baseAddress.Path = pairingSendHistoryRequest
+	req, err := http.NewRequest(http.MethodGet, c.baseAddress.String(), nil)
+	if err != nil {
+		return err
+	}
+	err = c.challengeTaker.DoChallenge(req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	// receivers that don't support or didn't opt in to the history transfer
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("[client] status not ok when receiving history request, received '%s'", resp.Status)
+	}
+
+	payload, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	request, err := c.historySender.DecryptRequest(payload)
+	if err != nil {
+		return err
+	}
+
+	for {
+		chunk, data, err := c.historySender.Chunk(request)
+		if err != nil {
+			return err
+		}
+
+		err = c.getChallenge()
+		if err != nil {
+			return err
+		}
+
+		c.baseAddress.Path = pairingReceiveHistory
+		req, err := http.NewRequest(http.MethodPost, c.baseAddress.String(), bytes.NewBuffer(data))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/octet-stream")
+		err = c.challengeTaker.DoChallenge(req)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.Do(req)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode == http.StatusGone {
+			return ErrHistoryTransferCanceled
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("[client] status not ok when sending history, received '%s'", resp.Status)
+		}
+
+		if chunk.Last {
+			return nil
+		}
+		request.Cursor = chunk.Cursor
+	}
+}
+
 // setupSendingClient creates a new SenderClient after parsing string inputs
 func setupSendingClient(backend *api.GethStatusBackend, cs, configJSON string) (*SenderClient, error) {
 	ccp := new(ConnectionParams)
@@ -340,6 +502,14 @@ func StartUpSendingClient(backend *api.GethStatusBackend, cs, configJSON string)
 	if err != nil {
 		return err
 	}
+	err = c.getChallenge()
+	if err != nil {
+		return err
+	}
+	err = c.receiveSyncWatermarks()
+	if err != nil {
+		return err
+	}
 	err = c.sendSyncDeviceData()
 	if err != nil {
 		return err
@@ -348,7 +518,18 @@ func StartUpSendingClient(backend *api.GethStatusBackend, cs, configJSON string)
 	if err != nil {
 		return err
 	}
-	return c.receiveInstallationData()
+	err = c.receiveInstallationData()
+	if err != nil {
+		return err
+	}
+
+	// the devices are paired at this point, a failed history transfer is only notified
+	err = c.sendHistory()
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionHistoryTransfer})
+		logutils.ZapLogger().Error("failed to send the message history", zap.Error(err))
+	}
+	return nil
 }
 
 /*
@@ -365,15 +546,16 @@ type ReceiverClient struct {
 	*BaseClient
 
 	accountReceiver      PayloadReceiver
-	rawMessageReceiver   PayloadReceiver
+	rawMessageReceiver   PayloadMounterReceiver
 	installationReceiver PayloadMounterReceiver
+	historyReceiver      *HistoryReceiver
 }
 
 // NewReceiverClient returns a fully qualified ReceiverClient created with the incoming parameters
 func NewReceiverClient(backend *api.GethStatusBackend, c *ConnectionParams, config *ReceiverClientConfig) (*ReceiverClient, error) {
 	logger := logutils.ZapLogger().Named("ReceiverClient")
 
-	bc, err := NewBaseClient(c, logger)
+	bc, err := newReceiverBaseClient(backend, c, config.ReceiverConfig, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -382,6 +564,9 @@ func NewReceiverClient(backend *api.GethStatusBackend, c *ConnectionParams, conf
 
 	ar, rmr, imr, err := NewPayloadReceivers(logger, pe, backend, config.ReceiverConfig)
 	if err != nil {
+		if closeErr := bc.closeRelay(); closeErr != nil {
+			logger.Error("failed to close the pairing relay", zap.Error(closeErr))
+		}
 		return nil, err
 	}
 
@@ -390,6 +575,7 @@ func NewReceiverClient(backend *api.GethStatusBackend, c *ConnectionParams, conf
 		accountReceiver:      ar,
 		rawMessageReceiver:   rmr,
 		installationReceiver: imr,
+		historyReceiver:      NewHistoryReceiver(backend, pe, config.ReceiverConfig.HistoryTransfer),
 	}, nil
 }
 
@@ -434,6 +620,40 @@ func (c *ReceiverClient) receiveAccountData() error {
 	return nil
 }
 
+// sendSyncWatermarks sends our watermarks, so that the sender skips the sync messages we already have
+func (c *ReceiverClient) sendSyncWatermarks() error {
+	err := c.rawMessageReceiver.Mount()
+	if err != nil {
+		return err
+	}
+
+	c.baseAddress.Path = pairingReceiveSyncWatermarks
+	req, err := http.NewRequest(http.MethodPost, c.baseAddress.String(), bytes.NewBuffer(c.rawMessageReceiver.ToSend()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	err = c.challengeTaker.DoChallenge(req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+
+	// senders without watermarks send all the sync messages
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("[client] status not ok when sending sync watermarks, status: %s", resp.Status)
+	}
+	return nil
+}
+
 func (c *ReceiverClient) receiveSyncDeviceData() error {
 	c.baseAddress.Path = pairingSendSyncDevice
 	req, err := http.NewRequest(http.MethodGet, c.baseAddress.String(), nil)
@@ -510,6 +730,70 @@ func (c *ReceiverClient) sendInstallationData() error {
 	return nil
 }
 
+// receiveHistory requests the message history chunk by chunk and stores it, if we opted in
+func (c *ReceiverClient) receiveHistory() error {
+	if !c.historyReceiver.Enabled() {
+		return nil
+	}
+
+	var cursor uint64
+	for {
+		if c.historyReceiver.Canceled() {
+			return ErrHistoryTransferCanceled
+		}
+
+		err := c.getChallenge()
+		if err != nil {
+			return err
+		}
+
+		request, err := c.historyReceiver.Request(cursor)
+		if err != nil {
+			return err
+		}
+
+		c.baseAddress.Path = pairingSendHistory
+		req, err := http.NewRequest(http.MethodPost, c.baseAddress.String(), bytes.NewBuffer(request))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/octet-stream")
+		err = c.challengeTaker.DoChallenge(req)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.Do(req)
+		if err != nil {
+			return err
+		}
+		// senders that don't support the history transfer
+		if resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		if resp.StatusCode == http.StatusGone {
+			return ErrHistoryTransferCanceled
+		}
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("[client] status not ok when receiving history, received '%s'", resp.Status)
+		}
+
+		payload, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		chunk, err := c.historyReceiver.Store(payload)
+		if err != nil {
+			return err
+		}
+
+		if chunk.Last {
+			return nil
+		}
+		cursor = chunk.Cursor
+	}
+}
+
 // setupReceivingClient creates a new ReceiverClient after parsing string inputs
 func setupReceivingClient(backend *api.GethStatusBackend, cs, configJSON string) (*ReceiverClient, error) {
 	ccp := new(ConnectionParams)
@@ -545,6 +829,11 @@ func StartUpReceivingClient(backend *api.GethStatusBackend, cs, configJSON strin
 	if err != nil {
 		return err
 	}
+	defer func() {
+		if err := c.closeRelay(); err != nil {
+			logutils.ZapLogger().Error("failed to close the pairing relay", zap.Error(err))
+		}
+	}()
 
 	err = c.getChallenge()
 	if err != nil {
@@ -555,6 +844,15 @@ func StartUpReceivingClient(backend *api.GethStatusBackend, cs, configJSON strin
 		return err
 	}
 
+	err = c.getChallenge()
+	if err != nil {
+		return err
+	}
+	err = c.sendSyncWatermarks()
+	if err != nil {
+		return err
+	}
+
 	err = c.getChallenge()
 	if err != nil {
 		return err
@@ -568,7 +866,18 @@ func StartUpReceivingClient(backend *api.GethStatusBackend, cs, configJSON strin
 	if err != nil {
 		return err
 	}
-	return c.sendInstallationData()
+	err = c.sendInstallationData()
+	if err != nil {
+		return err
+	}
+
+	// the devices are paired at this point, a failed history transfer is only notified
+	err = c.receiveHistory()
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionHistoryTransfer})
+		logutils.ZapLogger().Error("failed to receive the message history", zap.Error(err))
+	}
+	return nil
 }
 
 /*
@@ -39,6 +39,14 @@ type ReceiverConfig struct {
 	DeviceName     string                  `json:"deviceName"`
 	DB             *multiaccounts.Database `json:"-"`
 	LoggedInKeyUID string                  `json:"-"`
+
+	// HistoryTransfer opts in to the transfer of the message history, none is transferred when nil
+	HistoryTransfer *HistoryTransferConfig `json:"historyTransfer" validate:"omitempty"`
+}
+
+type HistoryTransferConfig struct {
+	// Days of message history to transfer, all of it when 0
+	Days uint32 `json:"days" validate:"gte=0"`
 }
 
 type KeystoreFilesConfig struct {
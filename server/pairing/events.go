@@ -21,6 +21,11 @@ const (
 	EventProcessSuccess        EventType = "process-success"
 	EventProcessError          EventType = "process-error"
 	EventReceivedKeystoreFiles EventType = "received-keystore-files"
+	EventSyncProgress          EventType = "sync-progress"
+
+	// Both Sender and Receiver, during the history transfer
+
+	EventHistoryProgress EventType = "history-progress"
 )
 
 // Event is a type for transfer events.
@@ -40,6 +45,7 @@ const (
 	ActionPairingInstallation
 	ActionPeerDiscovery
 	ActionKeystoreFilesTransfer
+	ActionHistoryTransfer
 )
 
 type AccountData struct {
@@ -47,3 +53,21 @@ type AccountData struct {
 	Password string                 `json:"password,omitempty"`
 	ChatKey  string                 `json:"chatKey,omitempty"`
 }
+
+// SyncProgress is the Data of an EventSyncProgress
+type SyncProgress struct {
+	// Processed is the number of sync messages processed so far
+	Processed int `json:"processed"`
+	// Total is the number of sync messages sent by the sender
+	Total int `json:"total"`
+	// Unchanged is the number of sync messages skipped by the sender as we already had them
+	Unchanged int `json:"unchanged"`
+}
+
+// HistoryProgress is the Data of an EventHistoryProgress
+type HistoryProgress struct {
+	// Transferred is the number of messages transferred so far
+	Transferred uint64 `json:"transferred"`
+	// Total is the number of messages to transfer
+	Total uint64 `json:"total"`
+}
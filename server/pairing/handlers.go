@@ -11,14 +11,19 @@ import (
 
 const (
 	// Handler routes for pairing
-	pairingBase                = "/pairing"
-	pairingChallenge           = pairingBase + "/challenge"
-	pairingSendAccount         = pairingBase + "/sendAccount"
-	pairingReceiveAccount      = pairingBase + "/receiveAccount"
-	pairingSendSyncDevice      = pairingBase + "/sendSyncDevice"
-	pairingReceiveSyncDevice   = pairingBase + "/receiveSyncDevice"
-	pairingSendInstallation    = pairingBase + "/sendInstallation"
-	pairingReceiveInstallation = pairingBase + "/receiveInstallation"
+	pairingBase                  = "/pairing"
+	pairingChallenge             = pairingBase + "/challenge"
+	pairingSendAccount           = pairingBase + "/sendAccount"
+	pairingReceiveAccount        = pairingBase + "/receiveAccount"
+	pairingSendSyncDevice        = pairingBase + "/sendSyncDevice"
+	pairingReceiveSyncDevice     = pairingBase + "/receiveSyncDevice"
+	pairingSendInstallation      = pairingBase + "/sendInstallation"
+	pairingReceiveInstallation   = pairingBase + "/receiveInstallation"
+	pairingSendSyncWatermarks    = pairingBase + "/sendSyncWatermarks"
+	pairingReceiveSyncWatermarks = pairingBase + "/receiveSyncWatermarks"
+	pairingSendHistory           = pairingBase + "/sendHistory"
+	pairingReceiveHistory        = pairingBase + "/receiveHistory"
+	pairingSendHistoryRequest    = pairingBase + "/sendHistoryRequest"
 )
 
 // Account handling
@@ -125,6 +130,135 @@ func handlePairingSyncDeviceSend(logger *zap.Logger, pm PayloadMounter, beforeSe
 	}
 }
 
+// Sync watermarks handling
+
+func handleReceiveSyncWatermarks(logger *zap.Logger, pr PayloadReceiver) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		payload, err := io.ReadAll(r.Body)
+		if err != nil {
+			logger.Error("handleReceiveSyncWatermarks io.ReadAll(r.Body)", zap.Error(err))
+			http.Error(w, "error", http.StatusInternalServerError)
+			return
+		}
+
+		err = pr.Receive(payload)
+		if err != nil {
+			logger.Error("handleReceiveSyncWatermarks pr.Receive(payload)", zap.Error(err))
+			http.Error(w, "error", http.StatusInternalServerError)
+			return
+		}
+	}
+}
+
+func handleSendSyncWatermarks(logger *zap.Logger, pm PayloadMounter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+
+		err := pm.Mount()
+		if err != nil {
+			logger.Error("handleSendSyncWatermarks pm.Mount()", zap.Error(err))
+			http.Error(w, "error", http.StatusInternalServerError)
+			return
+		}
+
+		_, err = w.Write(pm.ToSend())
+		if err != nil {
+			logger.Error("handleSendSyncWatermarks w.Write(pm.ToSend())", zap.Error(err))
+			http.Error(w, "error", http.StatusInternalServerError)
+			return
+		}
+	}
+}
+
+// History handling
+
+func handleSendHistory(logger *zap.Logger, hs *HistorySender) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		payload, err := io.ReadAll(r.Body)
+		if err != nil {
+			logger.Error("handleSendHistory io.ReadAll(r.Body)", zap.Error(err))
+			http.Error(w, "error", http.StatusInternalServerError)
+			return
+		}
+
+		request, err := hs.DecryptRequest(payload)
+		if err != nil {
+			logger.Error("handleSendHistory hs.DecryptRequest(payload)", zap.Error(err))
+			http.Error(w, "error", http.StatusBadRequest)
+			return
+		}
+
+		_, chunk, err := hs.Chunk(request)
+		if err == ErrHistoryTransferCanceled {
+			http.Error(w, err.Error(), http.StatusGone)
+			return
+		}
+		if err != nil {
+			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionHistoryTransfer})
+			logger.Error("handleSendHistory hs.Chunk(request)", zap.Error(err))
+			http.Error(w, "error", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, err = w.Write(chunk)
+		if err != nil {
+			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionHistoryTransfer})
+			logger.Error("handleSendHistory w.Write(chunk)", zap.Error(err))
+		}
+	}
+}
+
+func handleSendHistoryRequest(logger *zap.Logger, hr *HistoryReceiver) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !hr.Enabled() {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		request, err := hr.Request(0)
+		if err != nil {
+			logger.Error("handleSendHistoryRequest hr.Request(0)", zap.Error(err))
+			http.Error(w, "error", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, err = w.Write(request)
+		if err != nil {
+			logger.Error("handleSendHistoryRequest w.Write(request)", zap.Error(err))
+		}
+	}
+}
+
+func handleReceiveHistory(logger *zap.Logger, hr *HistoryReceiver) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !hr.Enabled() {
+			http.Error(w, "history transfer not requested", http.StatusForbidden)
+			return
+		}
+
+		payload, err := io.ReadAll(r.Body)
+		if err != nil {
+			logger.Error("handleReceiveHistory io.ReadAll(r.Body)", zap.Error(err))
+			http.Error(w, "error", http.StatusInternalServerError)
+			return
+		}
+
+		_, err = hr.Store(payload)
+		if err == ErrHistoryTransferCanceled {
+			http.Error(w, err.Error(), http.StatusGone)
+			return
+		}
+		if err != nil {
+			signal.SendLocalPairingEvent(Event{Type: EventProcessError, Error: err.Error(), Action: ActionHistoryTransfer})
+			logger.Error("handleReceiveHistory hr.Store(payload)", zap.Error(err))
+			http.Error(w, "error", http.StatusInternalServerError)
+			return
+		}
+	}
+}
+
 // Installation data handling
 
 func handleReceiveInstallation(logger *zap.Logger, pmr PayloadMounterReceiver) http.HandlerFunc {
@@ -0,0 +1,234 @@
+package pairing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/api"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/signal"
+)
+
+/*
+|--------------------------------------------------------------------------
+| History transfer
+|--------------------------------------------------------------------------
+|
+| Once the devices are paired, the receiver may opt in to the transfer of the message history.
+| The history is transferred one encrypted chunk at a time, the next chunk is only sent
+| once the previous one is stored by the receiver.
+|
+*/
+
+// historyChunkSize is the approximate size of a chunk of history
+const historyChunkSize = 1 << 20
+
+var (
+	ErrHistoryTransferCanceled   = errors.New("history transfer canceled")
+	ErrNoHistoryTransferToCancel = errors.New("no history transfer to cancel")
+)
+
+// historyTransfer is a running history transfer, canceled by CancelHistoryTransfer
+type historyTransfer struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	progress HistoryProgress
+}
+
+var (
+	historyTransfersLock sync.Mutex
+	historyTransfers     = make(map[*historyTransfer]struct{})
+)
+
+func startHistoryTransfer() *historyTransfer {
+	ctx, cancel := context.WithCancel(context.Background())
+	t := &historyTransfer{ctx: ctx, cancel: cancel}
+
+	historyTransfersLock.Lock()
+	defer historyTransfersLock.Unlock()
+	historyTransfers[t] = struct{}{}
+	return t
+}
+
+func (t *historyTransfer) finish() {
+	t.cancel()
+
+	historyTransfersLock.Lock()
+	defer historyTransfersLock.Unlock()
+	delete(historyTransfers, t)
+}
+
+func (t *historyTransfer) canceled() bool {
+	return t.ctx.Err() != nil
+}
+
+// progressed accounts for the messages of the chunk and notifies the progress
+func (t *historyTransfer) progressed(chunk *protobuf.PairingHistoryChunk) {
+	if chunk.TotalMessagesCount > 0 {
+		t.progress.Total = chunk.TotalMessagesCount
+	}
+	t.progress.Transferred += chunk.MessagesCount
+	signal.SendLocalPairingEvent(Event{Type: EventHistoryProgress, Action: ActionHistoryTransfer, Data: t.progress})
+	if chunk.Last {
+		signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionHistoryTransfer, Data: t.progress})
+	}
+}
+
+// CancelHistoryTransfer cancels the running history transfers, on either side of the transfer
+func CancelHistoryTransfer() error {
+	historyTransfersLock.Lock()
+	defer historyTransfersLock.Unlock()
+
+	if len(historyTransfers) == 0 {
+		return ErrNoHistoryTransferToCancel
+	}
+	for t := range historyTransfers {
+		t.cancel()
+		delete(historyTransfers, t)
+	}
+	return nil
+}
+
+// HistorySender loads and encrypts the chunks of history requested by the receiver
+type HistorySender struct {
+	backend *api.GethStatusBackend
+	pe      *PayloadEncryptor
+
+	mu       sync.Mutex
+	transfer *historyTransfer
+}
+
+func NewHistorySender(backend *api.GethStatusBackend, pe *PayloadEncryptor) *HistorySender {
+	return &HistorySender{backend: backend, pe: pe.Renew()}
+}
+
+// DecryptRequest decrypts a request of the receiver
+func (h *HistorySender) DecryptRequest(data []byte) (*protobuf.PairingHistoryRequest, error) {
+	plain, err := h.pe.decryptPlain(data)
+	if err != nil {
+		return nil, err
+	}
+	request := new(protobuf.PairingHistoryRequest)
+	return request, proto.Unmarshal(plain, request)
+}
+
+// Chunk returns the chunk of history following the cursor of the request and its encrypted form
+func (h *HistorySender) Chunk(request *protobuf.PairingHistoryRequest) (*protobuf.PairingHistoryChunk, []byte, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if request.Cursor == 0 {
+		if h.transfer != nil {
+			h.transfer.finish()
+		}
+		h.transfer = startHistoryTransfer()
+	}
+	if h.transfer == nil || h.transfer.canceled() {
+		return nil, nil, ErrHistoryTransferCanceled
+	}
+
+	messenger := h.backend.Messenger()
+	if messenger == nil {
+		return nil, nil, fmt.Errorf("messenger is nil when loading a history chunk")
+	}
+
+	chunk, err := messenger.PairingHistoryChunk(request, historyChunkSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := proto.Marshal(chunk)
+	if err != nil {
+		return nil, nil, err
+	}
+	encrypted, err := h.pe.encryptPlain(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	h.transfer.progressed(chunk)
+	if chunk.Last {
+		h.transfer.finish()
+	}
+	return chunk, encrypted, nil
+}
+
+// HistoryReceiver requests the chunks of history and stores them
+type HistoryReceiver struct {
+	backend *api.GethStatusBackend
+	pe      *PayloadEncryptor
+	config  *HistoryTransferConfig
+
+	mu       sync.Mutex
+	transfer *historyTransfer
+}
+
+func NewHistoryReceiver(backend *api.GethStatusBackend, pe *PayloadEncryptor, config *HistoryTransferConfig) *HistoryReceiver {
+	return &HistoryReceiver{backend: backend, pe: pe.Renew(), config: config}
+}
+
+// Enabled tells if the history transfer was opted in to
+func (h *HistoryReceiver) Enabled() bool {
+	return h.config != nil
+}
+
+// Request returns the encrypted request of the chunk following the cursor
+func (h *HistoryReceiver) Request(cursor uint64) ([]byte, error) {
+	data, err := proto.Marshal(&protobuf.PairingHistoryRequest{Days: h.config.Days, Cursor: cursor})
+	if err != nil {
+		return nil, err
+	}
+	return h.pe.encryptPlain(data)
+}
+
+// Store decrypts and stores a chunk of history
+func (h *HistoryReceiver) Store(data []byte) (*protobuf.PairingHistoryChunk, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	plain, err := h.pe.decryptPlain(data)
+	if err != nil {
+		return nil, err
+	}
+	chunk := new(protobuf.PairingHistoryChunk)
+	err = proto.Unmarshal(plain, chunk)
+	if err != nil {
+		return nil, err
+	}
+
+	if chunk.TotalMessagesCount > 0 || h.transfer == nil {
+		if h.transfer != nil {
+			h.transfer.finish()
+		}
+		h.transfer = startHistoryTransfer()
+	}
+	if h.transfer.canceled() {
+		return nil, ErrHistoryTransferCanceled
+	}
+
+	messenger := h.backend.Messenger()
+	if messenger == nil {
+		return nil, fmt.Errorf("messenger is nil when storing a history chunk")
+	}
+	err = messenger.SavePairingHistoryChunk(chunk)
+	if err != nil {
+		return nil, err
+	}
+
+	h.transfer.progressed(chunk)
+	if chunk.Last {
+		h.transfer.finish()
+	}
+	return chunk, nil
+}
+
+// Canceled tells if the running transfer was canceled, the receiving client checks it before requesting the next chunk
+func (h *HistoryReceiver) Canceled() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.transfer != nil && h.transfer.canceled()
+}
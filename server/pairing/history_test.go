@@ -0,0 +1,46 @@
+package pairing
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestHistoryRequest(t *testing.T) {
+	aesKey := make([]byte, 32)
+	_, err := rand.Read(aesKey)
+	require.NoError(t, err)
+	pe := NewPayloadEncryptor(aesKey)
+
+	hr := NewHistoryReceiver(nil, pe, nil)
+	require.False(t, hr.Enabled())
+
+	hr = NewHistoryReceiver(nil, pe, &HistoryTransferConfig{Days: 30})
+	require.True(t, hr.Enabled())
+
+	data, err := hr.Request(42)
+	require.NoError(t, err)
+
+	request, err := NewHistorySender(nil, pe).DecryptRequest(data)
+	require.NoError(t, err)
+	require.Equal(t, uint32(30), request.Days)
+	require.Equal(t, uint64(42), request.Cursor)
+}
+
+func TestCancelHistoryTransfer(t *testing.T) {
+	require.ErrorIs(t, CancelHistoryTransfer(), ErrNoHistoryTransferToCancel)
+
+	transfer := startHistoryTransfer()
+	require.False(t, transfer.canceled())
+	require.NoError(t, CancelHistoryTransfer())
+	require.True(t, transfer.canceled())
+	require.ErrorIs(t, CancelHistoryTransfer(), ErrNoHistoryTransferToCancel)
+
+	// the next chunks of a canceled transfer are refused
+	hs := &HistorySender{transfer: transfer}
+	_, _, err := hs.Chunk(&protobuf.PairingHistoryRequest{Cursor: 1})
+	require.ErrorIs(t, err, ErrHistoryTransferCanceled)
+}
@@ -116,6 +116,7 @@ type RawMessagesPayload struct {
 	rawMessages    []*protobuf.RawMessage
 	profileKeypair *accounts.Keypair
 	setting        *settings.Settings
+	watermarks     []*protobuf.PairingSyncWatermark
 }
 
 func NewRawMessagesPayload() *RawMessagesPayload {
@@ -139,6 +140,7 @@ func (rmm *RawMessagePayloadMarshaller) MarshalProtobuf() (data []byte, err erro
 	syncRawMessage := new(protobuf.SyncRawMessage)
 
 	syncRawMessage.RawMessages = rmm.payload.rawMessages
+	syncRawMessage.Watermarks = rmm.payload.watermarks
 	if rmm.payload.profileKeypair != nil && len(rmm.payload.profileKeypair.KeyUID) > 0 {
 		syncRawMessage.SubAccountsJsonBytes, err = json.Marshal(rmm.payload.profileKeypair)
 		if err != nil {
@@ -175,6 +177,7 @@ func (rmm *RawMessagePayloadMarshaller) UnmarshalProtobuf(data []byte) error {
 	}
 
 	rmm.payload.rawMessages = syncRawMessage.RawMessages
+	rmm.payload.watermarks = syncRawMessage.Watermarks
 	return nil
 }
 
@@ -146,13 +146,14 @@ func (apl *AccountPayloadLoader) Load() error {
 */
 
 // NewRawMessagePayloadMounter generates a new and initialised RawMessagePayload flavoured BasePayloadMounter
-// responsible for the whole lifecycle of an RawMessagePayload
-func NewRawMessagePayloadMounter(logger *zap.Logger, pe *PayloadEncryptor, backend *api.GethStatusBackend, config *SenderConfig) *BasePayloadMounter {
+// responsible for the whole lifecycle of an RawMessagePayload.
+// The sync messages the receiver already has according to the shared SyncWatermarksPayload are skipped.
+func NewRawMessagePayloadMounter(logger *zap.Logger, pe *PayloadEncryptor, backend *api.GethStatusBackend, config *SenderConfig, watermarks *SyncWatermarksPayload) *BasePayloadMounter {
 	pe = pe.Renew()
 	payload := NewRawMessagesPayload()
 
 	return NewBasePayloadMounter(
-		NewRawMessageLoader(backend, payload, config),
+		NewRawMessageLoader(backend, payload, watermarks, config),
 		NewRawMessagePayloadMarshaller(payload),
 		pe,
 	)
@@ -160,15 +161,17 @@ func NewRawMessagePayloadMounter(logger *zap.Logger, pe *PayloadEncryptor, backe
 
 type RawMessageLoader struct {
 	payload               *RawMessagesPayload
+	watermarks            *SyncWatermarksPayload
 	syncRawMessageHandler *SyncRawMessageHandler
 	keyUID                string
 	deviceType            string
 }
 
-func NewRawMessageLoader(backend *api.GethStatusBackend, payload *RawMessagesPayload, config *SenderConfig) *RawMessageLoader {
+func NewRawMessageLoader(backend *api.GethStatusBackend, payload *RawMessagesPayload, watermarks *SyncWatermarksPayload, config *SenderConfig) *RawMessageLoader {
 	return &RawMessageLoader{
 		syncRawMessageHandler: NewSyncRawMessageHandler(backend),
 		payload:               payload,
+		watermarks:            watermarks,
 		keyUID:                config.KeyUID,
 		deviceType:            config.DeviceType,
 	}
@@ -176,6 +179,10 @@ func NewRawMessageLoader(backend *api.GethStatusBackend, payload *RawMessagesPay
 
 func (r *RawMessageLoader) Load() (err error) {
 	r.payload.rawMessages, r.payload.profileKeypair, r.payload.setting, err = r.syncRawMessageHandler.PrepareRawMessage(r.keyUID, r.deviceType)
+	if err != nil {
+		return err
+	}
+	r.payload.rawMessages, r.payload.watermarks, err = r.syncRawMessageHandler.FilterSyncedRawMessages(r.payload.rawMessages, r.watermarks.watermarks)
 	return err
 }
 
@@ -237,12 +244,12 @@ func (r *InstallationPayloadLoader) Load() error {
 
 // NewPayloadMounters returns PayloadMounter s configured to handle local pairing transfers of:
 //   - AccountPayload, RawMessagePayload and InstallationPayload
-func NewPayloadMounters(logger *zap.Logger, pe *PayloadEncryptor, backend *api.GethStatusBackend, config *SenderConfig) (PayloadMounter, PayloadMounter, PayloadMounterReceiver, error) {
+func NewPayloadMounters(logger *zap.Logger, pe *PayloadEncryptor, backend *api.GethStatusBackend, config *SenderConfig) (PayloadMounter, PayloadMounterReceiver, PayloadMounterReceiver, error) {
 	am, err := NewAccountPayloadMounter(pe, config, logger)
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	rmm := NewRawMessagePayloadMounter(logger, pe, backend, config)
+	rmm := NewSenderSyncDevicePayloadMounterReceiver(logger, pe, backend, config)
 	imr := NewInstallationPayloadMounterReceiver(pe, backend, config.DeviceType)
 	return am, rmm, imr, nil
 }
@@ -336,7 +336,7 @@ func (r *InstallationPayloadStorer) Store() error {
 |
 */
 
-func NewPayloadReceivers(logger *zap.Logger, pe *PayloadEncryptor, backend *api.GethStatusBackend, config *ReceiverConfig) (PayloadReceiver, PayloadReceiver, PayloadMounterReceiver, error) {
+func NewPayloadReceivers(logger *zap.Logger, pe *PayloadEncryptor, backend *api.GethStatusBackend, config *ReceiverConfig) (PayloadReceiver, PayloadMounterReceiver, PayloadMounterReceiver, error) {
 	// A new SHARED AccountPayload
 	p := new(AccountPayload)
 
@@ -344,7 +344,7 @@ func NewPayloadReceivers(logger *zap.Logger, pe *PayloadEncryptor, backend *api.
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	rmr := NewRawMessagePayloadReceiver(p, pe, backend, config)
+	rmr := NewReceiverSyncDevicePayloadMounterReceiver(p, pe, backend, config)
 	imr := NewInstallationPayloadMounterReceiver(pe, backend, config.DeviceType)
 	return ar, rmr, imr, nil
 }
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/status-im/status-go/api"
 	"github.com/status-im/status-go/multiaccounts/accounts"
 	"github.com/status-im/status-go/multiaccounts/settings"
 	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/protocol"
 	"github.com/status-im/status-go/protocol/protobuf"
 
 	"github.com/status-im/status-go/signal"
@@ -84,6 +86,18 @@ func (s *SyncRawMessageHandler) PrepareRawMessage(keyUID, deviceType string) (rm
 	return
 }
 
+// FilterSyncedRawMessages drops the sync messages the receiver already has according to its watermarks,
+// and returns the watermarks of all the sync messages to be sent along with the remaining ones
+func (s *SyncRawMessageHandler) FilterSyncedRawMessages(rawMessages []*protobuf.RawMessage, receiverWatermarks []*protobuf.PairingSyncWatermark) ([]*protobuf.RawMessage, []*protobuf.PairingSyncWatermark, error) {
+	messenger := s.backend.Messenger()
+	if messenger == nil {
+		return nil, nil, fmt.Errorf("messenger is nil when FilterSyncedRawMessages")
+	}
+
+	filtered, watermarks := filterSyncedRawMessages(rawMessages, messenger.InstallationID(), uint64(time.Now().UnixMilli()), receiverWatermarks)
+	return filtered, watermarks, nil
+}
+
 func (s *SyncRawMessageHandler) HandleRawMessage(accountPayload *AccountPayload, nodeConfig *params.NodeConfig, settingCurrentNetwork, deviceType string, deviceName string, rmp *RawMessagesPayload) (err error) {
 	account := accountPayload.multiaccount
 
@@ -131,8 +145,7 @@ func (s *SyncRawMessageHandler) HandleRawMessage(accountPayload *AccountPayload,
 
 	installations := GetMessengerInstallationsMap(messenger)
 
-	err = messenger.HandleSyncRawMessages(rmp.rawMessages)
-
+	err = s.handleSyncRawMessages(messenger, rmp)
 	if err != nil {
 		return err
 	}
@@ -146,3 +159,54 @@ func (s *SyncRawMessageHandler) HandleRawMessage(accountPayload *AccountPayload,
 
 	return nil
 }
+
+// handleSyncRawMessages handles the sync messages in batches, saving the watermarks of the sender after each batch
+func (s *SyncRawMessageHandler) handleSyncRawMessages(messenger *protocol.Messenger, rmp *RawMessagesPayload) error {
+	// senders without watermarks send all the sync messages every time
+	var tracker *syncWatermarkTracker
+	if len(rmp.watermarks) > 0 {
+		known, err := messenger.PairingSyncWatermarks()
+		if err != nil {
+			return err
+		}
+		tracker = newSyncWatermarkTracker(rmp.watermarks, known)
+	}
+
+	progress := SyncProgress{Total: len(rmp.rawMessages)}
+	if tracker != nil {
+		progress.Unchanged = tracker.unchanged
+	}
+
+	for start := 0; start < len(rmp.rawMessages); start += syncWatermarkBatchSize {
+		end := start + syncWatermarkBatchSize
+		if end > len(rmp.rawMessages) {
+			end = len(rmp.rawMessages)
+		}
+		batch := rmp.rawMessages[start:end]
+
+		err := messenger.HandleSyncRawMessages(batch)
+		if err != nil {
+			return err
+		}
+
+		if tracker != nil {
+			tracker.markProcessed(batch)
+			err = messenger.SavePairingSyncWatermarks(tracker.installationID, tracker.current())
+			if err != nil {
+				return err
+			}
+		}
+
+		progress.Processed = end
+		signal.SendLocalPairingEvent(Event{
+			Type:   EventSyncProgress,
+			Action: ActionSyncDevice,
+			Data:   progress})
+	}
+
+	if tracker != nil && len(rmp.rawMessages) == 0 {
+		// nothing changed, but the sync messages removed by the sender are dropped from the watermarks
+		return messenger.SavePairingSyncWatermarks(tracker.installationID, tracker.current())
+	}
+	return nil
+}
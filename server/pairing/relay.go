@@ -0,0 +1,812 @@
+package pairing
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/api"
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/node"
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/protocol/common/shard"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/wakuv2"
+)
+
+/*
+|--------------------------------------------------------------------------
+| Relay
+|--------------------------------------------------------------------------
+|
+| When the ReceiverClient can't reach the SenderServer on the local network,
+| the http requests and responses of the pairing are tunnelled over waku,
+| on content topics derived from the AES key of the connection string.
+| The payloads are still encrypted by the PayloadEncryptor of the handlers,
+| the waku messages are additionally encrypted with a key derived from the AES key.
+|
+*/
+
+const (
+	// relayChunkSize keeps the waku messages well below the max message size
+	relayChunkSize = 256 * 1024
+	// relayMaxChunks bounds the size of a single request or response
+	relayMaxChunks = 1024
+
+	relayResendInterval = 2 * time.Second
+	relayPollInterval   = 200 * time.Millisecond
+	relayPublishTimeout = 10 * time.Second
+	relayRequestTimeout = 2 * time.Minute
+	// relayIdleTimeout stops the relay of the SenderServer once no client used it for this long
+	relayIdleTimeout = 10 * time.Minute
+	// relayDeliveredTTL is how long the ids of the delivered messages are remembered
+	// to ignore the retransmitted chunks, senders give up long before
+	relayDeliveredTTL = 2 * relayRequestTimeout
+	// relayConnectTimeout is the time given to a waku node started for the pairing to find peers
+	relayConnectTimeout = 30 * time.Second
+
+	// relayHost is the host of the urls requested over the relay, it is never resolved
+	relayHost = "pairing-relay"
+	// relayRemoteAddr is the remote address of the requests received over the relay,
+	// the ChallengeGiver pins the relay client to it like it pins the IP of a local client
+	relayRemoteAddr = "0.0.0.0:0"
+)
+
+var (
+	ErrRelayTimeout     = errors.New("pairing relay: request timed out")
+	ErrRelayClosed      = errors.New("pairing relay: closed")
+	ErrRelayNoPeers     = errors.New("pairing relay: no waku peers")
+	ErrRelayUnavailable = errors.New("pairing relay: waku is not available")
+)
+
+// relaySymKey is the key encrypting the waku messages of the relay
+func relaySymKey(aesKey []byte) []byte {
+	return crypto.Keccak256([]byte("pairing-relay-key"), aesKey)
+}
+
+// relayRequestTopic is the topic of the requests sent by the client and of the acks of the responses
+func relayRequestTopic(aesKey []byte) types.TopicType {
+	return types.BytesToTopic(crypto.Keccak256([]byte("pairing-relay-request"), aesKey))
+}
+
+// relayResponseTopic is the topic of the responses sent by the server and of the acks of the requests
+func relayResponseTopic(aesKey []byte) types.TopicType {
+	return types.BytesToTopic(crypto.Keccak256([]byte("pairing-relay-response"), aesKey))
+}
+
+// RelayTransport publishes and receives the frames of the pairing relay
+type RelayTransport interface {
+	Publish(topic types.TopicType, payload []byte) error
+	// Subscribe returns the payloads received on the topic until the transport is closed
+	Subscribe(topic types.TopicType) (<-chan []byte, error)
+	Close() error
+}
+
+/*
+|--------------------------------------------------------------------------
+| wakuRelayTransport
+|--------------------------------------------------------------------------
+*/
+
+type wakuRelayTransport struct {
+	waku        types.Waku
+	api         types.PublicWakuAPI
+	symKeyID    string
+	pubsubTopic string
+	logger      *zap.Logger
+
+	// stopWaku stops the waku node when it was started for the pairing only
+	stopWaku func() error
+
+	mu        sync.Mutex
+	filterIDs []string
+	quit      chan struct{}
+	closeOnce sync.Once
+}
+
+func newWakuRelayTransport(waku types.Waku, aesKey []byte, stopWaku func() error, logger *zap.Logger) (*wakuRelayTransport, error) {
+	symKeyID, err := waku.AddSymKeyDirect(relaySymKey(aesKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return &wakuRelayTransport{
+		waku:        waku,
+		api:         waku.PublicWakuAPI(),
+		symKeyID:    symKeyID,
+		pubsubTopic: shard.DefaultShardPubsubTopic(),
+		logger:      logger,
+		stopWaku:    stopWaku,
+		quit:        make(chan struct{}),
+	}, nil
+}
+
+func (t *wakuRelayTransport) Publish(topic types.TopicType, payload []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), relayPublishTimeout)
+	defer cancel()
+
+	_, err := t.api.Post(ctx, types.NewMessage{
+		SymKeyID:    t.symKeyID,
+		PubsubTopic: t.pubsubTopic,
+		Topic:       topic,
+		Payload:     payload,
+		// the pairing data must not be kept by store nodes
+		Ephemeral: true,
+	})
+	return err
+}
+
+func (t *wakuRelayTransport) Subscribe(topic types.TopicType) (<-chan []byte, error) {
+	filterID, err := t.api.NewMessageFilter(types.Criteria{
+		SymKeyID:    t.symKeyID,
+		PubsubTopic: t.pubsubTopic,
+		Topics:      []types.TopicType{topic},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	t.filterIDs = append(t.filterIDs, filterID)
+	t.mu.Unlock()
+
+	payloads := make(chan []byte, 100)
+	go func() {
+		defer close(payloads)
+
+		ticker := time.NewTicker(relayPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				messages, err := t.api.GetFilterMessages(filterID)
+				if err != nil {
+					t.logger.Warn("failed to get pairing relay messages", zap.Error(err))
+					continue
+				}
+				for _, message := range messages {
+					select {
+					case payloads <- message.Payload:
+					case <-t.quit:
+						return
+					}
+				}
+			case <-t.quit:
+				return
+			}
+		}
+	}()
+
+	return payloads, nil
+}
+
+func (t *wakuRelayTransport) Close() error {
+	var err error
+	t.closeOnce.Do(func() {
+		close(t.quit)
+
+		t.mu.Lock()
+		err = t.waku.UnsubscribeMany(t.filterIDs)
+		t.mu.Unlock()
+
+		t.waku.DeleteSymKey(t.symKeyID)
+
+		if t.stopWaku != nil {
+			stopErr := t.stopWaku()
+			if err == nil {
+				err = stopErr
+			}
+		}
+	})
+	return err
+}
+
+// startPairingWaku starts a waku node for the pairing of a device that is not logged in yet
+func startPairingWaku(nodeConfig *params.NodeConfig, logger *zap.Logger) (*wakuv2.Waku, error) {
+	if nodeConfig == nil {
+		return nil, ErrRelayUnavailable
+	}
+
+	cfg := node.NewWakuV2Config(nodeConfig, "")
+	// the node is temporary, it doesn't store messages and listens on any port
+	cfg.EnableStore = false
+	cfg.Port = 0
+
+	w, err := wakuv2.New("", nodeConfig.ClusterConfig.Fleet, cfg, logger, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(relayConnectTimeout)
+	for w.PeerCount() == 0 {
+		if time.Now().After(deadline) {
+			if err := w.Stop(); err != nil {
+				logger.Error("failed to stop the pairing waku node", zap.Error(err))
+			}
+			return nil, ErrRelayNoPeers
+		}
+		time.Sleep(relayPollInterval)
+	}
+
+	return w, nil
+}
+
+// newBackendRelayTransport returns a transport over the waku node of the backend,
+// or over a waku node started for the pairing if there is none
+func newBackendRelayTransport(backend *api.GethStatusBackend, nodeConfig *params.NodeConfig, aesKey []byte, logger *zap.Logger) (RelayTransport, error) {
+	if backend != nil && backend.StatusNode() != nil && backend.StatusNode().WakuV2Service() != nil {
+		return newWakuRelayTransport(gethbridge.NewGethWakuV2Wrapper(backend.StatusNode().WakuV2Service()), aesKey, nil, logger)
+	}
+
+	w, err := startPairingWaku(nodeConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := newWakuRelayTransport(gethbridge.NewGethWakuV2Wrapper(w), aesKey, w.Stop, logger)
+	if err != nil {
+		if stopErr := w.Stop(); stopErr != nil {
+			logger.Error("failed to stop the pairing waku node", zap.Error(stopErr))
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+/*
+|--------------------------------------------------------------------------
+| relayEndpoint
+|--------------------------------------------------------------------------
+|
+| Sends messages chunked in frames on one topic and receives them on the other.
+| Every received chunk is acknowledged, the chunks sent are published again
+| until they are acknowledged.
+|
+*/
+
+type relayOutgoing struct {
+	frames    [][]byte
+	acked     []bool
+	remaining int
+}
+
+type relayIncoming struct {
+	chunks   [][]byte
+	received int
+}
+
+type relayEndpoint struct {
+	transport    RelayTransport
+	sendTopic    types.TopicType
+	receiveTopic types.TopicType
+	// onMessage is called once for every message received
+	onMessage   func(id []byte, payload []byte)
+	idleTimeout time.Duration
+	logger      *zap.Logger
+
+	mu       sync.Mutex
+	outgoing map[string]*relayOutgoing
+	incoming map[string]*relayIncoming
+	// delivered maps the ids of the delivered messages to their delivery time
+	delivered map[string]time.Time
+
+	quit      chan struct{}
+	closeOnce sync.Once
+}
+
+func newRelayEndpoint(transport RelayTransport, sendTopic, receiveTopic types.TopicType, onMessage func([]byte, []byte), logger *zap.Logger) *relayEndpoint {
+	return &relayEndpoint{
+		transport:    transport,
+		sendTopic:    sendTopic,
+		receiveTopic: receiveTopic,
+		onMessage:    onMessage,
+		logger:       logger,
+		outgoing:     make(map[string]*relayOutgoing),
+		incoming:     make(map[string]*relayIncoming),
+		delivered:    make(map[string]time.Time),
+		quit:         make(chan struct{}),
+	}
+}
+
+func (e *relayEndpoint) start() error {
+	frames, err := e.transport.Subscribe(e.receiveTopic)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		ticker := time.NewTicker(relayResendInterval)
+		defer ticker.Stop()
+
+		var idle <-chan time.Time
+		var idleTimer *time.Timer
+		if e.idleTimeout > 0 {
+			idleTimer = time.NewTimer(e.idleTimeout)
+			defer idleTimer.Stop()
+			idle = idleTimer.C
+		}
+
+		for {
+			select {
+			case frame, ok := <-frames:
+				if !ok {
+					return
+				}
+				if idleTimer != nil {
+					if !idleTimer.Stop() {
+						<-idleTimer.C
+					}
+					idleTimer.Reset(e.idleTimeout)
+				}
+				e.handleFrame(frame)
+			case <-ticker.C:
+				e.resend()
+				e.pruneDelivered(time.Now())
+			case <-idle:
+				e.logger.Info("pairing relay idle, closing")
+				go e.close()
+				return
+			case <-e.quit:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (e *relayEndpoint) close() error {
+	var err error
+	e.closeOnce.Do(func() {
+		close(e.quit)
+		err = e.transport.Close()
+	})
+	return err
+}
+
+func (e *relayEndpoint) closed() bool {
+	select {
+	case <-e.quit:
+		return true
+	default:
+		return false
+	}
+}
+
+func (e *relayEndpoint) publish(frame *protobuf.PairingRelayFrame) error {
+	payload, err := proto.Marshal(frame)
+	if err != nil {
+		return err
+	}
+	return e.transport.Publish(e.sendTopic, payload)
+}
+
+// send chunks the payload and publishes the chunks until they are all acknowledged
+func (e *relayEndpoint) send(id []byte, payload []byte) error {
+	if e.closed() {
+		return ErrRelayClosed
+	}
+
+	chunksCount := (len(payload) + relayChunkSize - 1) / relayChunkSize
+	if chunksCount == 0 {
+		chunksCount = 1
+	}
+	if chunksCount > relayMaxChunks {
+		return fmt.Errorf("pairing relay: message of %d bytes is too large", len(payload))
+	}
+
+	out := &relayOutgoing{
+		frames:    make([][]byte, chunksCount),
+		acked:     make([]bool, chunksCount),
+		remaining: chunksCount,
+	}
+	for i := 0; i < chunksCount; i++ {
+		end := (i + 1) * relayChunkSize
+		if end > len(payload) {
+			end = len(payload)
+		}
+
+		frame, err := proto.Marshal(&protobuf.PairingRelayFrame{
+			Type:        protobuf.PairingRelayFrame_DATA,
+			MessageId:   id,
+			ChunkIndex:  uint32(i),
+			ChunksCount: uint32(chunksCount),
+			Data:        payload[i*relayChunkSize : end],
+		})
+		if err != nil {
+			return err
+		}
+		out.frames[i] = frame
+	}
+
+	e.mu.Lock()
+	e.outgoing[string(id)] = out
+	e.mu.Unlock()
+
+	for _, frame := range out.frames {
+		err := e.transport.Publish(e.sendTopic, frame)
+		if err != nil {
+			// the chunk is published again on the next resend
+			e.logger.Warn("failed to publish pairing relay frame", zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// cancel stops publishing the chunks of the message
+func (e *relayEndpoint) cancel(id []byte) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.outgoing, string(id))
+}
+
+func (e *relayEndpoint) resend() {
+	var frames [][]byte
+
+	e.mu.Lock()
+	for _, out := range e.outgoing {
+		for i, frame := range out.frames {
+			if !out.acked[i] {
+				frames = append(frames, frame)
+			}
+		}
+	}
+	e.mu.Unlock()
+
+	for _, frame := range frames {
+		err := e.transport.Publish(e.sendTopic, frame)
+		if err != nil {
+			e.logger.Warn("failed to publish pairing relay frame", zap.Error(err))
+		}
+	}
+}
+
+// pruneDelivered forgets the messages delivered more than relayDeliveredTTL ago
+func (e *relayEndpoint) pruneDelivered(now time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for id, deliveredAt := range e.delivered {
+		if now.Sub(deliveredAt) > relayDeliveredTTL {
+			delete(e.delivered, id)
+		}
+	}
+}
+
+func (e *relayEndpoint) handleFrame(payload []byte) {
+	frame := &protobuf.PairingRelayFrame{}
+	err := proto.Unmarshal(payload, frame)
+	if err != nil {
+		e.logger.Warn("failed to unmarshal pairing relay frame", zap.Error(err))
+		return
+	}
+
+	switch frame.Type {
+	case protobuf.PairingRelayFrame_ACK:
+		e.handleAck(frame)
+	case protobuf.PairingRelayFrame_DATA:
+		e.handleData(frame)
+	}
+}
+
+func (e *relayEndpoint) handleAck(frame *protobuf.PairingRelayFrame) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	out, ok := e.outgoing[string(frame.MessageId)]
+	if !ok || int(frame.ChunkIndex) >= len(out.acked) || out.acked[frame.ChunkIndex] {
+		return
+	}
+
+	out.acked[frame.ChunkIndex] = true
+	out.remaining--
+	if out.remaining == 0 {
+		delete(e.outgoing, string(frame.MessageId))
+	}
+}
+
+func (e *relayEndpoint) handleData(frame *protobuf.PairingRelayFrame) {
+	if frame.ChunksCount == 0 || frame.ChunksCount > relayMaxChunks || frame.ChunkIndex >= frame.ChunksCount {
+		e.logger.Warn("invalid pairing relay frame", zap.Uint32("chunkIndex", frame.ChunkIndex), zap.Uint32("chunksCount", frame.ChunksCount))
+		return
+	}
+
+	// Chunks are acknowledged every time they are received, as the previous ack may have been lost
+	err := e.publish(&protobuf.PairingRelayFrame{
+		Type:       protobuf.PairingRelayFrame_ACK,
+		MessageId:  frame.MessageId,
+		ChunkIndex: frame.ChunkIndex,
+	})
+	if err != nil {
+		e.logger.Warn("failed to acknowledge pairing relay frame", zap.Error(err))
+	}
+
+	id := string(frame.MessageId)
+
+	e.mu.Lock()
+	if _, ok := e.delivered[id]; ok {
+		e.mu.Unlock()
+		return
+	}
+
+	in, ok := e.incoming[id]
+	if !ok {
+		in = &relayIncoming{chunks: make([][]byte, frame.ChunksCount)}
+		e.incoming[id] = in
+	}
+	if int(frame.ChunksCount) != len(in.chunks) {
+		e.mu.Unlock()
+		e.logger.Warn("pairing relay frame chunks count mismatch", zap.Uint32("chunksCount", frame.ChunksCount))
+		return
+	}
+	if in.chunks[frame.ChunkIndex] == nil {
+		// keep empty chunks distinguishable from missing ones
+		in.chunks[frame.ChunkIndex] = append([]byte{}, frame.Data...)
+		in.received++
+	}
+	if in.received < len(in.chunks) {
+		e.mu.Unlock()
+		return
+	}
+
+	delete(e.incoming, id)
+	e.delivered[id] = time.Now()
+	e.mu.Unlock()
+
+	go e.onMessage(frame.MessageId, bytes.Join(in.chunks, nil))
+}
+
+func toRelayHeaders(header http.Header) []*protobuf.PairingRelayHeader {
+	headers := make([]*protobuf.PairingRelayHeader, 0, len(header))
+	for name, values := range header {
+		headers = append(headers, &protobuf.PairingRelayHeader{Name: name, Values: values})
+	}
+	return headers
+}
+
+func fromRelayHeaders(headers []*protobuf.PairingRelayHeader) http.Header {
+	header := make(http.Header, len(headers))
+	for _, h := range headers {
+		header[h.Name] = h.Values
+	}
+	return header
+}
+
+/*
+|--------------------------------------------------------------------------
+| relayServer
+|--------------------------------------------------------------------------
+|
+| Serves the requests received over the relay with the handlers of a Server
+|
+*/
+
+type relayServer struct {
+	*relayEndpoint
+	handler http.Handler
+}
+
+func newRelayServer(transport RelayTransport, aesKey []byte, handler http.Handler, logger *zap.Logger) *relayServer {
+	s := &relayServer{handler: handler}
+	s.relayEndpoint = newRelayEndpoint(transport, relayResponseTopic(aesKey), relayRequestTopic(aesKey), s.serve, logger)
+	s.idleTimeout = relayIdleTimeout
+	return s
+}
+
+// relayResponseWriter records the response of a handler
+type relayResponseWriter struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (w *relayResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *relayResponseWriter) Write(b []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+	return w.body.Write(b)
+}
+
+func (w *relayResponseWriter) WriteHeader(statusCode int) {
+	if w.statusCode == 0 {
+		w.statusCode = statusCode
+	}
+}
+
+func (s *relayServer) serve(id []byte, payload []byte) {
+	request := &protobuf.PairingRelayRequest{}
+	err := proto.Unmarshal(payload, request)
+	if err != nil {
+		s.logger.Warn("failed to unmarshal pairing relay request", zap.Error(err))
+		return
+	}
+
+	r, err := http.NewRequest(request.Method, "https://"+relayHost+request.Path, bytes.NewReader(request.Body))
+	if err != nil {
+		s.logger.Warn("invalid pairing relay request", zap.Error(err))
+		return
+	}
+	r.Header = fromRelayHeaders(request.Headers)
+	r.RemoteAddr = relayRemoteAddr
+
+	w := &relayResponseWriter{header: make(http.Header)}
+	s.handler.ServeHTTP(w, r)
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+
+	response, err := proto.Marshal(&protobuf.PairingRelayResponse{
+		StatusCode: uint32(w.statusCode),
+		Headers:    toRelayHeaders(w.header),
+		Body:       w.body.Bytes(),
+	})
+	if err != nil {
+		s.logger.Error("failed to marshal pairing relay response", zap.Error(err))
+		return
+	}
+
+	err = s.send(id, response)
+	if err != nil {
+		s.logger.Error("failed to send pairing relay response", zap.Error(err))
+	}
+}
+
+/*
+|--------------------------------------------------------------------------
+| relayRoundTripper
+|--------------------------------------------------------------------------
+|
+| http.RoundTripper sending the requests of a client over the relay
+|
+*/
+
+type relayRoundTripper struct {
+	*relayEndpoint
+
+	mu      sync.Mutex
+	pending map[string]chan []byte
+}
+
+func newRelayRoundTripper(transport RelayTransport, aesKey []byte, logger *zap.Logger) *relayRoundTripper {
+	rt := &relayRoundTripper{pending: make(map[string]chan []byte)}
+	rt.relayEndpoint = newRelayEndpoint(transport, relayRequestTopic(aesKey), relayResponseTopic(aesKey), rt.receive, logger)
+	return rt
+}
+
+func (rt *relayRoundTripper) receive(id []byte, payload []byte) {
+	rt.mu.Lock()
+	response, ok := rt.pending[string(id)]
+	delete(rt.pending, string(id))
+	rt.mu.Unlock()
+
+	if ok {
+		response <- payload
+	}
+}
+
+func (rt *relayRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		err = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	payload, err := proto.Marshal(&protobuf.PairingRelayRequest{
+		Method:  req.Method,
+		Path:    req.URL.RequestURI(),
+		Headers: toRelayHeaders(req.Header),
+		Body:    body,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	id := make([]byte, 16)
+	_, err = rand.Read(id)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make(chan []byte, 1)
+	rt.mu.Lock()
+	rt.pending[string(id)] = responses
+	rt.mu.Unlock()
+
+	defer func() {
+		rt.cancel(id)
+		rt.mu.Lock()
+		delete(rt.pending, string(id))
+		rt.mu.Unlock()
+	}()
+
+	err = rt.send(id, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := time.NewTimer(relayRequestTimeout)
+	defer timeout.Stop()
+
+	select {
+	case payload := <-responses:
+		response := &protobuf.PairingRelayResponse{}
+		err = proto.Unmarshal(payload, response)
+		if err != nil {
+			return nil, err
+		}
+
+		statusCode := int(response.StatusCode)
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+			StatusCode:    statusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        fromRelayHeaders(response.Headers),
+			Body:          io.NopCloser(bytes.NewReader(response.Body)),
+			ContentLength: int64(len(response.Body)),
+			Request:       req,
+		}, nil
+	case <-timeout.C:
+		return nil, ErrRelayTimeout
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	case <-rt.quit:
+		return nil, ErrRelayClosed
+	}
+}
+
+// newRelayBaseClient returns a BaseClient sending its requests over the relay
+func newRelayBaseClient(transport RelayTransport, c *ConnectionParams, logger *zap.Logger) (*BaseClient, error) {
+	rt := newRelayRoundTripper(transport, c.aesKey, logger)
+	err := rt.start()
+	if err != nil {
+		return nil, err
+	}
+
+	cj, err := cookiejar.New(nil)
+	if err != nil {
+		if closeErr := rt.close(); closeErr != nil {
+			logger.Error("failed to close the pairing relay", zap.Error(closeErr))
+		}
+		return nil, err
+	}
+
+	return &BaseClient{
+		Client:         &http.Client{Transport: rt, Jar: cj},
+		challengeTaker: NewChallengeTaker(NewPayloadEncryptor(c.aesKey)),
+		baseAddress:    &url.URL{Scheme: "https", Host: relayHost},
+		relay:          rt.relayEndpoint,
+	}, nil
+}
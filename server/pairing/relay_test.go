@@ -0,0 +1,180 @@
+package pairing
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+// memoryRelayBus delivers the frames published on a topic to its subscribers,
+// dropping every dropEvery-th frame
+type memoryRelayBus struct {
+	mu          sync.Mutex
+	subscribers map[types.TopicType][]chan []byte
+	published   int
+	dropEvery   int
+}
+
+func newMemoryRelayBus(dropEvery int) *memoryRelayBus {
+	return &memoryRelayBus{
+		subscribers: make(map[types.TopicType][]chan []byte),
+		dropEvery:   dropEvery,
+	}
+}
+
+type memoryRelayTransport struct {
+	bus *memoryRelayBus
+}
+
+func (t *memoryRelayTransport) Publish(topic types.TopicType, payload []byte) error {
+	t.bus.mu.Lock()
+	defer t.bus.mu.Unlock()
+
+	t.bus.published++
+	if t.bus.dropEvery > 0 && t.bus.published%t.bus.dropEvery == 0 {
+		return nil
+	}
+
+	for _, subscriber := range t.bus.subscribers[topic] {
+		subscriber <- payload
+	}
+	return nil
+}
+
+func (t *memoryRelayTransport) Subscribe(topic types.TopicType) (<-chan []byte, error) {
+	t.bus.mu.Lock()
+	defer t.bus.mu.Unlock()
+
+	payloads := make(chan []byte, 10000)
+	t.bus.subscribers[topic] = append(t.bus.subscribers[topic], payloads)
+	return payloads, nil
+}
+
+func (t *memoryRelayTransport) Close() error {
+	return nil
+}
+
+func TestRelayRoundTrip(t *testing.T) {
+	aesKey := make([]byte, 32)
+	_, err := rand.Read(aesKey)
+	require.NoError(t, err)
+
+	bus := newMemoryRelayBus(3)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, relayRemoteAddr, r.RemoteAddr)
+
+		cookie, err := r.Cookie("session")
+		if err == http.ErrNoCookie {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "1"})
+		} else {
+			w.Header().Set("Session", cookie.Value)
+		}
+
+		w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+		_, err = w.Write(body)
+		require.NoError(t, err)
+	})
+
+	server := newRelayServer(&memoryRelayTransport{bus: bus}, aesKey, mux, zap.NewNop())
+	require.NoError(t, server.start())
+	defer server.close() // nolint: errcheck
+
+	client, err := newRelayBaseClient(&memoryRelayTransport{bus: bus}, &ConnectionParams{aesKey: aesKey}, zap.NewNop())
+	require.NoError(t, err)
+	defer client.closeRelay() // nolint: errcheck
+
+	// spans several chunks
+	payload := make([]byte, 2*relayChunkSize+1)
+	_, err = rand.Read(payload)
+	require.NoError(t, err)
+
+	client.baseAddress.Path = "/echo"
+	resp, err := client.Post(client.baseAddress.String(), "application/octet-stream", bytes.NewReader(payload))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/octet-stream", resp.Header.Get("Content-Type"))
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, payload, body)
+
+	// the cookie set by the server is sent back
+	resp, err = client.Get(client.baseAddress.String())
+	require.NoError(t, err)
+	require.Equal(t, "1", resp.Header.Get("Session"))
+
+	client.baseAddress.Path = "/unknown"
+	resp, err = client.Get(client.baseAddress.String())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestRelayChallenge(t *testing.T) {
+	aesKey := make([]byte, 32)
+	_, err := rand.Read(aesKey)
+	require.NoError(t, err)
+
+	bus := newMemoryRelayBus(0)
+
+	cg, err := NewChallengeGiver(NewPayloadEncryptor(aesKey), zap.NewNop())
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(pairingChallenge, handlePairingChallenge(cg))
+	mux.HandleFunc(pairingSendAccount, middlewareChallenge(cg, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte("account"))
+		require.NoError(t, err)
+	})))
+
+	server := newRelayServer(&memoryRelayTransport{bus: bus}, aesKey, mux, zap.NewNop())
+	require.NoError(t, server.start())
+	defer server.close() // nolint: errcheck
+
+	client, err := newRelayBaseClient(&memoryRelayTransport{bus: bus}, &ConnectionParams{aesKey: aesKey}, zap.NewNop())
+	require.NoError(t, err)
+	defer client.closeRelay() // nolint: errcheck
+
+	// without the challenge the request is forbidden
+	client.baseAddress.Path = pairingSendAccount
+	resp, err := client.Get(client.baseAddress.String())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	require.NoError(t, client.getChallenge())
+
+	client.baseAddress.Path = pairingSendAccount
+	req, err := http.NewRequest(http.MethodGet, client.baseAddress.String(), nil)
+	require.NoError(t, err)
+	require.NoError(t, client.challengeTaker.DoChallenge(req))
+
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, []byte("account"), body)
+}
+
+func TestRelayPruneDelivered(t *testing.T) {
+	endpoint := newRelayEndpoint(&memoryRelayTransport{bus: newMemoryRelayBus(0)}, types.TopicType{}, types.TopicType{}, nil, zap.NewNop())
+
+	now := time.Now()
+	endpoint.delivered["old"] = now.Add(-relayDeliveredTTL - time.Second)
+	endpoint.delivered["recent"] = now
+
+	endpoint.pruneDelivered(now)
+	require.Len(t, endpoint.delivered, 1)
+	require.Contains(t, endpoint.delivered, "recent")
+}
@@ -106,9 +106,11 @@ func MakeServerConfig(config *ServerConfig) error {
 type SenderServer struct {
 	*BaseServer
 	accountMounter      PayloadMounter
-	rawMessageMounter   PayloadMounter
+	rawMessageMounter   PayloadMounterReceiver
 	installationMounter PayloadMounterReceiver
+	historySender       *HistorySender
 	backend             *api.GethStatusBackend
+	relay               *relayServer
 }
 
 // NewSenderServer returns a *SenderServer init from the given *SenderServerConfig
@@ -131,6 +133,7 @@ func NewSenderServer(backend *api.GethStatusBackend, config *SenderServerConfig)
 		accountMounter:      am,
 		rawMessageMounter:   rmm,
 		installationMounter: imr,
+		historySender:       NewHistorySender(backend, e),
 		backend:             backend,
 	}, nil
 }
@@ -149,15 +152,57 @@ func (s *SenderServer) startSendingData() error {
 		pairingChallenge:      handlePairingChallenge(s.challengeGiver),
 		pairingSendAccount:    middlewareChallenge(s.challengeGiver, handleSendAccount(logger, s.accountMounter, beforeSending)),
 		pairingSendSyncDevice: middlewareChallenge(s.challengeGiver, handlePairingSyncDeviceSend(logger, s.rawMessageMounter, beforeSending)),
+		// receive the watermarks of the receiver before sending the sync device data
+		pairingReceiveSyncWatermarks: middlewareChallenge(s.challengeGiver, handleReceiveSyncWatermarks(logger, s.rawMessageMounter)),
 		// TODO implement refactor of installation data exchange to follow the send/receive pattern of
 		//  the other handlers.
 		//  https://github.com/status-im/status-go/issues/3304
 		// receive installation data from receiver
 		pairingReceiveInstallation: middlewareChallenge(s.challengeGiver, handleReceiveInstallation(s.GetLogger(), s.installationMounter)),
+		// send the message history chunk by chunk, if the receiver opted in
+		pairingSendHistory: middlewareChallenge(s.challengeGiver, handleSendHistory(logger, s.historySender)),
 	})
+	s.startRelay()
 	return s.Start()
 }
 
+// startRelay serves the handlers over waku as well, for receivers that can't reach the server on the local network
+func (s *SenderServer) startRelay() {
+	logger := s.GetLogger()
+	if s.backend == nil || s.backend.StatusNode() == nil || s.backend.StatusNode().WakuV2Service() == nil {
+		logger.Info("waku is not available, pairing over the local network only")
+		return
+	}
+
+	transport, err := newBackendRelayTransport(s.backend, nil, s.config.EK, logger)
+	if err != nil {
+		logger.Error("failed to create the pairing relay transport", zap.Error(err))
+		return
+	}
+
+	relay := newRelayServer(transport, s.config.EK, s.Handler(), logger)
+	err = relay.start()
+	if err != nil {
+		logger.Error("failed to start the pairing relay", zap.Error(err))
+		if err := transport.Close(); err != nil {
+			logger.Error("failed to close the pairing relay transport", zap.Error(err))
+		}
+		return
+	}
+	s.relay = relay
+}
+
+// Stop stops the server and the relay serving its handlers over waku
+func (s *SenderServer) Stop() error {
+	if s.relay != nil {
+		if err := s.relay.close(); err != nil {
+			s.GetLogger().Error("failed to close the pairing relay", zap.Error(err))
+		}
+		s.relay = nil
+	}
+	return s.BaseServer.Stop()
+}
+
 // MakeFullSenderServer generates a fully configured and randomly seeded SenderServer
 func MakeFullSenderServer(backend *api.GethStatusBackend, config *SenderServerConfig) (*SenderServer, error) {
 	err := MakeServerConfig(config.ServerConfig)
@@ -214,8 +259,9 @@ func StartUpSenderServer(backend *api.GethStatusBackend, configJSON string) (str
 type ReceiverServer struct {
 	*BaseServer
 	accountReceiver      PayloadReceiver
-	rawMessageReceiver   PayloadReceiver
+	rawMessageReceiver   PayloadMounterReceiver
 	installationReceiver PayloadMounterReceiver
+	historyReceiver      *HistoryReceiver
 	backend              *api.GethStatusBackend
 }
 
@@ -239,6 +285,7 @@ func NewReceiverServer(backend *api.GethStatusBackend, config *ReceiverServerCon
 		accountReceiver:      ar,
 		rawMessageReceiver:   rmr,
 		installationReceiver: imr,
+		historyReceiver:      NewHistoryReceiver(backend, e, config.ReceiverConfig.HistoryTransfer),
 		backend:              backend,
 	}, nil
 }
@@ -257,11 +304,16 @@ func (s *ReceiverServer) startReceivingData() error {
 		pairingChallenge:         handlePairingChallenge(s.challengeGiver),
 		pairingReceiveAccount:    handleReceiveAccount(logger, s.accountReceiver),
 		pairingReceiveSyncDevice: handleParingSyncDeviceReceive(logger, s.rawMessageReceiver),
+		// send our watermarks to the sender before receiving the sync device data
+		pairingSendSyncWatermarks: middlewareChallenge(s.challengeGiver, handleSendSyncWatermarks(logger, s.rawMessageReceiver)),
 		// TODO implement refactor of installation data exchange to follow the send/receive pattern of
 		//  the other handlers.
 		//  https://github.com/status-im/status-go/issues/3304
 		// send installation data back to sender
 		pairingSendInstallation: middlewareChallenge(s.challengeGiver, handleSendInstallation(logger, s.installationReceiver, beforeSending)),
+		// receive the message history chunk by chunk, if we opted in
+		pairingSendHistoryRequest: middlewareChallenge(s.challengeGiver, handleSendHistoryRequest(logger, s.historyReceiver)),
+		pairingReceiveHistory:     middlewareChallenge(s.challengeGiver, handleReceiveHistory(logger, s.historyReceiver)),
 	})
 	return s.Start()
 }
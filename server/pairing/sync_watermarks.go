@@ -0,0 +1,355 @@
+package pairing
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/status-im/status-go/api"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+/*
+|--------------------------------------------------------------------------
+| Sync watermarks
+|--------------------------------------------------------------------------
+|
+| Devices that were already paired only transfer the sync messages that changed.
+| The receiver keeps the digests of the sync messages it got from every sender,
+| grouped by entity, and sends them to the sender before the sync device data.
+| The sender then skips the sync messages the receiver already has.
+|
+*/
+
+// syncWatermarkBatchSize is the number of sync messages processed between two saves of the watermarks,
+// an interrupted transfer resumes from the last save
+const syncWatermarkBatchSize = 100
+
+var syncEntities = map[protobuf.ApplicationMetadataMessage_Type]protobuf.PairingSyncWatermark_Entity{
+	protobuf.ApplicationMetadataMessage_SYNC_CHAT:               protobuf.PairingSyncWatermark_CHATS,
+	protobuf.ApplicationMetadataMessage_SYNC_CHAT_REMOVED:       protobuf.PairingSyncWatermark_CHATS,
+	protobuf.ApplicationMetadataMessage_SYNC_CHAT_MESSAGES_READ: protobuf.PairingSyncWatermark_CHATS,
+	protobuf.ApplicationMetadataMessage_SYNC_CLEAR_HISTORY:      protobuf.PairingSyncWatermark_CHATS,
+
+	protobuf.ApplicationMetadataMessage_CONTACT_UPDATE:                protobuf.PairingSyncWatermark_CONTACTS,
+	protobuf.ApplicationMetadataMessage_SYNC_INSTALLATION_CONTACT_V2:  protobuf.PairingSyncWatermark_CONTACTS,
+	protobuf.ApplicationMetadataMessage_SYNC_CONTACT_REQUEST_DECISION: protobuf.PairingSyncWatermark_CONTACTS,
+	protobuf.ApplicationMetadataMessage_SYNC_TRUSTED_USER:             protobuf.PairingSyncWatermark_CONTACTS,
+	protobuf.ApplicationMetadataMessage_SYNC_VERIFICATION_REQUEST:     protobuf.PairingSyncWatermark_CONTACTS,
+
+	protobuf.ApplicationMetadataMessage_SYNC_INSTALLATION_COMMUNITY: protobuf.PairingSyncWatermark_COMMUNITIES,
+	protobuf.ApplicationMetadataMessage_SYNC_COMMUNITY_SETTINGS:     protobuf.PairingSyncWatermark_COMMUNITIES,
+
+	protobuf.ApplicationMetadataMessage_SYNC_SETTING:                      protobuf.PairingSyncWatermark_SETTINGS,
+	protobuf.ApplicationMetadataMessage_SYNC_PROFILE_PICTURES:             protobuf.PairingSyncWatermark_SETTINGS,
+	protobuf.ApplicationMetadataMessage_SYNC_SOCIAL_LINKS:                 protobuf.PairingSyncWatermark_SETTINGS,
+	protobuf.ApplicationMetadataMessage_SYNC_PROFILE_SHOWCASE_PREFERENCES: protobuf.PairingSyncWatermark_SETTINGS,
+	protobuf.ApplicationMetadataMessage_SYNC_ENS_USERNAME_DETAIL:          protobuf.PairingSyncWatermark_SETTINGS,
+
+	protobuf.ApplicationMetadataMessage_SYNC_KEYPAIR:                     protobuf.PairingSyncWatermark_WALLET_ACCOUNTS,
+	protobuf.ApplicationMetadataMessage_SYNC_ACCOUNT:                     protobuf.PairingSyncWatermark_WALLET_ACCOUNTS,
+	protobuf.ApplicationMetadataMessage_SYNC_ACCOUNTS_POSITIONS:          protobuf.PairingSyncWatermark_WALLET_ACCOUNTS,
+	protobuf.ApplicationMetadataMessage_SYNC_ACCOUNT_CUSTOMIZATION_COLOR: protobuf.PairingSyncWatermark_WALLET_ACCOUNTS,
+	protobuf.ApplicationMetadataMessage_SYNC_SAVED_ADDRESS:               protobuf.PairingSyncWatermark_WALLET_ACCOUNTS,
+	protobuf.ApplicationMetadataMessage_SYNC_TOKEN_PREFERENCES:           protobuf.PairingSyncWatermark_WALLET_ACCOUNTS,
+	protobuf.ApplicationMetadataMessage_SYNC_COLLECTIBLE_PREFERENCES:     protobuf.PairingSyncWatermark_WALLET_ACCOUNTS,
+
+	protobuf.ApplicationMetadataMessage_SYNC_DELETE_FOR_ME_MESSAGE: protobuf.PairingSyncWatermark_MESSAGES,
+}
+
+func syncEntity(messageType protobuf.ApplicationMetadataMessage_Type) protobuf.PairingSyncWatermark_Entity {
+	if entity, ok := syncEntities[messageType]; ok {
+		return entity
+	}
+	return protobuf.PairingSyncWatermark_OTHER
+}
+
+// syncMessageName returns the name of the protobuf message of the type,
+// following the naming convention of the generated messenger handlers
+func syncMessageName(messageType protobuf.ApplicationMetadataMessage_Type) protoreflect.FullName {
+	words := strings.Split(strings.ToLower(messageType.String()), "_")
+	for i, word := range words {
+		if len(word) > 0 {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return protoreflect.FullName("protobuf." + strings.Join(words, ""))
+}
+
+// syncDigest identifies the content of a sync message. The clock of the message is ignored,
+// as the sync messages are generated with a new clock every time.
+func syncDigest(rawMessage *protobuf.RawMessage) []byte {
+	payload := rawMessage.Payload
+
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(syncMessageName(rawMessage.MessageType))
+	if err == nil {
+		message := messageType.New()
+		if err := proto.Unmarshal(payload, message.Interface()); err == nil {
+			if clock := message.Descriptor().Fields().ByName("clock"); clock != nil {
+				message.Clear(clock)
+			}
+			if withoutClock, err := (proto.MarshalOptions{Deterministic: true}).Marshal(message.Interface()); err == nil {
+				payload = withoutClock
+			}
+		}
+	}
+
+	hash := sha256.New()
+	_ = binary.Write(hash, binary.BigEndian, int32(rawMessage.MessageType))
+	hash.Write(payload)
+	return hash.Sum(nil)
+}
+
+// filterSyncedRawMessages drops the sync messages the receiver already has according to its watermarks.
+// It returns the remaining sync messages and the watermarks of all the sync messages.
+func filterSyncedRawMessages(rawMessages []*protobuf.RawMessage, installationID string, clock uint64, known []*protobuf.PairingSyncWatermark) ([]*protobuf.RawMessage, []*protobuf.PairingSyncWatermark) {
+	knownDigests := make(map[string]struct{})
+	for _, watermark := range known {
+		if watermark.InstallationId != installationID {
+			continue
+		}
+		for _, digest := range watermark.Digests {
+			knownDigests[string(digest)] = struct{}{}
+		}
+	}
+
+	var filtered []*protobuf.RawMessage
+	watermarks := make(map[protobuf.PairingSyncWatermark_Entity]*protobuf.PairingSyncWatermark)
+	digests := make(map[string]struct{})
+	for _, rawMessage := range rawMessages {
+		// the installation of the sender is always needed to pair the devices
+		if rawMessage.MessageType == protobuf.ApplicationMetadataMessage_SYNC_PAIR_INSTALLATION {
+			filtered = append(filtered, rawMessage)
+			continue
+		}
+
+		digest := syncDigest(rawMessage)
+		if _, ok := digests[string(digest)]; !ok {
+			digests[string(digest)] = struct{}{}
+
+			entity := syncEntity(rawMessage.MessageType)
+			watermark, ok := watermarks[entity]
+			if !ok {
+				watermark = &protobuf.PairingSyncWatermark{
+					InstallationId: installationID,
+					Entity:         entity,
+					Clock:          clock,
+				}
+				watermarks[entity] = watermark
+			}
+			watermark.Digests = append(watermark.Digests, digest)
+		}
+
+		if _, ok := knownDigests[string(digest)]; !ok {
+			filtered = append(filtered, rawMessage)
+		}
+	}
+
+	result := make([]*protobuf.PairingSyncWatermark, 0, len(watermarks))
+	for _, watermark := range watermarks {
+		result = append(result, watermark)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Entity < result[j].Entity
+	})
+
+	return filtered, result
+}
+
+// syncWatermarkTracker keeps the watermarks of the receiver up to date while the sync messages are processed,
+// so that a transfer interrupted midway doesn't send again the sync messages already processed
+type syncWatermarkTracker struct {
+	installationID string
+	// watermarks of the sender, with all its sync messages
+	watermarks []*protobuf.PairingSyncWatermark
+	// processed digests among the ones of the sender
+	processed map[string]struct{}
+	// unchanged is the number of sync messages the sender skipped
+	unchanged int
+}
+
+func newSyncWatermarkTracker(senderWatermarks []*protobuf.PairingSyncWatermark, known []*protobuf.PairingSyncWatermark) *syncWatermarkTracker {
+	if len(senderWatermarks) == 0 {
+		return nil
+	}
+
+	t := &syncWatermarkTracker{
+		installationID: senderWatermarks[0].InstallationId,
+		watermarks:     senderWatermarks,
+		processed:      make(map[string]struct{}),
+	}
+
+	senderDigests := make(map[string]struct{})
+	for _, watermark := range senderWatermarks {
+		for _, digest := range watermark.Digests {
+			senderDigests[string(digest)] = struct{}{}
+		}
+	}
+
+	// the digests we had that are no longer among the ones of the sender are dropped
+	for _, watermark := range known {
+		if watermark.InstallationId != t.installationID {
+			continue
+		}
+		for _, digest := range watermark.Digests {
+			if _, ok := senderDigests[string(digest)]; ok {
+				t.processed[string(digest)] = struct{}{}
+			}
+		}
+	}
+	t.unchanged = len(t.processed)
+
+	return t
+}
+
+func (t *syncWatermarkTracker) markProcessed(rawMessages []*protobuf.RawMessage) {
+	for _, rawMessage := range rawMessages {
+		t.processed[string(syncDigest(rawMessage))] = struct{}{}
+	}
+}
+
+// current returns the watermarks of the sync messages processed so far
+func (t *syncWatermarkTracker) current() []*protobuf.PairingSyncWatermark {
+	var current []*protobuf.PairingSyncWatermark
+	for _, watermark := range t.watermarks {
+		w := &protobuf.PairingSyncWatermark{
+			InstallationId: t.installationID,
+			Entity:         watermark.Entity,
+			Clock:          watermark.Clock,
+		}
+		for _, digest := range watermark.Digests {
+			if _, ok := t.processed[string(digest)]; ok {
+				w.Digests = append(w.Digests, digest)
+			}
+		}
+		if len(w.Digests) > 0 {
+			current = append(current, w)
+		}
+	}
+	return current
+}
+
+/*
+|--------------------------------------------------------------------------
+| SyncWatermarksPayload
+|--------------------------------------------------------------------------
+|
+| SyncWatermarksPayloadMounter, SyncWatermarksPayloadReceiver and SyncWatermarksPayloadMarshaller
+|
+*/
+
+type SyncWatermarksPayload struct {
+	watermarks []*protobuf.PairingSyncWatermark
+}
+
+// SyncWatermarksPayloadMarshaller is responsible for marshalling and unmarshalling sync watermarks
+type SyncWatermarksPayloadMarshaller struct {
+	payload *SyncWatermarksPayload
+}
+
+func NewSyncWatermarksPayloadMarshaller(payload *SyncWatermarksPayload) *SyncWatermarksPayloadMarshaller {
+	return &SyncWatermarksPayloadMarshaller{payload: payload}
+}
+
+func (swm *SyncWatermarksPayloadMarshaller) MarshalProtobuf() ([]byte, error) {
+	return proto.Marshal(&protobuf.PairingSyncWatermarks{Watermarks: swm.payload.watermarks})
+}
+
+func (swm *SyncWatermarksPayloadMarshaller) UnmarshalProtobuf(data []byte) error {
+	watermarks := new(protobuf.PairingSyncWatermarks)
+	err := proto.Unmarshal(data, watermarks)
+	if err != nil {
+		return err
+	}
+	swm.payload.watermarks = watermarks.Watermarks
+	return nil
+}
+
+// NewSyncWatermarksPayloadMounter generates a new and initialised SyncWatermarksPayload flavoured BasePayloadMounter
+func NewSyncWatermarksPayloadMounter(pe *PayloadEncryptor, backend *api.GethStatusBackend, payload *SyncWatermarksPayload) *BasePayloadMounter {
+	return NewBasePayloadMounter(
+		NewSyncWatermarksLoader(backend, payload),
+		NewSyncWatermarksPayloadMarshaller(payload),
+		pe.Renew(),
+	)
+}
+
+// SyncWatermarksLoader loads the watermarks of the receiver, there are none if it isn't logged in yet
+type SyncWatermarksLoader struct {
+	backend *api.GethStatusBackend
+	payload *SyncWatermarksPayload
+}
+
+func NewSyncWatermarksLoader(backend *api.GethStatusBackend, payload *SyncWatermarksPayload) *SyncWatermarksLoader {
+	return &SyncWatermarksLoader{backend: backend, payload: payload}
+}
+
+func (swl *SyncWatermarksLoader) Load() (err error) {
+	if swl.backend == nil || swl.backend.Messenger() == nil {
+		swl.payload.watermarks = nil
+		return nil
+	}
+	swl.payload.watermarks, err = swl.backend.Messenger().PairingSyncWatermarks()
+	return err
+}
+
+// NewSyncWatermarksPayloadReceiver generates a new and initialised SyncWatermarksPayload flavoured BasePayloadReceiver,
+// the received watermarks are used by the RawMessageLoader sharing the payload
+func NewSyncWatermarksPayloadReceiver(pe *PayloadEncryptor, payload *SyncWatermarksPayload) *BasePayloadReceiver {
+	return NewBasePayloadReceiver(
+		pe.Renew(),
+		NewSyncWatermarksPayloadMarshaller(payload),
+		&SyncWatermarksStorer{},
+		nil,
+	)
+}
+
+// SyncWatermarksStorer keeps the received watermarks in memory only
+type SyncWatermarksStorer struct{}
+
+func (sws *SyncWatermarksStorer) Store() error {
+	return nil
+}
+
+/*
+|--------------------------------------------------------------------------
+| SyncDevicePayloadMounterReceiver
+|--------------------------------------------------------------------------
+|
+| The sender mounts the sync device data and receives the watermarks,
+| the receiver mounts the watermarks and receives the sync device data
+|
+*/
+
+type SyncDevicePayloadMounterReceiver struct {
+	PayloadMounter
+	PayloadReceiver
+}
+
+// NewSenderSyncDevicePayloadMounterReceiver mounts the RawMessagesPayload and receives the SyncWatermarksPayload
+func NewSenderSyncDevicePayloadMounterReceiver(logger *zap.Logger, pe *PayloadEncryptor, backend *api.GethStatusBackend, config *SenderConfig) *SyncDevicePayloadMounterReceiver {
+	watermarks := new(SyncWatermarksPayload)
+	return &SyncDevicePayloadMounterReceiver{
+		NewRawMessagePayloadMounter(logger, pe, backend, config, watermarks),
+		NewSyncWatermarksPayloadReceiver(pe, watermarks),
+	}
+}
+
+// NewReceiverSyncDevicePayloadMounterReceiver mounts the SyncWatermarksPayload and receives the RawMessagesPayload
+func NewReceiverSyncDevicePayloadMounterReceiver(accountPayload *AccountPayload, pe *PayloadEncryptor, backend *api.GethStatusBackend, config *ReceiverConfig) *SyncDevicePayloadMounterReceiver {
+	return &SyncDevicePayloadMounterReceiver{
+		NewSyncWatermarksPayloadMounter(pe, backend, new(SyncWatermarksPayload)),
+		NewRawMessagePayloadReceiver(accountPayload, pe, backend, config),
+	}
+}
+
+func (s *SyncDevicePayloadMounterReceiver) LockPayload() {
+	s.PayloadMounter.LockPayload()
+	s.PayloadReceiver.LockPayload()
+}
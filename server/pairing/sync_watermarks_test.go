@@ -0,0 +1,65 @@
+package pairing
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func syncChatRawMessage(t *testing.T, id string, clock uint64) *protobuf.RawMessage {
+	payload, err := proto.Marshal(&protobuf.SyncChat{Id: id, Active: true, Clock: clock})
+	require.NoError(t, err)
+	return &protobuf.RawMessage{Payload: payload, MessageType: protobuf.ApplicationMetadataMessage_SYNC_CHAT}
+}
+
+func TestSyncDigestIgnoresClock(t *testing.T) {
+	require.Equal(t, syncDigest(syncChatRawMessage(t, "a", 1)), syncDigest(syncChatRawMessage(t, "a", 2)))
+	require.NotEqual(t, syncDigest(syncChatRawMessage(t, "a", 1)), syncDigest(syncChatRawMessage(t, "b", 1)))
+
+	// same payload, different type
+	rawMessage := syncChatRawMessage(t, "a", 1)
+	rawMessage.MessageType = protobuf.ApplicationMetadataMessage_SYNC_CHAT_REMOVED
+	require.NotEqual(t, syncDigest(syncChatRawMessage(t, "a", 1)), syncDigest(rawMessage))
+}
+
+func TestFilterSyncedRawMessages(t *testing.T) {
+	pairInstallation := &protobuf.RawMessage{MessageType: protobuf.ApplicationMetadataMessage_SYNC_PAIR_INSTALLATION}
+	rawMessages := []*protobuf.RawMessage{syncChatRawMessage(t, "a", 1), syncChatRawMessage(t, "b", 1), pairInstallation}
+
+	// first pairing, everything is sent
+	filtered, watermarks := filterSyncedRawMessages(rawMessages, "installation", 10, nil)
+	require.Equal(t, rawMessages, filtered)
+	require.Len(t, watermarks, 1)
+	require.Equal(t, protobuf.PairingSyncWatermark_CHATS, watermarks[0].Entity)
+	require.Equal(t, "installation", watermarks[0].InstallationId)
+	require.Equal(t, uint64(10), watermarks[0].Clock)
+	require.Len(t, watermarks[0].Digests, 2)
+
+	// the receiver processed everything, then chat b changed and chat c was added
+	tracker := newSyncWatermarkTracker(watermarks, nil)
+	tracker.markProcessed(filtered)
+	known := tracker.current()
+
+	changed := syncChatRawMessage(t, "b", 2)
+	changed.Payload, _ = proto.Marshal(&protobuf.SyncChat{Id: "b", Active: false, Clock: 2})
+	rawMessages = []*protobuf.RawMessage{syncChatRawMessage(t, "a", 2), changed, syncChatRawMessage(t, "c", 2), pairInstallation}
+
+	filtered, watermarks = filterSyncedRawMessages(rawMessages, "installation", 20, known)
+	require.Equal(t, []*protobuf.RawMessage{changed, rawMessages[2], pairInstallation}, filtered)
+	require.Len(t, watermarks[0].Digests, 3)
+
+	// watermarks of another installation are ignored
+	unfiltered, _ := filterSyncedRawMessages(rawMessages, "other", 20, known)
+	require.Equal(t, rawMessages, unfiltered)
+
+	// the digest of the previous version of chat b is dropped
+	tracker = newSyncWatermarkTracker(watermarks, known)
+	require.Equal(t, 1, tracker.unchanged)
+	tracker.markProcessed(filtered[:1])
+	current := tracker.current()
+	require.Len(t, current, 1)
+	require.Len(t, current[0].Digests, 2)
+}
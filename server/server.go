@@ -105,12 +105,18 @@ func (s *Server) applyHandlers() {
 	if s.server == nil {
 		s.server = new(http.Server)
 	}
+	s.server.Handler = s.Handler()
+}
+
+// Handler returns the http.Handler serving the registered handlers,
+// for transports other than the TLS listener
+func (s *Server) Handler() http.Handler {
 	mux := http.NewServeMux()
 
 	for p, h := range s.handlers {
 		mux.HandleFunc(p, h)
 	}
-	s.server.Handler = mux
+	return mux
 }
 
 func (s *Server) Start() error {
@@ -20,6 +20,7 @@ type MediaServer struct {
 	downloader      *ipfs.Downloader
 	multiaccountsDB *multiaccounts.Database
 	walletDB        *sql.DB
+	thumbnails      *thumbnailCache
 }
 
 // NewMediaServer returns a *MediaServer
@@ -40,23 +41,27 @@ func NewMediaServer(db *sql.DB, downloader *ipfs.Downloader, multiaccountsDB *mu
 		downloader:      downloader,
 		multiaccountsDB: multiaccountsDB,
 		walletDB:        walletDB,
+		thumbnails:      newThumbnailCache(0),
 	}
 	s.SetHandlers(HandlerPatternMap{
 		accountImagesPath:              handleAccountImages(s.multiaccountsDB, s.logger),
 		accountInitialsPath:            handleAccountInitials(s.multiaccountsDB, s.logger),
 		audioPath:                      handleAudio(s.db, s.logger),
-		contactImagesPath:              handleContactImages(s.db, s.logger),
+		videoPath:                      handleVideo(s.db, s.logger),
+		videoThumbnailPath:             handleVideoThumbnail(s.db, s.logger),
+		filesPath:                      handleFile(s.db, s.logger),
+		contactImagesPath:              handleContactImages(s.db, s.thumbnails, s.logger),
 		discordAttachmentsPath:         handleDiscordAttachment(s.db, s.logger),
 		discordAuthorsPath:             handleDiscordAuthorAvatar(s.db, s.logger),
 		generateQRCode:                 handleQRCodeGeneration(s.multiaccountsDB, s.logger),
-		imagesPath:                     handleImage(s.db, s.logger),
+		imagesPath:                     handleImage(s.db, s.thumbnails, s.logger),
 		ipfsPath:                       handleIPFS(s.downloader, s.logger),
 		LinkPreviewThumbnailPath:       handleLinkPreviewThumbnail(s.db, s.logger),
 		StatusLinkPreviewThumbnailPath: handleStatusLinkPreviewThumbnail(s.db, s.logger),
-		communityTokenImagesPath:       handleCommunityTokenImages(s.db, s.logger),
+		communityTokenImagesPath:       handleCommunityTokenImages(s.db, s.thumbnails, s.logger),
 		walletCommunityImagesPath:      handleWalletCommunityImages(s.walletDB, s.logger),
 		walletCollectionImagesPath:     handleWalletCollectionImages(s.walletDB, s.logger),
-		walletCollectibleImagesPath:    handleWalletCollectibleImages(s.walletDB, s.logger),
+		walletCollectibleImagesPath:    handleWalletCollectibleImages(s.walletDB, s.thumbnails, s.logger),
 	})
 
 	return s, nil
@@ -114,6 +119,30 @@ func (s *MediaServer) MakeAudioURL(id string) string {
 	return u.String()
 }
 
+func (s *MediaServer) MakeVideoURL(id string) string {
+	u := s.MakeBaseURL()
+	u.Path = videoPath
+	u.RawQuery = url.Values{"messageId": {id}}.Encode()
+
+	return u.String()
+}
+
+func (s *MediaServer) MakeVideoThumbnailURL(id string) string {
+	u := s.MakeBaseURL()
+	u.Path = videoThumbnailPath
+	u.RawQuery = url.Values{"messageId": {id}}.Encode()
+
+	return u.String()
+}
+
+func (s *MediaServer) MakeFileURL(id string) string {
+	u := s.MakeBaseURL()
+	u.Path = filesPath
+	u.RawQuery = url.Values{"messageId": {id}}.Encode()
+
+	return u.String()
+}
+
 func (s *MediaServer) MakeStickerURL(stickerHash string) string {
 	u := s.MakeBaseURL()
 	u.Path = ipfsPath
@@ -0,0 +1,199 @@
+package server
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/images"
+)
+
+const (
+	defaultMaxThumbnailCacheSize = 32 * 1024 * 1024
+
+	thumbnailSizeParam   = "thumbnail"
+	thumbnailFormatParam = "thumbnailFormat"
+)
+
+// thumbnailSizes are the sizes clients can select with the thumbnail query parameter,
+// the shortest side of the thumbnail is resized to the size, images are never enlarged
+var thumbnailSizes = map[string]images.ResizeDimension{
+	"small":  images.SmallDim,
+	"medium": images.LargeDim,
+	"large":  640,
+}
+
+var errUnknownThumbnailSize = errors.New("unknown thumbnail size")
+
+type thumbnailEncoder struct {
+	mime   string
+	encode func(w io.Writer, img image.Image) error
+}
+
+// thumbnailEncoders are the formats clients can select with the thumbnailFormat query parameter.
+// There is no WebP or AVIF encoder yet, requesting them falls back to the default format of the source.
+var thumbnailEncoders = map[string]thumbnailEncoder{
+	"jpeg": {
+		mime: "image/jpeg",
+		encode: func(w io.Writer, img image.Image) error {
+			return jpeg.Encode(w, img, &jpeg.Options{Quality: images.MaxJpegQuality})
+		},
+	},
+	"png": {
+		mime:   "image/png",
+		encode: png.Encode,
+	},
+}
+
+// thumbnailEncoderFor returns the encoder of the requested format, or else PNG for sources
+// that may be transparent and JPEG for the others
+func thumbnailEncoderFor(format string, source images.ImageType) thumbnailEncoder {
+	if encoder, ok := thumbnailEncoders[format]; ok {
+		return encoder
+	}
+	if source == images.PNG || source == images.GIF || source == images.WEBP {
+		return thumbnailEncoders["png"]
+	}
+	return thumbnailEncoders["jpeg"]
+}
+
+type thumbnail struct {
+	key        string
+	sourceHash [sha256.Size]byte
+	mime       string
+	payload    []byte
+}
+
+// thumbnailCache generates thumbnails of the images served by the media server and keeps them in memory,
+// evicting the least recently used ones once it grows over maxSize.
+// The hash of the source is kept along with each thumbnail, so that a thumbnail is generated again
+// as soon as its source changes.
+type thumbnailCache struct {
+	maxSize int
+
+	mu      sync.Mutex
+	size    int
+	lru     *list.List
+	entries map[string]*list.Element
+}
+
+func newThumbnailCache(maxSize int) *thumbnailCache {
+	if maxSize <= 0 {
+		maxSize = defaultMaxThumbnailCacheSize
+	}
+
+	return &thumbnailCache{
+		maxSize: maxSize,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// thumbnail returns the thumbnail of the source, key identifies the source across its versions
+func (c *thumbnailCache) thumbnail(key string, source []byte, size images.ResizeDimension, format string) (*thumbnail, error) {
+	sourceType := images.GetType(source)
+	encoder := thumbnailEncoderFor(format, sourceType)
+	key = fmt.Sprintf("%s/%d/%s", key, size, encoder.mime)
+	sourceHash := sha256.Sum256(source)
+
+	c.mu.Lock()
+	if element, ok := c.entries[key]; ok {
+		cached := element.Value.(*thumbnail)
+		if cached.sourceHash == sourceHash {
+			c.lru.MoveToFront(element)
+			c.mu.Unlock()
+			return cached, nil
+		}
+		// the source changed since the thumbnail was generated
+		c.remove(element)
+	}
+	c.mu.Unlock()
+
+	img, err := images.DecodeImageData(source, bytes.NewReader(source))
+	if err != nil {
+		return nil, err
+	}
+
+	var bb bytes.Buffer
+	err = encoder.encode(&bb, images.ShrinkOnly(size, img))
+	if err != nil {
+		return nil, err
+	}
+
+	generated := &thumbnail{key: key, sourceHash: sourceHash, mime: encoder.mime, payload: bb.Bytes()}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+	c.entries[key] = c.lru.PushFront(generated)
+	c.size += len(generated.payload)
+	c.evict()
+
+	return generated, nil
+}
+
+func (c *thumbnailCache) remove(element *list.Element) {
+	entry := element.Value.(*thumbnail)
+	c.lru.Remove(element)
+	delete(c.entries, entry.key)
+	c.size -= len(entry.payload)
+}
+
+func (c *thumbnailCache) evict() {
+	for c.size > c.maxSize && c.lru.Len() > 0 {
+		c.remove(c.lru.Back())
+	}
+}
+
+// requestedThumbnailSize returns the size selected by the thumbnail query parameter, if any
+func requestedThumbnailSize(r *http.Request) (images.ResizeDimension, bool, error) {
+	name := r.URL.Query().Get(thumbnailSizeParam)
+	if name == "" {
+		return 0, false, nil
+	}
+	size, ok := thumbnailSizes[name]
+	if !ok {
+		return 0, false, errUnknownThumbnailSize
+	}
+	return size, true, nil
+}
+
+// serveThumbnail writes the thumbnail of the payload when one is requested with the thumbnail query parameter.
+// It returns false when the caller should serve the payload as it is.
+func serveThumbnail(w http.ResponseWriter, r *http.Request, thumbnails *thumbnailCache, logger *zap.Logger, key string, payload []byte) bool {
+	size, requested, err := requestedThumbnailSize(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return true
+	}
+	if !requested {
+		return false
+	}
+
+	thumbnail, err := thumbnails.thumbnail(key, payload, size, r.URL.Query().Get(thumbnailFormatParam))
+	if err != nil {
+		logger.Error("failed to generate thumbnail, serving the original image", zap.String("key", key), zap.Error(err))
+		return false
+	}
+
+	w.Header().Set("Content-Type", thumbnail.mime)
+	w.Header().Set("Cache-Control", "no-store")
+
+	_, err = w.Write(thumbnail.payload)
+	if err != nil {
+		logger.Error("failed to write thumbnail", zap.Error(err))
+	}
+	return true
+}
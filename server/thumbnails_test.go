@@ -0,0 +1,110 @@
+package server
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/images"
+)
+
+func encodedImage(t *testing.T, width, height int, c color.Color, encode func(*bytes.Buffer, image.Image) error) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, c)
+		}
+	}
+	var bb bytes.Buffer
+	require.NoError(t, encode(&bb, img))
+	return bb.Bytes()
+}
+
+func pngImage(t *testing.T, width, height int, c color.Color) []byte {
+	return encodedImage(t, width, height, c, func(bb *bytes.Buffer, img image.Image) error { return png.Encode(bb, img) })
+}
+
+func jpegImage(t *testing.T, width, height int, c color.Color) []byte {
+	return encodedImage(t, width, height, c, func(bb *bytes.Buffer, img image.Image) error { return jpeg.Encode(bb, img, nil) })
+}
+
+func TestThumbnailCache(t *testing.T) {
+	cache := newThumbnailCache(0)
+	source := jpegImage(t, 800, 400, color.White)
+
+	thumbnail, err := cache.thumbnail("image/1", source, images.SmallDim, "")
+	require.NoError(t, err)
+	require.Equal(t, "image/jpeg", thumbnail.mime)
+	width, height, err := images.GetImageDimensions(thumbnail.payload)
+	require.NoError(t, err)
+	require.Equal(t, 160, width)
+	require.Equal(t, 80, height)
+
+	// served from the cache
+	cached, err := cache.thumbnail("image/1", source, images.SmallDim, "")
+	require.NoError(t, err)
+	require.Same(t, thumbnail, cached)
+
+	// no webp encoder, falls back to png for png sources
+	thumbnail, err = cache.thumbnail("image/2", pngImage(t, 100, 100, color.Transparent), images.SmallDim, "webp")
+	require.NoError(t, err)
+	require.Equal(t, "image/png", thumbnail.mime)
+
+	// the source changed, the thumbnail is generated again
+	changed := jpegImage(t, 400, 800, color.Black)
+	regenerated, err := cache.thumbnail("image/1", changed, images.SmallDim, "")
+	require.NoError(t, err)
+	require.NotSame(t, cached, regenerated)
+	width, height, err = images.GetImageDimensions(regenerated.payload)
+	require.NoError(t, err)
+	require.Equal(t, 80, width)
+	require.Equal(t, 160, height)
+	require.Len(t, cache.entries, 2)
+
+	// images are never enlarged
+	thumbnail, err = cache.thumbnail("image/3", jpegImage(t, 50, 50, color.White), images.LargeDim, "")
+	require.NoError(t, err)
+	width, _, err = images.GetImageDimensions(thumbnail.payload)
+	require.NoError(t, err)
+	require.Equal(t, 50, width)
+}
+
+func TestThumbnailCacheEviction(t *testing.T) {
+	source := pngImage(t, 200, 200, color.White)
+
+	cache := newThumbnailCache(1)
+	_, err := cache.thumbnail("image/1", source, images.SmallDim, "png")
+	require.NoError(t, err)
+	require.Empty(t, cache.entries)
+	require.Equal(t, 0, cache.size)
+}
+
+func (s *HandlersSuite) TestHandleImageThumbnail() {
+	source := jpegImage(s.T(), 1000, 500, color.White)
+	_, err := s.db.Exec(`INSERT INTO user_messages (id, whisper_timestamp, source, text, content_type, timestamp, chat_id, local_chat_id, response_to, clock_value, image_payload) VALUES ('1', 0, '', '', 7, 0, '1', '1', '', 0, ?)`, source)
+	s.Require().NoError(err)
+
+	handler := handleImage(s.db, newThumbnailCache(0), s.logger)
+
+	rr := s.httpGetReqRecorder(handler, "/dummy?"+url.Values{"messageId": {"1"}}.Encode())
+	s.Require().Equal(http.StatusOK, rr.Code)
+	s.Require().Equal(source, rr.Body.Bytes())
+
+	rr = s.httpGetReqRecorder(handler, "/dummy?"+url.Values{"messageId": {"1"}, "thumbnail": {"medium"}}.Encode())
+	s.Require().Equal(http.StatusOK, rr.Code)
+	s.Require().Equal("image/jpeg", rr.HeaderMap.Get("Content-Type"))
+	width, height, err := images.GetImageDimensions(rr.Body.Bytes())
+	s.Require().NoError(err)
+	s.Require().Equal(480, width)
+	s.Require().Equal(240, height)
+
+	rr = s.httpGetReqRecorder(handler, "/dummy?"+url.Values{"messageId": {"1"}, "thumbnail": {"huge"}}.Encode())
+	s.Require().Equal(http.StatusBadRequest, rr.Code)
+}
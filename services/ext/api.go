@@ -539,6 +539,92 @@ func (api *PublicAPI) BanUserFromCommunity(ctx context.Context, request *request
 	return api.service.messenger.BanUserFromCommunity(ctx, request)
 }
 
+// TimeoutCommunityMember prevents the member from posting for the given duration in seconds,
+// a zero duration lifts the timeout
+func (api *PublicAPI) TimeoutCommunityMember(request *requests.TimeoutCommunityMember) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.TimeoutCommunityMember(request)
+}
+
+// CommunityAuditLog returns the privileged changes recorded for the community
+func (api *PublicAPI) CommunityAuditLog(request *requests.GetCommunityAuditLog) ([]*communities.AuditLogEntry, error) {
+	return api.service.messenger.CommunityAuditLog(request)
+}
+
+// ExportCommunityAuditLog returns the whole audit log of the community and whether its chain is intact
+func (api *PublicAPI) ExportCommunityAuditLog(communityID types.HexBytes) (*communities.AuditLogExport, error) {
+	return api.service.messenger.ExportCommunityAuditLog(communityID)
+}
+
+// CreateCommunityInvite creates a signed invite link to the community
+func (api *PublicAPI) CreateCommunityInvite(request *requests.CreateCommunityInvite) (*protocol.CommunityInviteLink, error) {
+	return api.service.messenger.CreateCommunityInvite(request)
+}
+
+// RevokeCommunityInvite prevents the invite from being redeemed
+func (api *PublicAPI) RevokeCommunityInvite(request *requests.RevokeCommunityInvite) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.RevokeCommunityInvite(request)
+}
+
+// CommunityInvites returns the invites created for the community and how many times they were used
+func (api *PublicAPI) CommunityInvites(communityID types.HexBytes) ([]*protocol.CommunityInviteLink, error) {
+	return api.service.messenger.CommunityInvites(communityID)
+}
+
+// SetCommunityAutomodRules replaces the automated moderation rules of the community
+func (api *PublicAPI) SetCommunityAutomodRules(request *requests.SetCommunityAutomodRules) (*protobuf.CommunityAutomodRules, error) {
+	return api.service.messenger.SetCommunityAutomodRules(request)
+}
+
+// CommunityAutomodRules returns the automated moderation rules of the community
+func (api *PublicAPI) CommunityAutomodRules(communityID types.HexBytes) (*protobuf.CommunityAutomodRules, error) {
+	return api.service.messenger.CommunityAutomodRules(communityID)
+}
+
+// CreateCommunityCalendarEvent schedules an event in the community calendar
+func (api *PublicAPI) CreateCommunityCalendarEvent(request *requests.CreateCommunityCalendarEvent) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.CreateCommunityCalendarEvent(request)
+}
+
+// EditCommunityCalendarEvent updates an event of the community calendar, RSVPs are kept
+func (api *PublicAPI) EditCommunityCalendarEvent(request *requests.EditCommunityCalendarEvent) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.EditCommunityCalendarEvent(request)
+}
+
+// DeleteCommunityCalendarEvent removes an event from the community calendar
+func (api *PublicAPI) DeleteCommunityCalendarEvent(request *requests.DeleteCommunityCalendarEvent) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.DeleteCommunityCalendarEvent(request)
+}
+
+// CommunityCalendarEvents returns the events of the community calendar along with the user's RSVPs
+func (api *PublicAPI) CommunityCalendarEvents(communityID types.HexBytes) ([]*protocol.CommunityCalendarEvent, error) {
+	return api.service.messenger.CommunityCalendarEvents(communityID)
+}
+
+// RsvpCommunityCalendarEvent sends the user's answer to an event to the community control node
+func (api *PublicAPI) RsvpCommunityCalendarEvent(request *requests.RsvpCommunityCalendarEvent) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.RsvpCommunityCalendarEvent(request)
+}
+
+// SetCommunityDirectoryListing opts a community the user controls in or out of the community directory
+func (api *PublicAPI) SetCommunityDirectoryListing(request *requests.SetCommunityDirectoryListing) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.SetCommunityDirectoryListing(request)
+}
+
+// IsCommunityDirectoryListed returns whether the community is announced in the community directory
+func (api *PublicAPI) IsCommunityDirectoryListed(communityID types.HexBytes) (bool, error) {
+	return api.service.messenger.IsCommunityDirectoryListed(communityID)
+}
+
+// SetCommunityDirectoryEnabled opts in or out of browsing the community directory
+func (api *PublicAPI) SetCommunityDirectoryEnabled(enabled bool) error {
+	return api.service.messenger.SetCommunityDirectoryEnabled(enabled)
+}
+
+// SearchCommunityDirectory searches the communities announced in the community directory
+func (api *PublicAPI) SearchCommunityDirectory(request *requests.SearchCommunityDirectory) ([]*communities.DirectoryEntry, error) {
+	return api.service.messenger.SearchCommunityDirectory(request)
+}
+
 // UnbanUserFromCommunity removes the user's pk from the community ban list
 func (api *PublicAPI) UnbanUserFromCommunity(request *requests.UnbanUserFromCommunity) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.UnbanUserFromCommunity(request)
@@ -809,6 +895,11 @@ func (api *PublicAPI) DeleteMessage(id string) error {
 	return api.service.messenger.DeleteMessage(id)
 }
 
+// MediaStorageUsage returns the space taken by the media of the stored messages, in bytes
+func (api *PublicAPI) MediaStorageUsage() (*protocol.MediaStorageUsage, error) {
+	return api.service.messenger.MediaStorageUsage()
+}
+
 func (api *PublicAPI) DeleteMessagesByChatID(id string) error {
 	return api.service.messenger.DeleteMessagesByChatID(id)
 }
@@ -1094,6 +1185,18 @@ func (api *PublicAPI) GetSavedAddresses(ctx context.Context) ([]*wallet.SavedAdd
 	return api.service.messenger.GetSavedAddresses(ctx)
 }
 
+func (api *PublicAPI) LinkSavedAddressToContact(ctx context.Context, address ethcommon.Address, isTest bool, contactID string) error {
+	return api.service.messenger.LinkSavedAddressToContact(ctx, address, isTest, contactID)
+}
+
+func (api *PublicAPI) ExportSavedAddresses(ctx context.Context, format wallet.SavedAddressesFormat) (string, error) {
+	return api.service.messenger.ExportSavedAddresses(format)
+}
+
+func (api *PublicAPI) ImportSavedAddresses(ctx context.Context, format wallet.SavedAddressesFormat, data string, resolution wallet.SavedAddressConflictResolution) (*wallet.SavedAddressesImportResult, error) {
+	return api.service.messenger.ImportSavedAddresses(ctx, format, data, resolution)
+}
+
 // PushNotifications server endpoints
 func (api *PublicAPI) StartPushNotificationsServer() error {
 	err := api.service.accountsDB.SaveSettingField(settings.PushNotificationsServerEnabled, true)
@@ -1502,6 +1605,16 @@ func (api *PublicAPI) ExtractDiscordChannelsAndCategories(filesToImport []string
 	return api.service.messenger.ExtractDiscordChannelsAndCategories(filesToImport)
 }
 
+// RequestExtractImportChannelsAndCategories is the source agnostic version of
+// RequestExtractDiscordChannelsAndCategories, source is one of "discord", "slack" or "telegram"
+func (api *PublicAPI) RequestExtractImportChannelsAndCategories(source discord.ImportSource, filesToImport []string) {
+	api.service.messenger.RequestExtractImportChannelsAndCategories(source, filesToImport)
+}
+
+func (api *PublicAPI) ExtractImportChannelsAndCategories(source discord.ImportSource, filesToImport []string) (*protocol.MessengerResponse, map[string]*discord.ImportError) {
+	return api.service.messenger.ExtractImportChannelsAndCategories(source, filesToImport)
+}
+
 func (api *PublicAPI) RequestImportDiscordChannel(request *requests.ImportDiscordChannel) {
 	api.service.messenger.RequestImportDiscordChannel(request)
 }
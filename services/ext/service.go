@@ -702,8 +702,8 @@ func (s *Service) fetchCommunityCollectibleMetadata(community *communities.Commu
 }
 
 func tokenCriterionContainsCollectible(tokenCriterion *protobuf.TokenCriteria, id thirdparty.CollectibleUniqueID) bool {
-	// Check if token type matches
-	if tokenCriterion.Type != protobuf.CommunityTokenType_ERC721 {
+	// Check if token type matches, ERC1155 criteria list their token IDs as ERC721 ones do
+	if tokenCriterion.Type != protobuf.CommunityTokenType_ERC721 && tokenCriterion.Type != protobuf.CommunityTokenType_ERC1155 {
 		return false
 	}
 
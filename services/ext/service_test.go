@@ -0,0 +1,48 @@
+package ext
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/services/wallet/bigint"
+	w_common "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/thirdparty"
+)
+
+func TestTokenCriterionContainsCollectible(t *testing.T) {
+	contractAddress := common.HexToAddress("0x10")
+	id := thirdparty.CollectibleUniqueID{
+		ContractID: thirdparty.ContractID{
+			ChainID: w_common.ChainID(w_common.EthereumMainnet),
+			Address: contractAddress,
+		},
+		TokenID: &bigint.BigInt{Int: big.NewInt(7)},
+	}
+
+	criterion := func(tokenType protobuf.CommunityTokenType, tokenIDs ...uint64) *protobuf.TokenCriteria {
+		return &protobuf.TokenCriteria{
+			Type:              tokenType,
+			ContractAddresses: map[uint64]string{w_common.EthereumMainnet: contractAddress.Hex()},
+			TokenIds:          tokenIDs,
+		}
+	}
+
+	require.True(t, tokenCriterionContainsCollectible(criterion(protobuf.CommunityTokenType_ERC721), id))
+	require.True(t, tokenCriterionContainsCollectible(criterion(protobuf.CommunityTokenType_ERC721, 3, 7), id))
+	require.False(t, tokenCriterionContainsCollectible(criterion(protobuf.CommunityTokenType_ERC721, 3), id))
+
+	require.True(t, tokenCriterionContainsCollectible(criterion(protobuf.CommunityTokenType_ERC1155), id))
+	require.True(t, tokenCriterionContainsCollectible(criterion(protobuf.CommunityTokenType_ERC1155, 7), id))
+	require.False(t, tokenCriterionContainsCollectible(criterion(protobuf.CommunityTokenType_ERC1155, 3), id))
+
+	require.False(t, tokenCriterionContainsCollectible(criterion(protobuf.CommunityTokenType_ERC20), id))
+
+	otherChain := criterion(protobuf.CommunityTokenType_ERC1155, 7)
+	otherChain.ContractAddresses = map[uint64]string{w_common.OptimismMainnet: contractAddress.Hex()}
+	require.False(t, tokenCriterionContainsCollectible(otherChain, id))
+}
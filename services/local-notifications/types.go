@@ -5,6 +5,7 @@ const (
 	CategoryMessage                PushCategory = "newMessage"
 	CategoryGroupInvite            PushCategory = "groupInvite"
 	CategoryCommunityRequestToJoin              = "communityRequestToJoin"
+	CategoryCommunityEventReminder PushCategory = "communityEventReminder"
 
 	TypeTransaction NotificationType = "transaction"
 	TypeMessage     NotificationType = "message"
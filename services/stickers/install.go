@@ -37,6 +37,36 @@ func (api *API) Install(chainID uint64, packID *bigint.BigInt) error {
 		return err
 	}
 
+	return api.pinStickerPacks(*stickerPack)
+}
+
+// stickerPackHashes returns the hashes of the images of the sticker pack
+func stickerPackHashes(stickerPack StickerPack) []string {
+	var hashes []string
+	if stickerPack.Preview != "" {
+		hashes = append(hashes, stickerPack.Preview)
+	}
+	if stickerPack.Thumbnail != "" {
+		hashes = append(hashes, stickerPack.Thumbnail)
+	}
+	for _, sticker := range stickerPack.Stickers {
+		hashes = append(hashes, sticker.Hash)
+	}
+	return hashes
+}
+
+// pinStickerPacks keeps the images of installed sticker packs in the IPFS cache
+func (api *API) pinStickerPacks(stickerPacks ...StickerPack) error {
+	if api.downloader == nil {
+		return nil
+	}
+
+	for _, stickerPack := range stickerPacks {
+		err := api.downloader.Pin(stickerPackHashes(stickerPack)...)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -89,7 +119,8 @@ func (api *API) Uninstall(packID *bigint.BigInt) error {
 		return err
 	}
 
-	if _, exists := installedPacks[uint(packID.Uint64())]; !exists {
+	stickerPack, exists := installedPacks[uint(packID.Uint64())]
+	if !exists {
 		return errors.New("sticker pack is not installed")
 	}
 
@@ -100,6 +131,13 @@ func (api *API) Uninstall(packID *bigint.BigInt) error {
 		return err
 	}
 
+	if api.downloader != nil {
+		err = api.downloader.Unpin(stickerPackHashes(stickerPack)...)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Removing uninstalled pack from recent stickers
 
 	recentStickers, err := api.recentStickers()
@@ -3,6 +3,7 @@ package stickers
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p"
 	ethRpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/status-im/status-go/account"
@@ -46,6 +47,20 @@ type Service struct {
 
 // Start a service.
 func (s *Service) Start() error {
+	installedPacks, err := s.api.installedStickerPacks()
+	if err != nil {
+		log.Warn("could not pin installed sticker packs", "error", err)
+		return nil
+	}
+
+	packs := make([]StickerPack, 0, len(installedPacks))
+	for _, stickerPack := range installedPacks {
+		packs = append(packs, stickerPack)
+	}
+
+	if err := s.api.pinStickerPacks(packs...); err != nil {
+		log.Warn("could not pin installed sticker packs", "error", err)
+	}
 	return nil
 }
 
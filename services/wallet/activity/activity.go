@@ -256,6 +256,8 @@ func multiTransactionTypeToActivityType(mtType transfer.MultiTransactionType) Ty
 		return SwapAT
 	} else if mtType == transfer.MultiTransactionBridge {
 		return BridgeAT
+	} else if mtType == transfer.MultiTransactionApprove {
+		return ApproveAT
 	}
 	panic("unknown multi transaction type")
 }
@@ -317,6 +319,8 @@ func activityTypesToMultiTransactionTypes(trTypes []Type) []transfer.MultiTransa
 			mtType = transfer.MultiTransactionSwap
 		} else if t == BridgeAT {
 			mtType = transfer.MultiTransactionBridge
+		} else if t == ApproveAT {
+			mtType = transfer.MultiTransactionApprove
 		} else {
 			continue
 		}
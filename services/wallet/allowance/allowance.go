@@ -0,0 +1,56 @@
+package allowance
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	w_common "github.com/status-im/status-go/services/wallet/common"
+)
+
+type Type int
+
+const (
+	// Erc20Allowance is an amount of ERC20 tokens the spender is allowed to transfer on behalf of the owner
+	Erc20Allowance Type = iota + 1
+	// CollectionApproval is an ERC721/ERC1155 operator approval covering the whole collection of the owner
+	CollectionApproval
+)
+
+// ID identifies an allowance granted by a given owner
+type ID struct {
+	ChainID      w_common.ChainID `json:"chainId"`
+	TokenAddress common.Address   `json:"tokenAddress"`
+	Spender      common.Address   `json:"spender"`
+	Type         Type             `json:"type"`
+}
+
+// Allowance is the current state of an approval. BlockNumber and TxHash locate the last Approval/ApprovalForAll
+// event of the allowance, the amount is read from the token contract.
+type Allowance struct {
+	ID
+	Owner common.Address `json:"owner"`
+	// Amount is the remaining approved amount for Erc20Allowance and 1 for CollectionApproval
+	Amount      *hexutil.Big `json:"amount"`
+	BlockNumber uint64       `json:"blockNumber"`
+	TxHash      common.Hash  `json:"txHash"`
+	LogIndex    uint         `json:"-"`
+}
+
+// IsUnlimited returns true for approvals that don't cap the amount the spender can move
+func (a *Allowance) IsUnlimited() bool {
+	if a.Type == CollectionApproval {
+		return true
+	}
+	// Most dapps request MaxUint256, some use half of it to save gas on allowance decrements
+	return a.Amount != nil && a.Amount.ToInt().Cmp(new(big.Int).Rsh(w_common.MaxUint256, 1)) >= 0
+}
+
+// isNewerThan orders allowances by position of their originating log in the chain
+func (a *Allowance) isNewerThan(other *Allowance) bool {
+	if a.BlockNumber != other.BlockNumber {
+		return a.BlockNumber > other.BlockNumber
+	}
+	return a.LogIndex > other.LogIndex
+}
@@ -0,0 +1,193 @@
+package allowance
+
+import (
+	"database/sql"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	w_common "github.com/status-im/status-go/services/wallet/common"
+)
+
+type Persistence struct {
+	db *sql.DB
+}
+
+func NewPersistence(db *sql.DB) *Persistence {
+	return &Persistence{db: db}
+}
+
+const selectAllowanceColumns = "chain_id, owner, token_address, spender, allowance_type, amount, block_number, tx_hash, log_index"
+
+func rowsToAllowances(rows *sql.Rows) ([]*Allowance, error) {
+	var allowances []*Allowance
+	for rows.Next() {
+		a := &Allowance{}
+		var amount string
+		err := rows.Scan(
+			&a.ChainID,
+			&a.Owner,
+			&a.TokenAddress,
+			&a.Spender,
+			&a.Type,
+			&amount,
+			&a.BlockNumber,
+			&a.TxHash,
+			&a.LogIndex,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		value, ok := new(big.Int).SetString(amount, 10)
+		if !ok {
+			return nil, fmt.Errorf("failed to convert allowance amount to big.Int: %s", amount)
+		}
+		a.Amount = (*hexutil.Big)(value)
+
+		allowances = append(allowances, a)
+	}
+
+	return allowances, rows.Err()
+}
+
+// GetAllowances returns stored allowances granted by the given owners on the given chains.
+// Empty filters match everything.
+func (p *Persistence) GetAllowances(chainIDs []w_common.ChainID, owners []common.Address) ([]*Allowance, error) {
+	conditions := []string{}
+	args := []interface{}{}
+
+	if len(chainIDs) > 0 {
+		conditions = append(conditions, "chain_id IN (?"+strings.Repeat(",?", len(chainIDs)-1)+")")
+		for _, chainID := range chainIDs {
+			args = append(args, chainID)
+		}
+	}
+	if len(owners) > 0 {
+		conditions = append(conditions, "owner IN (?"+strings.Repeat(",?", len(owners)-1)+")")
+		for _, owner := range owners {
+			args = append(args, owner)
+		}
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM token_allowances", selectAllowanceColumns)
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY chain_id, block_number DESC, log_index DESC"
+
+	rows, err := p.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return rowsToAllowances(rows)
+}
+
+// GetAllowance returns the stored allowance or nil if there is none
+func (p *Persistence) GetAllowance(owner common.Address, id ID) (*Allowance, error) {
+	rows, err := p.db.Query(fmt.Sprintf(`SELECT %s FROM token_allowances
+		WHERE chain_id = ? AND owner = ? AND token_address = ? AND spender = ? AND allowance_type = ?`, selectAllowanceColumns),
+		id.ChainID, owner, id.TokenAddress, id.Spender, id.Type)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	allowances, err := rowsToAllowances(rows)
+	if err != nil || len(allowances) == 0 {
+		return nil, err
+	}
+	return allowances[0], nil
+}
+
+// ApplyAllowances stores the allowances found in a scanned block range and advances
+// the scan progress of the owner on that chain. Allowances with a zero amount are
+// revocations and remove the stored entry. Entries are only replaced by newer logs.
+func (p *Persistence) ApplyAllowances(chainID w_common.ChainID, owner common.Address, allowances []*Allowance, lastBlock uint64) (err error) {
+	tx, err := p.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	for _, a := range allowances {
+		var storedBlock uint64
+		var storedLogIndex uint
+		err = tx.QueryRow(`SELECT block_number, log_index FROM token_allowances
+			WHERE chain_id = ? AND owner = ? AND token_address = ? AND spender = ? AND allowance_type = ?`,
+			a.ChainID, a.Owner, a.TokenAddress, a.Spender, a.Type).Scan(&storedBlock, &storedLogIndex)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if err == nil && !a.isNewerThan(&Allowance{BlockNumber: storedBlock, LogIndex: storedLogIndex}) {
+			continue
+		}
+
+		if a.Amount == nil || a.Amount.ToInt().Sign() == 0 {
+			_, err = tx.Exec(`DELETE FROM token_allowances
+				WHERE chain_id = ? AND owner = ? AND token_address = ? AND spender = ? AND allowance_type = ?`,
+				a.ChainID, a.Owner, a.TokenAddress, a.Spender, a.Type)
+		} else {
+			_, err = tx.Exec(fmt.Sprintf(`INSERT OR REPLACE INTO token_allowances (%s) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, selectAllowanceColumns),
+				a.ChainID, a.Owner, a.TokenAddress, a.Spender, a.Type, a.Amount.ToInt().String(), a.BlockNumber, a.TxHash, a.LogIndex)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(`INSERT OR REPLACE INTO token_allowances_scan_progress (chain_id, owner, last_block) VALUES (?, ?, ?)`,
+		chainID, owner, lastBlock)
+	return err
+}
+
+// GetLastScannedBlock returns the last block scanned for approvals of the owner, or nil if it was never scanned
+func (p *Persistence) GetLastScannedBlock(chainID w_common.ChainID, owner common.Address) (*big.Int, error) {
+	var lastBlock uint64
+	err := p.db.QueryRow(`SELECT last_block FROM token_allowances_scan_progress WHERE chain_id = ? AND owner = ?`,
+		chainID, owner).Scan(&lastBlock)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetUint64(lastBlock), nil
+}
+
+// UpdateAmount sets the amount of a stored allowance to the value read from the token contract,
+// a zero amount removes the allowance
+func (p *Persistence) UpdateAmount(owner common.Address, id ID, amount *big.Int) error {
+	if amount.Sign() == 0 {
+		_, err := p.db.Exec(`DELETE FROM token_allowances
+			WHERE chain_id = ? AND owner = ? AND token_address = ? AND spender = ? AND allowance_type = ?`,
+			id.ChainID, owner, id.TokenAddress, id.Spender, id.Type)
+		return err
+	}
+
+	_, err := p.db.Exec(`UPDATE token_allowances SET amount = ?
+		WHERE chain_id = ? AND owner = ? AND token_address = ? AND spender = ? AND allowance_type = ?`,
+		amount.String(), id.ChainID, owner, id.TokenAddress, id.Spender, id.Type)
+	return err
+}
+
+// DeleteAllowances removes all stored allowances and scan progress of the owner, e.g. when the account is removed
+func (p *Persistence) DeleteAllowances(owner common.Address) error {
+	_, err := p.db.Exec(`DELETE FROM token_allowances WHERE owner = ?`, owner)
+	if err != nil {
+		return err
+	}
+	_, err = p.db.Exec(`DELETE FROM token_allowances_scan_progress WHERE owner = ?`, owner)
+	return err
+}
@@ -0,0 +1,149 @@
+package allowance
+
+import (
+	"database/sql"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	w_common "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/t/helpers"
+	"github.com/status-im/status-go/walletdatabase"
+
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestDB(t *testing.T) (db *sql.DB, close func()) {
+	db, err := helpers.SetupTestMemorySQLDB(walletdatabase.DbInitializer{})
+	require.NoError(t, err)
+	return db, func() {
+		require.NoError(t, db.Close())
+	}
+}
+
+func generateTestAllowance(owner common.Address, i int64, amount int64) *Allowance {
+	return &Allowance{
+		ID: ID{
+			ChainID:      w_common.ChainID(w_common.EthereumMainnet),
+			TokenAddress: common.BigToAddress(big.NewInt(1000 + i)),
+			Spender:      common.BigToAddress(big.NewInt(2000 + i)),
+			Type:         Erc20Allowance,
+		},
+		Owner:       owner,
+		Amount:      (*hexutil.Big)(big.NewInt(amount)),
+		BlockNumber: uint64(100 + i),
+		TxHash:      common.BigToHash(big.NewInt(3000 + i)),
+		LogIndex:    uint(i),
+	}
+}
+
+func TestApplyAndGetAllowances(t *testing.T) {
+	db, close := setupTestDB(t)
+	defer close()
+
+	p := NewPersistence(db)
+	owner := common.HexToAddress("0x1")
+	chainID := w_common.ChainID(w_common.EthereumMainnet)
+
+	lastBlock, err := p.GetLastScannedBlock(chainID, owner)
+	require.NoError(t, err)
+	require.Nil(t, lastBlock)
+
+	allowances := []*Allowance{
+		generateTestAllowance(owner, 1, 10),
+		generateTestAllowance(owner, 2, 20),
+	}
+	err = p.ApplyAllowances(chainID, owner, allowances, 200)
+	require.NoError(t, err)
+
+	lastBlock, err = p.GetLastScannedBlock(chainID, owner)
+	require.NoError(t, err)
+	require.Equal(t, int64(200), lastBlock.Int64())
+
+	stored, err := p.GetAllowances([]w_common.ChainID{chainID}, []common.Address{owner})
+	require.NoError(t, err)
+	require.Len(t, stored, 2)
+	// Newest first
+	require.Equal(t, *allowances[1], *stored[0])
+	require.Equal(t, *allowances[0], *stored[1])
+
+	other, err := p.GetAllowances(nil, []common.Address{common.HexToAddress("0x2")})
+	require.NoError(t, err)
+	require.Len(t, other, 0)
+}
+
+func TestApplyAllowancesKeepsNewest(t *testing.T) {
+	db, close := setupTestDB(t)
+	defer close()
+
+	p := NewPersistence(db)
+	owner := common.HexToAddress("0x1")
+	chainID := w_common.ChainID(w_common.EthereumMainnet)
+
+	newer := generateTestAllowance(owner, 1, 10)
+	err := p.ApplyAllowances(chainID, owner, []*Allowance{newer}, 200)
+	require.NoError(t, err)
+
+	older := generateTestAllowance(owner, 1, 50)
+	older.BlockNumber = newer.BlockNumber - 1
+	err = p.ApplyAllowances(chainID, owner, []*Allowance{older}, 200)
+	require.NoError(t, err)
+
+	stored, err := p.GetAllowance(owner, newer.ID)
+	require.NoError(t, err)
+	require.Equal(t, *newer, *stored)
+}
+
+func TestApplyAllowancesRevocation(t *testing.T) {
+	db, close := setupTestDB(t)
+	defer close()
+
+	p := NewPersistence(db)
+	owner := common.HexToAddress("0x1")
+	chainID := w_common.ChainID(w_common.EthereumMainnet)
+
+	granted := generateTestAllowance(owner, 1, 10)
+	err := p.ApplyAllowances(chainID, owner, []*Allowance{granted}, 200)
+	require.NoError(t, err)
+
+	revoked := generateTestAllowance(owner, 1, 0)
+	revoked.BlockNumber = 300
+	err = p.ApplyAllowances(chainID, owner, []*Allowance{revoked}, 300)
+	require.NoError(t, err)
+
+	stored, err := p.GetAllowance(owner, granted.ID)
+	require.NoError(t, err)
+	require.Nil(t, stored)
+
+	err = p.DeleteAllowances(owner)
+	require.NoError(t, err)
+	lastBlock, err := p.GetLastScannedBlock(chainID, owner)
+	require.NoError(t, err)
+	require.Nil(t, lastBlock)
+}
+
+func TestDeleteAllowances(t *testing.T) {
+	db, close := setupTestDB(t)
+	defer close()
+
+	p := NewPersistence(db)
+	owner := common.HexToAddress("0x1")
+	other := common.HexToAddress("0x2")
+	chainID := w_common.ChainID(w_common.EthereumMainnet)
+
+	require.NoError(t, p.ApplyAllowances(chainID, owner, []*Allowance{generateTestAllowance(owner, 1, 10)}, 200))
+	require.NoError(t, p.ApplyAllowances(chainID, other, []*Allowance{generateTestAllowance(other, 2, 20)}, 200))
+
+	require.NoError(t, p.DeleteAllowances(owner))
+
+	allowances, err := p.GetAllowances(nil, nil)
+	require.NoError(t, err)
+	require.Len(t, allowances, 1)
+	require.Equal(t, other, allowances[0].Owner)
+
+	lastBlock, err := p.GetLastScannedBlock(chainID, owner)
+	require.NoError(t, err)
+	require.Nil(t, lastBlock)
+}
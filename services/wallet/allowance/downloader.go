@@ -0,0 +1,190 @@
+package allowance
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/contracts/ierc1155"
+	"github.com/status-im/status-go/contracts/ierc20"
+	w_common "github.com/status-im/status-go/services/wallet/common"
+)
+
+// Same range used by the transfers logs downloader, providers cap the number of returned
+// logs rather than the range, and approvals are rare compared to transfers
+const blocksRangeSize = 100000
+
+// logsFetcher is the subset of chain.ClientInterface needed to scan approval logs and read the current allowances
+type logsFetcher interface {
+	NetworkID() uint64
+	BlockNumber(ctx context.Context) (uint64, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// Downloader finds Approval and ApprovalForAll events emitted for the given owners
+type Downloader struct {
+	client logsFetcher
+	owners []common.Address
+
+	approvalSignature       common.Hash
+	approvalForAllSignature common.Hash
+
+	erc20ABI      abi.ABI
+	collectionABI *abi.ABI
+}
+
+func NewDownloader(client logsFetcher, owners []common.Address) (*Downloader, error) {
+	erc20ABI, err := abi.JSON(strings.NewReader(ierc20.IERC20ABI))
+	if err != nil {
+		return nil, err
+	}
+	// isApprovedForAll has the same selector in ERC721 and ERC1155
+	collectionABI, err := ierc1155.Ierc1155MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Downloader{
+		client:                  client,
+		owners:                  owners,
+		approvalSignature:       w_common.GetEventSignatureHash(w_common.Erc20_721ApprovalEventSignature),
+		approvalForAllSignature: w_common.GetEventSignatureHash(w_common.ApprovalForAllEventSignature),
+		erc20ABI:                erc20ABI,
+		collectionABI:           collectionABI,
+	}, nil
+}
+
+func (d *Downloader) topics() [][]common.Hash {
+	owners := make([]common.Hash, len(d.owners))
+	for i, owner := range d.owners {
+		owners[i] = common.BytesToHash(owner.Bytes())
+	}
+	return [][]common.Hash{{d.approvalSignature, d.approvalForAllSignature}, owners}
+}
+
+// GetAllowancesInRange returns the allowance changes found in [from, to], ordered from oldest to newest
+func (d *Downloader) GetAllowancesInRange(ctx context.Context, from, to *big.Int) ([]*Allowance, error) {
+	logs, err := d.client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: from,
+		ToBlock:   to,
+		Topics:    d.topics(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	allowances := make([]*Allowance, 0, len(logs))
+	for i := range logs {
+		allowance := d.allowanceFromLog(&logs[i])
+		if allowance != nil {
+			allowances = append(allowances, allowance)
+		}
+	}
+
+	sort.SliceStable(allowances, func(i, j int) bool {
+		return allowances[j].isNewerThan(allowances[i])
+	})
+
+	log.Debug("get allowances in range", "chainID", d.client.NetworkID(), "from", from, "to", to, "logs", len(logs), "allowances", len(allowances))
+	return allowances, nil
+}
+
+func (d *Downloader) allowanceFromLog(ethlog *types.Log) *Allowance {
+	if ethlog.Removed {
+		return nil
+	}
+
+	allowance := &Allowance{
+		ID: ID{
+			ChainID:      w_common.ChainID(d.client.NetworkID()),
+			TokenAddress: ethlog.Address,
+		},
+		BlockNumber: ethlog.BlockNumber,
+		TxHash:      ethlog.TxHash,
+		LogIndex:    ethlog.Index,
+	}
+
+	switch w_common.GetApprovalEventType(ethlog) {
+	case w_common.Erc20ApprovalEventType:
+		owner, spender, amount, err := w_common.ParseErc20ApprovalLog(ethlog)
+		if err != nil {
+			log.Warn("failed to parse erc20 approval log", "txHash", ethlog.TxHash, "err", err)
+			return nil
+		}
+		allowance.Type = Erc20Allowance
+		allowance.Owner = owner
+		allowance.Spender = spender
+		allowance.Amount = (*hexutil.Big)(amount)
+	case w_common.ApprovalForAllEventType:
+		owner, operator, approved, err := w_common.ParseApprovalForAllLog(ethlog)
+		if err != nil {
+			log.Warn("failed to parse approval for all log", "txHash", ethlog.TxHash, "err", err)
+			return nil
+		}
+		allowance.Type = CollectionApproval
+		allowance.Owner = owner
+		allowance.Spender = operator
+		allowance.Amount = (*hexutil.Big)(big.NewInt(0))
+		if approved {
+			allowance.Amount = (*hexutil.Big)(big.NewInt(1))
+		}
+	default:
+		// Single token ERC721 approvals are cleared by the token contract on transfer,
+		// they don't outlive the ownership and are not tracked
+		return nil
+	}
+
+	return allowance
+}
+
+// GetCurrentAmount reads the allowance from the token contract. Spending an ERC20 allowance with
+// transferFrom doesn't emit an Approval event, so the amount of the last event is only an upper bound.
+// Collection approvals are returned as 1 when approved and 0 otherwise.
+func (d *Downloader) GetCurrentAmount(ctx context.Context, allowance *Allowance) (*big.Int, error) {
+	var (
+		contractABI *abi.ABI
+		method      string
+	)
+	switch allowance.Type {
+	case Erc20Allowance:
+		contractABI, method = &d.erc20ABI, "allowance"
+	case CollectionApproval:
+		contractABI, method = d.collectionABI, "isApprovedForAll"
+	default:
+		return nil, fmt.Errorf("unknown allowance type %d", allowance.Type)
+	}
+
+	input, err := contractABI.Pack(method, allowance.Owner, allowance.Spender)
+	if err != nil {
+		return nil, err
+	}
+	output, err := d.client.CallContract(ctx, ethereum.CallMsg{To: &allowance.TokenAddress, Data: input}, nil)
+	if err != nil {
+		return nil, err
+	}
+	values, err := contractABI.Unpack(method, output)
+	if err != nil {
+		return nil, err
+	}
+
+	switch value := values[0].(type) {
+	case *big.Int:
+		return value, nil
+	case bool:
+		if value {
+			return big.NewInt(1), nil
+		}
+		return big.NewInt(0), nil
+	}
+	return nil, fmt.Errorf("unexpected %s result %v", method, values[0])
+}
@@ -0,0 +1,229 @@
+package allowance
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	w_common "github.com/status-im/status-go/services/wallet/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testLogsFetcher struct {
+	head    uint64
+	logs    []types.Log
+	queries []ethereum.FilterQuery
+	// current allowances returned by the token contracts
+	amounts map[common.Address]int64
+	calls   int
+}
+
+func (f *testLogsFetcher) NetworkID() uint64 {
+	return w_common.EthereumMainnet
+}
+
+func (f *testLogsFetcher) BlockNumber(ctx context.Context) (uint64, error) {
+	return f.head, nil
+}
+
+func (f *testLogsFetcher) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	f.queries = append(f.queries, q)
+	var result []types.Log
+	for _, l := range f.logs {
+		if l.BlockNumber >= q.FromBlock.Uint64() && l.BlockNumber <= q.ToBlock.Uint64() {
+			result = append(result, l)
+		}
+	}
+	return result, nil
+}
+
+func (f *testLogsFetcher) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	f.calls++
+	amount, ok := f.amounts[*call.To]
+	if !ok {
+		return nil, errors.New("execution reverted")
+	}
+	return common.BigToHash(big.NewInt(amount)).Bytes(), nil
+}
+
+type testAccountHistory struct {
+	start *big.Int
+}
+
+func (h *testAccountHistory) GetHistoryStart(chainID uint64, address common.Address) (*big.Int, bool, error) {
+	return h.start, h.start != nil, nil
+}
+
+func approvalLog(token, owner, spender common.Address, amount int64, block uint64, index uint) types.Log {
+	return types.Log{
+		Address: token,
+		Topics: []common.Hash{
+			w_common.GetEventSignatureHash(w_common.Erc20_721ApprovalEventSignature),
+			common.BytesToHash(owner.Bytes()),
+			common.BytesToHash(spender.Bytes()),
+		},
+		Data:        common.BigToHash(big.NewInt(amount)).Bytes(),
+		BlockNumber: block,
+		Index:       index,
+	}
+}
+
+func approvalForAllLog(collection, owner, operator common.Address, approved bool, block uint64, index uint) types.Log {
+	value := int64(0)
+	if approved {
+		value = 1
+	}
+	return types.Log{
+		Address: collection,
+		Topics: []common.Hash{
+			w_common.GetEventSignatureHash(w_common.ApprovalForAllEventSignature),
+			common.BytesToHash(owner.Bytes()),
+			common.BytesToHash(operator.Bytes()),
+		},
+		Data:        common.BigToHash(big.NewInt(value)).Bytes(),
+		BlockNumber: block,
+		Index:       index,
+	}
+}
+
+func TestGetAllowancesInRange(t *testing.T) {
+	owner := common.HexToAddress("0x1")
+	token := common.HexToAddress("0x10")
+	collection := common.HexToAddress("0x20")
+	spender := common.HexToAddress("0x30")
+
+	erc721Approval := approvalLog(collection, owner, spender, 0, 5, 0)
+	erc721Approval.Topics = append(erc721Approval.Topics, common.BigToHash(big.NewInt(7)))
+
+	client := &testLogsFetcher{
+		head: 100,
+		logs: []types.Log{
+			approvalForAllLog(collection, owner, spender, true, 20, 1),
+			approvalLog(token, owner, spender, 500, 10, 3),
+			erc721Approval,
+		},
+	}
+
+	d, err := NewDownloader(client, []common.Address{owner})
+	require.NoError(t, err)
+	allowances, err := d.GetAllowancesInRange(context.Background(), big.NewInt(0), big.NewInt(100))
+	require.NoError(t, err)
+	require.Len(t, allowances, 2)
+
+	require.Equal(t, Erc20Allowance, allowances[0].Type)
+	require.Equal(t, token, allowances[0].TokenAddress)
+	require.Equal(t, spender, allowances[0].Spender)
+	require.Equal(t, owner, allowances[0].Owner)
+	require.Equal(t, int64(500), allowances[0].Amount.ToInt().Int64())
+	require.False(t, allowances[0].IsUnlimited())
+
+	require.Equal(t, CollectionApproval, allowances[1].Type)
+	require.Equal(t, collection, allowances[1].TokenAddress)
+	require.True(t, allowances[1].IsUnlimited())
+
+	require.Len(t, client.queries, 1)
+	require.Equal(t, []common.Hash{common.BytesToHash(owner.Bytes())}, client.queries[0].Topics[1])
+}
+
+func TestGetCurrentAmount(t *testing.T) {
+	owner := common.HexToAddress("0x1")
+	token := common.HexToAddress("0x10")
+	collection := common.HexToAddress("0x20")
+	spender := common.HexToAddress("0x30")
+
+	client := &testLogsFetcher{
+		amounts: map[common.Address]int64{token: 300, collection: 1},
+	}
+	d, err := NewDownloader(client, []common.Address{owner})
+	require.NoError(t, err)
+
+	erc20 := &Allowance{ID: ID{TokenAddress: token, Spender: spender, Type: Erc20Allowance}, Owner: owner}
+	amount, err := d.GetCurrentAmount(context.Background(), erc20)
+	require.NoError(t, err)
+	require.Equal(t, int64(300), amount.Int64())
+
+	approval := &Allowance{ID: ID{TokenAddress: collection, Spender: spender, Type: CollectionApproval}, Owner: owner}
+	amount, err = d.GetCurrentAmount(context.Background(), approval)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), amount.Int64())
+
+	erc20.TokenAddress = common.HexToAddress("0x40")
+	_, err = d.GetCurrentAmount(context.Background(), erc20)
+	require.Error(t, err)
+}
+
+func TestFetchOwnerAllowances(t *testing.T) {
+	db, close := setupTestDB(t)
+	defer close()
+
+	owner := common.HexToAddress("0x1")
+	token := common.HexToAddress("0x10")
+	otherToken := common.HexToAddress("0x11")
+	spender := common.HexToAddress("0x30")
+
+	client := &testLogsFetcher{
+		head: blocksRangeSize + 10,
+		logs: []types.Log{
+			approvalLog(token, owner, spender, 500, 10, 0),
+			approvalLog(otherToken, owner, spender, 700, 20, 0),
+			approvalLog(token, owner, spender, 0, blocksRangeSize+5, 0),
+		},
+		amounts: map[common.Address]int64{token: 0, otherToken: 200},
+	}
+
+	history := &testAccountHistory{}
+	m := &Manager{persistence: NewPersistence(db), history: history}
+
+	// Nothing is scanned until the start of the history of the owner is known
+	updated, err := m.fetchOwnerAllowances(context.Background(), client, owner)
+	require.NoError(t, err)
+	require.False(t, updated)
+	require.Len(t, client.queries, 0)
+
+	history.start = big.NewInt(5)
+	updated, err = m.fetchOwnerAllowances(context.Background(), client, owner)
+	require.NoError(t, err)
+	require.True(t, updated)
+	require.Len(t, client.queries, 2)
+	require.Equal(t, uint64(5), client.queries[0].FromBlock.Uint64())
+
+	// Revoked in the second range, the other allowance was partially spent since its approval
+	allowances, err := m.persistence.GetAllowances(nil, []common.Address{owner})
+	require.NoError(t, err)
+	require.Len(t, allowances, 1)
+	require.Equal(t, otherToken, allowances[0].TokenAddress)
+	require.Equal(t, int64(200), allowances[0].Amount.ToInt().Int64())
+
+	// Next scan resumes after the last scanned block
+	client.head += 10
+	client.logs = append(client.logs, approvalLog(token, owner, spender, 42, client.head, 0))
+	client.amounts[token] = 42
+	updated, err = m.fetchOwnerAllowances(context.Background(), client, owner)
+	require.NoError(t, err)
+	require.True(t, updated)
+	require.Len(t, client.queries, 3)
+	require.Equal(t, uint64(blocksRangeSize+11), client.queries[2].FromBlock.Uint64())
+
+	allowances, err = m.persistence.GetAllowances(nil, []common.Address{owner})
+	require.NoError(t, err)
+	require.Len(t, allowances, 2)
+
+	// The allowance is spent without any new event, a contract that fails keeps its amount
+	client.amounts[otherToken] = 0
+	delete(client.amounts, token)
+	updated, err = m.fetchOwnerAllowances(context.Background(), client, owner)
+	require.NoError(t, err)
+	require.True(t, updated)
+
+	allowances, err = m.persistence.GetAllowances(nil, []common.Address{owner})
+	require.NoError(t, err)
+	require.Len(t, allowances, 1)
+	require.Equal(t, token, allowances[0].TokenAddress)
+	require.Equal(t, int64(42), allowances[0].Amount.ToInt().Int64())
+}
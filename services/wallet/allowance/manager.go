@@ -0,0 +1,452 @@
+package allowance
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/contracts/ierc1155"
+	"github.com/status-im/status-go/contracts/ierc20"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/multiaccounts/accounts"
+	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/services/accounts/accountsevent"
+	"github.com/status-im/status-go/services/wallet/bridge"
+	"github.com/status-im/status-go/services/wallet/collectibles"
+	w_common "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/market"
+	"github.com/status-im/status-go/services/wallet/thirdparty"
+	"github.com/status-im/status-go/services/wallet/token"
+	"github.com/status-im/status-go/services/wallet/transfer"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+	"github.com/status-im/status-go/transactions"
+)
+
+const (
+	// EventAllowancesUpdated is emitted when a scan found new approvals or revocations
+	EventAllowancesUpdated walletevent.EventType = "wallet-allowances-updated"
+
+	// Revocations go through the plain transfer bridge, the tx args are sent as they are
+	revokeBridgeName = "Transfer"
+)
+
+var (
+	ErrAllowanceNotFound = errors.New("allowance not found")
+	ErrNoAllowances      = errors.New("no allowances to revoke")
+)
+
+// Details is an allowance enriched with token metadata and the value the spender could move
+type Details struct {
+	*Allowance
+	Unlimited bool `json:"unlimited"`
+	// Token metadata, only set for ERC20 allowances
+	Token *token.Token `json:"token,omitempty"`
+	// Collection metadata, only set for collection approvals
+	Collection *thirdparty.CollectionData `json:"collection,omitempty"`
+	// Balance is the current token balance of the owner for ERC20 allowances
+	// and the number of owned collectibles for collection approvals
+	Balance *hexutil.Big `json:"balance,omitempty"`
+	// AmountAtRisk is the part of the balance the spender can move, min(Amount, Balance) for ERC20 allowances
+	AmountAtRisk *hexutil.Big `json:"amountAtRisk,omitempty"`
+	// ValueAtRisk is AmountAtRisk converted to the requested currency, when a price is known
+	ValueAtRisk *float64 `json:"valueAtRisk,omitempty"`
+	Currency    string   `json:"currency,omitempty"`
+}
+
+// accountHistory tells where the transfers history of an account starts, approvals can't be older
+type accountHistory interface {
+	GetHistoryStart(chainID uint64, address common.Address) (start *big.Int, found bool, err error)
+}
+
+type Manager struct {
+	persistence         *Persistence
+	history             accountHistory
+	accountsDB          *accounts.Database
+	accountsFeed        *event.Feed
+	accountsWatcher     *accountsevent.Watcher
+	rpcClient           *rpc.Client
+	tokenManager        *token.Manager
+	collectiblesManager *collectibles.Manager
+	marketManager       *market.Manager
+	transactor          *transactions.Transactor
+	feed                *event.Feed
+}
+
+func NewManager(db *sql.DB, accountsDB *accounts.Database, accountsFeed *event.Feed, rpcClient *rpc.Client, tokenManager *token.Manager,
+	collectiblesManager *collectibles.Manager, marketManager *market.Manager, transactor *transactions.Transactor, feed *event.Feed) *Manager {
+	return &Manager{
+		persistence:         NewPersistence(db),
+		history:             transfer.NewBlockRangeSequentialDAO(db),
+		accountsDB:          accountsDB,
+		accountsFeed:        accountsFeed,
+		rpcClient:           rpcClient,
+		tokenManager:        tokenManager,
+		collectiblesManager: collectiblesManager,
+		marketManager:       marketManager,
+		transactor:          transactor,
+		feed:                feed,
+	}
+}
+
+// Start removes the allowances of the accounts that are removed from the wallet
+func (m *Manager) Start() {
+	if m.accountsWatcher != nil {
+		return
+	}
+
+	m.accountsWatcher = accountsevent.NewWatcher(m.accountsDB, m.accountsFeed, func(changedAddresses []common.Address, eventType accountsevent.EventType, currentAddresses []common.Address) {
+		if eventType != accountsevent.EventTypeRemoved {
+			return
+		}
+		for _, address := range changedAddresses {
+			err := m.persistence.DeleteAllowances(address)
+			if err != nil {
+				log.Error("failed to delete allowances of removed account", "address", address, "err", err)
+			}
+		}
+	})
+	m.accountsWatcher.Start()
+}
+
+func (m *Manager) Stop() {
+	if m.accountsWatcher != nil {
+		m.accountsWatcher.Stop()
+		m.accountsWatcher = nil
+	}
+}
+
+// FetchAllowances scans the chains for Approval and ApprovalForAll events emitted since the last scan
+// of each owner and updates the stored allowances. The first scan starts at the first transfer of the owner,
+// the scan progress is saved per block range, so an interrupted scan resumes where it stopped.
+// The amounts of the stored allowances are then read from the token contracts.
+func (m *Manager) FetchAllowances(ctx context.Context, chainIDs []uint64, owners []common.Address) error {
+	for _, chainID := range chainIDs {
+		client, err := m.rpcClient.EthClient(chainID)
+		if err != nil {
+			return err
+		}
+
+		for _, owner := range owners {
+			updated, err := m.fetchOwnerAllowances(ctx, client, owner)
+			if err != nil {
+				log.Error("failed to fetch allowances", "chainID", chainID, "owner", owner, "err", err)
+				return err
+			}
+			if updated {
+				m.feed.Send(walletevent.Event{
+					Type:     EventAllowancesUpdated,
+					ChainID:  chainID,
+					Accounts: []common.Address{owner},
+					At:       time.Now().Unix(),
+				})
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Manager) fetchOwnerAllowances(ctx context.Context, client logsFetcher, owner common.Address) (updated bool, err error) {
+	chainID := w_common.ChainID(client.NetworkID())
+
+	head, err := client.BlockNumber(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	from := new(big.Int)
+	lastScanned, err := m.persistence.GetLastScannedBlock(chainID, owner)
+	if err != nil {
+		return false, err
+	}
+	if lastScanned != nil {
+		from.Add(lastScanned, big.NewInt(1))
+	} else {
+		start, found, err := m.history.GetHistoryStart(uint64(chainID), owner)
+		if err != nil {
+			return false, err
+		}
+		if !found {
+			log.Debug("transfers history not loaded yet, postponing allowances scan", "chainID", chainID, "owner", owner)
+			return false, nil
+		}
+		from.Set(start)
+	}
+
+	to := new(big.Int).SetUint64(head)
+	downloader, err := NewDownloader(client, []common.Address{owner})
+	if err != nil {
+		return false, err
+	}
+	for from.Cmp(to) <= 0 {
+		if ctx.Err() != nil {
+			return updated, ctx.Err()
+		}
+
+		rangeEnd := new(big.Int).Add(from, big.NewInt(blocksRangeSize-1))
+		if rangeEnd.Cmp(to) > 0 {
+			rangeEnd.Set(to)
+		}
+
+		allowances, err := downloader.GetAllowancesInRange(ctx, new(big.Int).Set(from), rangeEnd)
+		if err != nil {
+			return updated, err
+		}
+
+		err = m.persistence.ApplyAllowances(chainID, owner, allowances, rangeEnd.Uint64())
+		if err != nil {
+			return updated, err
+		}
+		updated = updated || len(allowances) > 0
+
+		from.Add(rangeEnd, big.NewInt(1))
+	}
+
+	refreshed, err := m.refreshAmounts(ctx, downloader, chainID, owner)
+	return updated || refreshed, err
+}
+
+// refreshAmounts replaces the stored amounts with the values read from the token contracts.
+// A contract that fails to answer keeps its stored amount, it is read again on the next fetch.
+func (m *Manager) refreshAmounts(ctx context.Context, downloader *Downloader, chainID w_common.ChainID, owner common.Address) (updated bool, err error) {
+	allowances, err := m.persistence.GetAllowances([]w_common.ChainID{chainID}, []common.Address{owner})
+	if err != nil {
+		return false, err
+	}
+
+	for _, allowance := range allowances {
+		if ctx.Err() != nil {
+			return updated, ctx.Err()
+		}
+
+		amount, err := downloader.GetCurrentAmount(ctx, allowance)
+		if err != nil {
+			log.Warn("failed to read current allowance", "chainID", chainID, "token", allowance.TokenAddress,
+				"spender", allowance.Spender, "err", err)
+			continue
+		}
+		if amount.Cmp(allowance.Amount.ToInt()) == 0 {
+			continue
+		}
+
+		err = m.persistence.UpdateAmount(owner, allowance.ID, amount)
+		if err != nil {
+			return updated, err
+		}
+		updated = true
+	}
+
+	return updated, nil
+}
+
+// GetAllowances returns the stored allowances enriched with token metadata, balances and value at risk in the given currency
+func (m *Manager) GetAllowances(ctx context.Context, chainIDs []uint64, owners []common.Address, currency string) ([]*Details, error) {
+	ids := make([]w_common.ChainID, 0, len(chainIDs))
+	for _, chainID := range chainIDs {
+		ids = append(ids, w_common.ChainID(chainID))
+	}
+
+	allowances, err := m.persistence.GetAllowances(ids, owners)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*Details, 0, len(allowances))
+	symbols := make(map[string]bool)
+	for _, allowance := range allowances {
+		details := &Details{
+			Allowance: allowance,
+			Unlimited: allowance.IsUnlimited(),
+		}
+
+		switch allowance.Type {
+		case Erc20Allowance:
+			m.fillTokenDetails(ctx, details)
+			if details.Token != nil {
+				symbols[details.Token.Symbol] = true
+			}
+		case CollectionApproval:
+			m.fillCollectionDetails(ctx, details)
+		}
+
+		result = append(result, details)
+	}
+
+	if currency == "" || len(symbols) == 0 {
+		return result, nil
+	}
+
+	symbolsList := make([]string, 0, len(symbols))
+	for symbol := range symbols {
+		symbolsList = append(symbolsList, symbol)
+	}
+	prices, err := m.marketManager.FetchPrices(symbolsList, []string{currency})
+	if err != nil {
+		// Allowances are still useful without fiat values
+		log.Warn("failed to fetch prices for allowances", "err", err)
+		return result, nil
+	}
+
+	for _, details := range result {
+		if details.Token == nil || details.AmountAtRisk == nil {
+			continue
+		}
+		price, ok := prices[details.Token.Symbol][currency]
+		if !ok {
+			continue
+		}
+		value := tokenAmountToFloat(details.AmountAtRisk.ToInt(), details.Token.Decimals) * price
+		details.ValueAtRisk = &value
+		details.Currency = currency
+	}
+
+	return result, nil
+}
+
+func (m *Manager) fillTokenDetails(ctx context.Context, details *Details) {
+	chainID := uint64(details.ChainID)
+
+	// Don't use FindOrCreateTokenByAddress, approvals of spam tokens would end up in the custom tokens list
+	details.Token = m.tokenManager.FindTokenByAddress(chainID, details.TokenAddress)
+	if details.Token == nil {
+		discovered, err := m.tokenManager.DiscoverToken(ctx, chainID, details.TokenAddress)
+		if err != nil {
+			log.Debug("failed to discover allowance token", "chainID", chainID, "address", details.TokenAddress, "err", err)
+		}
+		details.Token = discovered
+	}
+
+	client, err := m.rpcClient.EthClient(chainID)
+	if err != nil {
+		return
+	}
+	balance, err := m.tokenManager.GetTokenBalance(ctx, client, details.Owner, details.TokenAddress)
+	if err != nil {
+		log.Debug("failed to get allowance token balance", "chainID", chainID, "address", details.TokenAddress, "err", err)
+		return
+	}
+
+	atRisk := new(big.Int).Set(balance)
+	if details.Amount.ToInt().Cmp(balance) < 0 {
+		atRisk.Set(details.Amount.ToInt())
+	}
+	details.Balance = (*hexutil.Big)(balance)
+	details.AmountAtRisk = (*hexutil.Big)(atRisk)
+}
+
+func (m *Manager) fillCollectionDetails(ctx context.Context, details *Details) {
+	contractID := thirdparty.ContractID{
+		ChainID: details.ChainID,
+		Address: details.TokenAddress,
+	}
+
+	collections, err := m.collectiblesManager.FetchCollectionsDataByContractID(ctx, []thirdparty.ContractID{contractID})
+	if err != nil {
+		log.Debug("failed to fetch approved collection data", "contractID", contractID, "err", err)
+	} else if len(collections) > 0 {
+		details.Collection = &collections[0]
+	}
+
+	balances, err := m.collectiblesManager.FetchBalancesByOwnerAndContractAddress(ctx, details.ChainID, details.Owner, []common.Address{details.TokenAddress})
+	if err != nil {
+		log.Debug("failed to fetch approved collection balances", "contractID", contractID, "err", err)
+		return
+	}
+
+	count := big.NewInt(int64(len(balances[details.TokenAddress])))
+	details.Balance = (*hexutil.Big)(count)
+	// An operator can move every collectible of the approved collection
+	details.AmountAtRisk = (*hexutil.Big)(new(big.Int).Set(count))
+}
+
+func tokenAmountToFloat(amount *big.Int, decimals uint) float64 {
+	value, _ := new(big.Float).Quo(new(big.Float).SetInt(amount), big.NewFloat(math.Pow10(int(decimals)))).Float64()
+	return value
+}
+
+// BuildRevokeTransactions builds the transactions revoking the given allowances of the owner.
+// The result can be passed to TransactionManager.CreateMultiTransactionFromCommand to send them
+// as a single multi-transaction, or signed one by one.
+func (m *Manager) BuildRevokeTransactions(owner common.Address, ids []ID) ([]*bridge.TransactionBridge, error) {
+	if len(ids) == 0 {
+		return nil, ErrNoAllowances
+	}
+
+	erc20ABI, err := abi.JSON(strings.NewReader(ierc20.IERC20ABI))
+	if err != nil {
+		return nil, err
+	}
+	collectionABI, err := ierc1155.Ierc1155MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*bridge.TransactionBridge, 0, len(ids))
+	for _, id := range ids {
+		allowance, err := m.persistence.GetAllowance(owner, id)
+		if err != nil {
+			return nil, err
+		}
+		if allowance == nil {
+			return nil, fmt.Errorf("%w: chainID %d, token %s, spender %s", ErrAllowanceNotFound, id.ChainID, id.TokenAddress, id.Spender)
+		}
+
+		var input []byte
+		switch id.Type {
+		case Erc20Allowance:
+			input, err = erc20ABI.Pack("approve", id.Spender, big.NewInt(0))
+		case CollectionApproval:
+			// setApprovalForAll has the same selector in ERC721 and ERC1155
+			input, err = collectionABI.Pack("setApprovalForAll", id.Spender, false)
+		default:
+			err = fmt.Errorf("unknown allowance type %d", id.Type)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		tx, err := m.buildRevokeTx(uint64(id.ChainID), owner, id.TokenAddress, input)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, tx)
+	}
+
+	return data, nil
+}
+
+func (m *Manager) buildRevokeTx(chainID uint64, owner common.Address, contract common.Address, input []byte) (*bridge.TransactionBridge, error) {
+	network := m.rpcClient.NetworkManager.Find(chainID)
+	if network == nil {
+		return nil, fmt.Errorf("network not found for chainID %d", chainID)
+	}
+
+	gas, err := m.transactor.EstimateGas(network, owner, contract, big.NewInt(0), input)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit := hexutil.Uint64(float64(gas) * bridge.IncreaseEstimatedGasFactor)
+
+	to := types.Address(contract)
+	return &bridge.TransactionBridge{
+		BridgeName: revokeBridgeName,
+		ChainID:    chainID,
+		TransferTx: &transactions.SendTxArgs{
+			From:  types.Address(owner),
+			To:    &to,
+			Value: (*hexutil.Big)(big.NewInt(0)),
+			Gas:   &gasLimit,
+			Data:  input,
+		},
+	}, nil
+}
@@ -19,11 +19,13 @@ import (
 	"github.com/status-im/status-go/params"
 	"github.com/status-im/status-go/rpc/network"
 	"github.com/status-im/status-go/services/wallet/activity"
+	"github.com/status-im/status-go/services/wallet/allowance"
 	"github.com/status-im/status-go/services/wallet/bridge"
 	"github.com/status-im/status-go/services/wallet/collectibles"
 	wcommon "github.com/status-im/status-go/services/wallet/common"
 	"github.com/status-im/status-go/services/wallet/currency"
 	"github.com/status-im/status-go/services/wallet/history"
+	"github.com/status-im/status-go/services/wallet/policy"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
 	"github.com/status-im/status-go/services/wallet/token"
 	"github.com/status-im/status-go/services/wallet/transfer"
@@ -156,8 +158,16 @@ func (api *API) FetchDecodedTxData(ctx context.Context, data string) (*thirdpart
 func (api *API) GetBalanceHistory(ctx context.Context, chainIDs []uint64, addresses []common.Address, tokenSymbol string, currencySymbol string, timeInterval history.TimeInterval) ([]*history.ValuePoint, error) {
 	log.Debug("wallet.api.GetBalanceHistory", "chainIDs", chainIDs, "address", addresses, "tokenSymbol", tokenSymbol, "currencySymbol", currencySymbol, "timeInterval", timeInterval)
 
-	var fromTimestamp uint64
-	now := uint64(time.Now().UTC().Unix())
+	fromTimestamp, now, err := timeIntervalStart(timeInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetBalanceHistoryRange(ctx, chainIDs, addresses, tokenSymbol, currencySymbol, fromTimestamp, now)
+}
+
+func timeIntervalStart(timeInterval history.TimeInterval) (fromTimestamp uint64, now uint64, err error) {
+	now = uint64(time.Now().UTC().Unix())
 	switch timeInterval {
 	case history.BalanceHistoryAllTime:
 		fromTimestamp = 0
@@ -170,10 +180,9 @@ func (api *API) GetBalanceHistory(ctx context.Context, chainIDs []uint64, addres
 	case history.BalanceHistory7Days:
 		fromTimestamp = now - history.TimeIntervalDurationSecs(timeInterval)
 	default:
-		return nil, fmt.Errorf("unknown time interval: %v", timeInterval)
+		return 0, 0, fmt.Errorf("unknown time interval: %v", timeInterval)
 	}
-
-	return api.GetBalanceHistoryRange(ctx, chainIDs, addresses, tokenSymbol, currencySymbol, fromTimestamp, now)
+	return fromTimestamp, now, nil
 }
 
 // GetBalanceHistoryRange retrieves token balance history for token identity on multiple chains for a time range
@@ -183,6 +192,30 @@ func (api *API) GetBalanceHistoryRange(ctx context.Context, chainIDs []uint64, a
 	return api.s.history.GetBalanceHistory(ctx, chainIDs, addresses, tokenSymbol, currencySymbol, fromTimestamp)
 }
 
+// GetPortfolioValueHistory retrieves the daily value of all the native and ERC20 tokens of the addresses on multiple chains
+func (api *API) GetPortfolioValueHistory(ctx context.Context, chainIDs []uint64, addresses []common.Address, currencySymbol string, timeInterval history.TimeInterval) ([]*history.ValuePoint, error) {
+	log.Debug("wallet.api.GetPortfolioValueHistory", "chainIDs", chainIDs, "address", addresses, "currencySymbol", currencySymbol, "timeInterval", timeInterval)
+
+	fromTimestamp, _, err := timeIntervalStart(timeInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.s.history.GetPortfolioValueHistory(ctx, chainIDs, addresses, currencySymbol, fromTimestamp)
+}
+
+// GetCollectionFloorPriceHistory retrieves the daily floor price of a collection, where the market provider has it
+func (api *API) GetCollectionFloorPriceHistory(ctx context.Context, chainID uint64, contractAddress common.Address, currencySymbol string, timeInterval history.TimeInterval) ([]*history.ValuePoint, error) {
+	log.Debug("wallet.api.GetCollectionFloorPriceHistory", "chainID", chainID, "contractAddress", contractAddress, "currencySymbol", currencySymbol, "timeInterval", timeInterval)
+
+	fromTimestamp, _, err := timeIntervalStart(timeInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.s.history.GetCollectionFloorPriceHistory(ctx, chainID, contractAddress, currencySymbol, fromTimestamp)
+}
+
 func (api *API) GetTokenList(ctx context.Context) (*token.ListWrapper, error) {
 	log.Debug("call to get token list")
 	rst := api.s.tokenManager.GetList()
@@ -316,6 +349,12 @@ func (api *API) FetchBalancesByOwnerAndContractAddress(ctx context.Context, chai
 	return api.s.collectiblesManager.FetchBalancesByOwnerAndContractAddress(ctx, chainID, ownerAddress, contractAddresses)
 }
 
+func (api *API) FetchERC1155Balances(ctx context.Context, chainID wcommon.ChainID, ownerAddress common.Address, contractAddress common.Address, tokenIDs []*big.Int) ([]thirdparty.TokenBalance, error) {
+	log.Debug("call to FetchERC1155Balances")
+
+	return api.s.collectiblesManager.FetchERC1155Balances(ctx, chainID, ownerAddress, contractAddress, tokenIDs)
+}
+
 func (api *API) GetCollectibleOwnership(id thirdparty.CollectibleUniqueID) ([]thirdparty.AccountBalance, error) {
 	return api.s.collectiblesManager.GetCollectibleOwnership(id)
 }
@@ -571,6 +610,72 @@ func (api *API) ProceedWithTransactionsSignatures(ctx context.Context, signature
 	return api.s.transactionManager.ProceedWithTransactionsSignatures(ctx, signatures)
 }
 
+// FetchAllowances scans the chains for ERC20 allowances and NFT collection approvals granted by the addresses
+func (api *API) FetchAllowances(ctx context.Context, chainIDs []uint64, addresses []common.Address) error {
+	log.Debug("wallet.api.FetchAllowances", "chainIDs.len", len(chainIDs), "addresses.len", len(addresses))
+	return api.s.allowanceManager.FetchAllowances(ctx, chainIDs, addresses)
+}
+
+// GetAllowances returns the known allowances of the addresses with their value at risk in the given currency
+func (api *API) GetAllowances(ctx context.Context, chainIDs []uint64, addresses []common.Address, currency string) ([]*allowance.Details, error) {
+	log.Debug("wallet.api.GetAllowances", "chainIDs.len", len(chainIDs), "addresses.len", len(addresses))
+	return api.s.allowanceManager.GetAllowances(ctx, chainIDs, addresses, currency)
+}
+
+// BuildRevokeAllowancesTransactions returns the transactions revoking the given allowances, to be reviewed or signed by the client
+func (api *API) BuildRevokeAllowancesTransactions(ctx context.Context, owner common.Address, ids []allowance.ID) ([]*bridge.TransactionBridge, error) {
+	log.Debug("wallet.api.BuildRevokeAllowancesTransactions", "owner", owner, "ids.len", len(ids))
+	return api.s.allowanceManager.BuildRevokeTransactions(owner, ids)
+}
+
+// RevokeAllowances revokes one or more allowances of the owner in a single multi-transaction.
+// In case of keycard account, password should be empty and signatures are requested as for CreateMultiTransaction
+func (api *API) RevokeAllowances(ctx context.Context, owner common.Address, ids []allowance.ID, password string) (*transfer.MultiTransactionCommandResult, error) {
+	log.Debug("wallet.api.RevokeAllowances", "owner", owner, "ids.len", len(ids))
+	data, err := api.s.allowanceManager.BuildRevokeTransactions(owner, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	command := &transfer.MultiTransactionCommand{
+		FromAddress: owner,
+		ToAddress:   owner,
+		FromAmount:  (*hexutil.Big)(big.NewInt(0)),
+		Type:        transfer.MultiTransactionApprove,
+	}
+	return api.s.transactionManager.CreateMultiTransactionFromCommand(ctx, command, data, api.router.bridges, password)
+}
+
+// GetSpendingPolicy returns the spending policy of the account, nil if it has none
+func (api *API) GetSpendingPolicy(ctx context.Context, address common.Address) (*policy.Policy, error) {
+	log.Debug("wallet.api.GetSpendingPolicy", "address", address)
+	return api.s.policyManager.GetPolicy(address)
+}
+
+// SetSpendingPolicy sets the spending policy of the account, the password of the account
+// is only required when the policy is less restrictive than the current one
+func (api *API) SetSpendingPolicy(ctx context.Context, p policy.Policy, password string) error {
+	log.Debug("wallet.api.SetSpendingPolicy", "address", p.Address)
+	return api.s.policyManager.SetPolicy(&p, password)
+}
+
+func (api *API) DeleteSpendingPolicy(ctx context.Context, address common.Address, password string) error {
+	log.Debug("wallet.api.DeleteSpendingPolicy", "address", address)
+	return api.s.policyManager.DeletePolicy(address, password)
+}
+
+// ConfirmSpendingPolicyRecipient confirms a new recipient of the account, transactions to it are no longer blocked
+func (api *API) ConfirmSpendingPolicyRecipient(ctx context.Context, address common.Address, recipient common.Address, password string) error {
+	log.Debug("wallet.api.ConfirmSpendingPolicyRecipient", "address", address, "recipient", recipient)
+	return api.s.policyManager.ConfirmRecipient(address, recipient, password)
+}
+
+// EvaluateSpendingPolicy returns the policy violations of the transaction without sending it
+func (api *API) EvaluateSpendingPolicy(ctx context.Context, chainID uint64, sendArgs transactions.SendTxArgs) ([]*policy.Violation, error) {
+	log.Debug("wallet.api.EvaluateSpendingPolicy", "chainID", chainID, "from", sendArgs.From)
+	return api.s.policyManager.Evaluate(transactions.NewOutgoingTx(chainID, sendArgs))
+}
+
 func (api *API) GetMultiTransactions(ctx context.Context, transactionIDs []transfer.MultiTransactionIDType) ([]*transfer.MultiTransaction, error) {
 	log.Debug("wallet.api.GetMultiTransactions", "IDs.len", len(transactionIDs))
 	return api.s.transactionManager.GetMultiTransactions(ctx, transactionIDs)
@@ -16,6 +16,8 @@ import (
 
 const IncreaseEstimatedGasFactor = 1.1
 
+// getSigner returns the signer of the transactions built by contract bindings. The bindings don't send the
+// transactions (NoSend), bridges send them with Transactor.SendTransactionWithSignature, which checks the spending policy.
 func getSigner(chainID uint64, from types.Address, verifiedAccount *account.SelectedExtKey) bind.SignerFn {
 	return func(addr common.Address, tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
 		s := ethTypes.NewLondonSigner(new(big.Int).SetUint64(chainID))
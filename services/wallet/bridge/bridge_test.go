@@ -0,0 +1,135 @@
+package bridge
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/status-im/status-go/account"
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/rpc"
+	"github.com/status-im/status-go/rpc/chain"
+	"github.com/status-im/status-go/transactions"
+	"github.com/status-im/status-go/transactions/fake"
+)
+
+const testChainID = 1337
+
+type testTxPolicy struct {
+	err     error
+	checked []transactions.OutgoingTx
+	sent    []common.Hash
+}
+
+func (p *testTxPolicy) CheckTransaction(tx transactions.OutgoingTx) error {
+	p.checked = append(p.checked, tx)
+	return p.err
+}
+
+func (p *testTxPolicy) TransactionSent(tx transactions.OutgoingTx, hash common.Hash) {
+	p.sent = append(p.sent, hash)
+}
+
+func setupTestTransactor(t *testing.T) (*rpc.Client, *transactions.Transactor, *fake.MockPublicTransactionPoolAPI) {
+	ctrl := gomock.NewController(t)
+	server, txServiceMock := fake.NewTestServer(ctrl)
+	client := gethrpc.DialInProc(server)
+	t.Cleanup(func() {
+		ctrl.Finish()
+		client.Close()
+		server.Stop()
+	})
+
+	rpcClient, err := rpc.NewClient(client, testChainID, params.UpstreamRPCConfig{}, nil, nil)
+	require.NoError(t, err)
+	rpcClient.UpstreamChainID = testChainID
+	rpcClient.SetClient(testChainID, chain.NewSimpleClient(client, testChainID))
+
+	transactor := transactions.NewTransactor()
+	transactor.SetNetworkID(testChainID)
+	transactor.SetRPC(rpcClient, time.Second)
+	return rpcClient, transactor, txServiceMock
+}
+
+func testSendTxArgs(from types.Address, to types.Address) transactions.SendTxArgs {
+	gas := hexutil.Uint64(100000)
+	return transactions.SendTxArgs{
+		From:     from,
+		To:       &to,
+		Gas:      &gas,
+		GasPrice: (*hexutil.Big)(big.NewInt(1000000000)),
+	}
+}
+
+func TestCollectibleTransfersCheckTxPolicy(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	selectedAccount := &account.SelectedExtKey{
+		Address:    crypto.PubkeyToAddress(key.PublicKey),
+		AccountKey: &types.Key{PrivateKey: key},
+	}
+	from := selectedAccount.Address
+	collection := types.HexToAddress("0x10")
+	recipient := common.HexToAddress("0x20")
+
+	rpcClient, transactor, txServiceMock := setupTestTransactor(t)
+
+	bridges := []struct {
+		bridge Bridge
+		args   *TransactionBridge
+	}{
+		{
+			NewERC721TransferBridge(rpcClient, transactor),
+			&TransactionBridge{ChainID: testChainID, ERC721TransferTx: &ERC721TransferTxArgs{
+				SendTxArgs: testSendTxArgs(from, collection),
+				TokenID:    (*hexutil.Big)(big.NewInt(7)),
+				Recipient:  recipient,
+			}},
+		},
+		{
+			NewERC1155TransferBridge(rpcClient, transactor),
+			&TransactionBridge{ChainID: testChainID, ERC1155TransferTx: &ERC1155TransferTxArgs{
+				SendTxArgs: testSendTxArgs(from, collection),
+				TokenID:    (*hexutil.Big)(big.NewInt(7)),
+				Recipient:  recipient,
+				Amount:     (*hexutil.Big)(big.NewInt(2)),
+			}},
+		},
+	}
+
+	for _, tt := range bridges {
+		t.Run(tt.bridge.Name(), func(t *testing.T) {
+			nonce := hexutil.Uint64(0)
+			txServiceMock.EXPECT().GetTransactionCount(gomock.Any(), common.Address(from), gethrpc.PendingBlockNumber).Return(&nonce, nil).Times(2)
+
+			// Denied transactions are never propagated
+			policy := &testTxPolicy{err: errors.New("denied")}
+			transactor.SetTxPolicy(policy)
+			_, err := tt.bridge.Send(tt.args, selectedAccount)
+			require.ErrorIs(t, err, policy.err)
+			require.Len(t, policy.checked, 1)
+			require.Equal(t, common.Address(from), policy.checked[0].From)
+			require.Equal(t, common.Address(collection), *policy.checked[0].To)
+			require.Empty(t, policy.sent)
+
+			// Allowed transactions are reported once sent
+			policy = &testTxPolicy{}
+			transactor.SetTxPolicy(policy)
+			txServiceMock.EXPECT().SendRawTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{}, nil)
+			hash, err := tt.bridge.Send(tt.args, selectedAccount)
+			require.NoError(t, err)
+			require.Len(t, policy.checked, 1)
+			require.Equal(t, []common.Hash{common.Hash(hash)}, policy.sent)
+		})
+	}
+}
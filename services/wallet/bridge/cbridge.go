@@ -331,6 +331,7 @@ func (s *CBridge) sendOrBuild(sendArgs *TransactionBridge, signerFn bind.SignerF
 	}
 
 	txOpts := sendArgs.CbridgeTx.ToTransactOpts(signerFn)
+	txOpts.NoSend = true
 	if token.IsNative() {
 		return contract.SendNative(
 			txOpts,
@@ -358,8 +359,7 @@ func (s *CBridge) Send(sendArgs *TransactionBridge, verifiedAccount *account.Sel
 	if err != nil {
 		return types.HexToHash(""), err
 	}
-
-	return types.Hash(tx.Hash()), nil
+	return s.transactor.SendTransactionWithSignature(tx)
 }
 
 func (s *CBridge) BuildTransaction(sendArgs *TransactionBridge) (*ethTypes.Transaction, error) {
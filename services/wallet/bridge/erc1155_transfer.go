@@ -121,6 +121,7 @@ func (s *ERC1155TransferBridge) sendOrBuild(sendArgs *TransactionBridge, signerF
 	argNonce := hexutil.Uint64(nonce)
 	sendArgs.ERC1155TransferTx.Nonce = &argNonce
 	txOpts := sendArgs.ERC1155TransferTx.ToTransactOpts(signerFn)
+	txOpts.NoSend = true
 	tx, err = contract.SafeTransferFrom(
 		txOpts, common.Address(sendArgs.ERC1155TransferTx.From),
 		sendArgs.ERC1155TransferTx.Recipient,
@@ -136,7 +137,7 @@ func (s *ERC1155TransferBridge) Send(sendArgs *TransactionBridge, verifiedAccoun
 	if err != nil {
 		return hash, err
 	}
-	return types.Hash(tx.Hash()), nil
+	return s.transactor.SendTransactionWithSignature(tx)
 }
 
 func (s *ERC1155TransferBridge) BuildTransaction(sendArgs *TransactionBridge) (*ethTypes.Transaction, error) {
@@ -117,6 +117,7 @@ func (s *ERC721TransferBridge) sendOrBuild(sendArgs *TransactionBridge, signerFn
 	argNonce := hexutil.Uint64(nonce)
 	sendArgs.ERC721TransferTx.Nonce = &argNonce
 	txOpts := sendArgs.ERC721TransferTx.ToTransactOpts(signerFn)
+	txOpts.NoSend = true
 	tx, err = contract.SafeTransferFrom(txOpts, common.Address(sendArgs.ERC721TransferTx.From),
 		sendArgs.ERC721TransferTx.Recipient,
 		sendArgs.ERC721TransferTx.TokenID.ToInt())
@@ -128,7 +129,7 @@ func (s *ERC721TransferBridge) Send(sendArgs *TransactionBridge, verifiedAccount
 	if err != nil {
 		return hash, err
 	}
-	return types.Hash(tx.Hash()), nil
+	return s.transactor.SendTransactionWithSignature(tx)
 }
 
 func (s *ERC721TransferBridge) BuildTransaction(sendArgs *TransactionBridge) (*ethTypes.Transaction, error) {
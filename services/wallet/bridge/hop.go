@@ -254,7 +254,7 @@ func (h *HopBridge) Send(sendArgs *TransactionBridge, verifiedAccount *account.S
 	if err != nil {
 		return types.Hash{}, err
 	}
-	return types.Hash(tx.Hash()), nil
+	return h.transactor.SendTransactionWithSignature(tx)
 }
 
 func (h *HopBridge) BuildTransaction(sendArgs *TransactionBridge) (*ethTypes.Transaction, error) {
@@ -267,6 +267,7 @@ func (h *HopBridge) sendToL2(chainID uint64, hopArgs *HopTxArgs, signerFn bind.S
 		return tx, err
 	}
 	txOpts := hopArgs.ToTransactOpts(signerFn)
+	txOpts.NoSend = true
 	if token.IsNative() {
 		txOpts.Value = (*big.Int)(hopArgs.Amount)
 	}
@@ -293,6 +294,7 @@ func (h *HopBridge) swapAndSend(chainID uint64, hopArgs *HopTxArgs, signerFn bin
 	}
 
 	txOpts := hopArgs.ToTransactOpts(signerFn)
+	txOpts.NoSend = true
 	if token.IsNative() {
 		txOpts.Value = (*big.Int)(hopArgs.Amount)
 	}
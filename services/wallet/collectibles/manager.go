@@ -17,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/status-im/status-go/contracts/community-tokens/collectibles"
+	"github.com/status-im/status-go/contracts/ierc1155"
 	"github.com/status-im/status-go/rpc"
 	"github.com/status-im/status-go/server"
 	"github.com/status-im/status-go/services/wallet/async"
@@ -250,6 +251,49 @@ func (o *Manager) FetchBalancesByOwnerAndContractAddress(ctx context.Context, ch
 	return ret, nil
 }
 
+// FetchERC1155Balances returns the owner's balance of each of the given token IDs of an ERC1155 contract.
+// Providers don't report ERC1155 amounts reliably, balances are read from the contract with balanceOfBatch.
+func (o *Manager) FetchERC1155Balances(ctx context.Context, chainID walletCommon.ChainID, ownerAddress common.Address, contractAddress common.Address, tokenIDs []*big.Int) ([]thirdparty.TokenBalance, error) {
+	if len(tokenIDs) == 0 {
+		return []thirdparty.TokenBalance{}, nil
+	}
+
+	backend, err := o.rpcClient.EthClient(uint64(chainID))
+	if err != nil {
+		return nil, err
+	}
+
+	caller, err := ierc1155.NewIerc1155Caller(contractAddress, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make([]common.Address, len(tokenIDs))
+	for i := range owners {
+		owners[i] = ownerAddress
+	}
+
+	balances, err := caller.BalanceOfBatch(&bind.CallOpts{
+		Context: ctx,
+	}, owners, tokenIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(balances) != len(tokenIDs) {
+		return nil, errors.New("unexpected number of balances")
+	}
+
+	ret := make([]thirdparty.TokenBalance, 0, len(tokenIDs))
+	for i, tokenID := range tokenIDs {
+		ret = append(ret, thirdparty.TokenBalance{
+			TokenID: &bigint.BigInt{Int: new(big.Int).Set(tokenID)},
+			Balance: &bigint.BigInt{Int: balances[i]},
+		})
+	}
+
+	return ret, nil
+}
+
 func (o *Manager) FetchAllAssetsByOwnerAndContractAddress(ctx context.Context, chainID walletCommon.ChainID, owner common.Address, contractAddresses []common.Address, cursor string, limit int, providerID string) (*thirdparty.FullCollectibleDataContainer, error) {
 	defer o.checkConnectionStatus(chainID)
 
@@ -44,6 +44,9 @@ const (
 	HopBridgeTransferFromL1CompletedEventType EventType = "hopBridgeTransferFromL1CompletedEvent"
 	HopBridgeWithdrawalBondedEventType        EventType = "hopBridgeWithdrawalBondedEvent"
 	HopBridgeTransferSentEventType            EventType = "hopBridgeTransferSentEvent"
+	Erc20ApprovalEventType                    EventType = "erc20ApprovalEvent"
+	Erc721ApprovalEventType                   EventType = "erc721ApprovalEvent"
+	ApprovalForAllEventType                   EventType = "approvalForAllEvent"
 	UnknownEventType                          EventType = "unknownEvent"
 
 	// Deposit (index_topic_1 address dst, uint256 wad)
@@ -61,6 +64,16 @@ const (
 	erc721TransferEventIndexedParameters  = 4 // signature, from, to, tokenId
 	erc1155TransferEventIndexedParameters = 4 // signature, operator, from, to (id, value are not indexed)
 
+	// Approval (index_topic_1 address owner, index_topic_2 address spender, uint256 value)
+	// Approval (index_topic_1 address owner, index_topic_2 address approved, index_topic_3 uint256 tokenId)
+	Erc20_721ApprovalEventSignature = "Approval(address,address,uint256)"
+	// ApprovalForAll (index_topic_1 address owner, index_topic_2 address operator, bool approved), shared by ERC721 and ERC1155
+	ApprovalForAllEventSignature = "ApprovalForAll(address,address,bool)"
+
+	erc20ApprovalEventIndexedParameters  = 3 // signature, owner, spender
+	erc721ApprovalEventIndexedParameters = 4 // signature, owner, approved, tokenId
+	approvalForAllEventIndexedParameters = 3 // signature, owner, operator
+
 	// Swap (index_topic_1 address sender, uint256 amount0In, uint256 amount1In, uint256 amount0Out, uint256 amount1Out, index_topic_2 address to)
 	uniswapV2SwapEventSignature = "Swap(address,uint256,uint256,uint256,uint256,address)" // also used by SushiSwap
 	// Swap (index_topic_1 address sender, index_topic_2 address recipient, int256 amount0, int256 amount1, uint160 sqrtPriceX96, uint128 liquidity, int24 tick)
@@ -130,6 +143,31 @@ func GetEventType(log *types.Log) EventType {
 	return UnknownEventType
 }
 
+// Detect approval event type for a certain item from the Events Log.
+// Approvals are kept out of GetEventType on purpose, they are not transfers and
+// must not be picked up by GetFirstEvent when classifying transactions.
+func GetApprovalEventType(log *types.Log) EventType {
+	if len(log.Topics) == 0 {
+		return UnknownEventType
+	}
+
+	switch log.Topics[0] {
+	case GetEventSignatureHash(Erc20_721ApprovalEventSignature):
+		switch len(log.Topics) {
+		case erc20ApprovalEventIndexedParameters:
+			return Erc20ApprovalEventType
+		case erc721ApprovalEventIndexedParameters:
+			return Erc721ApprovalEventType
+		}
+	case GetEventSignatureHash(ApprovalForAllEventSignature):
+		if len(log.Topics) == approvalForAllEventIndexedParameters {
+			return ApprovalForAllEventType
+		}
+	}
+
+	return UnknownEventType
+}
+
 func EventTypeToSubtransactionType(eventType EventType) Type {
 	switch eventType {
 	case Erc20TransferEventType:
@@ -258,6 +296,55 @@ func ParseErc721TransferLog(ethlog *types.Log) (from, to common.Address, tokenID
 	return
 }
 
+func ParseErc20ApprovalLog(ethlog *types.Log) (owner, spender common.Address, amount *big.Int, err error) {
+	amount = new(big.Int)
+	if len(ethlog.Topics) < erc20ApprovalEventIndexedParameters {
+		err = fmt.Errorf("not enough topics for erc20 approval %v", ethlog.Topics)
+		return
+	}
+
+	err = checkTopicsLength(*ethlog, 1, erc20ApprovalEventIndexedParameters)
+	if err != nil {
+		return
+	}
+
+	addressIdx := common.HashLength - common.AddressLength
+	copy(owner[:], ethlog.Topics[1][addressIdx:])
+	copy(spender[:], ethlog.Topics[2][addressIdx:])
+
+	if len(ethlog.Data) != common.HashLength {
+		err = fmt.Errorf("data is not padded to 32 bytes big int %v", ethlog.Data)
+		return
+	}
+	amount.SetBytes(ethlog.Data)
+
+	return
+}
+
+func ParseApprovalForAllLog(ethlog *types.Log) (owner, operator common.Address, approved bool, err error) {
+	if len(ethlog.Topics) < approvalForAllEventIndexedParameters {
+		err = fmt.Errorf("not enough topics for approval for all %v", ethlog.Topics)
+		return
+	}
+
+	err = checkTopicsLength(*ethlog, 1, approvalForAllEventIndexedParameters)
+	if err != nil {
+		return
+	}
+
+	addressIdx := common.HashLength - common.AddressLength
+	copy(owner[:], ethlog.Topics[1][addressIdx:])
+	copy(operator[:], ethlog.Topics[2][addressIdx:])
+
+	if len(ethlog.Data) != common.HashLength {
+		err = fmt.Errorf("data is not padded to 32 bytes bool %v", ethlog.Data)
+		return
+	}
+	approved = new(big.Int).SetBytes(ethlog.Data).Sign() != 0
+
+	return
+}
+
 func GetLogSubTxID(log types.Log) common.Hash {
 	// Get unique ID by using TxHash and log index
 	index := [4]byte{}
@@ -11,6 +11,18 @@ import (
 	"github.com/status-im/status-go/services/wallet/bigint"
 )
 
+// seriesType tells what a balance_history row holds
+type seriesType = string
+
+const (
+	seriesNative     seriesType = "eth"         // native balance fetched at transfer blocks
+	seriesERC20      seriesType = "erc20"       // token balance rebuilt from transfers
+	seriesERC721     seriesType = "erc721"      // number of owned collectibles rebuilt from transfers
+	seriesERC1155    seriesType = "erc1155"     // number of owned collectibles rebuilt from transfers
+	seriesPortfolio  seriesType = "portfolio"   // daily value of all the tokens of an account
+	seriesFloorPrice seriesType = "floor_price" // daily floor price of a collection
+)
+
 type BalanceDB struct {
 	db *sql.DB
 }
@@ -26,6 +38,7 @@ type entry struct {
 	chainID      uint64
 	address      common.Address
 	tokenSymbol  string
+	tokenType    seriesType
 	tokenAddress common.Address
 	block        *big.Int
 	timestamp    int64
@@ -63,9 +76,11 @@ func (b *BalanceDB) add(entry *entry) error {
 	return err
 }
 
+// getEntriesWithoutBalances returns the blocks with transfers of the address that have no native balance yet.
+// Token balances are rebuilt from the transfers themselves, see updateTokenBalances
 func (b *BalanceDB) getEntriesWithoutBalances(chainID uint64, address common.Address) (entries []*entry, err error) {
-	rows, err := b.db.Query("SELECT blk_number, tr.timestamp, token_address from transfers tr LEFT JOIN balance_history bh ON bh.block = tr.blk_number WHERE tr.network_id = ? AND tr.address = ? AND tr.type != 'erc721' AND bh.block IS NULL",
-		chainID, address)
+	rows, err := b.db.Query("SELECT DISTINCT tr.blk_number, tr.timestamp FROM transfers tr LEFT JOIN balance_history bh ON bh.chain_id = tr.network_id AND bh.address = tr.address AND bh.token_type = ? AND bh.block = tr.blk_number WHERE tr.network_id = ? AND tr.address = ? AND tr.type != 'erc721' AND bh.block IS NULL",
+		seriesNative, chainID, address)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -83,17 +98,10 @@ func (b *BalanceDB) getEntriesWithoutBalances(chainID uint64, address common.Add
 			block:   new(big.Int),
 		}
 
-		// tokenAddress can be NULL and can not unmarshal to common.Address
-		tokenHexAddress := make([]byte, common.AddressLength)
-		err := rows.Scan((*bigint.SQLBigInt)(entry.block), &entry.timestamp, &tokenHexAddress)
+		err := rows.Scan((*bigint.SQLBigInt)(entry.block), &entry.timestamp)
 		if err != nil {
 			return nil, err
 		}
-
-		tokenAddress := common.BytesToAddress(tokenHexAddress)
-		if tokenAddress != (common.Address{}) {
-			entry.tokenAddress = tokenAddress
-		}
 		entries = append(entries, entry)
 	}
 	return entries, nil
@@ -101,9 +109,9 @@ func (b *BalanceDB) getEntriesWithoutBalances(chainID uint64, address common.Add
 
 func (b *BalanceDB) getNewerThan(identity *assetIdentity, timestamp uint64) (entries []*entry, err error) {
 	// DISTINCT removes duplicates that can happen when a block has multiple transfers of same token
-	rawQueryStr := "SELECT DISTINCT block, timestamp, balance, address FROM balance_history WHERE chain_id = ? AND address IN (%s) AND currency = ? AND timestamp > ? ORDER BY timestamp"
+	rawQueryStr := "SELECT DISTINCT block, timestamp, balance, address FROM balance_history WHERE chain_id = ? AND address IN (%s) AND currency = ? AND token_type IN (?, ?) AND timestamp > ? ORDER BY timestamp"
 	queryString := fmt.Sprintf(rawQueryStr, identity.addressesToString())
-	rows, err := b.db.Query(queryString, identity.ChainID, identity.TokenSymbol, timestamp)
+	rows, err := b.db.Query(queryString, identity.ChainID, identity.TokenSymbol, seriesNative, seriesERC20, timestamp)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	} else if err != nil {
@@ -138,8 +146,8 @@ func (b *BalanceDB) getEntryPreviousTo(item *entry) (res *entry, err error) {
 		tokenSymbol: item.tokenSymbol,
 	}
 
-	queryStr := "SELECT block, timestamp, balance FROM balance_history WHERE chain_id = ? AND address = ? AND currency = ? AND timestamp < ? ORDER BY timestamp DESC LIMIT 1"
-	row := b.db.QueryRow(queryStr, item.chainID, item.address, item.tokenSymbol, item.timestamp)
+	queryStr := "SELECT block, timestamp, balance FROM balance_history WHERE chain_id = ? AND address = ? AND currency = ? AND token_type IN (?, ?) AND timestamp < ? ORDER BY timestamp DESC LIMIT 1"
+	row := b.db.QueryRow(queryStr, item.chainID, item.address, item.tokenSymbol, seriesNative, seriesERC20, item.timestamp)
 
 	err = row.Scan((*bigint.SQLBigInt)(res.block), &res.timestamp, (*bigint.SQLBigIntBytes)(res.balance))
 	if err == sql.ErrNoRows {
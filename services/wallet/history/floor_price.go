@@ -0,0 +1,114 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	w_common "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/thirdparty"
+)
+
+var ErrNoFloorPriceProvider = errors.New("no floor price provider available for chain")
+
+// Floor prices are not specific to an account, they are stored with an empty address
+var floorPriceAddress = common.Address{}
+
+func (s *Service) fetchFloorPrice(ctx context.Context, id thirdparty.ContractID) (*thirdparty.CollectionFloorPrice, error) {
+	err := ErrNoFloorPriceProvider
+	for _, provider := range s.floorPriceProviders {
+		if !provider.IsChainSupported(id.ChainID) {
+			continue
+		}
+
+		var floorPrice *thirdparty.CollectionFloorPrice
+		floorPrice, err = provider.FetchCollectionFloorPrice(ctx, id)
+		if err == nil {
+			return floorPrice, nil
+		}
+		log.Debug("Error fetching floor price", "provider", provider.ID(), "chainID", id.ChainID, "contract", id.Address, "err", err)
+	}
+	return nil, err
+}
+
+// sampleFloorPrice stores today's floor price of the collection unless it is already known.
+// Providers only expose the current floor price, the series grows by one point per day.
+func (s *Service) sampleFloorPrice(ctx context.Context, chainID uint64, contractAddress common.Address) error {
+	today := dayStart(time.Now().UTC().Unix())
+
+	samples, err := s.balance.db.getDailyValues(chainID, floorPriceAddress, seriesFloorPrice, contractAddress.Bytes(), today)
+	if err != nil || len(samples) > 0 {
+		return err
+	}
+
+	floorPrice, err := s.fetchFloorPrice(ctx, thirdparty.ContractID{
+		ChainID: w_common.ChainID(chainID),
+		Address: contractAddress,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.balance.db.addDailyValue(chainID, floorPriceAddress, seriesFloorPrice, contractAddress.Bytes(), &dailyValue{
+		day:      today,
+		value:    floorPrice.Price,
+		currency: floorPrice.Symbol,
+	})
+}
+
+// sampleFloorPrices stores today's floor price of the collections owned by the address
+func (s *Service) sampleFloorPrices(ctx context.Context, chainID uint64, address common.Address) error {
+	if len(s.floorPriceProviders) == 0 {
+		return nil
+	}
+
+	collections, err := s.balance.db.getOwnedCollections(chainID, address)
+	if err != nil {
+		return err
+	}
+
+	for _, contractAddress := range collections {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		err = s.sampleFloorPrice(ctx, chainID, contractAddress)
+		if err != nil {
+			log.Warn("Error sampling floor price", "chainID", chainID, "contract", contractAddress, "err", err)
+		}
+	}
+	return nil
+}
+
+// GetCollectionFloorPriceHistory returns the daily floor price of the collection converted to the given currency.
+// Days without a known floor price or exchange rate are left out.
+func (s *Service) GetCollectionFloorPriceHistory(ctx context.Context, chainID uint64, contractAddress common.Address, currencySymbol string, fromTimestamp uint64) ([]*ValuePoint, error) {
+	log.Debug("GetCollectionFloorPriceHistory", "chainID", chainID, "contractAddress", contractAddress, "currencySymbol", currencySymbol, "fromTimestamp", fromTimestamp)
+
+	err := s.sampleFloorPrice(ctx, chainID, contractAddress)
+	if err != nil && !errors.Is(err, ErrNoFloorPriceProvider) {
+		log.Warn("Error sampling floor price", "chainID", chainID, "contract", contractAddress, "err", err)
+	}
+
+	samples, err := s.balance.db.getDailyValues(chainID, floorPriceAddress, seriesFloorPrice, contractAddress.Bytes(), dayStart(int64(fromTimestamp)))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*ValuePoint, 0, len(samples))
+	for _, sample := range samples {
+		rate, err := s.rateForDay(sample.currency, currencySymbol, sample.day)
+		if err != nil {
+			log.Warn("Exchange rate missing for", "tokenSymbol", sample.currency, "currencySymbol", currencySymbol, "day", sample.day, "err", err)
+			continue
+		}
+		res = append(res, &ValuePoint{
+			Value:     sample.value * float64(rate),
+			Timestamp: uint64(sample.day),
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,272 @@
+package history
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const secondsInADay = int64(24 * 60 * 60)
+
+type execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+// dailyValue is a point of a derived series, like the portfolio value of an account
+type dailyValue struct {
+	day      int64 // Timestamp of the start of the day, UTC
+	value    float64
+	currency string
+}
+
+// rateGetter returns the exchange rate from token to currency in the day starting at the given timestamp
+type rateGetter func(tokenSymbol string, currencySymbol string, day int64) (float32, error)
+
+func dayStart(timestamp int64) int64 {
+	return timestamp - timestamp%secondsInADay
+}
+
+func (b *BalanceDB) addDailyValue(chainID uint64, address common.Address, series seriesType, tokenAddress []byte, v *dailyValue) error {
+	if tokenAddress == nil {
+		tokenAddress = []byte{}
+	}
+	_, err := b.db.Exec(`INSERT OR REPLACE INTO balance_history (chain_id, address, currency, token_type, token_address, block, timestamp, value)
+		VALUES (?, ?, ?, ?, ?, 0, ?, ?)`, chainID, address, v.currency, series, tokenAddress, v.day, v.value)
+	return err
+}
+
+// getDailyValues returns the values of the series starting from the given day, ordered by day
+func (b *BalanceDB) getDailyValues(chainID uint64, address common.Address, series seriesType, tokenAddress []byte, fromDay int64) ([]*dailyValue, error) {
+	if tokenAddress == nil {
+		tokenAddress = []byte{}
+	}
+	rows, err := b.db.Query(`SELECT timestamp, value, currency FROM balance_history
+		WHERE chain_id = ? AND address = ? AND token_type = ? AND token_address = ? AND timestamp >= ? ORDER BY timestamp`,
+		chainID, address, series, tokenAddress, fromDay)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*dailyValue
+	for rows.Next() {
+		v := &dailyValue{}
+		err := rows.Scan(&v.day, &v.value, &v.currency)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, rows.Err()
+}
+
+// invalidateDailyValues removes the values of the series for the days starting with the one of the given timestamp
+func invalidateDailyValues(db execer, chainID uint64, address common.Address, series seriesType, timestamp int64) error {
+	_, err := db.Exec("DELETE FROM balance_history WHERE chain_id = ? AND address = ? AND token_type = ? AND timestamp >= ?",
+		chainID, address, series, dayStart(timestamp))
+	return err
+}
+
+// tokenSeries is the balance history of a single token of an account
+type tokenSeries struct {
+	symbol   string
+	decimals int
+	entries  []*entry // Ordered by timestamp
+	next     int      // Index of the first entry after the current day
+}
+
+// balanceAt returns the balance at the given timestamp, it expects to be called with increasing timestamps
+func (t *tokenSeries) balanceAt(timestamp int64) *big.Int {
+	for t.next < len(t.entries) && t.entries[t.next].timestamp <= timestamp {
+		t.next++
+	}
+	if t.next == 0 {
+		return nil
+	}
+	return t.entries[t.next-1].balance
+}
+
+// portfolioValues values the token series at the end of each day from fromDay until lastDay included.
+// Days already present in cached are not computed again. The returned flags tell for each computed day
+// whether all the exchange rates were available, only those can be cached.
+func portfolioValues(series []*tokenSeries, currency string, fromDay int64, lastDay int64, cached map[int64]float64, rate rateGetter) (values []*dailyValue, complete []bool) {
+	for day := fromDay; day <= lastDay; day += secondsInADay {
+		dayEnd := day + secondsInADay - 1
+
+		value := 0.0
+		dayComplete := true
+		for _, s := range series {
+			balance := s.balanceAt(dayEnd)
+			if _, ok := cached[day]; ok || balance == nil || balance.Sign() == 0 {
+				continue
+			}
+
+			r, err := rate(s.symbol, currency, day)
+			if err != nil {
+				dayComplete = false
+				continue
+			}
+			value += tokenToValue(balance, r, big.NewFloat(math.Pow(10, float64(s.decimals))))
+		}
+
+		if cachedValue, ok := cached[day]; ok {
+			value = cachedValue
+		}
+		values = append(values, &dailyValue{day: day, value: value, currency: currency})
+		complete = append(complete, dayComplete)
+	}
+	return values, complete
+}
+
+// rateForDay returns the exchange rate for the day, fetching the missing rates once per token.
+// Rates for the current day are not final yet, the ones of the previous day are used instead.
+func (s *Service) rateForDay(tokenSymbol string, currencySymbol string, day int64) (float32, error) {
+	if strings.EqualFold(tokenSymbol, currencySymbol) {
+		return 1, nil
+	}
+
+	today := dayStart(time.Now().UTC().Unix())
+	if day >= today {
+		day = today - secondsInADay
+	}
+	date := time.Unix(day, 0).UTC()
+
+	rate, err := s.exchange.GetExchangeRateForDay(tokenSymbol, currencySymbol, date)
+	if err == nil {
+		return rate, nil
+	}
+
+	err = s.exchange.FetchAndCacheMissingRates(tokenSymbol, currencySymbol)
+	if err != nil {
+		return 0, err
+	}
+	return s.exchange.GetExchangeRateForDay(tokenSymbol, currencySymbol, date)
+}
+
+func (s *Service) getTokenSeries(chainID uint64, address common.Address) ([]*tokenSeries, error) {
+	network := s.networkManager.Find(chainID)
+	if network == nil {
+		return nil, nil
+	}
+
+	entries, err := s.balance.db.getTokenSeries(chainID, address)
+	if err != nil {
+		return nil, err
+	}
+
+	seriesByToken := make(map[common.Address]*tokenSeries)
+	var res []*tokenSeries
+	for _, e := range entries {
+		key := e.tokenAddress
+		if e.tokenType == seriesNative {
+			key = common.Address{}
+		}
+
+		series, ok := seriesByToken[key]
+		if !ok {
+			decimals := int(network.NativeCurrencyDecimals)
+			if e.tokenType != seriesNative {
+				token := s.tokenManager.FindTokenByAddress(chainID, e.tokenAddress)
+				if token == nil {
+					continue
+				}
+				decimals = int(token.Decimals)
+			}
+			series = &tokenSeries{symbol: e.tokenSymbol, decimals: decimals}
+			seriesByToken[key] = series
+			res = append(res, series)
+		}
+		series.entries = append(series.entries, e)
+	}
+	return res, nil
+}
+
+// accountPortfolioValues returns the daily portfolio value of the address on the chain since fromDay,
+// caching the values of the past days
+func (s *Service) accountPortfolioValues(chainID uint64, address common.Address, currency string, fromDay int64, today int64) ([]*dailyValue, error) {
+	series, err := s.getTokenSeries(chainID, address)
+	if err != nil || len(series) == 0 {
+		return nil, err
+	}
+
+	firstDay := today
+	for _, s := range series {
+		if day := dayStart(s.entries[0].timestamp); day < firstDay {
+			firstDay = day
+		}
+	}
+	if fromDay > firstDay {
+		firstDay = fromDay
+	}
+
+	cachedValues, err := s.balance.db.getDailyValues(chainID, address, seriesPortfolio, nil, firstDay)
+	if err != nil {
+		return nil, err
+	}
+	cached := make(map[int64]float64, len(cachedValues))
+	for _, v := range cachedValues {
+		if v.currency == currency && v.day < today {
+			cached[v.day] = v.value
+		}
+	}
+
+	values, complete := portfolioValues(series, currency, firstDay, today, cached, s.rateForDay)
+	for i, v := range values {
+		if _, ok := cached[v.day]; ok || !complete[i] || v.day >= today {
+			continue
+		}
+		err = s.balance.db.addDailyValue(chainID, address, seriesPortfolio, nil, v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// GetPortfolioValueHistory returns the daily value, in the given currency, of the native and ERC20 tokens
+// held by the addresses on the chains. The last point is the current value based on the latest exchange rates.
+func (s *Service) GetPortfolioValueHistory(ctx context.Context, chainIDs []uint64, addresses []common.Address, currencySymbol string, fromTimestamp uint64) ([]*ValuePoint, error) {
+	log.Debug("GetPortfolioValueHistory", "chainIDs", chainIDs, "address", addresses, "currencySymbol", currencySymbol, "fromTimestamp", fromTimestamp)
+
+	now := time.Now().UTC().Unix()
+	today := dayStart(now)
+	fromDay := dayStart(int64(fromTimestamp))
+	currency := strings.ToLower(currencySymbol)
+
+	totals := make(map[int64]float64)
+	for _, chainID := range chainIDs {
+		for _, address := range addresses {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			values, err := s.accountPortfolioValues(chainID, address, currency, fromDay, today)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range values {
+				totals[v.day] += v.value
+			}
+		}
+	}
+
+	res := make([]*ValuePoint, 0, len(totals))
+	for day, value := range totals {
+		timestamp := uint64(day)
+		if day == today {
+			timestamp = uint64(now)
+		}
+		res = append(res, &ValuePoint{Value: value, Timestamp: timestamp})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Timestamp < res[j].Timestamp
+	})
+	return res, nil
+}
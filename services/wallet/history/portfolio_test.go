@@ -0,0 +1,85 @@
+package history
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortfolioValues(t *testing.T) {
+	day0 := int64(1700006400) // Day start
+	day1 := day0 + secondsInADay
+	day2 := day1 + secondsInADay
+
+	eth := &tokenSeries{
+		symbol:   "ETH",
+		decimals: 18,
+		entries: []*entry{
+			{timestamp: day0 + 10, balance: new(big.Int).Mul(big.NewInt(2), big.NewInt(1e18))},
+			{timestamp: day2 + 10, balance: big.NewInt(1e18)},
+		},
+	}
+	tst := &tokenSeries{
+		symbol:   "TST",
+		decimals: 6,
+		entries: []*entry{
+			{timestamp: day1 + 10, balance: big.NewInt(5e6)},
+		},
+	}
+
+	rates := map[string]map[int64]float32{
+		"ETH": {day0: 1000, day1: 1100, day2: 1200},
+		"TST": {day1: 2},
+	}
+	rate := func(token string, currency string, day int64) (float32, error) {
+		require.Equal(t, "usd", currency)
+		r, ok := rates[token][day]
+		if !ok {
+			return 0, errors.New("missing rate")
+		}
+		return r, nil
+	}
+
+	values, complete := portfolioValues([]*tokenSeries{eth, tst}, "usd", day0, day2, nil, rate)
+	require.Len(t, values, 3)
+	require.Equal(t, day0, values[0].day)
+	require.Equal(t, 2000.0, values[0].value)
+	require.Equal(t, 2210.0, values[1].value)
+	// TST rate is missing for day2
+	require.Equal(t, 1200.0, values[2].value)
+	require.Equal(t, []bool{true, true, false}, complete)
+
+	// Cached days are not valued again
+	eth.next, tst.next = 0, 0
+	values, _ = portfolioValues([]*tokenSeries{eth, tst}, "usd", day0, day1, map[int64]float64{day0: 42}, rate)
+	require.Equal(t, 42.0, values[0].value)
+	require.Equal(t, 2210.0, values[1].value)
+}
+
+func TestDailyValues(t *testing.T) {
+	_, balanceDB, close := setupTestBalanceDB(t)
+	defer close()
+
+	day := int64(1700006400)
+	for i := int64(0); i < 3; i++ {
+		require.NoError(t, balanceDB.addDailyValue(testChainID, testAccount, seriesPortfolio, nil, &dailyValue{
+			day:      day + i*secondsInADay,
+			value:    float64(i),
+			currency: "usd",
+		}))
+	}
+
+	values, err := balanceDB.getDailyValues(testChainID, testAccount, seriesPortfolio, nil, day+secondsInADay)
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+	require.Equal(t, 1.0, values[0].value)
+
+	// Balances changing in the middle of a day invalidate the values from that day on
+	require.NoError(t, invalidateDailyValues(balanceDB.db, testChainID, testAccount, seriesPortfolio, day+secondsInADay+100))
+	values, err = balanceDB.getDailyValues(testChainID, testAccount, seriesPortfolio, nil, 0)
+	require.NoError(t, err)
+	require.Len(t, values, 1)
+	require.Equal(t, day, values[0].day)
+}
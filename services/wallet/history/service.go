@@ -24,6 +24,7 @@ import (
 
 	"github.com/status-im/status-go/services/wallet/balance"
 	"github.com/status-im/status-go/services/wallet/market"
+	"github.com/status-im/status-go/services/wallet/thirdparty"
 	"github.com/status-im/status-go/services/wallet/token"
 	"github.com/status-im/status-go/services/wallet/transfer"
 	"github.com/status-im/status-go/services/wallet/walletevent"
@@ -56,19 +57,22 @@ type Service struct {
 	transferWatcher *Watcher
 	exchange        *Exchange
 	balanceCache    balance.CacheIface
+
+	floorPriceProviders []thirdparty.CollectionFloorPriceProvider
 }
 
-func NewService(db *sql.DB, accountsDB *accounts.Database, eventFeed *event.Feed, rpcClient *statusrpc.Client, tokenManager *token.Manager, marketManager *market.Manager, balanceCache balance.CacheIface) *Service {
+func NewService(db *sql.DB, accountsDB *accounts.Database, eventFeed *event.Feed, rpcClient *statusrpc.Client, tokenManager *token.Manager, marketManager *market.Manager, balanceCache balance.CacheIface, floorPriceProviders []thirdparty.CollectionFloorPriceProvider) *Service {
 	return &Service{
-		balance:        NewBalance(NewBalanceDB(db)),
-		db:             db,
-		accountsDB:     accountsDB,
-		eventFeed:      eventFeed,
-		rpcClient:      rpcClient,
-		networkManager: rpcClient.NetworkManager,
-		tokenManager:   tokenManager,
-		exchange:       NewExchange(marketManager),
-		balanceCache:   balanceCache,
+		balance:             NewBalance(NewBalanceDB(db)),
+		db:                  db,
+		accountsDB:          accountsDB,
+		eventFeed:           eventFeed,
+		rpcClient:           rpcClient,
+		networkManager:      rpcClient.NetworkManager,
+		tokenManager:        tokenManager,
+		exchange:            NewExchange(marketManager),
+		balanceCache:        balanceCache,
+		floorPriceProviders: floorPriceProviders,
 	}
 }
 
@@ -360,7 +364,8 @@ func tokenToValue(tokenCount *big.Int, mainDenominationValue float32, weisInOneM
 }
 
 // updateBalanceHistory iterates over all networks depending on test/prod for the s.visibleTokenSymbol
-// and updates the balance history for the given address
+// and updates the balance history for the given address. Native balances are fetched at transfer blocks,
+// token and collectible balances are rebuilt from the transfers.
 //
 // expects ctx to have cancellation support and processing to be cancelled by the caller
 func (s *Service) updateBalanceHistory(ctx context.Context) error {
@@ -408,6 +413,17 @@ func (s *Service) updateBalanceHistory(ctx context.Context) error {
 			if err != nil {
 				return err
 			}
+
+			err = s.balance.db.updateTokenBalances(network.ChainID, common.Address(address), s.tokenSymbol)
+			if err != nil {
+				log.Error("Error updating token balances", "chainID", network.ChainID, "address", address.String(), "err", err)
+				return err
+			}
+
+			err = s.sampleFloorPrices(ctx, network.ChainID, common.Address(address))
+			if err != nil {
+				return err
+			}
 		}
 		s.triggerEvent(EventBalanceHistoryUpdateFinished, address, "")
 	}
@@ -416,44 +432,23 @@ func (s *Service) updateBalanceHistory(ctx context.Context) error {
 	return nil
 }
 
+// addEntriesToDB fetches and stores the native balance of the address at the blocks of the entries
 func (s *Service) addEntriesToDB(ctx context.Context, client chain.ClientInterface, network *params.Network, address statustypes.Address, entries []*entry) (err error) {
+	var firstTimestamp int64
 	for _, entry := range entries {
-		var balance *big.Int
-		// tokenAddess is zero for native currency
-		if (entry.tokenAddress == common.Address{}) {
-			// Check in cache
-			balance = s.balanceCache.GetBalance(common.Address(address), network.ChainID, entry.block)
-			log.Debug("Balance from cache", "chainID", network.ChainID, "address", address.String(), "block", entry.block, "balance", balance)
+		// Check in cache
+		balance := s.balanceCache.GetBalance(common.Address(address), network.ChainID, entry.block)
+		log.Debug("Balance from cache", "chainID", network.ChainID, "address", address.String(), "block", entry.block, "balance", balance)
 
+		if balance == nil {
+			balance, err = client.BalanceAt(ctx, common.Address(address), entry.block)
 			if balance == nil {
-				balance, err = client.BalanceAt(ctx, common.Address(address), entry.block)
-				if balance == nil {
-					log.Error("Error getting balance", "chainID", network.ChainID, "address", address.String(), "err", err, "unwrapped", errors.Unwrap(err))
-					return err
-				}
-				time.Sleep(50 * time.Millisecond) // TODO Remove this sleep after fixing exceeding rate limit
-			}
-			entry.tokenSymbol = network.NativeCurrencySymbol
-		} else {
-			// Check token first if it is supported
-			token := s.tokenManager.FindTokenByAddress(network.ChainID, entry.tokenAddress)
-			if token == nil {
-				log.Warn("Token not found", "chainID", network.ChainID, "address", address.String(), "tokenAddress", entry.tokenAddress.String())
-				// TODO Add "supported=false" flag to such tokens to avoid checking them again and again
-				continue // Skip token that we don't have symbol for. For example we don't have tokens in store for goerli optimism
-			} else {
-				entry.tokenSymbol = token.Symbol
-			}
-
-			// Check balance for token
-			balance, err = s.tokenManager.GetTokenBalanceAt(ctx, client, common.Address(address), entry.tokenAddress, entry.block)
-			log.Debug("Balance from token manager", "chainID", network.ChainID, "address", address.String(), "block", entry.block, "balance", balance)
-
-			if err != nil {
-				log.Error("Error getting token balance", "chainID", network.ChainID, "address", address.String(), "tokenAddress", entry.tokenAddress.String(), "err", err)
+				log.Error("Error getting balance", "chainID", network.ChainID, "address", address.String(), "err", err, "unwrapped", errors.Unwrap(err))
 				return err
 			}
+			time.Sleep(50 * time.Millisecond) // TODO Remove this sleep after fixing exceeding rate limit
 		}
+		entry.tokenSymbol = network.NativeCurrencySymbol
 
 		entry.balance = balance
 		err = s.balance.db.add(entry)
@@ -461,11 +456,28 @@ func (s *Service) addEntriesToDB(ctx context.Context, client chain.ClientInterfa
 			log.Error("Error adding balance", "chainID", network.ChainID, "address", address.String(), "err", err)
 			return err
 		}
+
+		if firstTimestamp == 0 || entry.timestamp < firstTimestamp {
+			firstTimestamp = entry.timestamp
+		}
 	}
 
+	if firstTimestamp > 0 {
+		// Portfolio values of the following days are outdated
+		return invalidateDailyValues(s.db, network.ChainID, common.Address(address), seriesPortfolio, firstTimestamp)
+	}
 	return nil
 }
 
+func (s *Service) tokenSymbol(chainID uint64, tokenAddress common.Address) string {
+	token := s.tokenManager.FindTokenByAddress(chainID, tokenAddress)
+	if token == nil {
+		// Balance is kept without symbol, it's not part of the symbol based and portfolio histories
+		return ""
+	}
+	return token.Symbol
+}
+
 func (s *Service) startTransfersWatcher() {
 	if s.transferWatcher != nil {
 		return
@@ -506,6 +518,12 @@ func (s *Service) startTransfersWatcher() {
 				continue
 			}
 
+			err = s.balance.db.updateTokenBalances(chainID, address, s.tokenSymbol)
+			if err != nil {
+				log.Error("Error updating token balances", "chainID", chainID, "address", address.String(), "err", err)
+				continue
+			}
+
 			// No event triggering here, because noone cares about balance history updates yet
 		}
 	}
@@ -544,11 +562,10 @@ func transfersToEntries(address common.Address, block *big.Int, transfers []tran
 			panic("Block number mismatch") // coding error
 		}
 		entry := &entry{
-			chainID:      transfer.NetworkID,
-			address:      transfer.Address,
-			tokenAddress: transfer.Receipt.ContractAddress,
-			block:        transfer.BlockNumber,
-			timestamp:    (int64)(transfer.Timestamp),
+			chainID:   transfer.NetworkID,
+			address:   transfer.Address,
+			block:     transfer.BlockNumber,
+			timestamp: (int64)(transfer.Timestamp),
 		}
 
 		entries = append(entries, entry)
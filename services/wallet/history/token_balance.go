@@ -0,0 +1,286 @@
+package history
+
+import (
+	"database/sql"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/services/wallet/bigint"
+	w_common "github.com/status-im/status-go/services/wallet/common"
+)
+
+// symbolResolver returns the symbol of a token or empty string if the token is unknown
+type symbolResolver func(chainID uint64, tokenAddress common.Address) string
+
+// movement is the balance change of a token caused by a single transfer
+type movement struct {
+	tokenType seriesType
+	block     *big.Int
+	timestamp int64
+	amount    *big.Int // Negative for outgoing transfers
+}
+
+// transferSeriesType maps a transfer type to the series its balance belongs to.
+// ERC721 transfers share their event signature with ERC20 ones and might be stored as such,
+// they can still be told apart by the token ID.
+func transferSeriesType(transferType w_common.Type, hasTokenID bool) seriesType {
+	switch transferType {
+	case w_common.Erc721Transfer:
+		return seriesERC721
+	case w_common.Erc1155Transfer:
+		return seriesERC1155
+	}
+	if hasTokenID {
+		return seriesERC721
+	}
+	return seriesERC20
+}
+
+func parsePadded128Hex(value sql.NullString) *big.Int {
+	amount := new(big.Int)
+	if value.Valid {
+		if _, ok := amount.SetString(value.String, 16); !ok {
+			amount.SetInt64(0)
+		}
+	}
+	return amount
+}
+
+// getTokensWithNewTransfers returns, for each token transferred by the address, the first block
+// with transfers that are not reflected in the balance history yet
+func (b *BalanceDB) getTokensWithNewTransfers(chainID uint64, address common.Address) (map[common.Address]*big.Int, error) {
+	rows, err := b.db.Query(`SELECT tr.token_address, MIN(tr.blk_number) FROM transfers tr
+		WHERE tr.network_id = ? AND tr.address = ? AND tr.type IN (?, ?, ?) AND tr.token_address IS NOT NULL
+		AND NOT EXISTS (
+			SELECT 1 FROM balance_history bh
+			WHERE bh.chain_id = tr.network_id AND bh.address = tr.address AND bh.token_type IN (?, ?, ?)
+			AND bh.token_address = tr.token_address AND bh.block = tr.blk_number
+		)
+		GROUP BY tr.token_address`,
+		chainID, address, w_common.Erc20Transfer, w_common.Erc721Transfer, w_common.Erc1155Transfer,
+		seriesERC20, seriesERC721, seriesERC1155)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make(map[common.Address]*big.Int)
+	for rows.Next() {
+		var tokenAddress []byte
+		block := new(big.Int)
+		err := rows.Scan(&tokenAddress, (*bigint.SQLBigInt)(block))
+		if err != nil {
+			return nil, err
+		}
+		res[common.BytesToAddress(tokenAddress)] = block
+	}
+	return res, rows.Err()
+}
+
+// getMovements returns the balance changes of the token for the address starting from the given block
+func (b *BalanceDB) getMovements(tx *sql.Tx, chainID uint64, address common.Address, tokenAddress common.Address, fromBlock *big.Int) ([]*movement, error) {
+	rows, err := tx.Query(`SELECT type, token_id IS NOT NULL, blk_number, timestamp, amount_padded128hex, tx_from_address, tx_to_address
+		FROM transfers WHERE network_id = ? AND address = ? AND token_address = ? AND type IN (?, ?, ?) AND blk_number >= ?
+		ORDER BY blk_number`,
+		chainID, address, tokenAddress, w_common.Erc20Transfer, w_common.Erc721Transfer, w_common.Erc1155Transfer,
+		(*bigint.SQLBigInt)(fromBlock))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*movement
+	for rows.Next() {
+		var (
+			transferType string
+			hasTokenID   bool
+			amountHex    sql.NullString
+			from, to     []byte
+		)
+		m := &movement{block: new(big.Int)}
+		err := rows.Scan(&transferType, &hasTokenID, (*bigint.SQLBigInt)(m.block), &m.timestamp, &amountHex, &from, &to)
+		if err != nil {
+			return nil, err
+		}
+
+		m.tokenType = transferSeriesType(w_common.Type(transferType), hasTokenID)
+		if m.tokenType == seriesERC721 {
+			m.amount = big.NewInt(1)
+		} else {
+			m.amount = parsePadded128Hex(amountHex)
+		}
+
+		incoming := to != nil && common.BytesToAddress(to) == address
+		outgoing := from != nil && common.BytesToAddress(from) == address
+		switch {
+		case incoming && outgoing:
+			m.amount.SetInt64(0)
+		case outgoing:
+			m.amount.Neg(m.amount)
+		case !incoming:
+			// Transfers not involving the address directly, e.g. ERC1155 operator transfers
+			m.amount.SetInt64(0)
+		}
+		res = append(res, m)
+	}
+	return res, rows.Err()
+}
+
+// getTokenBalancePreviousTo returns the balance of the token before the given block, zero if there is none
+func (b *BalanceDB) getTokenBalancePreviousTo(tx *sql.Tx, chainID uint64, address common.Address, tokenAddress common.Address, block *big.Int) (*big.Int, error) {
+	balance := new(big.Int)
+	err := tx.QueryRow(`SELECT balance FROM balance_history WHERE chain_id = ? AND address = ? AND token_type IN (?, ?, ?)
+		AND token_address = ? AND block < ? ORDER BY block DESC LIMIT 1`,
+		chainID, address, seriesERC20, seriesERC721, seriesERC1155, tokenAddress, (*bigint.SQLBigInt)(block)).Scan((*bigint.SQLBigIntBytes)(balance))
+	if err == sql.ErrNoRows {
+		return balance, nil
+	}
+	return balance, err
+}
+
+// rebuildTokenBalances replaces the balances of the token from the given block on with the ones computed
+// from the stored transfers. It returns the timestamp of the first replaced balance or zero if none changed.
+func (b *BalanceDB) rebuildTokenBalances(chainID uint64, address common.Address, tokenAddress common.Address, symbol string, fromBlock *big.Int) (firstTimestamp int64, err error) {
+	tx, err := b.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	balance, err := b.getTokenBalancePreviousTo(tx, chainID, address, tokenAddress, fromBlock)
+	if err != nil {
+		return 0, err
+	}
+
+	movements, err := b.getMovements(tx, chainID, address, tokenAddress, fromBlock)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec("DELETE FROM balance_history WHERE chain_id = ? AND address = ? AND token_type IN (?, ?, ?) AND token_address = ? AND block >= ?",
+		chainID, address, seriesERC20, seriesERC721, seriesERC1155, tokenAddress, (*bigint.SQLBigInt)(fromBlock))
+	if err != nil {
+		return 0, err
+	}
+
+	insert, err := tx.Prepare(`INSERT OR REPLACE INTO balance_history (chain_id, address, currency, token_type, token_address, block, timestamp, balance)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return 0, err
+	}
+	defer insert.Close()
+
+	for i := 0; i < len(movements); {
+		// Several transfers can happen in the same block, only the resulting balance is kept
+		m := movements[i]
+		for ; i < len(movements) && movements[i].block.Cmp(m.block) == 0; i++ {
+			balance.Add(balance, movements[i].amount)
+		}
+
+		if balance.Sign() < 0 {
+			// Older transfers are not loaded yet, the balance gets rebuilt once they are
+			log.Debug("Negative token balance", "chainID", chainID, "address", address, "tokenAddress", tokenAddress, "block", m.block)
+			balance.SetInt64(0)
+		}
+
+		currency := ""
+		if m.tokenType == seriesERC20 {
+			currency = symbol
+		}
+		_, err = insert.Exec(chainID, address, currency, m.tokenType, tokenAddress, (*bigint.SQLBigInt)(m.block), m.timestamp, (*bigint.SQLBigIntBytes)(balance))
+		if err != nil {
+			return 0, err
+		}
+
+		if firstTimestamp == 0 || m.timestamp < firstTimestamp {
+			firstTimestamp = m.timestamp
+		}
+	}
+
+	if firstTimestamp > 0 {
+		err = invalidateDailyValues(tx, chainID, address, seriesPortfolio, firstTimestamp)
+	}
+	return firstTimestamp, err
+}
+
+// updateTokenBalances rebuilds the token and collectible balances of the address affected by transfers
+// that are not reflected in the balance history yet
+func (b *BalanceDB) updateTokenBalances(chainID uint64, address common.Address, resolveSymbol symbolResolver) error {
+	tokens, err := b.getTokensWithNewTransfers(chainID, address)
+	if err != nil {
+		return err
+	}
+
+	for tokenAddress, fromBlock := range tokens {
+		_, err = b.rebuildTokenBalances(chainID, address, tokenAddress, resolveSymbol(chainID, tokenAddress), fromBlock)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTokenSeries returns the native and ERC20 balances of the address with a known symbol, ordered by timestamp
+func (b *BalanceDB) getTokenSeries(chainID uint64, address common.Address) ([]*entry, error) {
+	rows, err := b.db.Query(`SELECT currency, token_type, token_address, block, timestamp, balance FROM balance_history
+		WHERE chain_id = ? AND address = ? AND token_type IN (?, ?) AND currency != '' ORDER BY timestamp`,
+		chainID, address, seriesNative, seriesERC20)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*entry
+	for rows.Next() {
+		var tokenAddress []byte
+		e := &entry{
+			chainID: chainID,
+			address: address,
+			block:   new(big.Int),
+			balance: new(big.Int),
+		}
+		err := rows.Scan(&e.tokenSymbol, &e.tokenType, &tokenAddress, (*bigint.SQLBigInt)(e.block), &e.timestamp, (*bigint.SQLBigIntBytes)(e.balance))
+		if err != nil {
+			return nil, err
+		}
+		e.tokenAddress = common.BytesToAddress(tokenAddress)
+		res = append(res, e)
+	}
+	return res, rows.Err()
+}
+
+// getOwnedCollections returns the collectible contracts the address currently holds items of
+func (b *BalanceDB) getOwnedCollections(chainID uint64, address common.Address) ([]common.Address, error) {
+	rows, err := b.db.Query(`SELECT bh.token_address, bh.balance FROM balance_history bh
+		WHERE bh.chain_id = ? AND bh.address = ? AND bh.token_type IN (?, ?) AND bh.block = (
+			SELECT MAX(last.block) FROM balance_history last
+			WHERE last.chain_id = bh.chain_id AND last.address = bh.address AND last.token_type = bh.token_type AND last.token_address = bh.token_address
+		)`,
+		chainID, address, seriesERC721, seriesERC1155)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []common.Address
+	for rows.Next() {
+		var tokenAddress []byte
+		balance := new(big.Int)
+		err := rows.Scan(&tokenAddress, (*bigint.SQLBigIntBytes)(balance))
+		if err != nil {
+			return nil, err
+		}
+		if balance.Sign() > 0 {
+			res = append(res, common.BytesToAddress(tokenAddress))
+		}
+	}
+	return res, rows.Err()
+}
@@ -0,0 +1,175 @@
+package history
+
+import (
+	"database/sql"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/services/wallet/bigint"
+	w_common "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/transfer"
+	"github.com/status-im/status-go/t/helpers"
+	"github.com/status-im/status-go/walletdatabase"
+)
+
+const testChainID = 5
+
+var (
+	testAccount = common.HexToAddress("0x1")
+	testOther   = common.HexToAddress("0x2")
+	testERC20   = common.HexToAddress("0x100")
+	testERC721  = common.HexToAddress("0x200")
+)
+
+func setupTestBalanceDB(t *testing.T) (*sql.DB, *BalanceDB, func()) {
+	db, err := helpers.SetupTestMemorySQLDB(walletdatabase.DbInitializer{})
+	require.NoError(t, err)
+	return db, NewBalanceDB(db), func() {
+		require.NoError(t, db.Close())
+	}
+}
+
+var testTransferCounter int64
+
+func insertTokenTransfer(t *testing.T, db *sql.DB, from, to common.Address, tokenAddress common.Address, tokenID *big.Int, value int64, block int64) {
+	testTransferCounter++
+	tr := &transfer.TestTransfer{
+		TestTransaction: transfer.TestTransaction{
+			Hash:      common.BigToHash(big.NewInt(testTransferCounter)),
+			ChainID:   w_common.ChainID(testChainID),
+			From:      from,
+			Timestamp: block * 100,
+			BlkNumber: block,
+			Success:   true,
+		},
+		To:    to,
+		Value: value,
+	}
+	transfer.InsertTestTransferWithOptions(t, db, testAccount, tr, &transfer.TestTransferOptions{
+		TokenAddress: tokenAddress,
+		TokenID:      tokenID,
+	})
+}
+
+func testSymbols(chainID uint64, tokenAddress common.Address) string {
+	if tokenAddress == testERC20 {
+		return "TST"
+	}
+	return ""
+}
+
+type balanceRow struct {
+	block   int64
+	balance int64
+}
+
+func getTokenBalances(t *testing.T, db *sql.DB, tokenAddress common.Address) (rows []balanceRow, currency string, tokenType string) {
+	res, err := db.Query("SELECT block, balance, currency, token_type FROM balance_history WHERE chain_id = ? AND address = ? AND token_address = ? ORDER BY block",
+		testChainID, testAccount, tokenAddress)
+	require.NoError(t, err)
+	defer res.Close()
+
+	for res.Next() {
+		var block int64
+		balance := new(big.Int)
+		require.NoError(t, res.Scan(&block, (*bigint.SQLBigIntBytes)(balance), &currency, &tokenType))
+		rows = append(rows, balanceRow{block, balance.Int64()})
+	}
+	return rows, currency, tokenType
+}
+
+func TestUpdateTokenBalances(t *testing.T) {
+	db, balanceDB, close := setupTestBalanceDB(t)
+	defer close()
+
+	insertTokenTransfer(t, db, testOther, testAccount, testERC20, nil, 100, 10)
+	insertTokenTransfer(t, db, testAccount, testOther, testERC20, nil, 30, 20)
+	// Two transfers in the same block
+	insertTokenTransfer(t, db, testOther, testAccount, testERC20, nil, 5, 30)
+	insertTokenTransfer(t, db, testAccount, testAccount, testERC20, nil, 50, 30)
+
+	require.NoError(t, balanceDB.updateTokenBalances(testChainID, testAccount, testSymbols))
+
+	rows, currency, tokenType := getTokenBalances(t, db, testERC20)
+	require.Equal(t, []balanceRow{{10, 100}, {20, 70}, {30, 75}}, rows)
+	require.Equal(t, "TST", currency)
+	require.Equal(t, seriesERC20, tokenType)
+
+	// Nothing to do without new transfers
+	tokens, err := balanceDB.getTokensWithNewTransfers(testChainID, testAccount)
+	require.NoError(t, err)
+	require.Len(t, tokens, 0)
+
+	// Older transfers loaded later rebuild the following balances
+	insertTokenTransfer(t, db, testOther, testAccount, testERC20, nil, 1, 15)
+	require.NoError(t, balanceDB.updateTokenBalances(testChainID, testAccount, testSymbols))
+
+	rows, _, _ = getTokenBalances(t, db, testERC20)
+	require.Equal(t, []balanceRow{{10, 100}, {15, 101}, {20, 71}, {30, 76}}, rows)
+
+	entries, err := balanceDB.getTokenSeries(testChainID, testAccount)
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+	require.Equal(t, "TST", entries[0].tokenSymbol)
+	require.Equal(t, testERC20, entries[0].tokenAddress)
+}
+
+func TestUpdateTokenBalancesMissingHistory(t *testing.T) {
+	db, balanceDB, close := setupTestBalanceDB(t)
+	defer close()
+
+	// The incoming transfer is not loaded yet
+	insertTokenTransfer(t, db, testAccount, testOther, testERC20, nil, 30, 20)
+	require.NoError(t, balanceDB.updateTokenBalances(testChainID, testAccount, testSymbols))
+
+	rows, _, _ := getTokenBalances(t, db, testERC20)
+	require.Equal(t, []balanceRow{{20, 0}}, rows)
+
+	insertTokenTransfer(t, db, testOther, testAccount, testERC20, nil, 100, 10)
+	require.NoError(t, balanceDB.updateTokenBalances(testChainID, testAccount, testSymbols))
+
+	rows, _, _ = getTokenBalances(t, db, testERC20)
+	require.Equal(t, []balanceRow{{10, 100}, {20, 70}}, rows)
+}
+
+func TestUpdateCollectibleBalances(t *testing.T) {
+	db, balanceDB, close := setupTestBalanceDB(t)
+	defer close()
+
+	insertTokenTransfer(t, db, testOther, testAccount, testERC721, big.NewInt(1), 0, 10)
+	insertTokenTransfer(t, db, testOther, testAccount, testERC721, big.NewInt(2), 0, 20)
+	require.NoError(t, balanceDB.updateTokenBalances(testChainID, testAccount, testSymbols))
+
+	rows, currency, tokenType := getTokenBalances(t, db, testERC721)
+	require.Equal(t, []balanceRow{{10, 1}, {20, 2}}, rows)
+	require.Equal(t, "", currency)
+	require.Equal(t, seriesERC721, tokenType)
+
+	collections, err := balanceDB.getOwnedCollections(testChainID, testAccount)
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{testERC721}, collections)
+
+	insertTokenTransfer(t, db, testAccount, testOther, testERC721, big.NewInt(1), 0, 30)
+	insertTokenTransfer(t, db, testAccount, testOther, testERC721, big.NewInt(2), 0, 30)
+	require.NoError(t, balanceDB.updateTokenBalances(testChainID, testAccount, testSymbols))
+
+	collections, err = balanceDB.getOwnedCollections(testChainID, testAccount)
+	require.NoError(t, err)
+	require.Len(t, collections, 0)
+
+	// Collectibles are not part of the symbol based history
+	entries, err := balanceDB.getTokenSeries(testChainID, testAccount)
+	require.NoError(t, err)
+	require.Len(t, entries, 0)
+}
+
+func TestTransferSeriesType(t *testing.T) {
+	require.Equal(t, seriesERC20, transferSeriesType(w_common.Erc20Transfer, false))
+	require.Equal(t, seriesERC721, transferSeriesType(w_common.Erc20Transfer, true))
+	require.Equal(t, seriesERC721, transferSeriesType(w_common.Erc721Transfer, true))
+	require.Equal(t, seriesERC1155, transferSeriesType(w_common.Erc1155Transfer, true))
+}
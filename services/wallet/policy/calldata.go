@@ -0,0 +1,113 @@
+package policy
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/transactions"
+)
+
+var (
+	// transfer(address,uint256)
+	erc20TransferSelector = []byte{0xa9, 0x05, 0x9c, 0xbb}
+	// approve(address,uint256)
+	erc20ApproveSelector = []byte{0x09, 0x5e, 0xa7, 0xb3}
+	// setApprovalForAll(address,bool)
+	setApprovalForAllSelector = []byte{0xa2, 0x2c, 0xb4, 0x65}
+	// transferFrom(address,address,uint256), shared by ERC20 and ERC721
+	transferFromSelector = []byte{0x23, 0xb8, 0x72, 0xdd}
+	// safeTransferFrom(address,address,uint256)
+	erc721SafeTransferFromSelector = []byte{0x42, 0x84, 0x2e, 0x0e}
+	// safeTransferFrom(address,address,uint256,bytes)
+	erc721SafeTransferFromWithDataSelector = []byte{0xb8, 0x8d, 0x4f, 0xde}
+	// safeTransferFrom(address,address,uint256,uint256,bytes)
+	erc1155SafeTransferFromSelector = []byte{0xf2, 0x42, 0x43, 0x2a}
+	// safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)
+	erc1155SafeBatchTransferFromSelector = []byte{0x2e, 0xb2, 0xc2, 0xd6}
+)
+
+// Token calls grouped by the position of the recipient or spender in their arguments
+var (
+	beneficiaryFirstSelectors  = [][]byte{erc20TransferSelector, erc20ApproveSelector, setApprovalForAllSelector}
+	beneficiarySecondSelectors = [][]byte{transferFromSelector, erc721SafeTransferFromSelector,
+		erc721SafeTransferFromWithDataSelector, erc1155SafeTransferFromSelector, erc1155SafeBatchTransferFromSelector}
+)
+
+// decodedCall is an outgoing transaction with the token call it makes, if any
+type decodedCall struct {
+	tx transactions.OutgoingTx
+	// beneficiary is the recipient or spender of a token call
+	beneficiary *common.Address
+	// tokenAmount is the amount moved by a fungible token transfer, nil for other calls
+	tokenAmount *big.Int
+}
+
+func argumentAddress(data []byte, index int) *common.Address {
+	start := 4 + 32*index
+	if len(data) < start+32 {
+		return nil
+	}
+	address := common.BytesToAddress(data[start : start+32])
+	return &address
+}
+
+func argumentUint(data []byte, index int) *big.Int {
+	start := 4 + 32*index
+	if len(data) < start+32 {
+		return nil
+	}
+	return new(big.Int).SetBytes(data[start : start+32])
+}
+
+func hasSelector(data []byte, selectors [][]byte) bool {
+	for _, selector := range selectors {
+		if bytes.HasPrefix(data, selector) {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeCall(tx transactions.OutgoingTx) *decodedCall {
+	call := &decodedCall{tx: tx}
+	if tx.To == nil || len(tx.Data) < 4 {
+		return call
+	}
+
+	switch {
+	case hasSelector(tx.Data, beneficiaryFirstSelectors):
+		call.beneficiary = argumentAddress(tx.Data, 0)
+		if bytes.HasPrefix(tx.Data, erc20TransferSelector) {
+			call.tokenAmount = argumentUint(tx.Data, 1)
+		}
+	case hasSelector(tx.Data, beneficiarySecondSelectors):
+		call.beneficiary = argumentAddress(tx.Data, 1)
+		if bytes.HasPrefix(tx.Data, transferFromSelector) {
+			// Only valued when the contract is a known ERC20 token, ERC721 token IDs are not amounts
+			call.tokenAmount = argumentUint(tx.Data, 2)
+		}
+	}
+	return call
+}
+
+// recipient is the address receiving the funds or rights, the contract itself for unknown calls
+func (c *decodedCall) recipient() *common.Address {
+	if c.beneficiary != nil {
+		return c.beneficiary
+	}
+	return c.tx.To
+}
+
+// parties are the recipient and, for token calls, the token contract
+func (c *decodedCall) parties() []common.Address {
+	var parties []common.Address
+	if c.tx.To != nil {
+		parties = append(parties, *c.tx.To)
+	}
+	if c.beneficiary != nil && (c.tx.To == nil || *c.beneficiary != *c.tx.To) {
+		parties = append(parties, *c.beneficiary)
+	}
+	return parties
+}
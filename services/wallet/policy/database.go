@@ -0,0 +1,144 @@
+package policy
+
+import (
+	"database/sql"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type Persistence struct {
+	db *sql.DB
+}
+
+func NewPersistence(db *sql.DB) *Persistence {
+	return &Persistence{db: db}
+}
+
+// GetPolicy returns the policy of the account or nil if it has none
+func (p *Persistence) GetPolicy(address common.Address) (*Policy, error) {
+	policy := &Policy{Address: address}
+	var dailyLimit, perTxLimit sql.NullFloat64
+	err := p.db.QueryRow(`SELECT currency, daily_limit, per_tx_limit, confirm_new_recipients FROM spending_policies WHERE address = ?`, address).
+		Scan(&policy.Currency, &dailyLimit, &perTxLimit, &policy.ConfirmNewRecipients)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if dailyLimit.Valid {
+		policy.DailyLimit = &dailyLimit.Float64
+	}
+	if perTxLimit.Valid {
+		policy.PerTxLimit = &perTxLimit.Float64
+	}
+
+	rows, err := p.db.Query(`SELECT list_type, entry FROM spending_policy_lists WHERE address = ?`, address)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var listType ListType
+		var entry common.Address
+		if err = rows.Scan(&listType, &entry); err != nil {
+			return nil, err
+		}
+		switch listType {
+		case AllowList:
+			policy.AllowList = append(policy.AllowList, entry)
+		case DenyList:
+			policy.DenyList = append(policy.DenyList, entry)
+		}
+	}
+
+	return policy, rows.Err()
+}
+
+// SavePolicy creates or replaces the policy of the account
+func (p *Persistence) SavePolicy(policy *Policy) (err error) {
+	tx, err := p.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	var dailyLimit, perTxLimit sql.NullFloat64
+	if policy.DailyLimit != nil {
+		dailyLimit = sql.NullFloat64{Float64: *policy.DailyLimit, Valid: true}
+	}
+	if policy.PerTxLimit != nil {
+		perTxLimit = sql.NullFloat64{Float64: *policy.PerTxLimit, Valid: true}
+	}
+
+	_, err = tx.Exec(`INSERT OR REPLACE INTO spending_policies (address, currency, daily_limit, per_tx_limit, confirm_new_recipients) VALUES (?, ?, ?, ?, ?)`,
+		policy.Address, policy.Currency, dailyLimit, perTxLimit, policy.ConfirmNewRecipients)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`DELETE FROM spending_policy_lists WHERE address = ?`, policy.Address)
+	if err != nil {
+		return err
+	}
+
+	insert, err := tx.Prepare(`INSERT OR IGNORE INTO spending_policy_lists (address, list_type, entry) VALUES (?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer insert.Close()
+
+	for listType, entries := range map[ListType][]common.Address{AllowList: policy.AllowList, DenyList: policy.DenyList} {
+		for _, entry := range entries {
+			if _, err = insert.Exec(policy.Address, listType, entry); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *Persistence) DeletePolicy(address common.Address) error {
+	_, err := p.db.Exec(`DELETE FROM spending_policies WHERE address = ?`, address)
+	return err
+}
+
+func (p *Persistence) IsKnownRecipient(address common.Address, recipient common.Address) (bool, error) {
+	var exists bool
+	err := p.db.QueryRow(`SELECT EXISTS (SELECT 1 FROM spending_policy_known_recipients WHERE address = ? AND recipient = ?)`,
+		address, recipient).Scan(&exists)
+	return exists, err
+}
+
+func (p *Persistence) AddKnownRecipient(address common.Address, recipient common.Address) error {
+	_, err := p.db.Exec(`INSERT OR IGNORE INTO spending_policy_known_recipients (address, recipient) VALUES (?, ?)`, address, recipient)
+	return err
+}
+
+// RecordSpending stores the fiat value of a sent transaction, it is counted in the daily limit
+func (p *Persistence) RecordSpending(chainID uint64, txHash common.Hash, address common.Address, value float64, currency string, timestamp int64) error {
+	_, err := p.db.Exec(`INSERT OR IGNORE INTO spending_policy_ledger (chain_id, tx_hash, address, fiat_value, currency, timestamp) VALUES (?, ?, ?, ?, ?, ?)`,
+		chainID, txHash, address, value, currency, timestamp)
+	return err
+}
+
+// GetSpending returns the total value in currency sent by the account since the given timestamp
+func (p *Persistence) GetSpending(address common.Address, currency string, since int64) (float64, error) {
+	var total float64
+	err := p.db.QueryRow(`SELECT COALESCE(SUM(fiat_value), 0) FROM spending_policy_ledger WHERE address = ? AND currency = ? AND timestamp >= ?`,
+		address, currency, since).Scan(&total)
+	return total, err
+}
+
+// DeleteSpendingBefore removes ledger entries too old to be counted in a daily limit
+func (p *Persistence) DeleteSpendingBefore(timestamp int64) error {
+	_, err := p.db.Exec(`DELETE FROM spending_policy_ledger WHERE timestamp < ?`, timestamp)
+	return err
+}
@@ -0,0 +1,105 @@
+package policy
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/status-im/status-go/t/helpers"
+	"github.com/status-im/status-go/walletdatabase"
+)
+
+func setupTestDB(t *testing.T) (db *sql.DB, close func()) {
+	db, err := helpers.SetupTestMemorySQLDB(walletdatabase.DbInitializer{})
+	require.NoError(t, err)
+	return db, func() {
+		require.NoError(t, db.Close())
+	}
+}
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+func TestSaveAndGetPolicy(t *testing.T) {
+	db, close := setupTestDB(t)
+	defer close()
+
+	p := NewPersistence(db)
+	address := common.HexToAddress("0x1")
+
+	stored, err := p.GetPolicy(address)
+	require.NoError(t, err)
+	require.Nil(t, stored)
+
+	policy := &Policy{
+		Address:              address,
+		Currency:             "usd",
+		DailyLimit:           floatPtr(1000),
+		AllowList:            []common.Address{common.HexToAddress("0x10")},
+		DenyList:             []common.Address{common.HexToAddress("0x20"), common.HexToAddress("0x21")},
+		ConfirmNewRecipients: true,
+	}
+	require.NoError(t, p.SavePolicy(policy))
+
+	stored, err = p.GetPolicy(address)
+	require.NoError(t, err)
+	require.Equal(t, policy.Currency, stored.Currency)
+	require.Equal(t, *policy.DailyLimit, *stored.DailyLimit)
+	require.Nil(t, stored.PerTxLimit)
+	require.Equal(t, policy.AllowList, stored.AllowList)
+	require.ElementsMatch(t, policy.DenyList, stored.DenyList)
+	require.True(t, stored.ConfirmNewRecipients)
+
+	// Lists are replaced
+	policy.DenyList = nil
+	policy.PerTxLimit = floatPtr(50)
+	require.NoError(t, p.SavePolicy(policy))
+	stored, err = p.GetPolicy(address)
+	require.NoError(t, err)
+	require.Len(t, stored.DenyList, 0)
+	require.Equal(t, 50.0, *stored.PerTxLimit)
+
+	require.NoError(t, p.DeletePolicy(address))
+	stored, err = p.GetPolicy(address)
+	require.NoError(t, err)
+	require.Nil(t, stored)
+}
+
+func TestSpendingLedger(t *testing.T) {
+	db, close := setupTestDB(t)
+	defer close()
+
+	p := NewPersistence(db)
+	address := common.HexToAddress("0x1")
+
+	require.NoError(t, p.RecordSpending(1, common.HexToHash("0x1"), address, 10, "usd", 100))
+	require.NoError(t, p.RecordSpending(1, common.HexToHash("0x2"), address, 20, "usd", 200))
+	// Recorded once per transaction
+	require.NoError(t, p.RecordSpending(1, common.HexToHash("0x2"), address, 20, "usd", 200))
+	require.NoError(t, p.RecordSpending(1, common.HexToHash("0x3"), address, 40, "eur", 200))
+
+	total, err := p.GetSpending(address, "usd", 0)
+	require.NoError(t, err)
+	require.Equal(t, 30.0, total)
+
+	total, err = p.GetSpending(address, "usd", 150)
+	require.NoError(t, err)
+	require.Equal(t, 20.0, total)
+
+	require.NoError(t, p.DeleteSpendingBefore(150))
+	total, err = p.GetSpending(address, "usd", 0)
+	require.NoError(t, err)
+	require.Equal(t, 20.0, total)
+
+	known, err := p.IsKnownRecipient(address, common.HexToAddress("0x2"))
+	require.NoError(t, err)
+	require.False(t, known)
+	require.NoError(t, p.AddKnownRecipient(address, common.HexToAddress("0x2")))
+	known, err = p.IsKnownRecipient(address, common.HexToAddress("0x2"))
+	require.NoError(t, err)
+	require.True(t, known)
+}
@@ -0,0 +1,287 @@
+package policy
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/services/wallet/market"
+	"github.com/status-im/status-go/services/wallet/token"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+	"github.com/status-im/status-go/transactions"
+)
+
+const (
+	// EventSpendingPolicyViolated is sent when an outgoing transaction is blocked by the policy of its sender.
+	// The message is the JSON encoded ViolationsError.
+	EventSpendingPolicyViolated walletevent.EventType = "wallet-spending-policy-violated"
+
+	dailyLimitWindow = 24 * time.Hour
+	priceMaxAge      = 5 * 60 // seconds
+)
+
+var (
+	ErrMissingCurrency    = errors.New("a currency is required to set spending limits")
+	ErrNegativeLimit      = errors.New("spending limits can't be negative")
+	ErrAllowedAndDenied   = errors.New("an address can't be both allowed and denied")
+	ErrUnknownNetworkCoin = errors.New("unknown native currency of the network")
+)
+
+type networksFinder interface {
+	Find(chainID uint64) *params.Network
+}
+
+type tokensFinder interface {
+	FindTokenByAddress(chainID uint64, address common.Address) *token.Token
+}
+
+type pricesFetcher interface {
+	GetOrFetchPrices(symbols []string, currencies []string, maxAgeInSeconds int64) (market.DataPerTokenAndCurrency, error)
+}
+
+type accountsChecker interface {
+	AddressExists(address types.Address) (bool, error)
+}
+
+type passwordVerifier interface {
+	VerifyAccountPassword(keyStoreDir, address, password string) (*types.Key, error)
+}
+
+// Manager evaluates outgoing transactions against the spending policy of their sender,
+// it is set as the transactions.TxPolicy of the Transactor
+type Manager struct {
+	persistence *Persistence
+	networks    networksFinder
+	tokens      tokensFinder
+	prices      pricesFetcher
+	accounts    accountsChecker
+	passwords   passwordVerifier
+	keyStoreDir string
+	feed        *event.Feed
+	timeNow     func() time.Time
+}
+
+func NewManager(db *sql.DB, networks networksFinder, tokens tokensFinder, prices pricesFetcher, accounts accountsChecker, passwords passwordVerifier, keyStoreDir string, feed *event.Feed) *Manager {
+	return &Manager{
+		persistence: NewPersistence(db),
+		networks:    networks,
+		tokens:      tokens,
+		prices:      prices,
+		accounts:    accounts,
+		passwords:   passwords,
+		keyStoreDir: keyStoreDir,
+		feed:        feed,
+		timeNow:     time.Now,
+	}
+}
+
+// verifyPassword checks the password of the account the same way as when signing its transactions
+func (m *Manager) verifyPassword(address common.Address, password string) error {
+	_, err := m.passwords.VerifyAccountPassword(m.keyStoreDir, address.Hex(), password)
+	return err
+}
+
+func (m *Manager) GetPolicy(address common.Address) (*Policy, error) {
+	return m.persistence.GetPolicy(address)
+}
+
+// SetPolicy saves the policy of the account, the password of the account is required
+// when the policy is less restrictive than the current one
+func (m *Manager) SetPolicy(policy *Policy, password string) error {
+	if (policy.DailyLimit != nil || policy.PerTxLimit != nil) && policy.Currency == "" {
+		return ErrMissingCurrency
+	}
+	if (policy.DailyLimit != nil && *policy.DailyLimit < 0) || (policy.PerTxLimit != nil && *policy.PerTxLimit < 0) {
+		return ErrNegativeLimit
+	}
+	for _, address := range policy.AllowList {
+		if contains(policy.DenyList, address) {
+			return ErrAllowedAndDenied
+		}
+	}
+
+	current, err := m.persistence.GetPolicy(policy.Address)
+	if err != nil {
+		return err
+	}
+	if current != nil && policy.relaxes(current) {
+		if err := m.verifyPassword(policy.Address, password); err != nil {
+			return err
+		}
+	}
+
+	return m.persistence.SavePolicy(policy)
+}
+
+// DeletePolicy removes the policy of the account, it requires the password of the account
+func (m *Manager) DeletePolicy(address common.Address, password string) error {
+	if err := m.verifyPassword(address, password); err != nil {
+		return err
+	}
+	return m.persistence.DeletePolicy(address)
+}
+
+// ConfirmRecipient marks the recipient as known by the account, transactions to it
+// no longer need a confirmation. It requires the password of the account.
+func (m *Manager) ConfirmRecipient(address common.Address, recipient common.Address, password string) error {
+	if err := m.verifyPassword(address, password); err != nil {
+		return err
+	}
+	return m.persistence.AddKnownRecipient(address, recipient)
+}
+
+func (m *Manager) isKnownRecipient(address common.Address, recipient common.Address) (bool, error) {
+	// Moving funds between own accounts is never a new recipient
+	if m.accounts != nil {
+		own, err := m.accounts.AddressExists(types.Address(recipient))
+		if err != nil {
+			return false, err
+		}
+		if own {
+			return true, nil
+		}
+	}
+	return m.persistence.IsKnownRecipient(address, recipient)
+}
+
+func amountToFloat(amount *big.Int, decimals uint) float64 {
+	value := new(big.Float).SetInt(amount)
+	value.Quo(value, new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)))
+	result, _ := value.Float64()
+	return result
+}
+
+// fiatValue returns the value of the native coins and fungible tokens moved by the call,
+// nil if a needed price is not available
+func (m *Manager) fiatValue(call *decodedCall, currency string) (*float64, error) {
+	amounts := make(map[string]float64)
+	if call.tx.Value != nil && call.tx.Value.Sign() > 0 {
+		network := m.networks.Find(call.tx.ChainID)
+		if network == nil {
+			return nil, ErrUnknownNetworkCoin
+		}
+		amounts[network.NativeCurrencySymbol] += amountToFloat(call.tx.Value, uint(network.NativeCurrencyDecimals))
+	}
+	if call.tokenAmount != nil && call.tokenAmount.Sign() > 0 {
+		// Unknown contracts are not fungible tokens with a market price
+		if t := m.tokens.FindTokenByAddress(call.tx.ChainID, *call.tx.To); t != nil {
+			amounts[t.Symbol] += amountToFloat(call.tokenAmount, t.Decimals)
+		}
+	}
+
+	value := 0.0
+	if len(amounts) == 0 {
+		return &value, nil
+	}
+
+	symbols := make([]string, 0, len(amounts))
+	for symbol := range amounts {
+		symbols = append(symbols, symbol)
+	}
+	prices, err := m.prices.GetOrFetchPrices(symbols, []string{currency}, priceMaxAge)
+	if err != nil {
+		log.Warn("failed to fetch prices for spending policy", "err", err)
+		return nil, nil
+	}
+
+	for symbol, amount := range amounts {
+		price, ok := prices[symbol][currency]
+		if !ok {
+			return nil, nil
+		}
+		value += amount * price.Price
+	}
+	return &value, nil
+}
+
+// Evaluate returns the rules of the sender policy the transaction violates
+func (m *Manager) Evaluate(tx transactions.OutgoingTx) ([]*Violation, error) {
+	policy, err := m.persistence.GetPolicy(tx.From)
+	if err != nil || policy == nil {
+		return nil, err
+	}
+
+	call := decodeCall(tx)
+	e := &evaluation{call: call, knownRecipient: true}
+
+	if recipient := call.recipient(); policy.ConfirmNewRecipients && recipient != nil {
+		e.knownRecipient, err = m.isKnownRecipient(tx.From, *recipient)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if policy.DailyLimit != nil || policy.PerTxLimit != nil {
+		e.value, err = m.fiatValue(call, policy.Currency)
+		if err != nil {
+			return nil, err
+		}
+		e.spent, err = m.persistence.GetSpending(tx.From, policy.Currency, m.timeNow().Add(-dailyLimitWindow).Unix())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return policy.evaluate(e), nil
+}
+
+// CheckTransaction implements transactions.TxPolicy, the returned error is a *ViolationsError
+// when the transaction is blocked by the policy
+func (m *Manager) CheckTransaction(tx transactions.OutgoingTx) error {
+	violations, err := m.Evaluate(tx)
+	if err != nil || len(violations) == 0 {
+		return err
+	}
+
+	violationsErr := &ViolationsError{Violations: violations}
+	if m.feed != nil {
+		encoded, err := json.Marshal(violationsErr)
+		if err == nil {
+			m.feed.Send(walletevent.Event{
+				Type:     EventSpendingPolicyViolated,
+				ChainID:  tx.ChainID,
+				Accounts: []common.Address{tx.From},
+				Message:  string(encoded),
+				At:       m.timeNow().Unix(),
+			})
+		}
+	}
+	return violationsErr
+}
+
+// TransactionSent implements transactions.TxPolicy, it remembers the recipient and counts
+// the transaction value in the daily spending of the sender
+func (m *Manager) TransactionSent(tx transactions.OutgoingTx, hash common.Hash) {
+	call := decodeCall(tx)
+	if recipient := call.recipient(); recipient != nil {
+		if err := m.persistence.AddKnownRecipient(tx.From, *recipient); err != nil {
+			log.Error("failed to store known recipient", "err", err)
+		}
+	}
+
+	policy, err := m.persistence.GetPolicy(tx.From)
+	if err != nil || policy == nil || policy.Currency == "" {
+		return
+	}
+
+	value, err := m.fiatValue(call, policy.Currency)
+	if err != nil || value == nil || *value == 0 {
+		return
+	}
+
+	now := m.timeNow()
+	if err = m.persistence.RecordSpending(tx.ChainID, hash, tx.From, *value, policy.Currency, now.Unix()); err != nil {
+		log.Error("failed to record spending", "err", err)
+	}
+	if err = m.persistence.DeleteSpendingBefore(now.Add(-2 * dailyLimitWindow).Unix()); err != nil {
+		log.Error("failed to delete old spending", "err", err)
+	}
+}
@@ -0,0 +1,323 @@
+package policy
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/params"
+	"github.com/status-im/status-go/services/wallet/market"
+	"github.com/status-im/status-go/services/wallet/token"
+	"github.com/status-im/status-go/transactions"
+)
+
+const testChainID = 1
+
+var (
+	testOwner    = common.HexToAddress("0x1")
+	testOwnAcc   = common.HexToAddress("0x2")
+	testToken    = common.HexToAddress("0x100")
+	testRecipent = common.HexToAddress("0x200")
+)
+
+type testNetworks struct{}
+
+func (n *testNetworks) Find(chainID uint64) *params.Network {
+	if chainID != testChainID {
+		return nil
+	}
+	return &params.Network{ChainID: chainID, NativeCurrencySymbol: "ETH", NativeCurrencyDecimals: 18}
+}
+
+type testTokens struct{}
+
+func (t *testTokens) FindTokenByAddress(chainID uint64, address common.Address) *token.Token {
+	if address != testToken {
+		return nil
+	}
+	return &token.Token{Address: address, Symbol: "USDC", Decimals: 6, ChainID: chainID}
+}
+
+type testPrices struct {
+	err error
+}
+
+func (p *testPrices) GetOrFetchPrices(symbols []string, currencies []string, maxAgeInSeconds int64) (market.DataPerTokenAndCurrency, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return market.DataPerTokenAndCurrency{
+		"ETH":  {"usd": {Price: 2000}},
+		"USDC": {"usd": {Price: 1}},
+	}, nil
+}
+
+type testAccounts struct{}
+
+func (a *testAccounts) AddressExists(address types.Address) (bool, error) {
+	return common.Address(address) == testOwnAcc, nil
+}
+
+const testPassword = "password"
+
+var errWrongPassword = errors.New("could not decrypt key with given password")
+
+type testPasswords struct{}
+
+func (p *testPasswords) VerifyAccountPassword(keyStoreDir, address, password string) (*types.Key, error) {
+	if password != testPassword {
+		return nil, errWrongPassword
+	}
+	return &types.Key{}, nil
+}
+
+func setupTestManager(t *testing.T) (*Manager, *testPrices, func()) {
+	db, close := setupTestDB(t)
+	prices := &testPrices{}
+	m := NewManager(db, &testNetworks{}, &testTokens{}, prices, &testAccounts{}, &testPasswords{}, "", nil)
+	return m, prices, close
+}
+
+func ethTransfer(to common.Address, eth int64) transactions.OutgoingTx {
+	return transactions.OutgoingTx{
+		ChainID: testChainID,
+		From:    testOwner,
+		To:      &to,
+		Value:   new(big.Int).Mul(big.NewInt(eth), big.NewInt(1e18)),
+	}
+}
+
+func usdcTransfer(to common.Address, usdc int64) transactions.OutgoingTx {
+	data := append([]byte{}, erc20TransferSelector...)
+	data = append(data, common.LeftPadBytes(to.Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(big.NewInt(usdc*1e6).Bytes(), 32)...)
+	contract := testToken
+	return transactions.OutgoingTx{
+		ChainID: testChainID,
+		From:    testOwner,
+		To:      &contract,
+		Value:   big.NewInt(0),
+		Data:    data,
+	}
+}
+
+func violationTypes(violations []*Violation) []ViolationType {
+	types := make([]ViolationType, 0, len(violations))
+	for _, v := range violations {
+		types = append(types, v.Type)
+	}
+	return types
+}
+
+func TestSelectors(t *testing.T) {
+	for signature, selector := range map[string][]byte{
+		"transfer(address,uint256)":                                        erc20TransferSelector,
+		"approve(address,uint256)":                                         erc20ApproveSelector,
+		"setApprovalForAll(address,bool)":                                  setApprovalForAllSelector,
+		"transferFrom(address,address,uint256)":                            transferFromSelector,
+		"safeTransferFrom(address,address,uint256)":                        erc721SafeTransferFromSelector,
+		"safeTransferFrom(address,address,uint256,bytes)":                  erc721SafeTransferFromWithDataSelector,
+		"safeTransferFrom(address,address,uint256,uint256,bytes)":          erc1155SafeTransferFromSelector,
+		"safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)": erc1155SafeBatchTransferFromSelector,
+	} {
+		require.Equal(t, crypto.Keccak256([]byte(signature))[:4], selector, signature)
+	}
+}
+
+func TestNoPolicy(t *testing.T) {
+	m, _, close := setupTestManager(t)
+	defer close()
+
+	require.NoError(t, m.CheckTransaction(ethTransfer(testRecipent, 1000)))
+}
+
+func TestLimits(t *testing.T) {
+	m, prices, close := setupTestManager(t)
+	defer close()
+
+	now := time.Unix(1700000000, 0)
+	m.timeNow = func() time.Time { return now }
+
+	require.NoError(t, m.SetPolicy(&Policy{
+		Address:    testOwner,
+		Currency:   "usd",
+		PerTxLimit: floatPtr(3000),
+		DailyLimit: floatPtr(5000),
+	}, ""))
+
+	violations, err := m.Evaluate(ethTransfer(testRecipent, 1))
+	require.NoError(t, err)
+	require.Len(t, violations, 0)
+
+	violations, err = m.Evaluate(ethTransfer(testRecipent, 2))
+	require.NoError(t, err)
+	require.Equal(t, []ViolationType{ViolationPerTxLimit}, violationTypes(violations))
+	require.Equal(t, 4000.0, *violations[0].Amount)
+
+	// Token transfers are valued too
+	violations, err = m.Evaluate(usdcTransfer(testRecipent, 3500))
+	require.NoError(t, err)
+	require.Equal(t, []ViolationType{ViolationPerTxLimit}, violationTypes(violations))
+
+	// Sent transactions count in the daily limit
+	m.TransactionSent(ethTransfer(testRecipent, 1), common.HexToHash("0x1"))
+	m.TransactionSent(usdcTransfer(testRecipent, 2500), common.HexToHash("0x2"))
+	err = m.CheckTransaction(ethTransfer(testRecipent, 1))
+	var violationsErr *ViolationsError
+	require.True(t, errors.As(err, &violationsErr))
+	require.Equal(t, []ViolationType{ViolationDailyLimit}, violationTypes(violationsErr.Violations))
+	require.Equal(t, 6500.0, *violationsErr.Violations[0].Amount)
+
+	// The window is rolling
+	now = now.Add(dailyLimitWindow + time.Second)
+	require.NoError(t, m.CheckTransaction(ethTransfer(testRecipent, 1)))
+
+	// Limits can't be enforced without prices
+	prices.err = errors.New("offline")
+	violations, err = m.Evaluate(ethTransfer(testRecipent, 1))
+	require.NoError(t, err)
+	require.Equal(t, []ViolationType{ViolationUnknownValue}, violationTypes(violations))
+
+	// Zero value calls are not valued
+	violations, err = m.Evaluate(transactions.OutgoingTx{ChainID: testChainID, From: testOwner, To: &testRecipent, Value: big.NewInt(0)})
+	require.NoError(t, err)
+	require.Len(t, violations, 0)
+}
+
+func TestRecipientLists(t *testing.T) {
+	m, _, close := setupTestManager(t)
+	defer close()
+
+	denied := common.HexToAddress("0x300")
+	require.NoError(t, m.SetPolicy(&Policy{
+		Address:   testOwner,
+		AllowList: []common.Address{testRecipent},
+		DenyList:  []common.Address{denied},
+	}, ""))
+
+	violations, err := m.Evaluate(ethTransfer(testRecipent, 1))
+	require.NoError(t, err)
+	require.Len(t, violations, 0)
+
+	// The token recipient is checked, not the token contract
+	violations, err = m.Evaluate(usdcTransfer(testRecipent, 1))
+	require.NoError(t, err)
+	require.Len(t, violations, 0)
+
+	violations, err = m.Evaluate(usdcTransfer(denied, 1))
+	require.NoError(t, err)
+	require.Equal(t, []ViolationType{ViolationDeniedRecipient, ViolationNotAllowedRecipient}, violationTypes(violations))
+	require.Equal(t, denied, *violations[0].Address)
+
+	require.ErrorIs(t, m.SetPolicy(&Policy{
+		Address:   testOwner,
+		AllowList: []common.Address{denied},
+		DenyList:  []common.Address{denied},
+	}, ""), ErrAllowedAndDenied)
+	require.ErrorIs(t, m.SetPolicy(&Policy{Address: testOwner, DailyLimit: floatPtr(1)}, ""), ErrMissingCurrency)
+}
+
+func TestNewRecipientConfirmation(t *testing.T) {
+	m, _, close := setupTestManager(t)
+	defer close()
+
+	require.NoError(t, m.SetPolicy(&Policy{Address: testOwner, ConfirmNewRecipients: true}, ""))
+
+	violations, err := m.Evaluate(ethTransfer(testRecipent, 1))
+	require.NoError(t, err)
+	require.Equal(t, []ViolationType{ViolationNewRecipient}, violationTypes(violations))
+
+	// Own accounts are always known
+	violations, err = m.Evaluate(ethTransfer(testOwnAcc, 1))
+	require.NoError(t, err)
+	require.Len(t, violations, 0)
+
+	require.NoError(t, m.ConfirmRecipient(testOwner, testRecipent, testPassword))
+	violations, err = m.Evaluate(ethTransfer(testRecipent, 1))
+	require.NoError(t, err)
+	require.Len(t, violations, 0)
+
+	// Recipients become known once a transaction is sent to them
+	other := common.HexToAddress("0x400")
+	m.TransactionSent(usdcTransfer(other, 1), common.HexToHash("0x1"))
+	violations, err = m.Evaluate(ethTransfer(other, 1))
+	require.NoError(t, err)
+	require.Len(t, violations, 0)
+}
+
+func TestPolicyChangesRequirePassword(t *testing.T) {
+	m, _, close := setupTestManager(t)
+	defer close()
+
+	denied := common.HexToAddress("0x300")
+	current := Policy{
+		Address:              testOwner,
+		Currency:             "usd",
+		DailyLimit:           floatPtr(1000),
+		AllowList:            []common.Address{testRecipent},
+		DenyList:             []common.Address{denied},
+		ConfirmNewRecipients: true,
+	}
+	// A policy can be set without password when the account has none
+	require.NoError(t, m.SetPolicy(&current, ""))
+
+	// Stricter policies don't need the password
+	stricter := current
+	stricter.DailyLimit = floatPtr(500)
+	stricter.PerTxLimit = floatPtr(100)
+	require.NoError(t, m.SetPolicy(&stricter, ""))
+	current = stricter
+
+	raisedLimit := current
+	raisedLimit.DailyLimit = floatPtr(5000)
+	otherCurrency := current
+	otherCurrency.Currency = "eur"
+	allowed := current
+	allowed.AllowList = []common.Address{testRecipent, common.HexToAddress("0x400")}
+	noAllowList := current
+	noAllowList.AllowList = nil
+	undenied := current
+	undenied.DenyList = nil
+	noConfirmation := current
+	noConfirmation.ConfirmNewRecipients = false
+
+	for _, relaxed := range []Policy{raisedLimit, otherCurrency, allowed, noAllowList, undenied, noConfirmation} {
+		relaxed := relaxed
+		require.ErrorIs(t, m.SetPolicy(&relaxed, ""), errWrongPassword)
+		require.ErrorIs(t, m.SetPolicy(&relaxed, "wrong"), errWrongPassword)
+
+		saved, err := m.GetPolicy(testOwner)
+		require.NoError(t, err)
+		require.Equal(t, &current, saved)
+	}
+
+	require.NoError(t, m.SetPolicy(&raisedLimit, testPassword))
+	saved, err := m.GetPolicy(testOwner)
+	require.NoError(t, err)
+	require.Equal(t, 5000.0, *saved.DailyLimit)
+
+	// Confirming a recipient needs the password
+	other := common.HexToAddress("0x500")
+	require.ErrorIs(t, m.ConfirmRecipient(testOwner, other, "wrong"), errWrongPassword)
+	known, err := m.isKnownRecipient(testOwner, other)
+	require.NoError(t, err)
+	require.False(t, known)
+
+	// Deleting the policy needs the password
+	require.ErrorIs(t, m.DeletePolicy(testOwner, ""), errWrongPassword)
+	saved, err = m.GetPolicy(testOwner)
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+
+	require.NoError(t, m.DeletePolicy(testOwner, testPassword))
+	saved, err = m.GetPolicy(testOwner)
+	require.NoError(t, err)
+	require.Nil(t, saved)
+}
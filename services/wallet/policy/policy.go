@@ -0,0 +1,176 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type ListType int
+
+const (
+	AllowList ListType = iota + 1
+	DenyList
+)
+
+// Policy is the spending policy of an account. Limits are expressed in Currency, nil means no limit.
+type Policy struct {
+	Address    common.Address `json:"address"`
+	Currency   string         `json:"currency"`
+	DailyLimit *float64       `json:"dailyLimit,omitempty"`
+	PerTxLimit *float64       `json:"perTxLimit,omitempty"`
+	// Recipients and contracts the account can send to, any is allowed when empty
+	AllowList []common.Address `json:"allowList"`
+	// Recipients and contracts the account must never send to
+	DenyList []common.Address `json:"denyList"`
+	// Transactions to recipients the account never sent to need to be confirmed first
+	ConfirmNewRecipients bool `json:"confirmNewRecipients"`
+}
+
+type ViolationType string
+
+const (
+	ViolationDeniedRecipient     ViolationType = "denied-recipient"
+	ViolationNotAllowedRecipient ViolationType = "not-allowed-recipient"
+	ViolationPerTxLimit          ViolationType = "per-tx-limit"
+	ViolationDailyLimit          ViolationType = "daily-limit"
+	ViolationNewRecipient        ViolationType = "new-recipient"
+	// ViolationUnknownValue is reported when limits are set but the fiat value of the transaction can't be computed
+	ViolationUnknownValue ViolationType = "unknown-value"
+)
+
+// Violation is a policy rule the transaction doesn't comply with
+type Violation struct {
+	Type ViolationType `json:"type"`
+	// Address is the denied, not allowed or new recipient or contract
+	Address *common.Address `json:"address,omitempty"`
+	// Limit and Amount are set for limit violations, Amount is the value of the transaction
+	// for the per transaction limit and the spending of the last 24 hours including it for the daily limit
+	Limit    *float64 `json:"limit,omitempty"`
+	Amount   *float64 `json:"amount,omitempty"`
+	Currency string   `json:"currency,omitempty"`
+}
+
+// ViolationsError is returned to the transactions paths when a transaction violates the policy of its sender
+type ViolationsError struct {
+	Violations []*Violation `json:"violations"`
+}
+
+func (e *ViolationsError) Error() string {
+	types := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		if v.Address != nil {
+			types = append(types, fmt.Sprintf("%s (%s)", v.Type, v.Address.Hex()))
+		} else {
+			types = append(types, string(v.Type))
+		}
+	}
+	return "transaction violates the spending policy: " + strings.Join(types, ", ")
+}
+
+func contains(list []common.Address, address common.Address) bool {
+	for _, a := range list {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
+// limitRelaxed is true when the limit is removed or raised
+func limitRelaxed(limit *float64, current *float64) bool {
+	return current != nil && (limit == nil || *limit > *current)
+}
+
+// relaxes returns true when the policy allows a transaction the current policy would block
+func (p *Policy) relaxes(current *Policy) bool {
+	if current.DailyLimit != nil || current.PerTxLimit != nil {
+		// Limits in different currencies can't be compared
+		if p.Currency != current.Currency {
+			return true
+		}
+		if limitRelaxed(p.DailyLimit, current.DailyLimit) || limitRelaxed(p.PerTxLimit, current.PerTxLimit) {
+			return true
+		}
+	}
+
+	if len(current.AllowList) > 0 {
+		if len(p.AllowList) == 0 {
+			return true
+		}
+		for _, address := range p.AllowList {
+			if !contains(current.AllowList, address) {
+				return true
+			}
+		}
+	}
+
+	for _, address := range current.DenyList {
+		if !contains(p.DenyList, address) {
+			return true
+		}
+	}
+
+	return current.ConfirmNewRecipients && !p.ConfirmNewRecipients
+}
+
+// evaluation is the data a transaction is checked with against a policy
+type evaluation struct {
+	call *decodedCall
+	// Fiat value of the transaction in the policy currency, nil when it couldn't be computed
+	value *float64
+	// Spending of the last 24 hours, excluding this transaction
+	spent float64
+	// knownRecipient is false when the recipient needs a confirmation
+	knownRecipient bool
+}
+
+func (p *Policy) evaluate(e *evaluation) []*Violation {
+	var violations []*Violation
+
+	for _, address := range e.call.parties() {
+		address := address
+		if contains(p.DenyList, address) {
+			violations = append(violations, &Violation{Type: ViolationDeniedRecipient, Address: &address})
+		}
+	}
+
+	if recipient := e.call.recipient(); recipient != nil {
+		if len(p.AllowList) > 0 && !contains(p.AllowList, *recipient) {
+			violations = append(violations, &Violation{Type: ViolationNotAllowedRecipient, Address: recipient})
+		}
+		if p.ConfirmNewRecipients && !e.knownRecipient {
+			violations = append(violations, &Violation{Type: ViolationNewRecipient, Address: recipient})
+		}
+	}
+
+	if p.PerTxLimit == nil && p.DailyLimit == nil {
+		return violations
+	}
+	if e.value == nil {
+		return append(violations, &Violation{Type: ViolationUnknownValue, Currency: p.Currency})
+	}
+
+	if p.PerTxLimit != nil && *e.value > *p.PerTxLimit {
+		violations = append(violations, &Violation{
+			Type:     ViolationPerTxLimit,
+			Limit:    p.PerTxLimit,
+			Amount:   e.value,
+			Currency: p.Currency,
+		})
+	}
+	if p.DailyLimit != nil && *e.value > 0 {
+		total := e.spent + *e.value
+		if total > *p.DailyLimit {
+			violations = append(violations, &Violation{
+				Type:     ViolationDailyLimit,
+				Limit:    p.DailyLimit,
+				Amount:   &total,
+				Currency: p.Currency,
+			})
+		}
+	}
+
+	return violations
+}
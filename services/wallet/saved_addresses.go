@@ -3,6 +3,7 @@ package wallet
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,6 +11,8 @@ import (
 	multiAccCommon "github.com/status-im/status-go/multiaccounts/common"
 )
 
+var ErrSavedAddressNotFound = errors.New("saved address not found")
+
 type savedAddressMeta struct {
 	UpdateClock uint64 // wall clock used to deconflict concurrent updates
 }
@@ -25,6 +28,10 @@ type SavedAddress struct {
 	IsTest          bool                              `json:"isTest"`
 	CreatedAt       int64                             `json:"createdAt"`
 	Removed         bool                              `json:"removed"`
+	ContactID       string                            `json:"contactId"` // public key of the linked messenger contact
+	// Last address ENSName resolved to, tracked locally to warn when it changes
+	ENSResolvedAddress *common.Address `json:"ensResolvedAddress,omitempty"`
+	ENSResolvedAt      int64           `json:"ensResolvedAt"`
 	savedAddressMeta
 }
 
@@ -32,6 +39,11 @@ func (s *SavedAddress) ID() string {
 	return fmt.Sprintf("%s-%t", s.Address.Hex(), s.IsTest)
 }
 
+// ENSAddressChanged is true when ENSName now resolves to a different address than the saved one
+func (s *SavedAddress) ENSAddressChanged() bool {
+	return s.ENSName != "" && s.ENSResolvedAddress != nil && *s.ENSResolvedAddress != s.Address
+}
+
 func (s *SavedAddress) MarshalJSON() ([]byte, error) {
 	item := struct {
 		Address          common.Address                    `json:"address"`
@@ -43,6 +55,10 @@ func (s *SavedAddress) MarshalJSON() ([]byte, error) {
 		IsTest           bool                              `json:"isTest"`
 		CreatedAt        int64                             `json:"createdAt"`
 		Removed          bool                              `json:"removed"`
+		ContactID        string                            `json:"contactId"`
+		ENSResolvedAddr  *common.Address                   `json:"ensResolvedAddress,omitempty"`
+		ENSResolvedAt    int64                             `json:"ensResolvedAt"`
+		ENSChanged       bool                              `json:"ensAddressChanged"`
 	}{
 		Address:          s.Address,
 		MixedcaseAddress: s.Address.Hex(),
@@ -53,6 +69,10 @@ func (s *SavedAddress) MarshalJSON() ([]byte, error) {
 		IsTest:           s.IsTest,
 		CreatedAt:        s.CreatedAt,
 		Removed:          s.Removed,
+		ContactID:        s.ContactID,
+		ENSResolvedAddr:  s.ENSResolvedAddress,
+		ENSResolvedAt:    s.ENSResolvedAt,
+		ENSChanged:       s.ENSAddressChanged(),
 	}
 
 	return json.Marshal(item)
@@ -66,13 +86,14 @@ func NewSavedAddressesManager(db *sql.DB) *SavedAddressesManager {
 	return &SavedAddressesManager{db: db}
 }
 
-const rawQueryColumnsOrder = "address, name, removed, update_clock, chain_short_names, ens_name, is_test, created_at, color"
+const rawQueryColumnsOrder = "address, name, removed, update_clock, chain_short_names, ens_name, is_test, created_at, color, contact_id, ens_resolved_address, ens_resolved_at"
 
 // getSavedAddressesFromDBRows retrieves all data based on SELECT Query using rawQueryColumnsOrder
 func getSavedAddressesFromDBRows(rows *sql.Rows) ([]*SavedAddress, error) {
 	var addresses []*SavedAddress
 	for rows.Next() {
 		sa := &SavedAddress{}
+		var ensResolvedAddress []byte
 		// based on rawQueryColumnsOrder
 		err := rows.Scan(
 			&sa.Address,
@@ -84,10 +105,17 @@ func getSavedAddressesFromDBRows(rows *sql.Rows) ([]*SavedAddress, error) {
 			&sa.IsTest,
 			&sa.CreatedAt,
 			&sa.ColorID,
+			&sa.ContactID,
+			&ensResolvedAddress,
+			&sa.ENSResolvedAt,
 		)
 		if err != nil {
 			return nil, err
 		}
+		if len(ensResolvedAddress) > 0 {
+			resolved := common.BytesToAddress(ensResolvedAddress)
+			sa.ENSResolvedAddress = &resolved
+		}
 
 		addresses = append(addresses, sa)
 	}
@@ -95,13 +123,13 @@ func getSavedAddressesFromDBRows(rows *sql.Rows) ([]*SavedAddress, error) {
 	return addresses, nil
 }
 
-func (sam *SavedAddressesManager) getSavedAddresses(condition string) ([]*SavedAddress, error) {
+func (sam *SavedAddressesManager) getSavedAddresses(condition string, args ...interface{}) ([]*SavedAddress, error) {
 	var whereCondition string
 	if condition != "" {
 		whereCondition = fmt.Sprintf("WHERE %s", condition)
 	}
 
-	rows, err := sam.db.Query(fmt.Sprintf("SELECT %s FROM saved_addresses %s", rawQueryColumnsOrder, whereCondition))
+	rows, err := sam.db.Query(fmt.Sprintf("SELECT %s FROM saved_addresses %s", rawQueryColumnsOrder, whereCondition), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +139,15 @@ func (sam *SavedAddressesManager) getSavedAddresses(condition string) ([]*SavedA
 	return addresses, err
 }
 
+// GetSavedAddress returns the saved address or nil if it is not saved
+func (sam *SavedAddressesManager) GetSavedAddress(address common.Address, isTest bool) (*SavedAddress, error) {
+	addresses, err := sam.getSavedAddresses("removed != 1 AND address = ? AND is_test = ?", address, isTest)
+	if err != nil || len(addresses) == 0 {
+		return nil, err
+	}
+	return addresses[0], nil
+}
+
 func (sam *SavedAddressesManager) GetSavedAddresses() ([]*SavedAddress, error) {
 	return sam.getSavedAddresses("removed != 1")
 }
@@ -147,12 +184,23 @@ func (sam *SavedAddressesManager) upsertSavedAddress(sa SavedAddress, tx *sql.Tx
 		return err
 	}
 	sa.CreatedAt = time.Now().Unix()
+	sa.ENSResolvedAddress = nil
+	sa.ENSResolvedAt = 0
 	for _, savedAddress := range savedAddresses {
 		if savedAddress.Address == sa.Address && savedAddress.IsTest == sa.IsTest {
 			sa.CreatedAt = savedAddress.CreatedAt
+			// The resolution is only valid for the ENS name it was done for
+			if savedAddress.ENSName == sa.ENSName {
+				sa.ENSResolvedAddress = savedAddress.ENSResolvedAddress
+				sa.ENSResolvedAt = savedAddress.ENSResolvedAt
+			}
 			break
 		}
 	}
+	var ensResolvedAddress []byte
+	if sa.ENSResolvedAddress != nil {
+		ensResolvedAddress = sa.ENSResolvedAddress.Bytes()
+	}
 	sqlStatement := `
 	INSERT OR REPLACE
 	INTO
@@ -165,10 +213,13 @@ func (sam *SavedAddressesManager) upsertSavedAddress(sa SavedAddress, tx *sql.Tx
 			ens_name,
 			is_test,
 			created_at,
-			color
+			color,
+			contact_id,
+			ens_resolved_address,
+			ens_resolved_at
 		)
 	VALUES
-		(?, ?, ?, ?, ?, ?, ?, ?, ?)
+		(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 	insert, err := tx.Prepare(sqlStatement)
 	if err != nil {
@@ -176,7 +227,7 @@ func (sam *SavedAddressesManager) upsertSavedAddress(sa SavedAddress, tx *sql.Tx
 	}
 	defer insert.Close()
 	_, err = insert.Exec(sa.Address, sa.Name, sa.Removed, sa.UpdateClock, sa.ChainShortNames, sa.ENSName,
-		sa.IsTest, sa.CreatedAt, sa.ColorID)
+		sa.IsTest, sa.CreatedAt, sa.ColorID, sa.ContactID, ensResolvedAddress, sa.ENSResolvedAt)
 	return err
 }
 
@@ -261,3 +312,25 @@ func (sam *SavedAddressesManager) DeleteSoftRemovedSavedAddresses(threshold uint
 	_, err := sam.db.Exec(`DELETE FROM saved_addresses WHERE removed = 1 AND update_clock < ?`, threshold)
 	return err
 }
+
+// GetSavedAddressesWithENS returns the saved addresses linked to an ENS name
+func (sam *SavedAddressesManager) GetSavedAddressesWithENS() ([]*SavedAddress, error) {
+	return sam.getSavedAddresses("removed != 1 AND ens_name != ''")
+}
+
+// UpdateENSResolution stores the address the ENS name of the saved address resolved to.
+// It is a no-op if the saved address was linked to another ENS name in the meantime.
+func (sam *SavedAddressesManager) UpdateENSResolution(address common.Address, isTest bool, ensName string, resolved common.Address, resolvedAt int64) (updated bool, err error) {
+	res, err := sam.db.Exec(`UPDATE saved_addresses SET ens_resolved_address = ?, ens_resolved_at = ? WHERE address = ? AND is_test = ? AND ens_name = ?`,
+		resolved.Bytes(), resolvedAt, address, isTest, ensName)
+	if err != nil {
+		return false, err
+	}
+
+	nRows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return nRows > 0, nil
+}
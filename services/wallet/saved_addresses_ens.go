@@ -0,0 +1,140 @@
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	w_common "github.com/status-im/status-go/services/wallet/common"
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+const (
+	// EventSavedAddressENSChanged is sent when the ENS name of a saved address resolves to another address.
+	// The message is the JSON encoded saved address.
+	EventSavedAddressENSChanged walletevent.EventType = "wallet-saved-address-ens-changed"
+
+	savedAddressesENSCheckInterval   = 1 * time.Hour
+	savedAddressesENSResolveInterval = 24 * time.Hour
+	savedAddressesENSResolveTimeout  = 30 * time.Second
+)
+
+// ensAddressResolver is the subset of ens.API used to re-resolve saved addresses
+type ensAddressResolver interface {
+	AddressOf(ctx context.Context, chainID uint64, username string) (*common.Address, error)
+}
+
+// SavedAddressesENSResolver periodically re-resolves the ENS names linked to saved addresses
+// and warns when one of them points to a different address than the saved one.
+type SavedAddressesENSResolver struct {
+	manager  *SavedAddressesManager
+	resolver ensAddressResolver
+	feed     *event.Feed
+	timeNow  func() time.Time
+	cancelFn context.CancelFunc
+}
+
+func NewSavedAddressesENSResolver(manager *SavedAddressesManager, resolver ensAddressResolver, feed *event.Feed) *SavedAddressesENSResolver {
+	return &SavedAddressesENSResolver{
+		manager:  manager,
+		resolver: resolver,
+		feed:     feed,
+		timeNow:  time.Now,
+	}
+}
+
+func (r *SavedAddressesENSResolver) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancelFn = cancel
+
+	go func() {
+		ticker := time.NewTicker(savedAddressesENSCheckInterval)
+		defer ticker.Stop()
+		for {
+			if err := r.ResolveStale(ctx); err != nil {
+				log.Error("failed to re-resolve saved addresses ENS names", "err", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (r *SavedAddressesENSResolver) Stop() {
+	if r.cancelFn != nil {
+		r.cancelFn()
+	}
+}
+
+func ensChainID(isTest bool) uint64 {
+	if isTest {
+		return w_common.EthereumSepolia
+	}
+	return w_common.EthereumMainnet
+}
+
+// ResolveStale re-resolves the ENS names that were not resolved during the last savedAddressesENSResolveInterval
+func (r *SavedAddressesENSResolver) ResolveStale(ctx context.Context) error {
+	savedAddresses, err := r.manager.GetSavedAddressesWithENS()
+	if err != nil {
+		return err
+	}
+
+	staleBefore := r.timeNow().Add(-savedAddressesENSResolveInterval).Unix()
+	for _, sa := range savedAddresses {
+		if sa.ENSResolvedAt > staleBefore {
+			continue
+		}
+		if err := r.Resolve(ctx, sa); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Warn("failed to resolve saved address ENS name", "ens", sa.ENSName, "err", err)
+		}
+	}
+	return nil
+}
+
+// Resolve re-resolves the ENS name of the saved address and sends EventSavedAddressENSChanged
+// if it resolves to a different address than the one previously seen
+func (r *SavedAddressesENSResolver) Resolve(ctx context.Context, sa *SavedAddress) error {
+	ctx, cancel := context.WithTimeout(ctx, savedAddressesENSResolveTimeout)
+	defer cancel()
+
+	resolved, err := r.resolver.AddressOf(ctx, ensChainID(sa.IsTest), sa.ENSName)
+	if err != nil {
+		return err
+	}
+
+	previous := sa.ENSResolvedAddress
+	resolvedAt := r.timeNow().Unix()
+	updated, err := r.manager.UpdateENSResolution(sa.Address, sa.IsTest, sa.ENSName, *resolved, resolvedAt)
+	if err != nil || !updated {
+		return err
+	}
+
+	sa.ENSResolvedAddress = resolved
+	sa.ENSResolvedAt = resolvedAt
+
+	// Warn once per change, not on every resolution of an already reported mismatch
+	if sa.ENSAddressChanged() && (previous == nil || *previous != *resolved) {
+		encoded, err := sa.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		r.feed.Send(walletevent.Event{
+			Type:     EventSavedAddressENSChanged,
+			Accounts: []common.Address{sa.Address},
+			Message:  string(encoded),
+			At:       resolvedAt,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/status-im/status-go/services/wallet/walletevent"
+)
+
+type testENSResolver struct {
+	addresses map[string]common.Address
+	calls     int
+}
+
+func (r *testENSResolver) AddressOf(ctx context.Context, chainID uint64, username string) (*common.Address, error) {
+	r.calls++
+	address := r.addresses[username]
+	return &address, nil
+}
+
+func TestSavedAddressesENSResolver(t *testing.T) {
+	manager, stop := setupTestSavedAddressesDB(t)
+	defer stop()
+
+	address := common.HexToAddress("0x1")
+	err := manager.UpdateMetadataAndUpsertSavedAddress(SavedAddress{Address: address, Name: "Alice", ENSName: "alice.eth"})
+	require.NoError(t, err)
+	err = manager.UpdateMetadataAndUpsertSavedAddress(SavedAddress{Address: common.HexToAddress("0x2"), Name: "Bob"})
+	require.NoError(t, err)
+
+	feed := &event.Feed{}
+	events := make(chan walletevent.Event, 10)
+	sub := feed.Subscribe(events)
+	defer sub.Unsubscribe()
+
+	resolver := &testENSResolver{addresses: map[string]common.Address{"alice.eth": address}}
+	now := time.Unix(1700000000, 0)
+	r := NewSavedAddressesENSResolver(manager, resolver, feed)
+	r.timeNow = func() time.Time { return now }
+
+	require.NoError(t, r.ResolveStale(context.Background()))
+	require.Equal(t, 1, resolver.calls)
+	require.Len(t, events, 0)
+
+	sa, err := manager.GetSavedAddress(address, false)
+	require.NoError(t, err)
+	require.Equal(t, address, *sa.ENSResolvedAddress)
+	require.Equal(t, now.Unix(), sa.ENSResolvedAt)
+	require.False(t, sa.ENSAddressChanged())
+
+	// Not stale yet
+	require.NoError(t, r.ResolveStale(context.Background()))
+	require.Equal(t, 1, resolver.calls)
+
+	// The name now points to another address
+	now = now.Add(savedAddressesENSResolveInterval + time.Minute)
+	resolver.addresses["alice.eth"] = common.HexToAddress("0x3")
+	require.NoError(t, r.ResolveStale(context.Background()))
+	require.Equal(t, 2, resolver.calls)
+	require.Len(t, events, 1)
+	ev := <-events
+	require.Equal(t, EventSavedAddressENSChanged, ev.Type)
+
+	sa, err = manager.GetSavedAddress(address, false)
+	require.NoError(t, err)
+	require.True(t, sa.ENSAddressChanged())
+
+	// The change is reported once
+	now = now.Add(savedAddressesENSResolveInterval + time.Minute)
+	require.NoError(t, r.ResolveStale(context.Background()))
+	require.Len(t, events, 0)
+
+	// Editing the entry keeps the resolution, changing the ENS name resets it
+	sa.Name = "Alice renamed"
+	require.NoError(t, manager.UpdateMetadataAndUpsertSavedAddress(*sa))
+	sa, err = manager.GetSavedAddress(address, false)
+	require.NoError(t, err)
+	require.True(t, sa.ENSAddressChanged())
+
+	sa.ENSName = "alice2.eth"
+	require.NoError(t, manager.UpdateMetadataAndUpsertSavedAddress(*sa))
+	sa, err = manager.GetSavedAddress(address, false)
+	require.NoError(t, err)
+	require.Nil(t, sa.ENSResolvedAddress)
+	require.False(t, sa.ENSAddressChanged())
+}
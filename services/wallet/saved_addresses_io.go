@@ -0,0 +1,332 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	multiAccCommon "github.com/status-im/status-go/multiaccounts/common"
+)
+
+type SavedAddressesFormat string
+
+const (
+	SavedAddressesFormatCSV  SavedAddressesFormat = "csv"
+	SavedAddressesFormatJSON SavedAddressesFormat = "json"
+)
+
+// SavedAddressConflictResolution tells what to do with imported entries whose address is already saved
+type SavedAddressConflictResolution int
+
+const (
+	// SavedAddressConflictSkip keeps the saved entry and drops the imported one
+	SavedAddressConflictSkip SavedAddressConflictResolution = iota
+	// SavedAddressConflictOverwrite replaces the saved entry with the imported one
+	SavedAddressConflictOverwrite
+)
+
+// savedAddressChainPrefixes are the chain short names accepted as address prefix, e.g. "eth:opt:0x..."
+var savedAddressChainPrefixes = map[string]bool{
+	"eth":  true,
+	"opt":  true,
+	"arb1": true,
+}
+
+var (
+	ErrUnsupportedSavedAddressesFormat = errors.New("unsupported saved addresses format")
+	ErrMissingSavedAddressesCSVColumn  = errors.New("saved addresses csv is missing a required column")
+)
+
+var savedAddressesCSVHeader = []string{"name", "address", "ens", "contactId", "colorId", "isTest"}
+
+// savedAddressRecord is the import/export representation of a saved address.
+// Address carries the chain prefixes of the entry, e.g. "eth:arb1:0x...".
+type savedAddressRecord struct {
+	Name      string `json:"name"`
+	Address   string `json:"address"`
+	ENSName   string `json:"ens,omitempty"`
+	ContactID string `json:"contactId,omitempty"`
+	ColorID   string `json:"colorId,omitempty"`
+	IsTest    bool   `json:"isTest"`
+}
+
+// SavedAddressImportIssue describes an imported entry that was not saved
+type SavedAddressImportIssue struct {
+	Entry   int    `json:"entry,omitempty"` // 1-based position of an invalid entry in the imported data
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Error   string `json:"error"`
+}
+
+type SavedAddressesImportResult struct {
+	Imported []*SavedAddress            `json:"imported"`
+	Skipped  []*SavedAddressImportIssue `json:"skipped"`
+}
+
+// ParseSavedAddressWithPrefix splits an address with optional chain prefixes, e.g. "eth:opt:0x...",
+// into its chain short names, in the ChainShortNames format "eth:opt:", and address
+func ParseSavedAddressWithPrefix(value string) (chainShortNames string, address common.Address, err error) {
+	parts := strings.Split(strings.TrimSpace(value), ":")
+	hexAddress := parts[len(parts)-1]
+	if !common.IsHexAddress(hexAddress) {
+		return "", common.Address{}, fmt.Errorf("invalid address: %s", hexAddress)
+	}
+
+	seen := make(map[string]bool)
+	for _, prefix := range parts[:len(parts)-1] {
+		prefix = strings.ToLower(strings.TrimSpace(prefix))
+		if !savedAddressChainPrefixes[prefix] {
+			return "", common.Address{}, fmt.Errorf("unsupported chain prefix: %s", prefix)
+		}
+		if !seen[prefix] {
+			seen[prefix] = true
+			chainShortNames += prefix + ":"
+		}
+	}
+
+	return chainShortNames, common.HexToAddress(hexAddress), nil
+}
+
+// AddressWithPrefix returns the address prefixed with the chain short names of the entry
+func (s *SavedAddress) AddressWithPrefix() string {
+	return s.ChainShortNames + s.Address.Hex()
+}
+
+func (r *savedAddressRecord) toSavedAddress() (*SavedAddress, error) {
+	name := strings.TrimSpace(r.Name)
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	chainShortNames, address, err := ParseSavedAddressWithPrefix(r.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ensName := strings.ToLower(strings.TrimSpace(r.ENSName))
+	if ensName != "" && (!strings.Contains(ensName, ".") || strings.ContainsAny(ensName, " \t")) {
+		return nil, fmt.Errorf("invalid ens name: %s", r.ENSName)
+	}
+
+	contactID := strings.TrimSpace(r.ContactID)
+	if contactID != "" {
+		pubKey, err := hexutil.Decode(contactID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid contact id: %s", contactID)
+		}
+		if _, err = crypto.UnmarshalPubkey(pubKey); err != nil {
+			return nil, fmt.Errorf("invalid contact id: %s", contactID)
+		}
+	}
+
+	colorID := multiAccCommon.CustomizationColor(strings.TrimSpace(r.ColorID))
+	if colorID == "" {
+		colorID = multiAccCommon.CustomizationColorPrimary
+	}
+
+	return &SavedAddress{
+		Address:         address,
+		Name:            name,
+		ChainShortNames: chainShortNames,
+		ENSName:         ensName,
+		ContactID:       contactID,
+		ColorID:         colorID,
+		IsTest:          r.IsTest,
+	}, nil
+}
+
+func toSavedAddressRecord(sa *SavedAddress) savedAddressRecord {
+	return savedAddressRecord{
+		Name:      sa.Name,
+		Address:   sa.AddressWithPrefix(),
+		ENSName:   sa.ENSName,
+		ContactID: sa.ContactID,
+		ColorID:   string(sa.ColorID),
+		IsTest:    sa.IsTest,
+	}
+}
+
+// EncodeSavedAddresses serializes the saved addresses in the given format
+func EncodeSavedAddresses(format SavedAddressesFormat, savedAddresses []*SavedAddress) ([]byte, error) {
+	records := make([]savedAddressRecord, 0, len(savedAddresses))
+	for _, sa := range savedAddresses {
+		records = append(records, toSavedAddressRecord(sa))
+	}
+
+	switch format {
+	case SavedAddressesFormatJSON:
+		return json.MarshalIndent(records, "", "  ")
+	case SavedAddressesFormatCSV:
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		if err := w.Write(savedAddressesCSVHeader); err != nil {
+			return nil, err
+		}
+		for _, r := range records {
+			err := w.Write([]string{r.Name, r.Address, r.ENSName, r.ContactID, r.ColorID, strconv.FormatBool(r.IsTest)})
+			if err != nil {
+				return nil, err
+			}
+		}
+		w.Flush()
+		return buf.Bytes(), w.Error()
+	}
+	return nil, ErrUnsupportedSavedAddressesFormat
+}
+
+func decodeSavedAddressesCSV(data []byte) ([]savedAddressRecord, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.TrimLeadingSpace = true
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, required := range []string{"name", "address"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMissingSavedAddressesCSVColumn, required)
+		}
+	}
+
+	field := func(row []string, column string) string {
+		i, ok := columns[strings.ToLower(column)]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return row[i]
+	}
+
+	var records []savedAddressRecord
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		isTest, _ := strconv.ParseBool(strings.TrimSpace(field(row, "isTest")))
+		records = append(records, savedAddressRecord{
+			Name:      field(row, "name"),
+			Address:   field(row, "address"),
+			ENSName:   field(row, "ens"),
+			ContactID: field(row, "contactId"),
+			ColorID:   field(row, "colorId"),
+			IsTest:    isTest,
+		})
+	}
+	return records, nil
+}
+
+// DecodeSavedAddresses parses and validates serialized saved addresses.
+// Invalid and duplicated entries are reported as skipped instead of failing the whole import.
+func DecodeSavedAddresses(format SavedAddressesFormat, data []byte) ([]*SavedAddress, []*SavedAddressImportIssue, error) {
+	var records []savedAddressRecord
+	var err error
+	switch format {
+	case SavedAddressesFormatJSON:
+		err = json.Unmarshal(data, &records)
+	case SavedAddressesFormatCSV:
+		records, err = decodeSavedAddressesCSV(data)
+	default:
+		err = ErrUnsupportedSavedAddressesFormat
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var savedAddresses []*SavedAddress
+	var skipped []*SavedAddressImportIssue
+	seen := make(map[string]bool)
+	for i := range records {
+		sa, err := records[i].toSavedAddress()
+		if err == nil && seen[sa.ID()] {
+			err = errors.New("duplicated address")
+		}
+		if err != nil {
+			skipped = append(skipped, &SavedAddressImportIssue{
+				Entry:   i + 1,
+				Name:    records[i].Name,
+				Address: records[i].Address,
+				Error:   err.Error(),
+			})
+			continue
+		}
+		seen[sa.ID()] = true
+		savedAddresses = append(savedAddresses, sa)
+	}
+	return savedAddresses, skipped, nil
+}
+
+func (sam *SavedAddressesManager) ExportSavedAddresses(format SavedAddressesFormat) ([]byte, error) {
+	savedAddresses, err := sam.GetSavedAddresses()
+	if err != nil {
+		return nil, err
+	}
+	return EncodeSavedAddresses(format, savedAddresses)
+}
+
+func uniqueSavedAddressName(name string, taken map[string]bool) string {
+	if !taken[name] {
+		return name
+	}
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s (%d)", name, i)
+		if !taken[candidate] {
+			return candidate
+		}
+	}
+}
+
+// ResolveImportConflicts checks the imported entries against the saved addresses and returns the ones to save.
+// Entries with an already saved address are handled according to resolution, entries whose name is
+// used by another saved address are renamed with a numeric suffix since names must be unique.
+func (sam *SavedAddressesManager) ResolveImportConflicts(savedAddresses []*SavedAddress, resolution SavedAddressConflictResolution) (*SavedAddressesImportResult, error) {
+	existing, err := sam.GetSavedAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	existingByID := make(map[string]*SavedAddress, len(existing))
+	takenNames := map[bool]map[string]bool{false: {}, true: {}}
+	for _, sa := range existing {
+		existingByID[sa.ID()] = sa
+		takenNames[sa.IsTest][sa.Name] = true
+	}
+
+	result := &SavedAddressesImportResult{}
+	for _, sa := range savedAddresses {
+		if saved, ok := existingByID[sa.ID()]; ok {
+			if resolution != SavedAddressConflictOverwrite {
+				result.Skipped = append(result.Skipped, &SavedAddressImportIssue{
+					Name:    sa.Name,
+					Address: sa.AddressWithPrefix(),
+					Error:   "address already saved",
+				})
+				continue
+			}
+			// The overwritten entry frees its name
+			delete(takenNames[sa.IsTest], saved.Name)
+		}
+
+		imported := *sa
+		imported.Name = uniqueSavedAddressName(sa.Name, takenNames[sa.IsTest])
+		takenNames[sa.IsTest][imported.Name] = true
+		result.Imported = append(result.Imported, &imported)
+	}
+	return result, nil
+}
@@ -0,0 +1,130 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	multiAccCommon "github.com/status-im/status-go/multiaccounts/common"
+)
+
+func TestParseSavedAddressWithPrefix(t *testing.T) {
+	chainShortNames, address, err := ParseSavedAddressWithPrefix("eth:ARB1:eth:0x0000000000000000000000000000000000000001")
+	require.NoError(t, err)
+	require.Equal(t, "eth:arb1:", chainShortNames)
+	require.Equal(t, common.HexToAddress("0x1"), address)
+
+	chainShortNames, _, err = ParseSavedAddressWithPrefix("0x0000000000000000000000000000000000000001")
+	require.NoError(t, err)
+	require.Equal(t, "", chainShortNames)
+
+	_, _, err = ParseSavedAddressWithPrefix("bsc:0x0000000000000000000000000000000000000001")
+	require.Error(t, err)
+
+	_, _, err = ParseSavedAddressWithPrefix("eth:0x01")
+	require.Error(t, err)
+}
+
+func TestSavedAddressesEncodeDecode(t *testing.T) {
+	savedAddresses := []*SavedAddress{
+		{
+			Address:         common.HexToAddress("0x1"),
+			Name:            "Alice, main",
+			ChainShortNames: "eth:opt:",
+			ENSName:         "alice.eth",
+			ColorID:         multiAccCommon.CustomizationColorBlue,
+		},
+		{
+			Address: common.HexToAddress("0x2"),
+			Name:    "Bob",
+			ColorID: multiAccCommon.CustomizationColorPrimary,
+			IsTest:  true,
+		},
+	}
+
+	for _, format := range []SavedAddressesFormat{SavedAddressesFormatCSV, SavedAddressesFormatJSON} {
+		data, err := EncodeSavedAddresses(format, savedAddresses)
+		require.NoError(t, err)
+
+		decoded, skipped, err := DecodeSavedAddresses(format, data)
+		require.NoError(t, err)
+		require.Len(t, skipped, 0)
+		require.Equal(t, savedAddresses, decoded)
+	}
+
+	_, err := EncodeSavedAddresses("xml", savedAddresses)
+	require.ErrorIs(t, err, ErrUnsupportedSavedAddressesFormat)
+}
+
+func TestDecodeSavedAddressesValidation(t *testing.T) {
+	csv := `address,name,isTest
+eth:0x0000000000000000000000000000000000000001,First,false
+0x0000000000000000000000000000000000000001,Duplicate,false
+0x0000000000000000000000000000000000000001,Test network,true
+not-an-address,Invalid,false
+0x0000000000000000000000000000000000000003,,false
+`
+	decoded, skipped, err := DecodeSavedAddresses(SavedAddressesFormatCSV, []byte(csv))
+	require.NoError(t, err)
+	require.Len(t, decoded, 2)
+	require.Equal(t, "First", decoded[0].Name)
+	require.Equal(t, "eth:", decoded[0].ChainShortNames)
+	require.Equal(t, multiAccCommon.CustomizationColorPrimary, decoded[0].ColorID)
+	require.True(t, decoded[1].IsTest)
+
+	require.Len(t, skipped, 3)
+	require.Equal(t, 2, skipped[0].Entry)
+	require.Equal(t, 4, skipped[1].Entry)
+	require.Equal(t, 5, skipped[2].Entry)
+
+	_, _, err = DecodeSavedAddresses(SavedAddressesFormatCSV, []byte("name,ens\nAlice,alice.eth\n"))
+	require.ErrorIs(t, err, ErrMissingSavedAddressesCSVColumn)
+
+	_, skipped, err = DecodeSavedAddresses(SavedAddressesFormatJSON, []byte(`[{"name":"Alice","address":"0x0000000000000000000000000000000000000001","contactId":"0x1234"}]`))
+	require.NoError(t, err)
+	require.Len(t, skipped, 1)
+}
+
+func TestResolveImportConflicts(t *testing.T) {
+	manager, stop := setupTestSavedAddressesDB(t)
+	defer stop()
+
+	err := manager.UpdateMetadataAndUpsertSavedAddress(SavedAddress{Address: common.HexToAddress("0x1"), Name: "Alice"})
+	require.NoError(t, err)
+	err = manager.UpdateMetadataAndUpsertSavedAddress(SavedAddress{Address: common.HexToAddress("0x2"), Name: "Bob"})
+	require.NoError(t, err)
+
+	imported := []*SavedAddress{
+		{Address: common.HexToAddress("0x1"), Name: "Alice renamed"},
+		{Address: common.HexToAddress("0x3"), Name: "Bob"},
+		{Address: common.HexToAddress("0x4"), Name: "Bob", IsTest: true},
+	}
+
+	result, err := manager.ResolveImportConflicts(imported, SavedAddressConflictSkip)
+	require.NoError(t, err)
+	require.Len(t, result.Skipped, 1)
+	require.Equal(t, imported[0].Address.Hex(), result.Skipped[0].Address)
+	require.Len(t, result.Imported, 2)
+	require.Equal(t, "Bob (2)", result.Imported[0].Name)
+	require.Equal(t, "Bob", result.Imported[1].Name)
+
+	result, err = manager.ResolveImportConflicts(imported, SavedAddressConflictOverwrite)
+	require.NoError(t, err)
+	require.Len(t, result.Skipped, 0)
+	require.Len(t, result.Imported, 3)
+	require.Equal(t, "Alice renamed", result.Imported[0].Name)
+
+	for _, sa := range result.Imported {
+		require.NoError(t, manager.UpdateMetadataAndUpsertSavedAddress(*sa))
+	}
+	saved, err := manager.GetSavedAddresses()
+	require.NoError(t, err)
+	require.Len(t, saved, 4)
+
+	data, err := manager.ExportSavedAddresses(SavedAddressesFormatJSON)
+	require.NoError(t, err)
+	decoded, _, err := DecodeSavedAddresses(SavedAddressesFormatJSON, data)
+	require.NoError(t, err)
+	require.Len(t, decoded, 4)
+}
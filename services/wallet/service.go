@@ -20,6 +20,7 @@ import (
 	"github.com/status-im/status-go/services/ens"
 	"github.com/status-im/status-go/services/stickers"
 	"github.com/status-im/status-go/services/wallet/activity"
+	"github.com/status-im/status-go/services/wallet/allowance"
 	"github.com/status-im/status-go/services/wallet/balance"
 	"github.com/status-im/status-go/services/wallet/blockchainstate"
 	"github.com/status-im/status-go/services/wallet/collectibles"
@@ -27,6 +28,7 @@ import (
 	"github.com/status-im/status-go/services/wallet/currency"
 	"github.com/status-im/status-go/services/wallet/history"
 	"github.com/status-im/status-go/services/wallet/market"
+	"github.com/status-im/status-go/services/wallet/policy"
 	"github.com/status-im/status-go/services/wallet/thirdparty"
 	"github.com/status-im/status-go/services/wallet/thirdparty/alchemy"
 	"github.com/status-im/status-go/services/wallet/thirdparty/coingecko"
@@ -104,6 +106,10 @@ func NewService(
 	balanceCacher := balance.NewCacherWithTTL(5 * time.Minute)
 	tokenManager := token.NewTokenManager(db, rpcClient, communityManager, rpcClient.NetworkManager, appDB, mediaServer, feed)
 	savedAddressesManager := &SavedAddressesManager{db: db}
+	var savedAddressesENSResolver *SavedAddressesENSResolver
+	if ens != nil {
+		savedAddressesENSResolver = NewSavedAddressesENSResolver(savedAddressesManager, ens.API(), feed)
+	}
 	transactionManager := transfer.NewTransactionManager(db, gethManager, transactor, config, accountsDB, pendingTxManager, feed)
 	blockChainState := blockchainstate.NewBlockChainState()
 	transferController := transfer.NewTransferController(db, accountsDB, rpcClient, accountFeed, feed, transactionManager, pendingTxManager,
@@ -113,7 +119,6 @@ func NewService(
 	coingecko := coingecko.NewClient()
 	marketManager := market.NewManager(cryptoCompare, coingecko, feed)
 	reader := NewReader(rpcClient, tokenManager, marketManager, communityManager, accountsDB, NewPersistence(db), feed)
-	history := history.NewService(db, accountsDB, feed, rpcClient, tokenManager, marketManager, balanceCacher.Cache())
 	currency := currency.NewService(db, feed, tokenManager, marketManager)
 
 	openseaHTTPClient := opensea.NewHTTPClient()
@@ -145,11 +150,21 @@ func NewService(
 		openseaV2Client,
 	}
 
+	floorPriceProviders := []thirdparty.CollectionFloorPriceProvider{
+		openseaV2Client,
+	}
+
+	history := history.NewService(db, accountsDB, feed, rpcClient, tokenManager, marketManager, balanceCacher.Cache(), floorPriceProviders)
+
 	collectiblesManager := collectibles.NewManager(db, rpcClient, communityManager, contractOwnershipProviders, accountOwnershipProviders, collectibleDataProviders, collectionDataProviders, mediaServer, feed)
 	collectibles := collectibles.NewService(db, feed, accountsDB, accountFeed, settingsFeed, communityManager, rpcClient.NetworkManager, collectiblesManager)
 
 	activity := activity.NewService(db, tokenManager, collectiblesManager, feed, pendingTxManager)
 
+	allowanceManager := allowance.NewManager(db, accountsDB, accountFeed, rpcClient, tokenManager, collectiblesManager, marketManager, transactor, feed)
+
+	policyManager := policy.NewManager(db, rpcClient.NetworkManager, tokenManager, marketManager, accountsDB, gethManager, config.KeyStoreDir, feed)
+
 	walletconnect := walletconnect.NewService(db, rpcClient.NetworkManager, accountsDB, transactionManager, gethManager, feed, config)
 
 	return &Service{
@@ -159,6 +174,7 @@ func NewService(
 		tokenManager:          tokenManager,
 		communityManager:      communityManager,
 		savedAddressesManager: savedAddressesManager,
+		savedAddressesENS:     savedAddressesENSResolver,
 		transactionManager:    transactionManager,
 		pendingTxManager:      pendingTxManager,
 		transferController:    transferController,
@@ -181,6 +197,8 @@ func NewService(
 		blockChainState:       blockChainState,
 		keycardPairings:       NewKeycardPairings(),
 		walletConnect:         walletconnect,
+		allowanceManager:      allowanceManager,
+		policyManager:         policyManager,
 	}
 }
 
@@ -190,6 +208,7 @@ type Service struct {
 	accountsDB            *accounts.Database
 	rpcClient             *rpc.Client
 	savedAddressesManager *SavedAddressesManager
+	savedAddressesENS     *SavedAddressesENSResolver
 	tokenManager          *token.Manager
 	communityManager      *community.Manager
 	transactionManager    *transfer.TransactionManager
@@ -215,6 +234,8 @@ type Service struct {
 	blockChainState       *blockchainstate.BlockChainState
 	keycardPairings       *KeycardPairings
 	walletConnect         *walletconnect.Service
+	allowanceManager      *allowance.Manager
+	policyManager         *policy.Manager
 }
 
 // Start signals transmitter.
@@ -224,6 +245,13 @@ func (s *Service) Start() error {
 	err := s.signals.Start()
 	s.history.Start()
 	s.collectibles.Start()
+	s.allowanceManager.Start()
+	if s.transactor != nil {
+		s.transactor.SetTxPolicy(s.policyManager)
+	}
+	if s.savedAddressesENS != nil {
+		s.savedAddressesENS.Start()
+	}
 	s.started = true
 	return err
 }
@@ -243,6 +271,13 @@ func (s *Service) Stop() error {
 	s.history.Stop()
 	s.activity.Stop()
 	s.collectibles.Stop()
+	s.allowanceManager.Stop()
+	if s.transactor != nil {
+		s.transactor.SetTxPolicy(nil)
+	}
+	if s.savedAddressesENS != nil {
+		s.savedAddressesENS.Stop()
+	}
 	s.started = false
 	log.Info("wallet stopped")
 	return nil
@@ -229,3 +229,15 @@ type CollectionDataProvider interface {
 	CollectibleProvider
 	FetchCollectionsDataByContractID(ctx context.Context, ids []ContractID) ([]CollectionData, error)
 }
+
+// CollectionFloorPrice is the lowest listing price of a collection at the time it was fetched
+type CollectionFloorPrice struct {
+	ContractID ContractID `json:"contract_id"`
+	Price      float64    `json:"price"`
+	Symbol     string     `json:"symbol"` // Currency the price is expressed in, e.g. "ETH"
+}
+
+type CollectionFloorPriceProvider interface {
+	CollectibleProvider
+	FetchCollectionFloorPrice(ctx context.Context, id ContractID) (*CollectionFloorPrice, error)
+}
@@ -316,3 +316,49 @@ func (o *ClientV2) FetchCollectionsDataByContractID(ctx context.Context, contrac
 
 	return ret, nil
 }
+
+func (o *ClientV2) fetchCollectionStatsBySlug(ctx context.Context, chainID walletCommon.ChainID, slug string) (*CollectionStats, error) {
+	path := fmt.Sprintf("collections/%s/stats", slug)
+	url, err := o.urlGetter(chainID, path)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := o.client.doGetRequest(ctx, url, o.apiKey)
+	if err != nil {
+		o.connectionStatus.SetIsConnected(false)
+		return nil, err
+	}
+	o.connectionStatus.SetIsConnected(true)
+
+	// if Json is not returned there must be an error
+	if !json.Valid(body) {
+		return nil, fmt.Errorf("invalid json: %s", string(body))
+	}
+
+	stats := CollectionStats{}
+	err = json.Unmarshal(body, &stats)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+func (o *ClientV2) FetchCollectionFloorPrice(ctx context.Context, id thirdparty.ContractID) (*thirdparty.CollectionFloorPrice, error) {
+	contractData, err := o.fetchContractDataByContractID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if contractData == nil || contractData.Collection == "" {
+		return nil, fmt.Errorf("no collection found for contract %s", id.Address.String())
+	}
+
+	stats, err := o.fetchCollectionStatsBySlug(ctx, id.ChainID, contractData.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats.toCommon(id), nil
+}
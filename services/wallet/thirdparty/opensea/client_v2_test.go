@@ -46,3 +46,13 @@ func TestUnmarshallDetailedAsset(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expectedNFT, nftContainer.NFT)
 }
+
+func TestUnmarshallCollectionStats(t *testing.T) {
+	statsJSON := `{"total": {"volume": 1024.5, "sales": 3000, "average_price": 0.34, "num_owners": 1500, "market_cap": 2100.2, "floor_price": 0.42, "floor_price_symbol": "ETH"}, "intervals": []}`
+
+	stats := CollectionStats{}
+	err := json.Unmarshal([]byte(statsJSON), &stats)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.42, stats.Total.FloorPrice)
+	assert.Equal(t, "ETH", stats.Total.FloorPriceSymbol)
+}
@@ -138,6 +138,15 @@ type ContractID struct {
 	Chain   string         `json:"chain"`
 }
 
+type CollectionStats struct {
+	Total CollectionStatsTotal `json:"total"`
+}
+
+type CollectionStatsTotal struct {
+	FloorPrice       float64 `json:"floor_price"`
+	FloorPriceSymbol string  `json:"floor_price_symbol"`
+}
+
 type CollectionData struct {
 	Collection  string         `json:"collection"`
 	Name        string         `json:"name"`
@@ -236,3 +245,11 @@ func (c *CollectionData) toCommon(id thirdparty.ContractID, tokenStandard string
 	}
 	return ret
 }
+
+func (c *CollectionStats) toCommon(id thirdparty.ContractID) *thirdparty.CollectionFloorPrice {
+	return &thirdparty.CollectionFloorPrice{
+		ContractID: id,
+		Price:      c.Total.FloorPrice,
+		Symbol:     c.Total.FloorPriceSymbol,
+	}
+}
@@ -20,6 +20,10 @@ type BlockRangeSequentialDAO struct {
 	db *sql.DB
 }
 
+func NewBlockRangeSequentialDAO(db *sql.DB) *BlockRangeSequentialDAO {
+	return &BlockRangeSequentialDAO{db: db}
+}
+
 type BlockRange struct {
 	Start      *big.Int // Block of first transfer
 	FirstKnown *big.Int // Oldest scanned block
@@ -92,6 +96,23 @@ func (b *BlockRangeSequentialDAO) getBlockRange(chainID uint64, address common.A
 	return blockRange, exists, nil
 }
 
+// GetHistoryStart returns the block of the first ETH transfer of the account, or the last scanned block
+// if the account has no transfer. found is false while the history of the account is still being loaded.
+func (b *BlockRangeSequentialDAO) GetHistoryStart(chainID uint64, address common.Address) (start *big.Int, found bool, err error) {
+	blockRange, _, err := b.getBlockRange(chainID, address)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if blockRange.eth.Start != nil {
+		return blockRange.eth.Start, true, nil
+	}
+	if areAllHistoryBlocksLoaded(blockRange.eth) {
+		return blockRange.eth.LastKnown, true, nil
+	}
+	return nil, false, nil
+}
+
 func (b *BlockRangeSequentialDAO) deleteRange(account common.Address) error {
 	log.Debug("delete blocks range", "account", account)
 	delete, err := b.db.Prepare(`DELETE FROM blocks_ranges_sequential WHERE address = ?`)
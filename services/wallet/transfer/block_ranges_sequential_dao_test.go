@@ -139,3 +139,34 @@ func TestBlockRangeSequentialDAO_updateEthRange(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockRangeSequentialDAO_GetHistoryStart(t *testing.T) {
+	walletDb, stop := setupBlockRangesTestDB(t)
+	defer stop()
+
+	b := NewBlockRangeSequentialDAO(walletDb)
+	account := common.HexToAddress("0x1")
+
+	_, found, err := b.GetHistoryStart(1, account)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// History partially loaded
+	require.NoError(t, b.upsertEthRange(1, account, &BlockRange{FirstKnown: big.NewInt(50), LastKnown: big.NewInt(100)}))
+	_, found, err = b.GetHistoryStart(1, account)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// Loaded up to genesis without any transfer
+	require.NoError(t, b.upsertEthRange(1, account, &BlockRange{FirstKnown: big.NewInt(0), LastKnown: big.NewInt(100)}))
+	start, found, err := b.GetHistoryStart(1, account)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, big.NewInt(100), start)
+
+	require.NoError(t, b.upsertEthRange(1, account, &BlockRange{Start: big.NewInt(20), FirstKnown: big.NewInt(0), LastKnown: big.NewInt(100)}))
+	start, found, err = b.GetHistoryStart(1, account)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, big.NewInt(20), start)
+}
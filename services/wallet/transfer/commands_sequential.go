@@ -196,6 +196,11 @@ func (c *findNewBlocksCommand) Run(parent context.Context) error {
 
 	c.blockChainState.SetLastBlockNumber(c.chainClient.NetworkID(), headNum.Uint64())
 
+	// c.fromBlockNumber is the last block indexed, the lag is refreshed every time a head is seen
+	for _, account := range accountsToCheck {
+		setIndexerLag(c.chainClient.NetworkID(), account, c.fromBlockNumber, headNum)
+	}
+
 	if len(accountsWithDetectedChanges) != 0 {
 		log.Debug("findNewBlocksCommand detected accounts with changes, proceeding", "accounts", accountsWithDetectedChanges, "from", c.fromBlockNumber)
 		err = c.findAndSaveEthBlocks(parent, c.fromBlockNumber, headNum, accountsToCheck)
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"math/big"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/pkg/errors"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/exp/slices" // since 1.21, this is in the standard library
 
@@ -1555,6 +1557,7 @@ func TestFetchNewBlocksCommand(t *testing.T) {
 	err = cmd.Run(ctx)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1), cmd.fromBlockNumber.Uint64())
+	require.Equal(t, float64(0), indexerLag(t, tc.NetworkID(), address1))
 
 	// Verify that cmd.fromBlockNumber is incremented, equal to the head block number
 	tc.currentBlock = 2 // this is the head block number that will be returned by the mock client
@@ -1563,6 +1566,8 @@ func TestFetchNewBlocksCommand(t *testing.T) {
 	err = cmd.Run(ctx)
 	require.NoError(t, err)
 	require.Equal(t, tc.currentBlock, cmd.fromBlockNumber.Uint64())
+	// the head was one block ahead of the last indexed block when it was seen
+	require.Equal(t, float64(1), indexerLag(t, tc.NetworkID(), address1))
 
 	// Verify that blocks are found and cmd.fromBlockNumber is incremented
 	tc.resetCounter()
@@ -1603,6 +1608,13 @@ func TestFetchNewBlocksCommand(t *testing.T) {
 	require.Equal(t, 3, tc.callsCounter["FilterLogs"], "calls to FilterLogs")
 }
 
+func indexerLag(t *testing.T, chainID uint64, account common.Address) float64 {
+	metric := &dto.Metric{}
+	err := indexerLagGauge.WithLabelValues(strconv.FormatUint(chainID, 10), account.Hex()).Write(metric)
+	require.NoError(t, err)
+	return metric.GetGauge().GetValue()
+}
+
 type TestClientWithError struct {
 	*TestClient
 }
@@ -0,0 +1,33 @@
+package transfer
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// By default the /metrics endpoint is not available.
+// It is exposed only if -metrics flag is set.
+
+var (
+	// The wallet only indexes its own accounts, which keeps the account label bounded
+	indexerLagGauge = prom.NewGaugeVec(prom.GaugeOpts{
+		Name: "status_wallet_transfers_indexer_lag_blocks",
+		Help: "Number of blocks between the chain head and the last block indexed for new transfers, by chain and account.",
+	}, []string{"chain_id", "account"})
+)
+
+func init() {
+	prom.MustRegister(indexerLagGauge)
+}
+
+func setIndexerLag(chainID uint64, account common.Address, lastIndexed *big.Int, head *big.Int) {
+	lag := float64(0)
+	if lastIndexed != nil && head != nil && head.Cmp(lastIndexed) > 0 {
+		lag, _ = new(big.Float).SetInt(new(big.Int).Sub(head, lastIndexed)).Float64()
+	}
+	indexerLagGauge.WithLabelValues(strconv.FormatUint(chainID, 10), account.Hex()).Set(lag)
+}
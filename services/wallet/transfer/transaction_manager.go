@@ -86,6 +86,7 @@ const (
 	MultiTransactionSend = iota
 	MultiTransactionSwap
 	MultiTransactionBridge
+	MultiTransactionApprove
 )
 
 type MultiTransaction struct {
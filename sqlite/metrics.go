@@ -0,0 +1,103 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql/driver"
+	"time"
+
+	sqlcipher "github.com/mutecomm/go-sqlcipher/v4"
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// By default the /metrics endpoint is not available.
+// It is exposed only if -metrics flag is set.
+
+const (
+	operationExec  = "exec"
+	operationQuery = "query"
+)
+
+var (
+	queryDuration = prom.NewHistogramVec(prom.HistogramOpts{
+		Name:    "sqlite_query_duration_seconds",
+		Help:    "The time it took to run a statement, for queries until the first row is available.",
+		Buckets: prom.ExponentialBuckets(0.0005, 4, 8),
+	}, []string{"operation"})
+	queryFailuresCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "sqlite_query_failures_total",
+		Help: "Number of statements that failed.",
+	}, []string{"operation"})
+)
+
+func init() {
+	prom.MustRegister(queryDuration)
+	prom.MustRegister(queryFailuresCounter)
+}
+
+func observeQuery(operation string, start time.Time, err error) {
+	queryDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+	if err != nil {
+		queryFailuresCounter.WithLabelValues(operation).Inc()
+	}
+}
+
+// instrumentedDriver times the statements run on the connections of the wrapped driver
+type instrumentedDriver struct {
+	*sqlcipher.SQLiteDriver
+}
+
+func (d *instrumentedDriver) Open(dsn string) (driver.Conn, error) {
+	conn, err := d.SQLiteDriver.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &instrumentedConn{conn.(*sqlcipher.SQLiteConn)}, nil
+}
+
+type instrumentedConn struct {
+	*sqlcipher.SQLiteConn
+}
+
+func (c *instrumentedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	start := time.Now()
+	result, err := c.SQLiteConn.ExecContext(ctx, query, args)
+	observeQuery(operationExec, start, err)
+	return result, err
+}
+
+func (c *instrumentedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	start := time.Now()
+	rows, err := c.SQLiteConn.QueryContext(ctx, query, args)
+	observeQuery(operationQuery, start, err)
+	return rows, err
+}
+
+func (c *instrumentedConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *instrumentedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	stmt, err := c.SQLiteConn.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &instrumentedStmt{stmt.(*sqlcipher.SQLiteStmt)}, nil
+}
+
+type instrumentedStmt struct {
+	*sqlcipher.SQLiteStmt
+}
+
+func (s *instrumentedStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	start := time.Now()
+	result, err := s.SQLiteStmt.ExecContext(ctx, args)
+	observeQuery(operationExec, start, err)
+	return result, err
+}
+
+func (s *instrumentedStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	start := time.Now()
+	rows, err := s.SQLiteStmt.QueryContext(ctx, args)
+	observeQuery(operationQuery, start, err)
+	return rows, err
+}
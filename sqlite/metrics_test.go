@@ -0,0 +1,42 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+func histogramCount(t *testing.T, operation string) uint64 {
+	var metric dto.Metric
+	observer, err := queryDuration.GetMetricWithLabelValues(operation)
+	require.NoError(t, err)
+	require.NoError(t, observer.(interface{ Write(*dto.Metric) error }).Write(&metric))
+	return metric.GetHistogram().GetSampleCount()
+}
+
+func TestQueriesAreTimed(t *testing.T) {
+	db, err := OpenDB(InMemoryPath, "test-key", ReducedKDFIterationsNumber)
+	require.NoError(t, err)
+	defer db.Close()
+
+	execs := histogramCount(t, operationExec)
+	queries := histogramCount(t, operationQuery)
+
+	_, err = db.Exec("CREATE TABLE timed (id INTEGER)")
+	require.NoError(t, err)
+
+	stmt, err := db.Prepare("INSERT INTO timed (id) VALUES (?)")
+	require.NoError(t, err)
+	_, err = stmt.Exec(1)
+	require.NoError(t, err)
+	require.NoError(t, stmt.Close())
+
+	var id int
+	require.NoError(t, db.QueryRow("SELECT id FROM timed WHERE id = ?", 1).Scan(&id))
+	require.Equal(t, 1, id)
+
+	require.Equal(t, execs+2, histogramCount(t, operationExec))
+	require.Equal(t, queries+1, histogramCount(t, operationQuery))
+}
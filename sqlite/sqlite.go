@@ -166,7 +166,7 @@ func buildSqlcipherDSN(path string) (string, error) {
 
 func openDB(path string, key string, kdfIterationsNumber int, cipherPageSize int) (*sql.DB, error) {
 	driverName := fmt.Sprintf("sqlcipher_with_extensions-%d", len(sql.Drivers()))
-	sql.Register(driverName, &sqlcipher.SQLiteDriver{
+	sql.Register(driverName, &instrumentedDriver{&sqlcipher.SQLiteDriver{
 		ConnectHook: func(conn *sqlcipher.SQLiteConn) error {
 			if _, err := conn.Exec("PRAGMA foreign_keys=ON", []driver.Value{}); err != nil {
 				return errors.New("failed to set `foreign_keys` pragma")
@@ -210,7 +210,7 @@ func openDB(path string, key string, kdfIterationsNumber int, cipherPageSize int
 
 			return nil
 		},
-	})
+	}})
 
 	dsn, err := buildSqlcipherDSN(path)
 	if err != nil {
@@ -0,0 +1,59 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+)
+
+const (
+	defaultServiceName = "status-go"
+	tracesPath         = "/v1/traces"
+)
+
+// newOTLPExporter creates an exporter posting the spans to endpoint/v1/traces,
+// using the protobuf encoding of OTLP/HTTP
+func newOTLPExporter(endpoint string) (sdktrace.SpanExporter, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if endpointURL.Scheme != "http" && endpointURL.Scheme != "https" {
+		return nil, fmt.Errorf("invalid OTLP endpoint %q, expected an http or https URL", endpoint)
+	}
+
+	options := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(endpointURL.Host),
+		otlptracehttp.WithURLPath(strings.TrimSuffix(endpointURL.Path, "/") + tracesPath),
+	}
+	if endpointURL.Scheme == "http" {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+	return otlptracehttp.New(context.Background(), options...)
+}
+
+func newResource(serviceName string) *resource.Resource {
+	if serviceName == "" {
+		serviceName = defaultServiceName
+	}
+	return resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))
+}
+
+// The spans are exported in batches by a background goroutine, so that the traced code is never blocked:
+// spans are dropped when the export queue is full
+func batchOptions(config Config) []sdktrace.BatchSpanProcessorOption {
+	var options []sdktrace.BatchSpanProcessorOption
+	if config.BatchSize > 0 {
+		options = append(options, sdktrace.WithMaxExportBatchSize(config.BatchSize))
+	}
+	if config.FlushInterval > 0 {
+		options = append(options, sdktrace.WithBatchTimeout(config.FlushInterval))
+	}
+	return options
+}
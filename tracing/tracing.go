@@ -0,0 +1,243 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/logutils"
+)
+
+const instrumentationScope = "github.com/status-im/status-go/tracing"
+
+// TraceID identifies a trace, it is the W3C trace context trace-id
+type TraceID = trace.TraceID
+
+// SpanKind is the OpenTelemetry kind of a span
+type SpanKind = trace.SpanKind
+
+const (
+	SpanKindInternal = trace.SpanKindInternal
+	SpanKindServer   = trace.SpanKindServer
+	SpanKindClient   = trace.SpanKindClient
+	SpanKindProducer = trace.SpanKindProducer
+	SpanKindConsumer = trace.SpanKindConsumer
+)
+
+// Attribute is a key value pair describing a span
+type Attribute = attribute.KeyValue
+
+func String(key string, value string) Attribute {
+	return attribute.String(key, value)
+}
+
+func Int(key string, value int) Attribute {
+	return attribute.Int(key, value)
+}
+
+func Bool(key string, value bool) Attribute {
+	return attribute.Bool(key, value)
+}
+
+// Span is a unit of work of a trace, it wraps an OpenTelemetry span.
+// A nil span is valid and does nothing, it is returned when tracing is not enabled,
+// so that callers never need to check whether tracing is enabled.
+type Span struct {
+	span trace.Span
+}
+
+// The trace context is propagated across processes with the W3C traceparent and tracestate headers
+var propagator = propagation.TraceContext{}
+
+var (
+	mu       sync.RWMutex
+	provider *sdktrace.TracerProvider
+	tracer   trace.Tracer
+)
+
+// Enabled returns whether spans are recorded
+func Enabled() bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	return tracer != nil
+}
+
+// StartSpan starts a span, as a child of the span of the context if there is one.
+// The returned context carries the new span.
+func StartSpan(ctx context.Context, name string, attributes ...Attribute) (context.Context, *Span) {
+	return StartSpanWithKind(ctx, name, SpanKindInternal, attributes...)
+}
+
+// StartSpanWithKind starts a span of the given kind, see StartSpan
+func StartSpanWithKind(ctx context.Context, name string, kind SpanKind, attributes ...Attribute) (context.Context, *Span) {
+	mu.RLock()
+	t := tracer
+	mu.RUnlock()
+	if t == nil {
+		return ctx, nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ctx, span := t.Start(ctx, name, trace.WithSpanKind(kind), trace.WithAttributes(attributes...))
+	return ctx, &Span{span: span}
+}
+
+// SpanFromContext returns the span carried by the context, if any
+func SpanFromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+	span := trace.SpanFromContext(ctx)
+	if !span.SpanContext().IsValid() {
+		return nil
+	}
+	return &Span{span: span}
+}
+
+// ContextWithSpan returns a context carrying the span, to continue a trace
+// across goroutines or queues
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	if span == nil {
+		return ctx
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return trace.ContextWithSpan(ctx, span.span)
+}
+
+// Inject writes the W3C trace context of the span of the context to the carrier,
+// for instance the headers of an outgoing request
+func Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	propagator.Inject(ctx, carrier)
+}
+
+// Extract returns a context continuing the trace of the W3C trace context read from the carrier
+func Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	return propagator.Extract(ctx, carrier)
+}
+
+// LogFields returns the zap fields identifying the span of the context,
+// so that logs can be correlated with traces
+func LogFields(ctx context.Context) []zap.Field {
+	return SpanFromContext(ctx).LogFields()
+}
+
+// LogFields returns the zap fields identifying the span
+func (s *Span) LogFields() []zap.Field {
+	if s == nil {
+		return nil
+	}
+	spanContext := s.span.SpanContext()
+	return []zap.Field{
+		zap.String("traceID", spanContext.TraceID().String()),
+		zap.String("spanID", spanContext.SpanID().String()),
+	}
+}
+
+// TraceID returns the ID of the trace of the span
+func (s *Span) TraceID() TraceID {
+	if s == nil {
+		return TraceID{}
+	}
+	return s.span.SpanContext().TraceID()
+}
+
+// SetAttributes adds attributes to the span
+func (s *Span) SetAttributes(attributes ...Attribute) {
+	if s == nil {
+		return
+	}
+	s.span.SetAttributes(attributes...)
+}
+
+// RecordError marks the span as failed, a nil error is ignored
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+// End ends the span and queues it for export, calls after the first one are ignored
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.span.End()
+}
+
+// Config configures the export of the spans
+type Config struct {
+	// Endpoint is the base URL of the OTLP/HTTP collector, spans are posted to Endpoint/v1/traces
+	Endpoint string
+	// ServiceName is reported as the service.name resource attribute
+	ServiceName string
+	// BatchSize is the maximum number of spans sent in a single request
+	BatchSize int
+	// FlushInterval is the maximum time a span waits before being sent
+	FlushInterval time.Duration
+	// Exporter replaces the OTLP/HTTP exporter of Endpoint, for instance with an in-memory exporter
+	Exporter sdktrace.SpanExporter
+}
+
+var ErrAlreadyStarted = errors.New("tracing already started")
+
+// Start enables tracing, spans are exported until Stop is called
+func Start(config Config) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if tracer != nil {
+		return ErrAlreadyStarted
+	}
+
+	exporter := config.Exporter
+	if exporter == nil {
+		var err error
+		exporter, err = newOTLPExporter(config.Endpoint)
+		if err != nil {
+			return err
+		}
+	}
+
+	provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter, batchOptions(config)...),
+		sdktrace.WithResource(newResource(config.ServiceName)),
+	)
+	tracer = provider.Tracer(instrumentationScope)
+
+	logger := logutils.ZapLogger().Named("tracing")
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		logger.Warn("failed to export spans", zap.Error(err))
+	}))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+	return nil
+}
+
+// Stop disables tracing and exports the spans that are still queued
+func Stop(ctx context.Context) error {
+	mu.Lock()
+	p := provider
+	provider = nil
+	tracer = nil
+	mu.Unlock()
+
+	if p == nil {
+		return nil
+	}
+	otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	return p.Shutdown(ctx)
+}
@@ -0,0 +1,183 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// memoryExporter keeps the spans when tracing is stopped, so that they can be checked afterwards
+type memoryExporter struct {
+	*tracetest.InMemoryExporter
+}
+
+func (e memoryExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func (e memoryExporter) span(name string) *tracetest.SpanStub {
+	for _, span := range e.GetSpans() {
+		if span.Name == name {
+			return &span
+		}
+	}
+	return nil
+}
+
+// collector decodes the OTLP/HTTP requests of the exporter
+type collector struct {
+	mu    sync.Mutex
+	names []string
+	spans []string
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != tracesPath || r.Header.Get("Content-Type") != "application/x-protobuf" {
+		http.Error(w, "unexpected request", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var request collectortrace.ExportTraceServiceRequest
+	if err := proto.Unmarshal(body, &request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, resourceSpans := range request.ResourceSpans {
+		for _, attribute := range resourceSpans.Resource.Attributes {
+			if attribute.Key == string(semconv.ServiceNameKey) {
+				c.names = append(c.names, attribute.Value.GetStringValue())
+			}
+		}
+		for _, scopeSpans := range resourceSpans.ScopeSpans {
+			for _, span := range scopeSpans.Spans {
+				c.spans = append(c.spans, span.Name)
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	response, _ := proto.Marshal(&collectortrace.ExportTraceServiceResponse{})
+	_, _ = w.Write(response)
+}
+
+func TestDisabledTracing(t *testing.T) {
+	require.False(t, Enabled())
+
+	ctx, span := StartSpan(context.Background(), "disabled")
+	require.Nil(t, span)
+	require.Nil(t, SpanFromContext(ctx))
+	require.Empty(t, LogFields(ctx))
+
+	// a nil span can be used as any other span
+	span.SetAttributes(String("key", "value"))
+	span.RecordError(errors.New("failure"))
+	span.End()
+}
+
+func TestExportSpans(t *testing.T) {
+	exporter := memoryExporter{tracetest.NewInMemoryExporter()}
+
+	require.NoError(t, Start(Config{Exporter: exporter, ServiceName: "test", FlushInterval: time.Hour}))
+	require.ErrorIs(t, Start(Config{Exporter: exporter}), ErrAlreadyStarted)
+
+	ctx, parent := StartSpan(context.Background(), "parent", String("chatID", "chat"))
+	require.Len(t, LogFields(ctx), 2)
+
+	_, child := StartSpanWithKind(ctx, "child", SpanKindProducer, Int("size", 3))
+	child.SetAttributes(Bool("ephemeral", true))
+	child.RecordError(errors.New("failure"))
+	child.End()
+	parent.End()
+	parent.End()
+
+	require.NoError(t, Stop(context.Background()))
+	require.False(t, Enabled())
+	require.Len(t, exporter.GetSpans(), 2)
+
+	exportedParent := exporter.span("parent")
+	require.NotNil(t, exportedParent)
+	require.Equal(t, parent.TraceID(), exportedParent.SpanContext.TraceID())
+	require.False(t, exportedParent.Parent.IsValid())
+	require.Equal(t, codes.Unset, exportedParent.Status.Code)
+	require.Equal(t, []attribute.KeyValue{String("chatID", "chat")}, exportedParent.Attributes)
+	serviceName, ok := exportedParent.Resource.Set().Value(semconv.ServiceNameKey)
+	require.True(t, ok)
+	require.Equal(t, "test", serviceName.AsString())
+
+	exportedChild := exporter.span("child")
+	require.NotNil(t, exportedChild)
+	require.Equal(t, exportedParent.SpanContext.TraceID(), exportedChild.SpanContext.TraceID())
+	require.Equal(t, exportedParent.SpanContext.SpanID(), exportedChild.Parent.SpanID())
+	require.Equal(t, SpanKindProducer, exportedChild.SpanKind)
+	require.Equal(t, codes.Error, exportedChild.Status.Code)
+	require.Equal(t, "failure", exportedChild.Status.Description)
+	require.Equal(t, []attribute.KeyValue{Int("size", 3), Bool("ephemeral", true)}, exportedChild.Attributes)
+}
+
+func TestExportToCollector(t *testing.T) {
+	c := &collector{}
+	server := httptest.NewServer(c)
+	defer server.Close()
+
+	require.NoError(t, Start(Config{Endpoint: server.URL, ServiceName: "test", FlushInterval: time.Hour}))
+
+	ctx, parent := StartSpan(context.Background(), "parent")
+	_, child := StartSpan(ctx, "child")
+	child.End()
+	parent.End()
+
+	require.NoError(t, Stop(context.Background()))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	require.Equal(t, []string{"test"}, c.names)
+	require.ElementsMatch(t, []string{"parent", "child"}, c.spans)
+}
+
+func TestPropagation(t *testing.T) {
+	exporter := memoryExporter{tracetest.NewInMemoryExporter()}
+	require.NoError(t, Start(Config{Exporter: exporter}))
+
+	ctx, parent := StartSpan(context.Background(), "parent")
+	carrier := propagation.MapCarrier{}
+	Inject(ctx, carrier)
+	require.NotEmpty(t, carrier.Get("traceparent"))
+
+	_, remoteChild := StartSpan(Extract(context.Background(), carrier), "remoteChild")
+	remoteChild.End()
+	parent.End()
+
+	require.NoError(t, Stop(context.Background()))
+
+	exportedChild := exporter.span("remoteChild")
+	require.NotNil(t, exportedChild)
+	require.Equal(t, parent.TraceID(), exportedChild.SpanContext.TraceID())
+	require.True(t, exportedChild.Parent.IsRemote())
+}
+
+func TestInvalidEndpoint(t *testing.T) {
+	require.Error(t, Start(Config{Endpoint: "localhost:4318"}))
+	require.False(t, Enabled())
+}
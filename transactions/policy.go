@@ -0,0 +1,78 @@
+package transactions
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// OutgoingTx is the part of an outgoing transaction relevant to spending policies
+type OutgoingTx struct {
+	ChainID uint64
+	From    common.Address
+	To      *common.Address
+	Value   *big.Int
+	Data    []byte
+}
+
+// TxPolicy is checked by the Transactor before an outgoing transaction is built, hashed for
+// signing and again right before it is propagated. CheckTransaction returns an error when the transaction must not be sent.
+type TxPolicy interface {
+	CheckTransaction(tx OutgoingTx) error
+	// TransactionSent is called once the transaction was accepted by the network
+	TransactionSent(tx OutgoingTx, hash common.Hash)
+}
+
+// SetTxPolicy sets the policy checked for every outgoing transaction, nil disables the checks
+func (t *Transactor) SetTxPolicy(policy TxPolicy) {
+	t.txPolicyMu.Lock()
+	defer t.txPolicyMu.Unlock()
+	t.txPolicy = policy
+}
+
+func (t *Transactor) getTxPolicy() TxPolicy {
+	t.txPolicyMu.RLock()
+	defer t.txPolicyMu.RUnlock()
+	return t.txPolicy
+}
+
+// NewOutgoingTx returns the policy relevant part of the transaction args
+func NewOutgoingTx(chainID uint64, args SendTxArgs) OutgoingTx {
+	tx := OutgoingTx{
+		ChainID: chainID,
+		From:    common.Address(args.From),
+		Value:   new(big.Int),
+		Data:    args.GetInput(),
+	}
+	if args.To != nil {
+		to := common.Address(*args.To)
+		tx.To = &to
+	}
+	if args.Value != nil {
+		tx.Value.Set((*big.Int)(args.Value))
+	}
+	return tx
+}
+
+func outgoingTxFromSignedTx(tx *gethtypes.Transaction) (OutgoingTx, error) {
+	from, err := gethtypes.Sender(gethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return OutgoingTx{}, err
+	}
+	return OutgoingTx{
+		ChainID: tx.ChainId().Uint64(),
+		From:    from,
+		To:      tx.To(),
+		Value:   tx.Value(),
+		Data:    tx.Data(),
+	}, nil
+}
+
+func (t *Transactor) checkTxPolicy(tx OutgoingTx) error {
+	policy := t.getTxPolicy()
+	if policy == nil {
+		return nil
+	}
+	return policy.CheckTransaction(tx)
+}
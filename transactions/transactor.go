@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	ethereum "github.com/ethereum/go-ethereum"
@@ -50,6 +51,8 @@ type Transactor struct {
 	rpcCallTimeout time.Duration
 	networkID      uint64
 	log            log.Logger
+	txPolicy       TxPolicy
+	txPolicyMu     sync.RWMutex
 }
 
 // NewTransactor returns a new Manager.
@@ -135,19 +138,22 @@ func (t *Transactor) AddSignatureToTransaction(chainID uint64, tx *gethtypes.Tra
 func (t *Transactor) SendRawTransaction(chainID uint64, rawTx string) error {
 	rpcWrapper := newRPCWrapper(t.rpcWrapper.RPCClient, chainID)
 
-	ctx, cancel := context.WithTimeout(context.Background(), t.rpcCallTimeout)
-	defer cancel()
+	encoded, err := hexutil.Decode(rawTx)
+	if err != nil {
+		return err
+	}
+	tx := new(gethtypes.Transaction)
+	if err = tx.UnmarshalBinary(encoded); err != nil {
+		return err
+	}
 
-	return rpcWrapper.SendRawTransaction(ctx, rawTx)
+	return t.sendTransaction(rpcWrapper, tx)
 }
 
 func (t *Transactor) SendTransactionWithSignature(tx *gethtypes.Transaction) (hash types.Hash, err error) {
 	rpcWrapper := newRPCWrapper(t.rpcWrapper.RPCClient, tx.ChainId().Uint64())
 
-	ctx, cancel := context.WithTimeout(context.Background(), t.rpcCallTimeout)
-	defer cancel()
-
-	if err := rpcWrapper.SendTransaction(ctx, tx); err != nil {
+	if err := t.sendTransaction(rpcWrapper, tx); err != nil {
 		return hash, err
 	}
 	return types.Hash(tx.Hash()), nil
@@ -184,6 +190,10 @@ func (t *Transactor) BuildTransactionWithSignature(chainID uint64, args SendTxAr
 		return nil, ErrInvalidSignatureSize
 	}
 
+	if err := t.checkTxPolicy(NewOutgoingTx(chainID, args)); err != nil {
+		return nil, err
+	}
+
 	tx := t.buildTransaction(args)
 	expectedNonce, err := t.NextNonce(t.rpcWrapper.RPCClient, chainID, args.From)
 	if err != nil {
@@ -209,6 +219,10 @@ func (t *Transactor) HashTransaction(args SendTxArgs) (validatedArgs SendTxArgs,
 
 	validatedArgs = args
 
+	if err = t.checkTxPolicy(NewOutgoingTx(t.rpcWrapper.chainID, args)); err != nil {
+		return validatedArgs, hash, err
+	}
+
 	nonce, err := t.NextNonce(t.rpcWrapper.RPCClient, t.rpcWrapper.chainID, args.From)
 	if err != nil {
 		return validatedArgs, hash, err
@@ -226,7 +240,7 @@ func (t *Transactor) HashTransaction(args SendTxArgs) (validatedArgs SendTxArgs,
 		}
 	}
 
-	chainID := big.NewInt(int64(t.networkID))
+	chainID := big.NewInt(int64(t.rpcWrapper.chainID))
 	value := (*big.Int)(args.Value)
 
 	var gas uint64
@@ -306,6 +320,10 @@ func (t *Transactor) validateAndBuildTransaction(rpcWrapper *rpcWrapper, args Se
 		return tx, ErrInvalidSendTxArgs
 	}
 
+	if err = t.checkTxPolicy(NewOutgoingTx(rpcWrapper.chainID, args)); err != nil {
+		return tx, err
+	}
+
 	var nonce uint64
 	if args.Nonce != nil {
 		nonce = uint64(*args.Nonce)
@@ -378,13 +396,39 @@ func (t *Transactor) validateAndPropagate(rpcWrapper *rpcWrapper, selectedAccoun
 	if err != nil {
 		return hash, err
 	}
+	if err := t.sendTransaction(rpcWrapper, signedTx); err != nil {
+		return hash, err
+	}
+	return types.Hash(signedTx.Hash()), nil
+}
+
+// sendTransaction propagates a signed transaction. Every transaction sent by the Transactor goes through it,
+// the transaction is checked against the spending policy right before it is sent and reported once sent.
+func (t *Transactor) sendTransaction(rpcWrapper *rpcWrapper, tx *gethtypes.Transaction) error {
+	policy := t.getTxPolicy()
+
+	var outgoingTx OutgoingTx
+	if policy != nil {
+		var err error
+		outgoingTx, err = outgoingTxFromSignedTx(tx)
+		if err != nil {
+			return err
+		}
+		if err = policy.CheckTransaction(outgoingTx); err != nil {
+			return err
+		}
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), t.rpcCallTimeout)
 	defer cancel()
 
-	if err := rpcWrapper.SendTransaction(ctx, signedTx); err != nil {
-		return hash, err
+	if err := rpcWrapper.SendTransaction(ctx, tx); err != nil {
+		return err
 	}
-	return types.Hash(signedTx.Hash()), nil
+	if policy != nil {
+		policy.TransactionSent(outgoingTx, tx.Hash())
+	}
+	return nil
 }
 
 func (t *Transactor) buildTransaction(args SendTxArgs) *gethtypes.Transaction {
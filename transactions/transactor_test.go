@@ -1,6 +1,8 @@
 package transactions
 
 import (
+	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -244,6 +246,119 @@ func (s *TransactorSuite) TestAccountMismatch() {
 	s.EqualError(err, ErrInvalidTxSender.Error())
 }
 
+type denyAllTxPolicy struct {
+	checked []OutgoingTx
+}
+
+var errDeniedByPolicy = errors.New("denied by policy")
+
+func (p *denyAllTxPolicy) CheckTransaction(tx OutgoingTx) error {
+	p.checked = append(p.checked, tx)
+	return errDeniedByPolicy
+}
+
+func (p *denyAllTxPolicy) TransactionSent(tx OutgoingTx, hash common.Hash) {}
+
+type recordingTxPolicy struct {
+	sent map[common.Hash]OutgoingTx
+}
+
+func (p *recordingTxPolicy) CheckTransaction(tx OutgoingTx) error {
+	return nil
+}
+
+func (p *recordingTxPolicy) TransactionSent(tx OutgoingTx, hash common.Hash) {
+	p.sent[hash] = tx
+}
+
+func (s *TransactorSuite) signedTestTx(key *ecdsa.PrivateKey, to common.Address) (*gethtypes.Transaction, []byte) {
+	signer := gethtypes.NewLondonSigner(big.NewInt(int64(s.nodeConfig.NetworkID)))
+	tx := gethtypes.NewTransaction(0, to, big.NewInt(10), 21000, big.NewInt(2000000000), nil)
+	hash := signer.Hash(tx)
+	sig, err := gethcrypto.Sign(hash[:], key)
+	s.Require().NoError(err)
+	signedTx, err := tx.WithSignature(signer, sig)
+	s.Require().NoError(err)
+	return signedTx, sig
+}
+
+func (s *TransactorSuite) TestTxPolicy() {
+	key, _ := gethcrypto.GenerateKey()
+	selectedAccount := &account.SelectedExtKey{
+		Address:    account.FromAddress(utils.TestConfig.Account1.WalletAddress),
+		AccountKey: &types.Key{PrivateKey: key},
+	}
+	args := SendTxArgs{
+		From:  account.FromAddress(utils.TestConfig.Account1.WalletAddress),
+		To:    account.ToAddress(utils.TestConfig.Account2.WalletAddress),
+		Value: (*hexutil.Big)(big.NewInt(10)),
+	}
+
+	policy := &denyAllTxPolicy{}
+	s.manager.SetTxPolicy(policy)
+	defer s.manager.SetTxPolicy(nil)
+
+	// Rejected before any RPC call is made
+	_, err := s.manager.SendTransaction(args, selectedAccount)
+	s.ErrorIs(err, errDeniedByPolicy)
+	_, _, err = s.manager.HashTransaction(args)
+	s.ErrorIs(err, errDeniedByPolicy)
+	_, err = s.manager.BuildTransactionWithSignature(s.nodeConfig.NetworkID, args, make([]byte, ValidSignatureSize))
+	s.ErrorIs(err, errDeniedByPolicy)
+
+	s.Require().Len(policy.checked, 3)
+	s.Equal(common.Address(args.From), policy.checked[0].From)
+	s.Equal(common.Address(*args.To), *policy.checked[0].To)
+	s.Equal(int64(10), policy.checked[0].Value.Int64())
+
+	// Transactions signed outside of the transactor are checked before being propagated
+	signedTx, sig := s.signedTestTx(key, common.Address(*args.To))
+	_, err = s.manager.SendTransactionWithSignature(signedTx)
+	s.ErrorIs(err, errDeniedByPolicy)
+	_, err = s.manager.AddSignatureToTransactionAndSend(s.nodeConfig.NetworkID, signedTx, sig)
+	s.ErrorIs(err, errDeniedByPolicy)
+	encoded, err := signedTx.MarshalBinary()
+	s.Require().NoError(err)
+	err = s.manager.SendRawTransaction(s.nodeConfig.NetworkID, hexutil.Encode(encoded))
+	s.ErrorIs(err, errDeniedByPolicy)
+
+	s.Require().Len(policy.checked, 6)
+	for _, checked := range policy.checked[3:] {
+		s.Equal(gethcrypto.PubkeyToAddress(key.PublicKey), checked.From)
+		s.Equal(common.Address(*args.To), *checked.To)
+		s.Equal(s.nodeConfig.NetworkID, checked.ChainID)
+	}
+}
+
+func (s *TransactorSuite) TestTxPolicyTransactionSent() {
+	key, _ := gethcrypto.GenerateKey()
+	to := common.Address(*account.ToAddress(utils.TestConfig.Account2.WalletAddress))
+	policy := &recordingTxPolicy{sent: make(map[common.Hash]OutgoingTx)}
+	s.manager.SetTxPolicy(policy)
+	defer s.manager.SetTxPolicy(nil)
+
+	signedTx, sig := s.signedTestTx(key, to)
+	encoded, err := signedTx.MarshalBinary()
+	s.Require().NoError(err)
+	s.txServiceMock.EXPECT().SendRawTransaction(gomock.Any(), hexutil.Bytes(encoded)).Return(common.Hash{}, nil).Times(3)
+
+	hash, err := s.manager.SendTransactionWithSignature(signedTx)
+	s.Require().NoError(err)
+	s.Equal(signedTx.Hash(), common.Hash(hash))
+	s.Require().Contains(policy.sent, signedTx.Hash())
+	s.Equal(int64(10), policy.sent[signedTx.Hash()].Value.Int64())
+
+	delete(policy.sent, signedTx.Hash())
+	_, err = s.manager.AddSignatureToTransactionAndSend(s.nodeConfig.NetworkID, signedTx, sig)
+	s.Require().NoError(err)
+	s.Contains(policy.sent, signedTx.Hash())
+
+	delete(policy.sent, signedTx.Hash())
+	err = s.manager.SendRawTransaction(s.nodeConfig.NetworkID, hexutil.Encode(encoded))
+	s.Require().NoError(err)
+	s.Contains(policy.sent, signedTx.Hash())
+}
+
 func (s *TransactorSuite) TestSendTransactionWithSignature() {
 	privKey, err := crypto.GenerateKey()
 	s.Require().NoError(err)
@@ -255,7 +255,7 @@ func (api *PublicWakuAPI) Post(ctx context.Context, req NewMessage) (hexutil.Byt
 		Ephemeral:    &req.Ephemeral,
 	}
 
-	hash, err := api.w.Send(req.PubsubTopic, wakuMsg)
+	hash, err := api.w.Send(ctx, req.PubsubTopic, wakuMsg)
 
 	if err != nil {
 		return nil, err
@@ -67,6 +67,7 @@ import (
 	"github.com/status-im/status-go/connection"
 	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/timesource"
+	"github.com/status-im/status-go/tracing"
 	"github.com/status-im/status-go/wakuv2/common"
 	"github.com/status-im/status-go/wakuv2/persistence"
 
@@ -126,7 +127,8 @@ type Waku struct {
 	bandwidthCounter *metrics.BandwidthCounter
 
 	protectedTopicStore *persistence.ProtectedTopicsStore
-	sendQueue           chan *protocol.Envelope
+	dbStore             *persistence.DBStore // set when the node runs a store
+	sendQueue           chan *outgoingEnvelope
 	msgQueue            chan *common.ReceivedMessage // Message queue for waku messages that havent been decoded
 
 	ctx    context.Context
@@ -202,7 +204,7 @@ func New(nodeKey string, fleet string, cfg *Config, logger *zap.Logger, appDB *s
 		envelopes:                       make(map[gethcommon.Hash]*common.ReceivedMessage),
 		expirations:                     make(map[uint32]mapset.Set),
 		msgQueue:                        make(chan *common.ReceivedMessage, messageQueueLimit),
-		sendQueue:                       make(chan *protocol.Envelope, 1000),
+		sendQueue:                       make(chan *outgoingEnvelope, 1000),
 		connStatusChan:                  make(chan node.ConnStatus, 100),
 		connStatusSubscriptions:         make(map[string]*types.ConnStatusSubscription),
 		ctx:                             ctx,
@@ -307,6 +309,7 @@ func New(nodeKey string, fleet string, cfg *Config, logger *zap.Logger, appDB *s
 			return nil, err
 		}
 		opts = append(opts, node.WithMessageProvider(dbStore))
+		waku.dbStore = dbStore
 	}
 
 	if !cfg.LightClient {
@@ -1020,10 +1023,18 @@ func (w *Waku) SkipPublishToTopic(value bool) {
 	w.settings.SkipPublishToTopic = value
 }
 
+// outgoingEnvelope is an envelope waiting in the send queue,
+// along with the span of the Send call that queued it
+type outgoingEnvelope struct {
+	envelope *protocol.Envelope
+	span     *tracing.Span
+}
+
 func (w *Waku) broadcast() {
 	for {
 		select {
-		case envelope := <-w.sendQueue:
+		case outgoing := <-w.sendQueue:
+			envelope := outgoing.envelope
 			logger := w.logger.With(zap.String("envelopeHash", hexutil.Encode(envelope.Hash())), zap.String("pubsubTopic", envelope.PubsubTopic()), zap.String("contentTopic", envelope.Message().ContentTopic), zap.Int64("timestamp", envelope.Message().GetTimestamp()))
 			var fn publishFn
 			if w.settings.SkipPublishToTopic {
@@ -1046,8 +1057,15 @@ func (w *Waku) broadcast() {
 				}
 			}
 
+			ctx, span := tracing.StartSpanWithKind(tracing.ContextWithSpan(w.ctx, outgoing.span), "Waku.broadcast", tracing.SpanKindProducer,
+				tracing.String("envelopeHash", hexutil.Encode(envelope.Hash())),
+				tracing.String("pubsubTopic", envelope.PubsubTopic()),
+				tracing.Bool("lightClient", w.settings.LightClient),
+			)
+			logger = logger.With(tracing.LogFields(ctx)...)
+
 			w.wg.Add(1)
-			go w.publishEnvelope(envelope, fn, logger)
+			go w.publishEnvelope(envelope, fn, logger, span)
 		case <-w.ctx.Done():
 			return
 		}
@@ -1056,11 +1074,13 @@ func (w *Waku) broadcast() {
 
 type publishFn = func(envelope *protocol.Envelope, logger *zap.Logger) error
 
-func (w *Waku) publishEnvelope(envelope *protocol.Envelope, publishFn publishFn, logger *zap.Logger) {
+func (w *Waku) publishEnvelope(envelope *protocol.Envelope, publishFn publishFn, logger *zap.Logger, span *tracing.Span) {
 	defer w.wg.Done()
+	defer span.End()
 
 	var event common.EventType
 	if err := publishFn(envelope, logger); err != nil {
+		span.RecordError(err)
 		logger.Error("could not send message", zap.Error(err))
 		event = common.EventEnvelopeExpired
 	} else {
@@ -1075,8 +1095,18 @@ func (w *Waku) publishEnvelope(envelope *protocol.Envelope, publishFn publishFn,
 
 // Send injects a message into the waku send queue, to be distributed in the
 // network in the coming cycles.
-func (w *Waku) Send(pubsubTopic string, msg *pb.WakuMessage) ([]byte, error) {
+func (w *Waku) Send(ctx context.Context, pubsubTopic string, msg *pb.WakuMessage) (_ []byte, err error) {
 	pubsubTopic = w.getPubsubTopic(pubsubTopic)
+
+	_, span := tracing.StartSpanWithKind(ctx, "Waku.Send", tracing.SpanKindProducer,
+		tracing.String("pubsubTopic", pubsubTopic),
+		tracing.String("contentTopic", msg.ContentTopic),
+	)
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+
 	if w.protectedTopicStore != nil {
 		privKey, err := w.protectedTopicStore.FetchPrivateKey(pubsubTopic)
 		if err != nil {
@@ -1092,8 +1122,9 @@ func (w *Waku) Send(pubsubTopic string, msg *pb.WakuMessage) ([]byte, error) {
 	}
 
 	envelope := protocol.NewEnvelope(msg, msg.GetTimestamp(), pubsubTopic)
+	span.SetAttributes(tracing.String("envelopeHash", hexutil.Encode(envelope.Hash())))
 
-	w.sendQueue <- envelope
+	w.sendQueue <- &outgoingEnvelope{envelope: envelope, span: span}
 
 	w.poolMu.Lock()
 	_, alreadyCached := w.envelopes[gethcommon.BytesToHash(envelope.Hash())]
@@ -1378,12 +1409,20 @@ func (w *Waku) OnNewEnvelopes(envelope *protocol.Envelope, msgType common.Messag
 		w.statusTelemetryClient.PushReceivedEnvelope(envelope)
 	}
 
+	ctx, span := tracing.StartSpanWithKind(w.ctx, "Waku.OnNewEnvelopes", tracing.SpanKindConsumer,
+		tracing.Bool("fromStore", msgType == common.StoreMessageType),
+		tracing.String("envelopeHash", hexutil.Encode(envelope.Hash())),
+		tracing.String("pubsubTopic", envelope.PubsubTopic()),
+		tracing.String("contentTopic", envelope.Message().ContentTopic),
+	)
+	defer span.End()
+
 	logger := w.logger.With(
 		zap.Any("messageType", msgType),
 		zap.String("envelopeHash", hexutil.Encode(envelope.Hash())),
 		zap.String("contentTopic", envelope.Message().ContentTopic),
 		zap.Int64("timestamp", envelope.Message().GetTimestamp()),
-	)
+	).With(tracing.LogFields(ctx)...)
 
 	logger.Debug("received new envelope")
 	trouble := false
@@ -1391,6 +1430,7 @@ func (w *Waku) OnNewEnvelopes(envelope *protocol.Envelope, msgType common.Messag
 	_, err := w.add(recvMessage, processImmediately)
 	if err != nil {
 		logger.Info("invalid envelope received", zap.Error(err))
+		span.RecordError(err)
 		trouble = true
 	}
 
@@ -1823,6 +1863,37 @@ func (w *Waku) Clean() error {
 	return nil
 }
 
+// StoreStats describes the messages kept by the store of the node
+type StoreStats struct {
+	Enabled          bool  `json:"enabled"`
+	Capacity         int   `json:"capacity"`
+	RetentionSeconds int   `json:"retentionSeconds"`
+	Messages         int   `json:"messages"`
+	MostRecent       int64 `json:"mostRecent"`
+}
+
+// StoreStats returns the stats of the store of the node, if the node runs one
+func (w *Waku) StoreStats() (*StoreStats, error) {
+	stats := &StoreStats{Enabled: w.dbStore != nil}
+	if w.dbStore == nil {
+		return stats, nil
+	}
+
+	stats.Capacity = w.cfg.StoreCapacity
+	stats.RetentionSeconds = w.cfg.StoreSeconds
+
+	var err error
+	stats.Messages, err = w.dbStore.Count()
+	if err != nil {
+		return nil, err
+	}
+	stats.MostRecent, err = w.dbStore.MostRecentTimestamp()
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
 func (w *Waku) PeerID() peer.ID {
 	return w.node.Host().ID()
 }
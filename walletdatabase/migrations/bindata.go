@@ -23,6 +23,10 @@
 // 1706531789_remove_gasfee-only-eth-transfers.up.sql (627B)
 // 1707160323_add_contract_type_table.up.sql (282B)
 // 1708089811_add_nullable_fiesl_blocks_ranges.up.sql (450B)
+// 1708600000_add_token_allowances.up.sql (643B)
+// 1708700000_add_contact_and_ens_resolution_to_saved_addresses.up.sql (224B)
+// 1708800000_add_spending_policies.up.sql (1.048kB)
+// 1708900000_add_token_balance_history.up.sql (1.032kB)
 // doc.go (74B)
 
 package migrations
@@ -551,6 +555,86 @@ func _1708089811_add_nullable_fiesl_blocks_rangesUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1708600000_add_token_allowancesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x91\xd1\x6a\x83\x30\x14\x86\xef\x7d\x8a\xff\xb2\x05\xdf\x60\x57\xba\x66\x2e\xcc\xc5\xa1\x91\xb6\x57\x21\x35\xa1\x4a\xd3\x44\x4c\x4a\xbb\xb7\x1f\xb8\x75\x30\x71\x2b\x83\x5e\xe7\x3f\x27\xff\xf7\x9d\xc7\x92\x24\x9c\x80\x27\x69\x4e\x40\x9f\xc0\x0a\x0e\xb2\xa1\x15\xaf\x10\xdc\x41\x5b\x21\x8d\x71\x67\x69\x1b\xed\xb1\x88\x00\xa0\x69\x65\x67\x45\xa7\x50\xb3\x8a\x66\x8c\xac\x90\xd2\x8c\x32\x3e\x8e\xb2\x3a\xcf\xe3\x31\xe6\xce\x56\x0f\x48\xf3\x22\x9d\x3c\x7c\xad\x55\x6a\xd0\xde\xcf\x05\x7c\xaf\xad\x9a\x9f\xfd\x2e\x23\xc2\x7b\xaf\x41\x19\x27\x19\x29\xa7\xa1\xa3\x3b\xd9\x00\x4e\x36\xd3\x4e\x3b\xe3\x9a\x83\xb0\xa7\xe3\x4e\x0f\x37\xea\x87\x8b\x68\xa5\x6f\xe7\x4a\x18\xb7\x17\x9d\x55\xfa\xf2\xcb\xff\x6f\x25\x7d\x4d\xca\x2d\x5e\xc8\x16\x8b\xab\xad\xf8\x53\x48\xfc\x13\x3f\xbe\xc2\xc6\x13\xb4\x65\xb4\xc4\x9a\xf2\xe7\xa2\xe6\x28\x8b\x35\x5d\x3d\x44\xd1\x3f\x4e\x25\x7c\x23\xad\xe8\x07\xb7\x1f\x25\xdf\xe7\x70\x46\xfa\x20\x46\x85\x37\x36\xfc\x25\x60\x06\xec\x23\x00\x00\xff\xff\xff\xe8\xa7\x10\x83\x02\x00\x00")
+
+func _1708600000_add_token_allowancesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1708600000_add_token_allowancesUpSql,
+		"1708600000_add_token_allowances.up.sql",
+	)
+}
+
+func _1708600000_add_token_allowancesUpSql() (*asset, error) {
+	bytes, err := _1708600000_add_token_allowancesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1708600000_add_token_allowances.up.sql", size: 643, mode: os.FileMode(0644), modTime: time.Unix(1792389955, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xed, 0xd2, 0xca, 0xaf, 0x49, 0x59, 0xe, 0xcb, 0xb5, 0x2c, 0x3, 0x2, 0xb, 0x61, 0xaf, 0x2b, 0x8b, 0xb1, 0xe3, 0xc9, 0xe4, 0xc4, 0x36, 0xce, 0x47, 0xdb, 0x49, 0x35, 0xd2, 0x72, 0xdc, 0xf0}}
+	return a, nil
+}
+
+var __1708700000_add_contact_and_ens_resolution_to_saved_addressesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\xce\xb1\x0a\xc2\x30\x10\x87\xf1\xbd\x4f\xf1\xa7\x4f\xe0\xde\xe9\xda\x44\x14\xce\x04\xc2\xc5\x35\x84\xe6\x06\x41\x5a\xe8\x05\x9f\xdf\x55\x70\x11\xf7\x8f\x1f\x1f\xb1\xf8\x04\xa1\x99\x3d\xac\xbe\xb4\x95\xda\xda\xa1\x66\x6a\x20\xe7\xb0\x44\xce\xb7\x80\x75\xdf\x7a\x5d\x7b\x79\x34\xdc\x29\x2d\x17\x4a\x08\x51\x10\x32\x33\x9c\x3f\x53\x66\xc1\x38\x4e\xc3\x8f\x9c\x6e\x56\x0e\xb5\xfd\xf9\x51\x60\xe6\x38\xff\x29\x74\x5c\x83\x7c\x1f\x9d\xa6\xe1\x1d\x00\x00\xff\xff\x51\x01\x55\x2a\xe0\x00\x00\x00")
+
+func _1708700000_add_contact_and_ens_resolution_to_saved_addressesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1708700000_add_contact_and_ens_resolution_to_saved_addressesUpSql,
+		"1708700000_add_contact_and_ens_resolution_to_saved_addresses.up.sql",
+	)
+}
+
+func _1708700000_add_contact_and_ens_resolution_to_saved_addressesUpSql() (*asset, error) {
+	bytes, err := _1708700000_add_contact_and_ens_resolution_to_saved_addressesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1708700000_add_contact_and_ens_resolution_to_saved_addresses.up.sql", size: 224, mode: os.FileMode(0644), modTime: time.Unix(1792390846, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x80, 0x14, 0x38, 0x8c, 0x37, 0x5a, 0x33, 0x44, 0x8, 0xa6, 0x43, 0x46, 0xb9, 0x1a, 0x16, 0xa0, 0xb5, 0xcd, 0x32, 0x56, 0xac, 0x60, 0xd3, 0x47, 0x32, 0x6b, 0xa9, 0x3e, 0x56, 0xad, 0xa1, 0x32}}
+	return a, nil
+}
+
+var __1708800000_add_spending_policiesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x92\xc1\x6e\xa3\x30\x10\x86\xef\x3c\xc5\x1c\x1b\x29\x6f\xd0\x93\x09\x43\xd6\x5a\xaf\xbd\x32\x8e\x9a\x9e\x2c\x04\x6e\x63\x2d\x71\x90\xed\x6e\xc3\xdb\xaf\x60\x1b\x68\x42\x12\x55\xea\x95\xf9\x6d\xbe\xff\xf3\xac\x24\x12\x85\xa0\x48\xca\x10\x68\x0e\x5c\x28\xc0\x2d\x2d\x54\x01\xa1\x35\xae\xb6\xee\x55\xb7\x87\xc6\x56\xd6\x04\x78\x48\x00\xca\xba\xf6\x26\x04\x48\x99\x48\x87\x34\xdf\x30\x06\xbf\x25\xfd\x45\xe4\x33\xfc\xc4\xe7\x65\x02\x50\xbd\x79\x6f\x5c\xd5\x81\xc2\xad\x1a\x53\xfd\xa4\x2e\x6d\xd3\xe9\xc6\xee\x6d\x04\x89\x64\xf8\xd6\x1a\xaf\xe3\xf1\xe2\x63\x75\x70\x2f\xd6\xef\xb5\x33\xef\xda\x9b\xca\xb6\xd6\xb8\x18\x20\x15\x82\x21\xe1\xd3\x9f\x33\xcc\xc9\x86\x29\xc8\x09\x2b\x30\x59\xc0\x13\x55\x3f\xc4\x46\x81\x14\x4f\x34\x7b\x4c\x92\x2f\x17\xec\xa9\x42\xbc\x53\xb2\xa7\xea\x23\x3a\x76\xad\x01\xca\xcf\x8b\x19\x17\x7d\x37\x3f\x90\x0b\x89\x74\xcd\x7b\x31\x0f\x1f\xb7\x2e\x40\x62\x8e\x12\xf9\x0a\xaf\x48\x9e\x52\x82\x43\x86\x0c\x15\xc2\x8a\x14\x2b\x92\x61\x7f\xdf\x27\xd1\x70\x8a\x2e\x27\xac\xe5\x7f\x8e\xc5\xf7\x44\xfc\x71\x87\x77\xf7\xd9\xfa\x5d\x27\x63\x6e\x3e\xba\x4e\x3b\x1e\xf8\x26\x66\x63\xea\x57\xe3\x07\xb8\x6a\x57\x5a\xa7\x6d\x0d\x1b\x5e\xd0\x35\xc7\x0c\x52\xba\xbe\x7c\xa2\x78\xd4\xbb\x32\xec\xe6\x98\x37\xab\xbd\xd8\x32\xea\xbf\x65\xf3\x66\x86\xbd\x3c\x9b\xdd\xde\xf1\x68\xf7\x26\xc4\x72\xdf\xce\x96\xe4\xcc\xc7\x89\x79\x79\x02\xbb\xa3\x83\xf2\x0c\xb7\x17\x3a\x6c\x7d\xd4\xd7\x95\xe8\x8f\x42\x7a\x22\x11\xfc\xa6\xbe\xf1\x5d\xc6\xf4\xe2\x31\xf9\x17\x00\x00\xff\xff\xa2\x72\x24\x93\x18\x04\x00\x00")
+
+func _1708800000_add_spending_policiesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1708800000_add_spending_policiesUpSql,
+		"1708800000_add_spending_policies.up.sql",
+	)
+}
+
+func _1708800000_add_spending_policiesUpSql() (*asset, error) {
+	bytes, err := _1708800000_add_spending_policiesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1708800000_add_spending_policies.up.sql", size: 1048, mode: os.FileMode(0644), modTime: time.Unix(1792391235, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xaf, 0x43, 0x8e, 0xc5, 0x37, 0x20, 0x6f, 0x91, 0x76, 0xc2, 0xfe, 0x36, 0x0, 0xcc, 0xb5, 0x31, 0xdf, 0xb1, 0xd9, 0x7a, 0xa3, 0x9f, 0x5b, 0x9c, 0x18, 0xc, 0x4c, 0xf0, 0x58, 0xc8, 0xf8, 0x4d}}
+	return a, nil
+}
+
+var __1708900000_add_token_balance_historyUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x93\xd1\x6e\xa3\x3c\x10\x85\xef\x79\x8a\xf3\x5f\xf1\xaf\x94\xf4\x01\x16\xed\x05\x2d\x8e\x12\x89\xc2\x2e\x25\xdd\xdc\x45\xc6\x1e\x14\x2b\xc6\x8e\x6c\x93\x15\x6f\xbf\x22\x4d\x50\xda\x54\x55\xf6\x16\xe6\x9c\xf9\x3c\x33\x67\x3e\x47\x6d\xf7\x64\xc0\x8d\x84\xb0\x5a\x93\x08\xaa\xd1\x84\x86\x6b\x6e\x04\x79\x70\x47\x70\xd4\xf4\x4a\x07\xb4\xce\x76\x08\x3b\x82\x0f\xd6\x91\x44\x70\xdc\xf8\x96\x9c\x3f\xc9\x95\x24\x13\x54\xab\x48\xa2\x19\x10\x46\xdb\x6d\x18\x0e\x34\xfe\x8c\xe6\xf3\xf3\x17\x2e\xa5\x23\xef\x67\x30\x3c\xa8\xe3\x55\xa3\x3d\xd1\xe1\x64\x2e\xa9\xe5\xbd\x0e\xfe\x01\x0b\xeb\xae\xa1\xfc\xa5\x18\xca\x9f\x2a\x4d\xdf\x35\xe4\x60\x5b\xd8\x3f\x86\x24\x54\xa0\xce\x3f\x44\x69\x5e\xb3\x0a\x75\xfa\x98\xb3\x8b\x62\xbb\x53\x23\xf3\x80\x34\xcb\xf0\x54\xe6\xeb\xe7\xe2\x9a\xf0\x35\xad\x9e\x96\x69\x85\xa2\xac\x51\xac\xf3\x1c\x19\x5b\xa4\xeb\xbc\x46\x4c\x61\x17\x27\xff\xe6\x78\x7e\x21\x1e\xf3\xf2\xf1\xd6\x71\x13\xc7\xc9\x38\x8d\x57\xae\x7b\x1a\xd1\x25\x39\x75\x24\x09\xc9\x95\x1e\xe0\xc9\x29\xf2\xdf\x71\xb0\x2e\xb4\x56\x2b\x8b\xe3\xa5\x90\x1b\x70\x21\x6c\x6f\xc2\xbb\x6d\x59\x83\x56\x5b\xeb\x70\x70\x4a\xd0\xbd\xa8\x6f\xae\x15\x4b\xf3\x24\x8a\xb2\xaa\xfc\x89\x55\x91\xb1\x0d\x56\x0b\xb0\xcd\xea\xa5\x7e\xf9\x28\xde\x9e\xd7\x3b\x6c\xc9\x04\x37\x24\xf7\x89\x5a\xa5\x03\xb9\x93\x44\x91\x4f\xa2\xa7\x8a\xa5\x35\xc3\xba\x58\xfd\x5a\xb3\xb3\xfa\xeb\x46\x28\x8b\x9b\x77\xfc\x2f\x76\x5c\x99\xad\x92\x33\x4c\xe7\x24\x7a\xe7\xc8\x88\x61\x76\xb5\xd7\xd9\xc7\x9b\x6b\xb4\x15\xfb\x19\x82\xea\xc8\x07\xde\x1d\xbe\x4d\x44\x9f\xa3\xbc\xc7\xbf\x17\xe5\x0b\x80\xeb\xce\xd1\x14\xbe\x29\x03\xbd\x1f\x53\x65\xd1\x10\x5a\x0a\x62\x47\x12\x3c\x4c\x31\x7b\xc3\xff\x2c\x6c\x7e\xe8\x1a\xab\x61\x8d\x1e\xa2\x8c\xe5\xac\x66\x58\x54\xe5\xf3\x0d\xed\xef\x25\xab\xd8\x34\x2a\xfc\xf7\x03\x31\xab\x97\x71\x12\xfd\x0d\x00\x00\xff\xff\xac\x2a\x67\xc7\x08\x04\x00\x00")
+
+func _1708900000_add_token_balance_historyUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1708900000_add_token_balance_historyUpSql,
+		"1708900000_add_token_balance_history.up.sql",
+	)
+}
+
+func _1708900000_add_token_balance_historyUpSql() (*asset, error) {
+	bytes, err := _1708900000_add_token_balance_historyUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1708900000_add_token_balance_history.up.sql", size: 1032, mode: os.FileMode(0644), modTime: time.Unix(1792391955, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc, 0xbc, 0x1b, 0x12, 0x7f, 0xeb, 0x9, 0x55, 0x61, 0xe3, 0xe, 0xb5, 0x74, 0x31, 0xdd, 0xa8, 0xcb, 0x2, 0xd8, 0xb9, 0x0, 0x36, 0xfa, 0x6a, 0xfc, 0x53, 0xea, 0xb9, 0x80, 0x91, 0x14, 0xe0}}
+	return a, nil
+}
+
 var _docGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xc9\xb1\x0d\xc4\x20\x0c\x05\xd0\x9e\x29\xfe\x02\xd8\xfd\x6d\xe3\x4b\xac\x2f\x44\x82\x09\x78\x7f\xa5\x49\xfd\xa6\x1d\xdd\xe8\xd8\xcf\x55\x8a\x2a\xe3\x47\x1f\xbe\x2c\x1d\x8c\xfa\x6f\xe3\xb4\x34\xd4\xd9\x89\xbb\x71\x59\xb6\x18\x1b\x35\x20\xa2\x9f\x0a\x03\xa2\xe5\x0d\x00\x00\xff\xff\x60\xcd\x06\xbe\x4a\x00\x00\x00")
 
 func docGoBytes() ([]byte, error) {
@@ -685,6 +769,10 @@ var _bindata = map[string]func() (*asset, error){
 	"1706531789_remove_gasfee-only-eth-transfers.up.sql":                            _1706531789_remove_gasfeeOnlyEthTransfersUpSql,
 	"1707160323_add_contract_type_table.up.sql":                                     _1707160323_add_contract_type_tableUpSql,
 	"1708089811_add_nullable_fiesl_blocks_ranges.up.sql":                            _1708089811_add_nullable_fiesl_blocks_rangesUpSql,
+	"1708600000_add_token_allowances.up.sql":                                        _1708600000_add_token_allowancesUpSql,
+	"1708700000_add_contact_and_ens_resolution_to_saved_addresses.up.sql":           _1708700000_add_contact_and_ens_resolution_to_saved_addressesUpSql,
+	"1708800000_add_spending_policies.up.sql":                                       _1708800000_add_spending_policiesUpSql,
+	"1708900000_add_token_balance_history.up.sql":                                   _1708900000_add_token_balance_historyUpSql,
 	"doc.go": docGo,
 }
 
@@ -757,6 +845,10 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1706531789_remove_gasfee-only-eth-transfers.up.sql":                            {_1706531789_remove_gasfeeOnlyEthTransfersUpSql, map[string]*bintree{}},
 	"1707160323_add_contract_type_table.up.sql":                                     {_1707160323_add_contract_type_tableUpSql, map[string]*bintree{}},
 	"1708089811_add_nullable_fiesl_blocks_ranges.up.sql":                            {_1708089811_add_nullable_fiesl_blocks_rangesUpSql, map[string]*bintree{}},
+	"1708600000_add_token_allowances.up.sql":                                        {_1708600000_add_token_allowancesUpSql, map[string]*bintree{}},
+	"1708700000_add_contact_and_ens_resolution_to_saved_addresses.up.sql":           {_1708700000_add_contact_and_ens_resolution_to_saved_addressesUpSql, map[string]*bintree{}},
+	"1708800000_add_spending_policies.up.sql":                                       {_1708800000_add_spending_policiesUpSql, map[string]*bintree{}},
+	"1708900000_add_token_balance_history.up.sql":                                   {_1708900000_add_token_balance_historyUpSql, map[string]*bintree{}},
 	"doc.go": {docGo, map[string]*bintree{}},
 }}
 
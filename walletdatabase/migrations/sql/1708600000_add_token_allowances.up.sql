@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS token_allowances (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    owner BLOB NOT NULL,
+    token_address BLOB NOT NULL,
+    spender BLOB NOT NULL,
+    allowance_type INTEGER NOT NULL,
+    amount TEXT NOT NULL,
+    block_number UNSIGNED BIGINT NOT NULL,
+    tx_hash BLOB NOT NULL,
+    log_index INTEGER NOT NULL,
+    PRIMARY KEY (chain_id, owner, token_address, spender, allowance_type)
+) WITHOUT ROWID;
+
+CREATE TABLE IF NOT EXISTS token_allowances_scan_progress (
+    chain_id UNSIGNED BIGINT NOT NULL,
+    owner BLOB NOT NULL,
+    last_block UNSIGNED BIGINT NOT NULL,
+    PRIMARY KEY (chain_id, owner)
+) WITHOUT ROWID;
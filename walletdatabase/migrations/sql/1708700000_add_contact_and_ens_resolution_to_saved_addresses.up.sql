@@ -0,0 +1,3 @@
+ALTER TABLE saved_addresses ADD COLUMN contact_id VARCHAR NOT NULL DEFAULT "";
+ALTER TABLE saved_addresses ADD COLUMN ens_resolved_address BLOB;
+ALTER TABLE saved_addresses ADD COLUMN ens_resolved_at INT NOT NULL DEFAULT 0;
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS spending_policies (
+  address BLOB NOT NULL PRIMARY KEY,
+  currency TEXT NOT NULL,
+  daily_limit REAL,
+  per_tx_limit REAL,
+  confirm_new_recipients BOOLEAN NOT NULL DEFAULT FALSE
+) WITHOUT ROWID;
+
+CREATE TABLE IF NOT EXISTS spending_policy_lists (
+  address BLOB NOT NULL,
+  list_type INT NOT NULL,
+  entry BLOB NOT NULL,
+  FOREIGN KEY(address) REFERENCES spending_policies(address) ON DELETE CASCADE,
+  PRIMARY KEY (address, list_type, entry)
+) WITHOUT ROWID;
+
+CREATE TABLE IF NOT EXISTS spending_policy_known_recipients (
+  address BLOB NOT NULL,
+  recipient BLOB NOT NULL,
+  PRIMARY KEY (address, recipient)
+) WITHOUT ROWID;
+
+CREATE TABLE IF NOT EXISTS spending_policy_ledger (
+  chain_id UNSIGNED BIGINT NOT NULL,
+  tx_hash BLOB NOT NULL,
+  address BLOB NOT NULL,
+  fiat_value REAL NOT NULL,
+  currency TEXT NOT NULL,
+  timestamp INT NOT NULL,
+  PRIMARY KEY (chain_id, tx_hash)
+) WITHOUT ROWID;
+
+CREATE INDEX IF NOT EXISTS idx_spending_policy_ledger_address_timestamp ON spending_policy_ledger (address, timestamp);
@@ -0,0 +1,14 @@
+-- Token and collectible balances are rebuilt from the stored transfers and identified by token_type and
+-- token_address, native balances keep the defaults. For collectibles balance is the number of owned items.
+ALTER TABLE balance_history ADD COLUMN token_type VARCHAR NOT NULL DEFAULT 'eth';
+ALTER TABLE balance_history ADD COLUMN token_address BLOB NOT NULL DEFAULT X'';
+-- Value of derived daily series: portfolio value of an account and collection floor price
+ALTER TABLE balance_history ADD COLUMN value REAL;
+
+DROP INDEX IF EXISTS balance_history_identify_entry;
+DROP INDEX IF EXISTS balance_history_filter_entries;
+CREATE UNIQUE INDEX balance_history_identify_entry ON balance_history (chain_id, address, currency, token_type, token_address, block, timestamp);
+CREATE INDEX balance_history_filter_entries ON balance_history (chain_id, address, token_type, token_address, timestamp);
+
+-- Token balances used to be fetched at transfer blocks and identified by symbol only
+DELETE FROM balance_history WHERE currency != 'ETH';